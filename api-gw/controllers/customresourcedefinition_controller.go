@@ -59,15 +59,16 @@ func (r *CustomResourceDefinitionReconciler) Reconcile(ctx context.Context, req
 	}
 
 	logger.Infof("Received CRD notification for Name %s Type %s\n", crd.Name, eventType)
-	if err := r.ProcessAnnotation(req.NamespacedName.Name, crd.Annotations, eventType); err != nil {
-		logger.Errorf("Error Processing CRD Annotation %v\n", err)
-	}
 
-	// Get correct version
+	// Served versions of the spec are needed to construct URIs of the versions
 	if err := r.ProcessCrdSpec(req.NamespacedName.Name, crd.Spec, eventType); err != nil {
 		logger.Errorf("Error Processing CRD spec %v\n", err)
 	}
 
+	if err := r.ProcessAnnotation(req.NamespacedName.Name, crd.Annotations, eventType); err != nil {
+		logger.Errorf("Error Processing CRD Annotation %v\n", err)
+	}
+
 	// Recreate openapi specification
 	api.Recreate()
 
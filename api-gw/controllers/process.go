@@ -50,6 +50,12 @@ func (r *CustomResourceDefinitionReconciler) ProcessAnnotation(crdType string,
 
 	n.NexusRestAPIGen.Uris = append(n.NexusRestAPIGen.Uris, newUris...)
 
+	// add URIs of each served version if the CRD serves more than one
+	if versions := model.GetServedVersions(crdType); len(versions) > 1 {
+		n.NexusRestAPIGen.Uris = append(n.NexusRestAPIGen.Uris,
+			ConstructVersionedURIs(versions, n.NexusRestAPIGen.Uris, urisMap)...)
+	}
+
 	// It has stored the URI with the CRD type and CRD type with the Node Info.
 	model.ConstructMapUriToUriInfo(eventType, urisMap)
 	model.ConstructMapURIToCRDType(eventType, crdType, n.NexusRestAPIGen.Uris)
@@ -93,6 +99,24 @@ func ConstructNewURIs(n model.NexusAnnotation, urisMap map[string]model.RestUriI
 	}
 }
 
+// ConstructVersionedURIs constructs URIs of the versions prefixed with the version, e.g. /v2/root/{orgchart.Root}, and
+// stores them in cache. URIs without version prefix serve the default version of the CRD.
+func ConstructVersionedURIs(versions []string, uris []nexus.RestURIs, urisMap map[string]model.RestUriInfo) []nexus.RestURIs {
+	var versionedUris []nexus.RestURIs
+	for _, version := range versions {
+		for _, uri := range uris {
+			info := urisMap[uri.Uri]
+			info.Version = version
+
+			versionedUri := uri
+			versionedUri.Uri = "/" + version + uri.Uri
+			urisMap[versionedUri.Uri] = info
+			versionedUris = append(versionedUris, versionedUri)
+		}
+	}
+	return versionedUris
+}
+
 func processChildOrLink(nodes map[string]model.NodeHelperChild, uri nexus.RestURIs, urisMap map[string]model.RestUriInfo, newUris *[]nexus.RestURIs) {
 	for _, n := range nodes {
 		uriPath := uri.Uri + "/" + n.FieldName
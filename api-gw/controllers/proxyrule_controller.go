@@ -19,6 +19,7 @@ package controllers
 import (
 	"api-gw/pkg/envoy"
 	"api-gw/pkg/model"
+	"api-gw/pkg/utils"
 	"context"
 	"fmt"
 
@@ -59,42 +60,19 @@ func (r *ProxyRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	switch eventType {
 	case model.Delete:
-		// calling delete on both since we don't know the match type of the deleted object
-		// is there a way to get the spec of the deleted object?
 		err := envoy.DeleteUpstream(req.NamespacedName.Name)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("error deleting envoy upstream: %s", err)
 		}
-		err = envoy.DeleteHeaderUpstream(req.NamespacedName.Name)
-		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("error deleting envoy header upstream: %s", err)
-		}
 		log.Debugf("deleted proxy rule %s", req.NamespacedName.Name)
 	case model.Upsert:
-		if proxyRule.Spec.MatchCondition.Type == "jwt" {
-			err := envoy.AddUpstream(req.NamespacedName.Name, &envoy.UpstreamConfig{
-				Name:          proxyRule.Name,
-				Host:          proxyRule.Spec.Upstream.Host,
-				Port:          proxyRule.Spec.Upstream.Port,
-				JwtClaimKey:   proxyRule.Spec.MatchCondition.Key,
-				JwtClaimValue: proxyRule.Spec.MatchCondition.Value,
-			})
-			if err != nil {
-				return ctrl.Result{}, fmt.Errorf("error adding envoy upstream: %s", err)
-			}
-		} else if proxyRule.Spec.MatchCondition.Type == "header" {
-			err := envoy.AddHeaderUpstream(req.NamespacedName.Name, &envoy.HeaderMatchedUpstream{
-				Name:        proxyRule.Name,
-				Host:        proxyRule.Spec.Upstream.Host,
-				Port:        proxyRule.Spec.Upstream.Port,
-				HeaderName:  proxyRule.Spec.MatchCondition.Key,
-				HeaderValue: proxyRule.Spec.MatchCondition.Value,
-			})
-			if err != nil {
-				return ctrl.Result{}, fmt.Errorf("error adding envoy header upstream: %s", err)
-			}
-		} else {
-			return ctrl.Result{}, fmt.Errorf("match type %s not supported", proxyRule.Spec.MatchCondition.Type)
+		upstream, err := utils.GetUpstreamConfig(proxyRule.Name, proxyRule.Spec)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("invalid proxy rule %s: %s", proxyRule.Name, err)
+		}
+		err = envoy.AddUpstream(req.NamespacedName.Name, upstream)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error adding envoy upstream: %s", err)
 		}
 		log.Debugf("updated proxy rule %s", proxyRule.Name)
 	}
@@ -70,6 +70,8 @@ import (
 	"api-gw/pkg/server/echo_server"
 )
 
+const apiGwConfigFile = "/config/api-gw-config"
+
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
@@ -142,11 +144,23 @@ func main() {
 	customFormatter.FullTimestamp = true
 	log.SetFormatter(customFormatter)
 
-	conf, err := config.LoadConfig("/config/api-gw-config")
+	conf, err := config.LoadConfig(apiGwConfigFile)
 	if err != nil {
 		log.Warnf("Error loading config: %v\n", err)
 	}
 	config.Cfg = conf
+	if conf != nil {
+		envoy.SetServiceRoutes(conf.ServiceRoutes)
+	}
+	// reload the routing table of the tenant services when the config changes
+	if err = config.WatchConfig(apiGwConfigFile, func(conf *config.Config) {
+		log.Infoln("api-gw config changed, refreshing the service routes")
+		if err := envoy.SetServiceRoutes(conf.ServiceRoutes); err != nil {
+			log.Errorf("failed to refresh the service routes: %s", err)
+		}
+	}); err != nil {
+		log.Warnf("Error watching config: %v\n", err)
+	}
 
 	if common.IsModeAdmin() {
 		skuConfig, err := config.LoadSKUConfig("/config/skuconfigmap")
@@ -309,14 +323,14 @@ func InitManager(metricsAddr string, probeAddr string, enableLeaderElection bool
 	log.Infof("SSL CertsEnabled: %s", common.SSLEnabled)
 
 	log.Infoln("Init xDS server")
-	if jwt, upstreams, headerUpstreams, err := utils.GetEnvoyInitParams(); err != nil {
+	if jwt, upstreams, err := utils.GetEnvoyInitParams(); err != nil {
 		log.Errorf("error getting envoy init params: %s\n", err)
 		// start with a blank envoy config and let the controllers reconcile the envoy state
-		if err = envoy.Init(nil, nil, nil, lvl); err != nil {
+		if err = envoy.Init(nil, nil, lvl); err != nil {
 			log.Fatalf("error initializing envoy in main(): %s", err)
 		}
 	} else {
-		if err = envoy.Init(jwt, upstreams, headerUpstreams, lvl); err != nil {
+		if err = envoy.Init(jwt, upstreams, lvl); err != nil {
 			panic(err)
 		}
 	}
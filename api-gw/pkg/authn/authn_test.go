@@ -103,8 +103,8 @@ var _ = Describe("Authn tests", func() {
 			Prefix: []string{"/home", "/allspark-static"},
 		}
 
-		envoy.Init(nil, nil, nil, log.DebugLevel)
-		snap, err := envoy.GenerateNewSnapshot(nil, nil, nil, nil)
+		envoy.Init(nil, nil, log.DebugLevel)
+		snap, err := envoy.GenerateNewSnapshot(nil, nil, nil)
 		Expect(snap).NotTo(BeNil())
 		Expect(err).To(BeNil())
 
@@ -395,12 +395,12 @@ var _ = Describe("Authn tests", func() {
 				})
 				Expect(err).NotTo(HaveOccurred())
 
-				jwtConfig, upstreamConfigs, headerConfigs, err := utils.GetEnvoyInitParams()
+				jwtConfig, upstreamConfigs, err := utils.GetEnvoyInitParams()
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(jwtConfig).ToNot(BeNil())
-				Expect(upstreamConfigs).ToNot(BeNil())
-				Expect(headerConfigs).ToNot(BeEmpty())
+				Expect(upstreamConfigs).To(HaveKey("proxy-rule-1"))
+				Expect(upstreamConfigs).To(HaveKey("proxy-rule-2"))
 
 			})
 
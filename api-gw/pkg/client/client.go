@@ -9,6 +9,7 @@ import (
 
 	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
 	nexus_client "golang-appnet.eng.vmware.com/nexus-sdk/api/build/nexus-client"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -46,7 +47,8 @@ func NewNexusClient(config *rest.Config) error {
 	return nil
 }
 
-func CreateObject(gvr schema.GroupVersionResource, kind, hashedName string, labels map[string]string, body map[string]interface{}) error {
+func CreateObject(gvr schema.GroupVersionResource, kind, hashedName string, labels map[string]string, body map[string]interface{},
+	ownerReferences []metav1.OwnerReference) error {
 	labelsUnstructured := map[string]interface{}{}
 	for k, v := range labels {
 		labelsUnstructured[k] = v
@@ -63,6 +65,7 @@ func CreateObject(gvr schema.GroupVersionResource, kind, hashedName string, labe
 			"spec": body,
 		},
 	}
+	obj.SetOwnerReferences(ownerReferences)
 
 	// Create resource
 	_, err := Client.Resource(gvr).Create(context.TODO(), obj, metav1.CreateOptions{})
@@ -77,6 +80,35 @@ func GetObject(gvr schema.GroupVersionResource, hashedName string, opts metav1.G
 	return obj, nil
 }
 
+// GetOwnerReference returns reference to the parent of a new object. Objects owned by their parents are deleted by
+// Kubernetes garbage collector together with the parent.
+func GetOwnerReference(parentCrdType string, parentCrdInfo model.NodeInfo, labels map[string]string) (metav1.OwnerReference, error) {
+	parentParts := strings.Split(parentCrdType, ".")
+	gvr := schema.GroupVersionResource{
+		Group:    strings.Join(parentParts[1:], "."),
+		Version:  model.GetStorageCrdVersion(parentCrdType),
+		Resource: parentParts[0],
+	}
+
+	parentName := labels[parentCrdType]
+	hashedParentName := nexus.GetHashedName(parentCrdType, parentCrdInfo.ParentHierarchy, labels, parentName)
+	parent, err := Client.Resource(gvr).Get(context.TODO(), hashedParentName, metav1.GetOptions{})
+	if err != nil {
+		return metav1.OwnerReference{}, err
+	}
+
+	parentNameParts := strings.Split(parentCrdInfo.Name, ".")
+	return metav1.OwnerReference{
+		APIVersion: gvr.GroupVersion().String(),
+		Kind:       parentNameParts[len(parentNameParts)-1],
+		Name:       parent.GetName(),
+		UID:        parent.GetUID(),
+	}, nil
+}
+
+// DeleteObject deletes the object and removes it from its parent. Children of the object are deleted by Kubernetes
+// garbage collector, so the subtree is deleted even if the gateway stops in the middle. Children created before
+// they were owned by their parents are deleted by the gateway.
 func DeleteObject(gvr schema.GroupVersionResource, crdType string, crdInfo model.NodeInfo, hashedName string) error {
 	// Get object
 	obj, err := Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
@@ -86,9 +118,9 @@ func DeleteObject(gvr schema.GroupVersionResource, crdType string, crdInfo model
 
 	labels := obj.GetLabels()
 
-	// Delete all children
+	// Delete children without owner
 	listOpts := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", crdType, labels["nexus/display_name"])}
-	for k, _ := range crdInfo.Children {
+	for k := range crdInfo.Children {
 		err = DeleteChildren(k, listOpts)
 		if err != nil {
 			return err
@@ -105,7 +137,8 @@ func DeleteObject(gvr schema.GroupVersionResource, crdType string, crdInfo model
 	}
 
 	// Delete object
-	err = Client.Resource(gvr).Delete(context.TODO(), hashedName, metav1.DeleteOptions{})
+	propagationPolicy := metav1.DeletePropagationBackground
+	err = Client.Resource(gvr).Delete(context.TODO(), hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 	if err != nil {
 		return err
 	}
@@ -113,9 +146,11 @@ func DeleteObject(gvr schema.GroupVersionResource, crdType string, crdInfo model
 	return nil
 }
 
+// DeleteChildren deletes objects of the crdType and of its descendants which match listOpts and have no owner
+// references. Owned objects are deleted by Kubernetes garbage collector.
 func DeleteChildren(crdType string, listOpts metav1.ListOptions) error {
 	crdInfo := model.CrdTypeToNodeInfo[crdType]
-	for k, _ := range crdInfo.Children {
+	for k := range crdInfo.Children {
 		err := DeleteChildren(k, listOpts)
 		if err != nil {
 			return err
@@ -125,14 +160,22 @@ func DeleteChildren(crdType string, listOpts metav1.ListOptions) error {
 	parts := strings.Split(crdType, ".")
 	gvr := schema.GroupVersionResource{
 		Group:    strings.Join(parts[1:], "."),
-		Version:  "v1",
+		Version:  model.GetDefaultCrdVersion(crdType),
 		Resource: parts[0],
 	}
-	err := Client.Resource(gvr).DeleteCollection(context.TODO(), metav1.DeleteOptions{}, listOpts)
+	objs, err := Client.Resource(gvr).List(context.TODO(), listOpts)
 	if err != nil {
 		return err
 	}
-
+	for _, obj := range objs.Items {
+		if len(obj.GetOwnerReferences()) > 0 {
+			continue
+		}
+		err = Client.Resource(gvr).Delete(context.TODO(), obj.GetName(), metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -158,7 +201,7 @@ func UpdateParentWithAddedChild(parentCrdType string, parentCrdInfo model.NodeIn
 	parentParts := strings.Split(parentCrdType, ".")
 	gvr := schema.GroupVersionResource{
 		Group:    strings.Join(parentParts[1:], "."),
-		Version:  "v1",
+		Version:  model.GetDefaultCrdVersion(parentCrdType),
 		Resource: parentParts[0],
 	}
 
@@ -207,7 +250,7 @@ func UpdateParentWithRemovedChild(parentCrdType string, parentCrdInfo model.Node
 	parentParts := strings.Split(parentCrdType, ".")
 	gvr := schema.GroupVersionResource{
 		Group:    strings.Join(parentParts[1:], "."),
-		Version:  "v1",
+		Version:  model.GetDefaultCrdVersion(parentCrdType),
 		Resource: parentParts[0],
 	}
 
@@ -365,8 +365,8 @@ var _ = Describe("Common Function tests", func() {
 			Prefix: []string{"/home", "/allspark-static"},
 		}
 
-		envoy.Init(nil, nil, nil, logrus.Level(log.Level()))
-		snap, err := envoy.GenerateNewSnapshot(nil, nil, nil, nil)
+		envoy.Init(nil, nil, logrus.Level(log.Level()))
+		snap, err := envoy.GenerateNewSnapshot(nil, nil, nil)
 		Expect(snap).NotTo(BeNil())
 		Expect(err).To(BeNil())
 
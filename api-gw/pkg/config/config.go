@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
+	"github.com/fsnotify/fsnotify"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
@@ -15,6 +17,8 @@ type Config struct {
 	BackendService     string       `json:"backend_service" yaml:"backend_service,omitempty"`
 	TenantApiGwDomain  string       `json:"tenant_api_gw_domain" yaml:"tenant_api_gw_domain,omitempty"`
 	CustomNotFoundPage string       `json:"custom_not_found_page" yaml:"custom_not_found_page,omitempty"`
+	// ServiceRoutes replace the default routing table of the tenant services if set
+	ServiceRoutes []ServiceRoute `json:"service_routes" yaml:"service_routes,omitempty"`
 }
 
 type ServerConfig struct {
@@ -24,6 +28,29 @@ type ServerConfig struct {
 	KeyPath  string `json:"keyPath" yaml:"keyPath"`
 }
 
+// ServiceRoute routes the requests matching one of Prefixes or ExactPath, or carrying all HeaderMatches whatever
+// their path, to the service Name:Port, which is deployed per tenant unless it's Global. AdditionalMatch lists query
+// parameters all the requests routed to the service must have. Routes with higher Priority are matched first, e.g. a
+// "/" catch-all route should have the lowest priority so that it doesn't shadow the others.
+type ServiceRoute struct {
+	Name            string               `json:"name" yaml:"name"`
+	Port            uint32               `json:"port" yaml:"port"`
+	Global          bool                 `json:"global" yaml:"global,omitempty"`
+	Priority        int                  `json:"priority" yaml:"priority,omitempty"`
+	Prefixes        []ServiceRoutePrefix `json:"prefixes" yaml:"prefixes,omitempty"`
+	ExactPath       []ServiceRoutePrefix `json:"exact_path" yaml:"exact_path,omitempty"`
+	HeaderMatches   map[string]string    `json:"header_matches" yaml:"header_matches,omitempty"`
+	AdditionalMatch map[string]string    `json:"additional_match" yaml:"additional_match,omitempty"`
+}
+
+// ServiceRoutePrefix matches the path prefix, or the whole path in ExactPath, and the header "Header: yes" if Header
+// is set. The matched prefix or path is replaced with PrefixRewrite if it's set.
+type ServiceRoutePrefix struct {
+	Prefix        string `json:"prefix" yaml:"prefix"`
+	PrefixRewrite string `json:"prefix_rewrite" yaml:"prefix_rewrite,omitempty"`
+	Header        string `json:"header" yaml:"header,omitempty"`
+}
+
 var Cfg *Config
 
 type GlobalStaticRoutes struct {
@@ -62,9 +89,60 @@ func LoadConfig(configFile string) (*Config, error) {
 		return nil, fmt.Errorf("config doesn't contain Server.KeyPath")
 	}
 
+	for _, route := range config.ServiceRoutes {
+		if route.Name == "" || route.Port == 0 {
+			return nil, fmt.Errorf("config contains service route without name or port")
+		}
+		if len(route.Prefixes) == 0 && len(route.ExactPath) == 0 && len(route.HeaderMatches) == 0 {
+			return nil, fmt.Errorf("config contains service route %s without prefixes, exact paths or header matches", route.Name)
+		}
+	}
+
 	return config, nil
 }
 
+// WatchConfig calls onChange with the reloaded config whenever configFile changes. The
+// directory of the file is watched, as a mounted ConfigMap is updated by swapping the symlinks of its directory.
+// A config that fails to load is logged and skipped, so that the previous one stays in use.
+func WatchConfig(configFile string, onChange func(*Config)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create config watcher: %s", err)
+	}
+	if err = watcher.Add(filepath.Dir(configFile)); err != nil {
+		watcher.Close()
+		return fmt.Errorf("failed to watch config dir: %s", err)
+	}
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Rename|fsnotify.Remove) == 0 {
+					continue
+				}
+				log.Debugf("received event %s on config dir, reloading %s", event, configFile)
+				conf, err := LoadConfig(configFile)
+				if err != nil {
+					log.Warnf("Error reloading config, keeping the previous one: %v", err)
+					continue
+				}
+				onChange(conf)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Errorf("config watcher error: %s", err)
+			}
+		}
+	}()
+	return nil
+}
+
 type SKUMap struct {
 	SKU map[string][]string `json:"sku"`
 }
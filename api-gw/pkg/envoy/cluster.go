@@ -22,7 +22,7 @@ func makeTSMTenantClusters(tenantconfigs []*TenantConfig) (clusters []types.Reso
 		return nil, nil
 	}
 	for _, tenant := range tenantconfigs {
-		for _, svc := range cosmosServices {
+		for _, svc := range getCosmosServices() {
 			if !svc.Global {
 				clusterRoute, err := makeCluster(fmt.Sprintf("%s-%s", svc.Name, tenant.Name), fmt.Sprintf("%s.%s", svc.Name, tenant.Name), svc.Port, nil)
 				if err != nil {
@@ -35,7 +35,7 @@ func makeTSMTenantClusters(tenantconfigs []*TenantConfig) (clusters []types.Reso
 	return clusters, nil
 }
 
-func makeClusters(tenantConfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, upstreams map[string]*UpstreamConfig, headerUpstreams map[string]*HeaderMatchedUpstream) ([]types.Resource, error) {
+func makeClusters(tenantConfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, upstreams map[string]*UpstreamConfig) ([]types.Resource, error) {
 	var clusters []types.Resource
 
 	if common.IsModeAdmin() {
@@ -79,15 +79,6 @@ func makeClusters(tenantConfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig,
 	if len(upstreamClusters) > 0 {
 		clusters = append(clusters, upstreamClusters...)
 	}
-
-	var headerUpstreamClusters []types.Resource
-	headerUpstreamClusters, err = makeHeaderUpstreamClusters(headerUpstreams)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create header upstream clusters: %s", err)
-	}
-	if len(headerUpstreamClusters) > 0 {
-		clusters = append(clusters, headerUpstreamClusters...)
-	}
 	return clusters, nil
 }
 
@@ -103,26 +94,29 @@ func makeUpstreamClusters(upstreams map[string]*UpstreamConfig) ([]types.Resourc
 	var upstreamClusters []types.Resource
 
 	for _, upstream := range upstreams {
-		cluster, err := makeCluster(upstream.Name, upstream.Host, upstream.Port, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create cluster for upstream %s", upstream.Name)
+		if len(upstream.WeightedUpstreams) == 0 {
+			cluster, err := makeCluster(upstream.Name, upstream.Host, upstream.Port, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create cluster for upstream %s", upstream.Name)
+			}
+			upstreamClusters = append(upstreamClusters, cluster)
+			continue
+		}
+		for i, weightedUpstream := range upstream.WeightedUpstreams {
+			name := weightedUpstreamClusterName(upstream.Name, i)
+			cluster, err := makeCluster(name, weightedUpstream.Host, weightedUpstream.Port, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create cluster for upstream %s", name)
+			}
+			upstreamClusters = append(upstreamClusters, cluster)
 		}
-		upstreamClusters = append(upstreamClusters, cluster)
 	}
 	return upstreamClusters, nil
 }
 
-func makeHeaderUpstreamClusters(headerUpstreams map[string]*HeaderMatchedUpstream) ([]types.Resource, error) {
-	var headerUpstreamClusters []types.Resource
-
-	for _, headerUpstream := range headerUpstreams {
-		cluster, err := makeCluster(headerUpstream.Name, headerUpstream.Host, headerUpstream.Port, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create cluster for header upstream %s", headerUpstream.Name)
-		}
-		headerUpstreamClusters = append(headerUpstreamClusters, cluster)
-	}
-	return headerUpstreamClusters, nil
+// weightedUpstreamClusterName returns name of the cluster of i-th weighted upstream of the upstream config
+func weightedUpstreamClusterName(name string, i int) string {
+	return fmt.Sprintf("%s-%d", name, i)
 }
 
 func makeCluster(name, host string, port uint32, tlsTransport *core.TransportSocket) (*cluster.Cluster, error) {
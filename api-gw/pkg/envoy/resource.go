@@ -2,6 +2,7 @@ package envoy
 
 import (
 	"api-gw/pkg/common"
+	"sort"
 	"time"
 
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
@@ -46,17 +47,20 @@ type CosmosService struct {
 	PrefixSettings  []PrefixSettings
 	ExactPath       []PrefixSettings
 	Global          bool
+	Priority        int
 	AdditionalMatch map[string]string
 	HeaderMatches   map[string]string
 }
 
-// Routes should be added in this order to avoid route ambuiguity issues
-// Example the / default route is added at bottom to avoid confusion between /tsm to go to tenant api-gateway instead of api-gateway
-var cosmosServices []CosmosService = []CosmosService{
+// defaultCosmosServices are routed if no service routes are set. Routes of the services with higher
+// priority are matched first, e.g. the / default route has the lowest priority to avoid confusion between /tsm to go
+// to tenant api-gateway instead of api-gateway
+var defaultCosmosServices = []CosmosService{
 	{
-		Name: "nexus-api-gw",
-		Port: 80,
-		Svc:  "nexus-api-gw",
+		Name:     "nexus-api-gw",
+		Port:     80,
+		Priority: 40,
+		Svc:      "nexus-api-gw",
 		PrefixSettings: []PrefixSettings{
 			{
 				Prefix: "/declarative/",
@@ -71,10 +75,11 @@ var cosmosServices []CosmosService = []CosmosService{
 		},
 	},
 	{
-		Name:   "allspark-ui",
-		Port:   80,
-		Svc:    "allspark-ui",
-		Global: true,
+		Name:     "allspark-ui",
+		Port:     80,
+		Priority: 30,
+		Svc:      "allspark-ui",
+		Global:   true,
 		PrefixSettings: []PrefixSettings{
 			{
 				Prefix: "/home",
@@ -83,14 +88,12 @@ var cosmosServices []CosmosService = []CosmosService{
 				Prefix: "/login",
 			},
 		},
-		HeaderMatches: map[string]string{
-			"static": "yes",
-		},
 	},
 	{
-		Name: "tenant-api-gw",
-		Port: 3000,
-		Svc:  "tenant-api-gw",
+		Name:     "tenant-api-gw",
+		Port:     3000,
+		Priority: 20,
+		Svc:      "tenant-api-gw",
 		PrefixSettings: []PrefixSettings{
 			{
 				Prefix:        "/tsm/",
@@ -99,9 +102,10 @@ var cosmosServices []CosmosService = []CosmosService{
 		},
 	},
 	{
-		Name: "local-api-gateway",
-		Port: 3000,
-		Svc:  "local-api-gateway",
+		Name:     "local-api-gateway",
+		Port:     3000,
+		Priority: 10,
+		Svc:      "local-api-gateway",
 		PrefixSettings: []PrefixSettings{
 			{
 				Prefix:        "/local/",
@@ -121,24 +125,95 @@ var cosmosServices []CosmosService = []CosmosService{
 	},
 }
 
-// UpstreamConfig defines a condition (jwt_payload[JwtClaimKey] == JwtClaimValue), if matched,
-// causes the request to be proxied to the upstream Host:Port
+// getCosmosServices returns the service routes set by SetServiceRoutes, or the default ones if there are none,
+// ordered by priority
+func getCosmosServices() []CosmosService {
+	services := defaultCosmosServices
+	if routes := getServiceRoutes(); len(routes) > 0 {
+		services = nil
+		for _, route := range routes {
+			service := CosmosService{
+				Name:            route.Name,
+				Port:            route.Port,
+				Svc:             route.Name,
+				Global:          route.Global,
+				Priority:        route.Priority,
+				AdditionalMatch: route.AdditionalMatch,
+				HeaderMatches:   route.HeaderMatches,
+			}
+			for _, prefix := range route.Prefixes {
+				service.PrefixSettings = append(service.PrefixSettings, PrefixSettings{
+					Prefix:        prefix.Prefix,
+					PrefixRewrite: prefix.PrefixRewrite,
+					Header:        prefix.Header,
+				})
+			}
+			for _, path := range route.ExactPath {
+				service.ExactPath = append(service.ExactPath, PrefixSettings{
+					Prefix:        path.Prefix,
+					PrefixRewrite: path.PrefixRewrite,
+					Header:        path.Header,
+				})
+			}
+			services = append(services, service)
+		}
+	}
+
+	sorted := make([]CosmosService, len(services))
+	copy(sorted, services)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority > sorted[j].Priority
+	})
+	return sorted
+}
+
+// UpstreamConfig defines conditions, if all of them are matched, cause the request to be proxied to the upstream
+// Host:Port, or split between WeightedUpstreams by their weights if they're set. The conditions are
+// jwt_payload[JwtClaimKey] == JwtClaimValue, headers[name] == value for each of Headers, path prefix or regex,
+// one of Methods and query[name] == value for each of QueryParams; at least one of them must be set.
 type UpstreamConfig struct {
-	Name          string
-	JwtClaimKey   string
-	JwtClaimValue string
-	Host          string
-	Port          uint32
+	Name              string
+	JwtClaimKey       string
+	JwtClaimValue     string
+	Headers           map[string]string
+	PathPrefix        string
+	PathRegex         string
+	Methods           []string
+	QueryParams       map[string]string
+	Host              string
+	Port              uint32
+	WeightedUpstreams []WeightedUpstream
+	Retry             *RetryPolicy
+	// Timeout of the request including retries, envoy default is used if it's zero
+	Timeout time.Duration
+}
+
+func (u *UpstreamConfig) conditionsCount() int {
+	count := len(u.Headers) + len(u.QueryParams)
+	if u.JwtClaimKey != "" {
+		count++
+	}
+	if u.PathPrefix != "" || u.PathRegex != "" {
+		count++
+	}
+	if len(u.Methods) > 0 {
+		count++
+	}
+	return count
+}
+
+// WeightedUpstream receives share of requests matched by UpstreamConfig proportional to Weight
+type WeightedUpstream struct {
+	Host   string
+	Port   uint32
+	Weight uint32
 }
 
-// HeaderMatchedUpstream defines a condition (headers[HeaderName] == HeaderValue), if matched,
-// causes the request to be proxied to the upstream Host:Port
-type HeaderMatchedUpstream struct {
-	Name        string
-	HeaderName  string
-	HeaderValue string
-	Host        string
-	Port        uint32
+// RetryPolicy retries requests failed on RetryOn conditions of envoy, e.g. "5xx,reset", at most NumRetries times
+type RetryPolicy struct {
+	RetryOn       string
+	NumRetries    uint32
+	PerTryTimeout time.Duration
 }
 
 const (
@@ -154,7 +229,7 @@ const (
 	globalUIsvcname    = "allspark-ui"
 )
 
-func GenerateNewSnapshot(tenantConfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, upstreams map[string]*UpstreamConfig, headerUpstreams map[string]*HeaderMatchedUpstream) (*cachev3.Snapshot, error) {
+func GenerateNewSnapshot(tenantConfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, upstreams map[string]*UpstreamConfig) (*cachev3.Snapshot, error) {
 	routeListener, err := makeRouteListener(jwtAuthnConfig)
 	if err != nil {
 		log.Errorf("failed to create route listener: %s", err)
@@ -172,14 +247,14 @@ func GenerateNewSnapshot(tenantConfigs []*TenantConfig, jwtAuthnConfig *JwtAuthn
 	}
 
 	var routes *route.RouteConfiguration
-	routes, err = makeRoutes(tenantConfigs, jwtAuthnConfig, upstreams, headerUpstreams)
+	routes, err = makeRoutes(tenantConfigs, jwtAuthnConfig, upstreams)
 	if err != nil {
 		log.Errorf("failed to create routes: %s", err)
 		return nil, err
 	}
 
 	var clusters []types.Resource
-	clusters, err = makeClusters(tenantConfigs, jwtAuthnConfig, upstreams, headerUpstreams)
+	clusters, err = makeClusters(tenantConfigs, jwtAuthnConfig, upstreams)
 	if err != nil {
 		log.Errorf("failed to create clusters: %s", err)
 		return nil, err
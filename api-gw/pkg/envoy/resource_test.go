@@ -5,6 +5,7 @@ import (
 	"api-gw/pkg/config"
 	"api-gw/pkg/envoy"
 	"strings"
+	"time"
 
 	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
@@ -235,8 +236,8 @@ var _ = Describe("nexus proxy tests", func() {
 			Prefix: []string{"/home", "/allspark-static"},
 		}
 		logLevel, _ := log.ParseLevel("debug")
-		envoy.Init(nil, nil, nil, logLevel)
-		snap, err := envoy.GenerateNewSnapshot(nil, nil, nil, nil)
+		envoy.Init(nil, nil, logLevel)
+		snap, err := envoy.GenerateNewSnapshot(nil, nil, nil)
 		Expect(snap).NotTo(BeNil())
 		Expect(err).To(BeNil())
 
@@ -253,7 +254,7 @@ var _ = Describe("nexus proxy tests", func() {
 			},
 		}
 		common.Mode = "admin"
-		snap, err := envoy.GenerateNewSnapshot(tenantConfigs, nil, nil, nil)
+		snap, err := envoy.GenerateNewSnapshot(tenantConfigs, nil, nil)
 		Expect(err).To(BeNil())
 		Expect(snap).NotTo(BeNil())
 		l := snap.GetResources(resource.ListenerType)
@@ -270,7 +271,7 @@ var _ = Describe("nexus proxy tests", func() {
 			},
 		}
 		common.Mode = "admin"
-		snap, err := envoy.GenerateNewSnapshot(tenantConfigs, nil, nil, nil)
+		snap, err := envoy.GenerateNewSnapshot(tenantConfigs, nil, nil)
 		Expect(err).To(BeNil())
 		Expect(snap).ToNot(BeNil())
 		l := snap.GetResources(resource.ListenerType)
@@ -292,15 +293,157 @@ var _ = Describe("nexus proxy tests", func() {
 		Expect(len(c)).To(Equal(5))
 	})
 
+	It("initialize nexus-proxy state with service routes of the config ordered by priority", func() {
+		Expect(envoy.SetServiceRoutes([]config.ServiceRoute{
+			{
+				Name:     "catch-all",
+				Port:     80,
+				Prefixes: []config.ServiceRoutePrefix{{Prefix: "/"}},
+			},
+			{
+				Name:     "billing",
+				Port:     3000,
+				Priority: 10,
+				Prefixes: []config.ServiceRoutePrefix{{Prefix: "/billing/", PrefixRewrite: "/"}},
+			},
+		})).To(Succeed())
+		tenantConfigs := []*envoy.TenantConfig{
+			{
+				Name:   "test",
+				Status: false,
+			},
+		}
+		envoy.TenantConfigs = tenantConfigs
+		defer func() {
+			Expect(envoy.SetServiceRoutes(nil)).To(Succeed())
+			envoy.TenantConfigs = nil
+		}()
+		common.Mode = "admin"
+		snap, err := envoy.GenerateNewSnapshot(tenantConfigs, nil, nil)
+		Expect(err).To(BeNil())
+		Expect(snap).ToNot(BeNil())
+		r := snap.GetResources(resource.RouteType)
+		c := snap.GetResources(resource.ClusterType)
+		Expect(c).To(HaveKey("billing-test"))
+		Expect(c).To(HaveKey("catch-all-test"))
+
+		routes, ok := r["default"].(*routev3.RouteConfiguration)
+		Expect(ok).To(Equal(true))
+		var clusters []string
+		for _, route := range routes.GetVirtualHosts()[0].GetRoutes() {
+			if cluster := route.GetRoute().GetCluster(); strings.HasSuffix(cluster, "-test") {
+				clusters = append(clusters, cluster)
+			}
+		}
+		Expect(clusters).To(Equal([]string{"billing-test", "catch-all-test"}))
+	})
+
+	It("initialize nexus-proxy state with the default service routes in their order", func() {
+		tenantConfigs := []*envoy.TenantConfig{
+			{
+				Name:   "test",
+				Status: false,
+			},
+		}
+		envoy.TenantConfigs = tenantConfigs
+		defer func() { envoy.TenantConfigs = nil }()
+		common.Mode = "admin"
+		snap, err := envoy.GenerateNewSnapshot(tenantConfigs, nil, nil)
+		Expect(err).To(BeNil())
+		routes, ok := snap.GetResources(resource.RouteType)["default"].(*routev3.RouteConfiguration)
+		Expect(ok).To(Equal(true))
+
+		var tsmRoutes []string
+		for _, route := range routes.GetVirtualHosts()[0].GetRoutes() {
+			cluster := route.GetRoute().GetCluster()
+			if cluster == "nexus-api-gw-test" && route.GetMatch().GetPrefix() == "/declarative/" {
+				tsmRoutes = []string{}
+			}
+			if tsmRoutes != nil {
+				var headers []string
+				for _, header := range route.GetMatch().GetHeaders() {
+					headers = append(headers, header.GetName())
+				}
+				tsmRoutes = append(tsmRoutes, strings.TrimSpace(cluster+" "+route.GetMatch().GetPrefix()+" "+strings.Join(headers, ",")))
+			}
+		}
+		// the global allspark-ui service doesn't match the org-id header of the tenant
+		Expect(tsmRoutes).To(Equal([]string{
+			"nexus-api-gw-test /declarative/ org-id",
+			"nexus-api-gw-test /apis org-id",
+			"nexus-api-gw-test /tsm/explorer/ org-id",
+			"allspark-ui /home",
+			"allspark-ui /login",
+			"tenant-api-gw-test /tsm/ org-id",
+			"local-api-gateway-test /local/ org-id",
+			"nexus-api-gw-test / org-id",
+		}))
+	})
+
+	It("route exact paths, header matches and additional matches of the service routes", func() {
+		Expect(envoy.SetServiceRoutes([]config.ServiceRoute{
+			{
+				Name:            "billing",
+				Port:            3000,
+				ExactPath:       []config.ServiceRoutePrefix{{Prefix: "/billing", PrefixRewrite: "/"}},
+				AdditionalMatch: map[string]string{"version": "v2"},
+			},
+			{
+				Name:          "allspark-ui",
+				Port:          80,
+				Global:        true,
+				HeaderMatches: map[string]string{"static": "yes"},
+			},
+		})).To(Succeed())
+		tenantConfigs := []*envoy.TenantConfig{
+			{
+				Name:   "test",
+				Status: false,
+			},
+		}
+		envoy.TenantConfigs = tenantConfigs
+		defer func() {
+			Expect(envoy.SetServiceRoutes(nil)).To(Succeed())
+			envoy.TenantConfigs = nil
+		}()
+		common.Mode = "admin"
+		snap, err := envoy.GenerateNewSnapshot(tenantConfigs, nil, nil)
+		Expect(err).To(BeNil())
+		r := snap.GetResources(resource.RouteType)
+		routes, ok := r["default"].(*routev3.RouteConfiguration)
+		Expect(ok).To(Equal(true))
+
+		var billing, ui *routev3.Route
+		for _, route := range routes.GetVirtualHosts()[0].GetRoutes() {
+			switch route.GetRoute().GetCluster() {
+			case "billing-test":
+				billing = route
+			case "allspark-ui":
+				ui = route
+			}
+		}
+		Expect(billing).ToNot(BeNil())
+		Expect(billing.GetMatch().GetPath()).To(Equal("/billing"))
+		Expect(billing.GetRoute().GetPrefixRewrite()).To(Equal("/"))
+		Expect(billing.GetMatch().GetHeaders()[0].GetName()).To(Equal("org-id"))
+		Expect(billing.GetMatch().GetQueryParameters()[0].GetName()).To(Equal("version"))
+		Expect(billing.GetMatch().GetQueryParameters()[0].GetStringMatch().GetExact()).To(Equal("v2"))
+
+		Expect(ui).ToNot(BeNil())
+		Expect(ui.GetMatch().GetPrefix()).To(Equal("/"))
+		Expect(ui.GetMatch().GetHeaders()).To(HaveLen(1))
+		Expect(ui.GetMatch().GetHeaders()[0].GetName()).To(Equal("static"))
+		Expect(ui.GetMatch().GetHeaders()[0].GetStringMatch().GetExact()).To(Equal("yes"))
+	})
+
 	It("initialize nexus-proxy state with Header-based routing rules", func() {
-		headerUpstreams := map[string]*envoy.HeaderMatchedUpstream{"test1": {
-			Name:        "test1",
-			HeaderName:  "x-tenant",
-			HeaderValue: "1",
-			Host:        "example.com",
-			Port:        80,
+		upstreams := map[string]*envoy.UpstreamConfig{"test1": {
+			Name:    "test1",
+			Headers: map[string]string{"x-tenant": "1"},
+			Host:    "example.com",
+			Port:    80,
 		}}
-		snap, err := envoy.GenerateNewSnapshot(nil, nil, nil, headerUpstreams)
+		snap, err := envoy.GenerateNewSnapshot(nil, nil, upstreams)
 		Expect(err).To(BeNil())
 		Expect(snap).ToNot(BeNil())
 		l := snap.GetResources(resource.ListenerType)
@@ -320,14 +463,13 @@ var _ = Describe("nexus proxy tests", func() {
 
 		// add another upstream and check assertions
 		// we expect a route and a cluster to have gotten added
-		headerUpstreams["test2"] = &envoy.HeaderMatchedUpstream{
-			Name:        "test2",
-			HeaderName:  "x-tenant",
-			HeaderValue: "2",
-			Host:        "google.com",
-			Port:        443,
+		upstreams["test2"] = &envoy.UpstreamConfig{
+			Name:    "test2",
+			Headers: map[string]string{"x-tenant": "2"},
+			Host:    "google.com",
+			Port:    443,
 		}
-		snap, err = envoy.GenerateNewSnapshot(nil, nil, nil, headerUpstreams)
+		snap, err = envoy.GenerateNewSnapshot(nil, nil, upstreams)
 		Expect(err).To(BeNil())
 		Expect(snap).ToNot(BeNil())
 		l = snap.GetResources(resource.ListenerType)
@@ -346,6 +488,136 @@ var _ = Describe("nexus proxy tests", func() {
 		Expect(len(c)).To(Equal(4))
 	})
 
+	It("match JWT routing rules before the other ones", func() {
+		upstreams := map[string]*envoy.UpstreamConfig{
+			"a-header": {
+				Name:       "a-header",
+				Headers:    map[string]string{"x-tenant": "1"},
+				PathPrefix: "/api",
+				Host:       "header.example.com",
+				Port:       80,
+			},
+			"b-jwt": {
+				Name:          "b-jwt",
+				JwtClaimKey:   "username",
+				JwtClaimValue: "foo@example.com",
+				Host:          "jwt.example.com",
+				Port:          80,
+			},
+			"c-header": {
+				Name:    "c-header",
+				Headers: map[string]string{"x-tenant": "2"},
+				Host:    "header.example.com",
+				Port:    80,
+			},
+		}
+		snap, err := envoy.GenerateNewSnapshot(nil, nil, upstreams)
+		Expect(err).To(BeNil())
+		routes, ok := snap.GetResources(resource.RouteType)["default"].(*routev3.RouteConfiguration)
+		Expect(ok).To(Equal(true))
+		var clusters []string
+		for _, route := range routes.GetVirtualHosts()[0].GetRoutes() {
+			if _, ok := upstreams[route.GetRoute().GetCluster()]; ok {
+				clusters = append(clusters, route.GetRoute().GetCluster())
+			}
+		}
+		// JWT rules first, then rules with more conditions
+		Expect(clusters).To(Equal([]string{"b-jwt", "a-header", "c-header"}))
+	})
+
+	It("initialize nexus-proxy state with path, method, query and weighted routing rules", func() {
+		upstreams := map[string]*envoy.UpstreamConfig{
+			"canary": {
+				Name:       "canary",
+				PathPrefix: "/api",
+				Methods:    []string{"GET", "POST"},
+				WeightedUpstreams: []envoy.WeightedUpstream{
+					{Host: "stable.example.com", Port: 80, Weight: 90},
+					{Host: "canary.example.com", Port: 80, Weight: 10},
+				},
+				Retry: &envoy.RetryPolicy{
+					RetryOn:       "5xx",
+					NumRetries:    3,
+					PerTryTimeout: time.Second,
+				},
+				Timeout: 10 * time.Second,
+			},
+			"search": {
+				Name:        "search",
+				Headers:     map[string]string{"x-tenant": "1"},
+				PathRegex:   "^/search/[a-z]+$",
+				QueryParams: map[string]string{"version": "2"},
+				Host:        "search.example.com",
+				Port:        80,
+			},
+		}
+		snap, err := envoy.GenerateNewSnapshot(nil, nil, upstreams)
+		Expect(err).To(BeNil())
+		Expect(snap).ToNot(BeNil())
+		r := snap.GetResources(resource.RouteType)
+		c := snap.GetResources(resource.ClusterType)
+
+		routes, ok := r["default"].(*routev3.RouteConfiguration)
+		Expect(ok).To(Equal(true))
+		virtualHosts := routes.GetVirtualHosts()[0]
+		Expect(len(virtualHosts.GetRoutes())).To(Equal(8))
+		Expect(c).To(HaveKey("canary-0"))
+		Expect(c).To(HaveKey("canary-1"))
+		Expect(c).To(HaveKey("search"))
+		Expect(len(c)).To(Equal(5))
+
+		// the upstream with more conditions is matched first
+		search := virtualHosts.GetRoutes()[6]
+		Expect(search.GetMatch().GetSafeRegex().GetRegex()).To(Equal("^/search/[a-z]+$"))
+		Expect(search.GetMatch().GetHeaders()[0].GetName()).To(Equal("x-tenant"))
+		Expect(search.GetMatch().GetQueryParameters()[0].GetName()).To(Equal("version"))
+		Expect(search.GetRoute().GetCluster()).To(Equal("search"))
+
+		canary := virtualHosts.GetRoutes()[7]
+		Expect(canary.GetMatch().GetPrefix()).To(Equal("/api"))
+		Expect(canary.GetMatch().GetHeaders()[0].GetName()).To(Equal(":method"))
+		Expect(canary.GetMatch().GetHeaders()[0].GetStringMatch().GetSafeRegex().GetRegex()).To(Equal("^(GET|POST)$"))
+		weightedClusters := canary.GetRoute().GetWeightedClusters().GetClusters()
+		Expect(len(weightedClusters)).To(Equal(2))
+		Expect(weightedClusters[0].GetName()).To(Equal("canary-0"))
+		Expect(weightedClusters[0].GetWeight().GetValue()).To(Equal(uint32(90)))
+		Expect(canary.GetRoute().GetRetryPolicy().GetNumRetries().GetValue()).To(Equal(uint32(3)))
+		Expect(canary.GetRoute().GetTimeout().AsDuration()).To(Equal(10 * time.Second))
+
+		upstreams["invalid"] = &envoy.UpstreamConfig{
+			Name: "invalid",
+			Host: "example.com",
+			Port: 80,
+		}
+		_, err = envoy.GenerateNewSnapshot(nil, nil, upstreams)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("disable the timeout of routes of upstreams without timeout", func() {
+		upstreams := map[string]*envoy.UpstreamConfig{
+			"search": {
+				Name:       "search",
+				PathPrefix: "/search",
+				Host:       "search.example.com",
+				Port:       80,
+			},
+		}
+		snap, err := envoy.GenerateNewSnapshot(nil, nil, upstreams)
+		Expect(err).To(BeNil())
+		routes, ok := snap.GetResources(resource.RouteType)["default"].(*routev3.RouteConfiguration)
+		Expect(ok).To(Equal(true))
+		var search *routev3.Route
+		for _, route := range routes.GetVirtualHosts()[0].GetRoutes() {
+			if route.GetRoute().GetCluster() == "search" {
+				search = route
+			}
+		}
+		Expect(search).ToNot(BeNil())
+		// a zero timeout disables the default timeout of envoy of 15s
+		Expect(search.GetRoute().GetTimeout()).ToNot(BeNil())
+		Expect(search.GetRoute().GetTimeout().AsDuration()).To(BeZero())
+	})
+
 	It("initialize nexus-proxy state with JWT authn and JWT-claim based routing rules", func() {
 		jwt := &envoy.JwtAuthnConfig{
 			IdpName:              "csp",
@@ -365,7 +637,7 @@ var _ = Describe("nexus proxy tests", func() {
 		}}
 
 		snap, err := envoy.GenerateNewSnapshot([]*envoy.TenantConfig{},
-			jwt, upstreams, nil)
+			jwt, upstreams)
 		Expect(err).To(BeNil())
 		Expect(snap).ToNot(BeNil())
 		l := snap.GetResources(resource.ListenerType)
@@ -393,7 +665,7 @@ var _ = Describe("nexus proxy tests", func() {
 			Host:          "google.com",
 			Port:          443,
 		}
-		snap, err = envoy.GenerateNewSnapshot(nil, jwt, upstreams, nil)
+		snap, err = envoy.GenerateNewSnapshot(nil, jwt, upstreams)
 		Expect(err).To(BeNil())
 		Expect(snap).ToNot(BeNil())
 		l = snap.GetResources(resource.ListenerType)
@@ -3,15 +3,19 @@ package envoy
 import (
 	"api-gw/pkg/common"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-func makeRoutes(tenantconfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, upstreams map[string]*UpstreamConfig, headerUpstreams map[string]*HeaderMatchedUpstream) (*route.RouteConfiguration, error) {
-	routes, err := getRoutes(TenantConfigs, jwtAuthnConfig, upstreams, headerUpstreams)
+func makeRoutes(tenantconfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, upstreams map[string]*UpstreamConfig) (*route.RouteConfiguration, error) {
+	routes, err := getRoutes(TenantConfigs, jwtAuthnConfig, upstreams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build routes: %s", err)
 	} else {
@@ -27,7 +31,7 @@ func makeRoutes(tenantconfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, u
 }
 
 // getRoutes returns an ordered list of routes that envoy will try to match sequentially
-func getRoutes(tenantconfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, upstreams map[string]*UpstreamConfig, headerUpstreams map[string]*HeaderMatchedUpstream) ([]*route.Route, error) {
+func getRoutes(tenantconfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, upstreams map[string]*UpstreamConfig) ([]*route.Route, error) {
 	var routes []*route.Route
 
 	routes = append(routes, defaultRoute())
@@ -76,106 +80,205 @@ func getRoutes(tenantconfigs []*TenantConfig, jwtAuthnConfig *JwtAuthnConfig, up
 		routes = append(routes, upstreamRoutes...)
 	}
 
-	var headerUpstreamRoutes []*route.Route
-	headerUpstreamRoutes, err = getHeaderUpstreamRoutes(headerUpstreams)
-	if err != nil {
-		return nil, fmt.Errorf("error getting header upstream routes: %s", err)
-	}
-	if len(headerUpstreamRoutes) > 0 {
-		routes = append(routes, headerUpstreamRoutes...)
-	}
-
 	return routes, nil
 }
 
+// getUpstreamRoutes returns routes of the upstreams. Upstreams matching JWT claims are matched before the other ones,
+// as JWT rules were matched before header rules. Within both groups the upstreams with more conditions are matched
+// first so that a more specific rule isn't shadowed by a generic one, upstreams with the same number of conditions
+// are ordered by name.
 func getUpstreamRoutes(upstreams map[string]*UpstreamConfig) ([]*route.Route, error) {
-	var routes []*route.Route
+	var sorted []*UpstreamConfig
 	for _, upstream := range upstreams {
-		if upstream.JwtClaimKey == "" || upstream.JwtClaimValue == "" {
-			return nil, fmt.Errorf("invalid jwt match condition")
+		sorted = append(sorted, upstream)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if ji, jj := sorted[i].JwtClaimKey != "", sorted[j].JwtClaimKey != ""; ji != jj {
+			return ji
+		}
+		if ci, cj := sorted[i].conditionsCount(), sorted[j].conditionsCount(); ci != cj {
+			return ci > cj
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	var routes []*route.Route
+	for _, upstream := range sorted {
+		match, err := makeUpstreamRouteMatch(upstream)
+		if err != nil {
+			return nil, err
+		}
+		action, err := makeUpstreamRouteAction(upstream)
+		if err != nil {
+			return nil, err
 		}
 		routes = append(routes, &route.Route{
-			// route based on JWT content
-			Match: &route.RouteMatch{
-				PathSpecifier: &route.RouteMatch_Prefix{
-					Prefix: "/",
-				},
-				DynamicMetadata: []*matcherv3.MetadataMatcher{
+			Match: match,
+			Action: &route.Route_Route{
+				Route: action,
+			},
+		})
+	}
+	return routes, nil
+}
+
+func makeUpstreamRouteMatch(upstream *UpstreamConfig) (*route.RouteMatch, error) {
+	if (upstream.JwtClaimKey == "") != (upstream.JwtClaimValue == "") {
+		return nil, fmt.Errorf("invalid jwt match condition")
+	}
+	if upstream.conditionsCount() == 0 {
+		return nil, fmt.Errorf("upstream %s has no match condition", upstream.Name)
+	}
+	if upstream.PathPrefix != "" && upstream.PathRegex != "" {
+		return nil, fmt.Errorf("upstream %s has both path prefix and path regex match conditions", upstream.Name)
+	}
+
+	match := &route.RouteMatch{
+		PathSpecifier: &route.RouteMatch_Prefix{
+			Prefix: "/",
+		},
+	}
+	if upstream.PathPrefix != "" {
+		match.PathSpecifier = &route.RouteMatch_Prefix{
+			Prefix: upstream.PathPrefix,
+		}
+	}
+	if upstream.PathRegex != "" {
+		if _, err := regexp.Compile(upstream.PathRegex); err != nil {
+			return nil, fmt.Errorf("invalid path regex of upstream %s: %s", upstream.Name, err)
+		}
+		match.PathSpecifier = &route.RouteMatch_SafeRegex{
+			SafeRegex: &matcherv3.RegexMatcher{
+				Regex: upstream.PathRegex,
+			},
+		}
+	}
+
+	if upstream.JwtClaimKey != "" {
+		// route based on JWT content
+		match.DynamicMetadata = []*matcherv3.MetadataMatcher{
+			{
+				Filter: "envoy.filters.http.jwt_authn",
+				Path: []*matcherv3.MetadataMatcher_PathSegment{
 					{
-						Filter: "envoy.filters.http.jwt_authn",
-						Path: []*matcherv3.MetadataMatcher_PathSegment{
-							{
-								Segment: &matcherv3.MetadataMatcher_PathSegment_Key{
-									Key: jwtPayload,
-								},
-							},
-							{
-								Segment: &matcherv3.MetadataMatcher_PathSegment_Key{
-									Key: upstream.JwtClaimKey,
-								},
-							},
+						Segment: &matcherv3.MetadataMatcher_PathSegment_Key{
+							Key: jwtPayload,
 						},
-						Value: &matcherv3.ValueMatcher{
-							MatchPattern: &matcherv3.ValueMatcher_StringMatch{
-								StringMatch: &matcherv3.StringMatcher{
-									MatchPattern: &matcherv3.StringMatcher_Exact{
-										Exact: upstream.JwtClaimValue,
-									},
-								},
-							},
+					},
+					{
+						Segment: &matcherv3.MetadataMatcher_PathSegment_Key{
+							Key: upstream.JwtClaimKey,
 						},
 					},
 				},
-			},
-			Action: &route.Route_Route{
-				Route: &route.RouteAction{
-					ClusterSpecifier: &route.RouteAction_Cluster{
-						Cluster: upstream.Name,
+				Value: &matcherv3.ValueMatcher{
+					MatchPattern: &matcherv3.ValueMatcher_StringMatch{
+						StringMatch: exactStringMatcher(upstream.JwtClaimValue),
 					},
 				},
 			},
+		}
+	}
+
+	for _, name := range sortedKeys(upstream.Headers) {
+		match.Headers = append(match.Headers, &route.HeaderMatcher{
+			Name: name,
+			HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+				StringMatch: exactStringMatcher(upstream.Headers[name]),
+			},
 		})
 	}
-	return routes, nil
-}
 
-func getHeaderUpstreamRoutes(headerUpstreams map[string]*HeaderMatchedUpstream) ([]*route.Route, error) {
-	var routes []*route.Route
-	for _, hup := range headerUpstreams {
-		if hup.HeaderName == "" || hup.HeaderValue == "" {
-			return nil, fmt.Errorf("found invalid header match condition")
+	if len(upstream.Methods) > 0 {
+		var methods []string
+		for _, method := range upstream.Methods {
+			methods = append(methods, regexp.QuoteMeta(method))
 		}
-		routes = append(routes, &route.Route{
-			// route based on JWT content
-			Match: &route.RouteMatch{
-				PathSpecifier: &route.RouteMatch_Prefix{
-					Prefix: "/",
-				},
-
-				Headers: []*route.HeaderMatcher{{
-					Name: hup.HeaderName,
-					HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
-						StringMatch: &matcherv3.StringMatcher{
-							MatchPattern: &matcherv3.StringMatcher_Exact{
-								Exact: hup.HeaderValue,
-							},
+		match.Headers = append(match.Headers, &route.HeaderMatcher{
+			Name: ":method",
+			HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+				StringMatch: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_SafeRegex{
+						SafeRegex: &matcherv3.RegexMatcher{
+							Regex: "^(" + strings.Join(methods, "|") + ")$",
 						},
 					},
-				}},
-			},
-			Action: &route.Route_Route{
-				Route: &route.RouteAction{
-					Timeout: &durationpb.Duration{
-						Seconds: 0,
-					},
-					ClusterSpecifier: &route.RouteAction_Cluster{
-						Cluster: hup.Name,
-					},
 				},
 			},
 		})
 	}
-	return routes, nil
+
+	for _, name := range sortedKeys(upstream.QueryParams) {
+		match.QueryParameters = append(match.QueryParameters, &route.QueryParameterMatcher{
+			Name: name,
+			QueryParameterMatchSpecifier: &route.QueryParameterMatcher_StringMatch{
+				StringMatch: exactStringMatcher(upstream.QueryParams[name]),
+			},
+		})
+	}
+	return match, nil
+}
+
+func makeUpstreamRouteAction(upstream *UpstreamConfig) (*route.RouteAction, error) {
+	action := &route.RouteAction{
+		Timeout: &durationpb.Duration{
+			Seconds: 0,
+		},
+		ClusterSpecifier: &route.RouteAction_Cluster{
+			Cluster: upstream.Name,
+		},
+	}
+
+	if len(upstream.WeightedUpstreams) > 0 {
+		weightedClusters := &route.WeightedCluster{}
+		var totalWeight uint32
+		for i, weightedUpstream := range upstream.WeightedUpstreams {
+			weightedClusters.Clusters = append(weightedClusters.Clusters, &route.WeightedCluster_ClusterWeight{
+				Name:   weightedUpstreamClusterName(upstream.Name, i),
+				Weight: wrapperspb.UInt32(weightedUpstream.Weight),
+			})
+			totalWeight += weightedUpstream.Weight
+		}
+		if totalWeight == 0 {
+			return nil, fmt.Errorf("weights of upstreams of %s sum to zero", upstream.Name)
+		}
+		weightedClusters.TotalWeight = wrapperspb.UInt32(totalWeight)
+		action.ClusterSpecifier = &route.RouteAction_WeightedClusters{
+			WeightedClusters: weightedClusters,
+		}
+	}
+
+	if upstream.Timeout > 0 {
+		action.Timeout = durationpb.New(upstream.Timeout)
+	}
+
+	if upstream.Retry != nil {
+		action.RetryPolicy = &route.RetryPolicy{
+			RetryOn:    upstream.Retry.RetryOn,
+			NumRetries: wrapperspb.UInt32(upstream.Retry.NumRetries),
+		}
+		if upstream.Retry.PerTryTimeout > 0 {
+			action.RetryPolicy.PerTryTimeout = durationpb.New(upstream.Retry.PerTryTimeout)
+		}
+	}
+	return action, nil
+}
+
+func exactStringMatcher(value string) *matcherv3.StringMatcher {
+	return &matcherv3.StringMatcher{
+		MatchPattern: &matcherv3.StringMatcher_Exact{
+			Exact: value,
+		},
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func makeGlobalRoutes() []*route.Route {
@@ -282,81 +385,105 @@ func defaultRouteQueryParams() *route.Route {
 	}
 }
 
+// makeTSMRoutes returns the routes of the services of getCosmosServices for the tenant. The exact paths of a service
+// are matched before its prefixes, and its header matches are matched last whatever the path of the request.
 func makeTSMRoutes(TenantName string) []*route.Route {
-	var headersMatch []*route.HeaderMatcher
 	var routes []*route.Route
-	match := &matcherv3.StringMatcher{
-		MatchPattern: &matcherv3.StringMatcher_Exact{
-			Exact: TenantName,
-		},
-	}
-
-	headersMatch = append(headersMatch, &route.HeaderMatcher{
-		Name: "org-id",
-		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
-			StringMatch: match,
-		},
-	})
-
-	for _, svc := range cosmosServices {
-		var match *route.RouteMatch
-		var cluster string
+	for _, svc := range getCosmosServices() {
 		fmt.Printf("Adding route for service %s for tenant %s", svc.Name, TenantName)
+		for _, exactRoute := range svc.ExactPath {
+			match := &route.RouteMatch{
+				PathSpecifier: &route.RouteMatch_Path{
+					Path: exactRoute.Prefix,
+				},
+			}
+			if r := makeTSMRoute(svc, TenantName, match, exactRoute); r != nil {
+				routes = append(routes, r)
+			}
+		}
 		for _, prefixRoute := range svc.PrefixSettings {
-			var prefixRewrite string
-			if prefixRoute.PrefixRewrite != "" {
-				prefixRewrite = prefixRoute.PrefixRewrite
-			} else {
-				prefixRewrite = prefixRoute.Prefix
+			match := &route.RouteMatch{
+				PathSpecifier: &route.RouteMatch_Prefix{
+					Prefix: prefixRoute.Prefix,
+				},
 			}
-			var overallheadersMatch []*route.HeaderMatcher
-			overallheadersMatch = headersMatch
-			if prefixRoute.Header != "" {
-				overallheadersMatch = append(headersMatch, &route.HeaderMatcher{
-					Name: prefixRoute.Header,
-					HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
-						StringMatch: &matcherv3.StringMatcher{
-							MatchPattern: &matcherv3.StringMatcher_Exact{
-								Exact: "yes",
-							},
-						},
-					},
-				})
+			if r := makeTSMRoute(svc, TenantName, match, prefixRoute); r != nil {
+				routes = append(routes, r)
 			}
-			if !svc.Global {
-				match = &route.RouteMatch{
-					PathSpecifier: &route.RouteMatch_Prefix{
-						Prefix: prefixRoute.Prefix,
-					},
-					Headers: overallheadersMatch,
-				}
-				cluster = fmt.Sprintf("%s-%s", svc.Name, TenantName)
-			} else {
-				match = &route.RouteMatch{
-					PathSpecifier: &route.RouteMatch_Prefix{
-						Prefix: prefixRoute.Prefix,
-					},
-				}
-				cluster = svc.Name
+		}
+		if len(svc.HeaderMatches) > 0 {
+			match := &route.RouteMatch{
+				PathSpecifier: &route.RouteMatch_Prefix{
+					Prefix: "/",
+				},
 			}
-			if (jwt != nil && prefixRoute.Prefix != "/login") || (jwt == nil) {
-				routes = append(routes, &route.Route{
-					Match: match,
-					Action: &route.Route_Route{
-						Route: &route.RouteAction{
-							ClusterSpecifier: &route.RouteAction_Cluster{
-								Cluster: cluster,
-							},
-							PrefixRewrite: prefixRewrite,
-						},
+			for _, name := range sortedKeys(svc.HeaderMatches) {
+				match.Headers = append(match.Headers, &route.HeaderMatcher{
+					Name: name,
+					HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+						StringMatch: exactStringMatcher(svc.HeaderMatches[name]),
 					},
 				})
 			}
+			routes = append(routes, makeTSMRoute(svc, TenantName, match, PrefixSettings{Prefix: "/"}))
 		}
 	}
 	return routes
 }
 
+// makeTSMRoute completes the match of the service path, it returns nil if the path is served by the login route.
+// Routes of non-global services match the org-id header of the tenant, all of them match the query parameters of
+// AdditionalMatch.
+func makeTSMRoute(svc CosmosService, TenantName string, match *route.RouteMatch, settings PrefixSettings) *route.Route {
+	if jwt != nil && settings.Prefix == "/login" {
+		return nil
+	}
+
+	prefixRewrite := settings.PrefixRewrite
+	if prefixRewrite == "" {
+		prefixRewrite = settings.Prefix
+	}
+
+	cluster := svc.Name
+	if !svc.Global {
+		match.Headers = append(match.Headers, &route.HeaderMatcher{
+			Name: "org-id",
+			HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+				StringMatch: exactStringMatcher(TenantName),
+			},
+		})
+		if settings.Header != "" {
+			match.Headers = append(match.Headers, &route.HeaderMatcher{
+				Name: settings.Header,
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: exactStringMatcher("yes"),
+				},
+			})
+		}
+		cluster = fmt.Sprintf("%s-%s", svc.Name, TenantName)
+	}
+	for _, name := range sortedKeys(svc.AdditionalMatch) {
+		match.QueryParameters = append(match.QueryParameters, &route.QueryParameterMatcher{
+			Name: name,
+			QueryParameterMatchSpecifier: &route.QueryParameterMatcher_StringMatch{
+				StringMatch: exactStringMatcher(svc.AdditionalMatch[name]),
+			},
+		})
+	}
+
+	return &route.Route{
+		Match: match,
+		Action: &route.Route_Route{
+			Route: &route.RouteAction{
+				ClusterSpecifier: &route.RouteAction_Cluster{
+					Cluster: cluster,
+				},
+				PrefixRewrite: prefixRewrite,
+			},
+		},
+	}
+}
+
 func getLoginRoute(jwt *JwtAuthnConfig) *route.Route {
 	var routeAction *route.Route_Route
 	if jwt == nil {
@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"api-gw/pkg/config"
 	"context"
 	"fmt"
 	"net"
@@ -13,11 +14,11 @@ import (
 )
 
 var (
-	cache           cachev3.SnapshotCache
-	jwt             *JwtAuthnConfig
-	upstreams       map[string]*UpstreamConfig
-	headerUpstreams map[string]*HeaderMatchedUpstream
-	TenantConfigs   []*TenantConfig
+	cache         cachev3.SnapshotCache
+	jwt           *JwtAuthnConfig
+	upstreams     map[string]*UpstreamConfig
+	serviceRoutes []config.ServiceRoute
+	TenantConfigs []*TenantConfig
 )
 
 const (
@@ -28,12 +29,12 @@ const (
 
 var jwtMutex sync.Mutex
 var upstreamsMutex sync.Mutex
-var headerUpstreamMutex sync.Mutex
+var serviceRoutesMutex sync.Mutex
 var refreshEnvoyMutex sync.Mutex
 var XDSServer *grpc.Server
 var XDSListener net.Listener
 
-func Init(j *JwtAuthnConfig, u map[string]*UpstreamConfig, hu map[string]*HeaderMatchedUpstream, level log.Level) error {
+func Init(j *JwtAuthnConfig, u map[string]*UpstreamConfig, level log.Level) error {
 	log.Infof("initializing xDS server...")
 	jwt = j
 	if u == nil {
@@ -41,11 +42,6 @@ func Init(j *JwtAuthnConfig, u map[string]*UpstreamConfig, hu map[string]*Header
 	} else {
 		upstreams = u
 	}
-	if hu == nil {
-		headerUpstreams = make(map[string]*HeaderMatchedUpstream)
-	} else {
-		headerUpstreams = hu
-	}
 
 	logger := log.New()
 	log.SetLevel(level)
@@ -54,7 +50,7 @@ func Init(j *JwtAuthnConfig, u map[string]*UpstreamConfig, hu map[string]*Header
 	cache = cachev3.NewSnapshotCache(false, cachev3.IDHash{}, logger)
 
 	// Create the snapshot that we'll serve to Envoy
-	snapshot, err := GenerateNewSnapshot(nil, nil, nil, nil)
+	snapshot, err := GenerateNewSnapshot(nil, nil, nil)
 	if err != nil {
 		log.Errorf("failed to generate a new snapshot: %s", err)
 		return err
@@ -87,12 +83,31 @@ func Init(j *JwtAuthnConfig, u map[string]*UpstreamConfig, hu map[string]*Header
 	return nil
 }
 
+// SetServiceRoutes replaces the routing table of the tenant services, the default one is used if routes are empty.
+// The envoy configuration is refreshed if the xDS server is already initialized.
+func SetServiceRoutes(routes []config.ServiceRoute) error {
+	serviceRoutesMutex.Lock()
+	serviceRoutes = routes
+	serviceRoutesMutex.Unlock()
+
+	if cache == nil {
+		return nil
+	}
+	return RefreshEnvoyConfiguration()
+}
+
+func getServiceRoutes() []config.ServiceRoute {
+	serviceRoutesMutex.Lock()
+	defer serviceRoutesMutex.Unlock()
+	return serviceRoutes
+}
+
 func RefreshEnvoyConfiguration() error {
 	refreshEnvoyMutex.Lock()
 	defer refreshEnvoyMutex.Unlock()
 
 	log.Debugf("refreshing envoy configuration...")
-	snapshot, err := GenerateNewSnapshot(TenantConfigs, jwt, upstreams, headerUpstreams)
+	snapshot, err := GenerateNewSnapshot(TenantConfigs, jwt, upstreams)
 	if err != nil {
 		log.Errorf("failed to generate a new snapshot: %s", err)
 		return err
@@ -198,27 +213,3 @@ func DeleteUpstream(name string) error {
 	}
 	return nil
 }
-
-func AddHeaderUpstream(name string, headerUpstream *HeaderMatchedUpstream) error {
-	headerUpstreamMutex.Lock()
-	defer headerUpstreamMutex.Unlock()
-
-	headerUpstreams[name] = headerUpstream
-	err := RefreshEnvoyConfiguration()
-	if err != nil {
-		return fmt.Errorf("AddHeaderUpstream: error while refreshing envoy configuration: %s", err)
-	}
-	return nil
-}
-
-func DeleteHeaderUpstream(name string) error {
-	upstreamsMutex.Lock()
-	defer upstreamsMutex.Unlock()
-
-	delete(headerUpstreams, name)
-	err := RefreshEnvoyConfiguration()
-	if err != nil {
-		return fmt.Errorf("DeleteHeaderUpstream: error while refreshing envoy configuration: %s", err)
-	}
-	return nil
-}
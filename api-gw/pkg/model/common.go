@@ -59,6 +59,8 @@ type NodeInfo struct {
 
 type RestUriInfo struct {
 	TypeOfURI URIType
+	// Version of the CRD served on the URI, it's empty for URIs without version prefix which serve the default version
+	Version string
 }
 
 type URIType int
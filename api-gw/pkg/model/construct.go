@@ -121,6 +121,65 @@ func ConstructMapCRDTypeToSpec(eventType EventType, crdType string, spec apiexte
 	CrdTypeToSpec[crdType] = spec
 }
 
+// DefaultCrdVersion is the version served on URIs without version prefix if the CRD serves it.
+const DefaultCrdVersion = "v1"
+
+// GetServedVersions returns versions served by the CRD in the order of the CRD spec.
+func GetServedVersions(crdType string) []string {
+	crdTypeToSpecMutex.Lock()
+	defer crdTypeToSpecMutex.Unlock()
+
+	var versions []string
+	for _, v := range CrdTypeToSpec[crdType].Versions {
+		if v.Served {
+			versions = append(versions, v.Name)
+		}
+	}
+	return versions
+}
+
+// GetDefaultCrdVersion returns version served on URIs without version prefix: DefaultCrdVersion if the CRD serves it,
+// otherwise the storage version of the CRD.
+func GetDefaultCrdVersion(crdType string) string {
+	crdTypeToSpecMutex.Lock()
+	defer crdTypeToSpecMutex.Unlock()
+
+	storageVersion := ""
+	for _, v := range CrdTypeToSpec[crdType].Versions {
+		if v.Served && v.Name == DefaultCrdVersion {
+			return DefaultCrdVersion
+		}
+		if v.Storage {
+			storageVersion = v.Name
+		}
+	}
+	if storageVersion == "" {
+		return DefaultCrdVersion
+	}
+	return storageVersion
+}
+
+// GetStorageCrdVersion returns the storage version of the CRD, it's DefaultCrdVersion if the spec of the CRD is unknown.
+func GetStorageCrdVersion(crdType string) string {
+	crdTypeToSpecMutex.Lock()
+	defer crdTypeToSpecMutex.Unlock()
+
+	for _, v := range CrdTypeToSpec[crdType].Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return DefaultCrdVersion
+}
+
+// GetCrdVersion returns version of the CRD served on the URI.
+func GetCrdVersion(crdType, uri string) string {
+	if info, ok := GetUriInfo(uri); ok && info.Version != "" {
+		return info.Version
+	}
+	return GetDefaultCrdVersion(crdType)
+}
+
 func GetRestUris(crdType string) ([]nexus.RestURIs, bool) {
 	crdTypeToRestUrisMutex.Lock()
 	defer crdTypeToRestUrisMutex.Unlock()
@@ -7,6 +7,7 @@ import (
 	"github.com/jarcoal/httpmock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -37,6 +38,37 @@ var _ = Describe("Construct tests", func() {
 		model.ConstructDatamodel(model.Delete, "vmware-test.org", &unstructuredObj)
 		Expect(model.DatamodelToDatamodelInfo).ToNot(HaveKey("vmware-test.org"))
 	})
+	It("should get version of CRD served on URI", func() {
+		model.ConstructMapCRDTypeToSpec(model.Upsert, "managers.vmware-test.org", apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: false},
+				{Name: "v1beta1", Served: true},
+				{Name: "v2", Served: true, Storage: true},
+			},
+		})
+		model.ConstructMapUriToUriInfo(model.Upsert, map[string]model.RestUriInfo{
+			"/v1beta1/manager/{vmware-test.Manager}": {Version: "v1beta1"},
+		})
+
+		Expect(model.GetServedVersions("managers.vmware-test.org")).To(Equal([]string{"v1beta1", "v2"}))
+		Expect(model.GetDefaultCrdVersion("managers.vmware-test.org")).To(Equal("v2"))
+		Expect(model.GetCrdVersion("managers.vmware-test.org", "/manager/{vmware-test.Manager}")).To(Equal("v2"))
+		Expect(model.GetCrdVersion("managers.vmware-test.org", "/v1beta1/manager/{vmware-test.Manager}")).To(Equal("v1beta1"))
+
+		// CRDs serving v1 serve it on URIs without version and unknown CRDs are served in v1
+		model.ConstructMapCRDTypeToSpec(model.Upsert, "managers.vmware-test.org", apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1", Served: true},
+				{Name: "v2", Served: true, Storage: true},
+			},
+		})
+		Expect(model.GetDefaultCrdVersion("managers.vmware-test.org")).To(Equal("v1"))
+		Expect(model.GetDefaultCrdVersion("unknown.vmware-test.org")).To(Equal("v1"))
+		// owner references point to the storage version
+		Expect(model.GetStorageCrdVersion("managers.vmware-test.org")).To(Equal("v2"))
+		Expect(model.GetStorageCrdVersion("unknown.vmware-test.org")).To(Equal("v1"))
+	})
+
 	It("should verify initConnection works", func() {
 		connector := model.ConnectorObject{
 			Service:  "http://localhost:80/version",
@@ -102,6 +102,68 @@ var _ = Describe("OpenAPI tests", func() {
 		api.New("vmware.org")
 		api.AddPath(restUri, "vmware.org")
 		Expect(api.Schemas["vmware.org"].Paths[restUri.Uri].Get).To(Not(BeNil()))
+
+		var paramNames []string
+		for _, param := range api.Schemas["vmware.org"].Paths[restUri.Uri].Get.Parameters {
+			paramNames = append(paramNames, param.Value.Name)
+		}
+		Expect(paramNames).To(ContainElements("limit", "continue", "filter", "sort", "fields", "watch", "resourceVersion"))
+
+		listSchema := api.Schemas["vmware.org"].Components.Schemas["orgchart.Leader.List"].Value
+		Expect(listSchema.Properties).To(HaveKey("items"))
+		Expect(listSchema.Properties).To(HaveKey("continue"))
+		Expect(listSchema.Properties).To(HaveKey("remainingItemCount"))
+	})
+
+	It("should add paths and components of each served CRD version", func() {
+		restUri := nexus.RestURIs{
+			Uri:     "/leaders",
+			Methods: nexus.HTTPListResponse,
+		}
+		v2RestUri := nexus.RestURIs{
+			Uri:     "/v2/leaders",
+			Methods: nexus.HTTPListResponse,
+		}
+
+		crdJson, err := yamlv1.YAMLToJSON([]byte(crdExample))
+		Expect(err).NotTo(HaveOccurred())
+		var crd apiextensionsv1.CustomResourceDefinition
+		err = json.Unmarshal(crdJson, &crd)
+		Expect(err).NotTo(HaveOccurred())
+
+		// v2 renames designation to title
+		v2 := *crd.Spec.Versions[0].DeepCopy()
+		v2.Name = "v2"
+		v2.Storage = false
+		v2Spec := v2.Schema.OpenAPIV3Schema.Properties["spec"]
+		v2Spec.Properties["title"] = v2Spec.Properties["designation"]
+		delete(v2Spec.Properties, "designation")
+		v2.Schema.OpenAPIV3Schema.Properties["spec"] = v2Spec
+		crd.Spec.Versions = append(crd.Spec.Versions, v2)
+
+		model.ConstructMapCRDTypeToNode(model.Upsert, "leaders.orgchart.vmware.org", "orgchart.Leader",
+			[]string{}, nil, nil, false, "")
+		model.ConstructMapURIToCRDType(model.Upsert, "leaders.orgchart.vmware.org", []nexus.RestURIs{restUri, v2RestUri})
+		model.ConstructMapUriToUriInfo(model.Upsert, map[string]model.RestUriInfo{
+			"/leaders":    {TypeOfURI: model.DefaultURI},
+			"/v2/leaders": {TypeOfURI: model.DefaultURI, Version: "v2"},
+		})
+		model.ConstructMapCRDTypeToSpec(model.Upsert, "leaders.orgchart.vmware.org", crd.Spec)
+		Expect(model.GetServedVersions("leaders.orgchart.vmware.org")).To(Equal([]string{"v1", "v2"}))
+
+		api.New("vmware.org")
+		api.AddPath(restUri, "vmware.org")
+		api.AddPath(v2RestUri, "vmware.org")
+
+		Expect(api.Schemas["vmware.org"].Paths["/leaders"].Get.Responses["200"].Ref).
+			To(Equal("#/components/responses/Listorgchart.Leader"))
+		Expect(api.Schemas["vmware.org"].Paths["/v2/leaders"].Get.Responses["200"].Ref).
+			To(Equal("#/components/responses/Listorgchart.Leader.v2"))
+
+		schemas := api.Schemas["vmware.org"].Components.Schemas
+		Expect(schemas["orgchart.Leader.Post"].Value.Properties).To(HaveKey("designation"))
+		Expect(schemas["orgchart.Leader.v2.Post"].Value.Properties).To(HaveKey("title"))
+		Expect(schemas["orgchart.Leader.v2.Post"].Value.Properties).NotTo(HaveKey("designation"))
 	})
 
 	It("should add PATCH endpoint", func() {
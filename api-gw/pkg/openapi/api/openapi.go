@@ -91,7 +91,9 @@ func DatamodelUpdateNotification() {
 func AddPath(uri nexus.RestURIs, datamodel string) {
 	crdType := model.UriToCRDType[uri.Uri]
 	crdInfo := model.CrdTypeToNodeInfo[crdType]
-	parseSpec(crdType, datamodel)
+	version := model.GetCrdVersion(crdType, uri.Uri)
+	name := componentName(crdType, crdInfo.Name, version)
+	parseSpec(crdType, datamodel, version)
 
 	h := sha1.New()
 
@@ -104,47 +106,54 @@ func AddPath(uri nexus.RestURIs, datamodel string) {
 
 		switch method {
 		case "LIST":
+			var listParams []*openapi3.ParameterRef
+			listParams = append(listParams, params...)
+			listParams = append(listParams, constructListParams()...)
+			listParams = append(listParams, constructWatchParams()...)
 			operation := &openapi3.Operation{
 				OperationID: opId,
 				Tags:        []string{nameParts[1]},
-				Parameters:  params,
+				Parameters:  listParams,
 				Responses: openapi3.Responses{
 					"200": &openapi3.ResponseRef{
-						Ref: "#/components/responses/List" + crdInfo.Name,
+						Ref: "#/components/responses/List" + name,
 					},
 				},
 			}
 			pathItem.Get = operation
 		case http.MethodGet:
+			var getParams []*openapi3.ParameterRef
+			getParams = append(getParams, params...)
+			getParams = append(getParams, constructWatchParams()...)
 			operation := &openapi3.Operation{
 				OperationID: opId,
 				Tags:        []string{nameParts[1]},
-				Parameters:  params,
+				Parameters:  getParams,
 			}
 			if uriInfo, ok := model.GetUriInfo(uri.Uri); ok {
 				switch uriInfo.TypeOfURI {
 				case model.StatusURI:
 					operation.Responses = openapi3.Responses{
 						"200": &openapi3.ResponseRef{
-							Ref: "#/components/responses/Get" + crdInfo.Name + ".Status",
+							Ref: "#/components/responses/Get" + name + ".Status",
 						},
 					}
 				case model.SingleLinkURI:
 					operation.Responses = openapi3.Responses{
 						"200": &openapi3.ResponseRef{
-							Ref: "#/components/responses/Get" + crdInfo.Name + ".SingleLink",
+							Ref: "#/components/responses/Get" + name + ".SingleLink",
 						},
 					}
 				case model.NamedLinkURI:
 					operation.Responses = openapi3.Responses{
 						"200": &openapi3.ResponseRef{
-							Ref: "#/components/responses/Get" + crdInfo.Name + ".NamedLink",
+							Ref: "#/components/responses/Get" + name + ".NamedLink",
 						},
 					}
 				default:
 					operation.Responses = openapi3.Responses{
 						"200": &openapi3.ResponseRef{
-							Ref: "#/components/responses/Get" + crdInfo.Name,
+							Ref: "#/components/responses/Get" + name,
 						},
 					}
 				}
@@ -163,7 +172,7 @@ func AddPath(uri nexus.RestURIs, datamodel string) {
 			}
 			if uriInfo, ok := model.GetUriInfo(uri.Uri); ok && uriInfo.TypeOfURI == model.StatusURI {
 				operation.RequestBody = &openapi3.RequestBodyRef{
-					Ref: "#/components/requestBodies/Create" + crdInfo.Name + ".Status",
+					Ref: "#/components/requestBodies/Create" + name + ".Status",
 				}
 				operation.Responses = openapi3.Responses{
 					"200": &openapi3.ResponseRef{
@@ -179,7 +188,7 @@ func AddPath(uri nexus.RestURIs, datamodel string) {
 				operation.Parameters = putParams
 
 				operation.RequestBody = &openapi3.RequestBodyRef{
-					Ref: "#/components/requestBodies/Create" + crdInfo.Name,
+					Ref: "#/components/requestBodies/Create" + name,
 				}
 				operation.Responses = openapi3.Responses{
 					"200": &openapi3.ResponseRef{
@@ -204,11 +213,11 @@ func AddPath(uri nexus.RestURIs, datamodel string) {
 			}
 			if uriInfo, ok := model.GetUriInfo(uri.Uri); ok && uriInfo.TypeOfURI == model.StatusURI {
 				operation.RequestBody = &openapi3.RequestBodyRef{
-					Ref: "#/components/requestBodies/Create" + crdInfo.Name + ".Status",
+					Ref: "#/components/requestBodies/Create" + name + ".Status",
 				}
 			} else {
 				operation.RequestBody = &openapi3.RequestBodyRef{
-					Ref: "#/components/requestBodies/Create" + crdInfo.Name,
+					Ref: "#/components/requestBodies/Create" + name,
 				}
 			}
 			pathItem.Patch = operation
@@ -231,19 +240,25 @@ func AddPath(uri nexus.RestURIs, datamodel string) {
 	Schemas[datamodel].Paths[uri.Uri] = pathItem
 }
 
-// parseSpec parses openapi schema spec and status subresource
-func parseSpec(crdType string, datamodel string) {
+// parseSpec parses openapi schema spec and status subresource of the CRD version
+func parseSpec(crdType string, datamodel string, version string) {
 	crdInfo := model.CrdTypeToNodeInfo[crdType]
 	crdSpec := model.CrdTypeToSpec[crdType]
+	name := componentName(crdType, crdInfo.Name, version)
 
-	getKey := makeKey(crdInfo.Name, "Get")
-	postKey := makeKey(crdInfo.Name, "Post")
-	listKey := makeKey(crdInfo.Name, "List")
-	statusKey := makeKey(crdInfo.Name, "Status")
-	singleLinkKey := makeKey(crdInfo.Name, "SingleLink")
-	namedLinkKey := makeKey(crdInfo.Name, "NamedLink")
+	getKey := makeKey(name, "Get")
+	postKey := makeKey(name, "Post")
+	listKey := makeKey(name, "List")
+	statusKey := makeKey(name, "Status")
+	singleLinkKey := makeKey(name, "SingleLink")
+	namedLinkKey := makeKey(name, "NamedLink")
 
 	openapiSchema := crdSpec.Versions[0].Schema.OpenAPIV3Schema
+	for _, v := range crdSpec.Versions {
+		if v.Name == version {
+			openapiSchema = v.Schema.OpenAPIV3Schema
+		}
+	}
 	specProps := openapiSchema.Properties["spec"].Properties
 	jsonSpecSchema := openapi3.NewObjectSchema()
 	parseFields(jsonSpecSchema, specProps)
@@ -266,7 +281,14 @@ func parseSpec(crdType string, datamodel string) {
 	jsonListObjectSchema.WithProperty("name", openapi3.NewStringSchema())
 	jsonListObjectSchema.WithProperty("spec", jsonSpecSchema)
 	jsonListObjectSchema.WithProperty("status", jsonStatusSchema)
-	jsonListSchema := openapi3.NewArraySchema().WithItems(jsonListObjectSchema)
+	jsonListSchema := openapi3.NewObjectSchema()
+	jsonListSchema.WithProperty("items", openapi3.NewArraySchema().WithItems(jsonListObjectSchema))
+	continueSchema := openapi3.NewStringSchema()
+	continueSchema.Description = "Token of the next page, empty on the last page"
+	jsonListSchema.WithProperty("continue", continueSchema)
+	remainingItemCountSchema := openapi3.NewInt64Schema()
+	remainingItemCountSchema.Description = "Estimated number of objects after this page"
+	jsonListSchema.WithProperty("remainingItemCount", remainingItemCountSchema)
 
 	Schemas[datamodel].Components.Schemas[listKey] = openapi3.NewSchemaRef("", jsonListSchema)
 
@@ -276,14 +298,14 @@ func parseSpec(crdType string, datamodel string) {
 	Schemas[datamodel].Components.Schemas[singleLinkKey] = openapi3.NewSchemaRef("", jsonSingleLinkSchema)
 	Schemas[datamodel].Components.Schemas[namedLinkKey] = openapi3.NewSchemaRef("", jsonNamedLinkSchema)
 
-	Schemas[datamodel].Components.RequestBodies["Create"+crdInfo.Name] = &openapi3.RequestBodyRef{
+	Schemas[datamodel].Components.RequestBodies["Create"+name] = &openapi3.RequestBodyRef{
 		Value: openapi3.NewRequestBody().
 			WithDescription("Request used to create " + crdInfo.Name).
 			WithRequired(true).
 			WithJSONSchemaRef(&openapi3.SchemaRef{Ref: "#/components/schemas/" + postKey}),
 	}
 
-	Schemas[datamodel].Components.Responses["Get"+crdInfo.Name] = &openapi3.ResponseRef{
+	Schemas[datamodel].Components.Responses["Get"+name] = &openapi3.ResponseRef{
 		Value: openapi3.NewResponse().
 			WithDescription("Response returned back after getting " + crdInfo.Name + " object").
 			WithContent(
@@ -306,7 +328,7 @@ func parseSpec(crdType string, datamodel string) {
 			),
 	}
 
-	Schemas[datamodel].Components.Responses["List"+crdInfo.Name] = &openapi3.ResponseRef{
+	Schemas[datamodel].Components.Responses["List"+name] = &openapi3.ResponseRef{
 		Value: openapi3.NewResponse().
 			WithDescription("Response returned back after getting " + crdInfo.Name + " objects").
 			WithContent(
@@ -432,6 +454,67 @@ func constructUpdateParam() *openapi3.ParameterRef {
 	}
 }
 
+// constructListParams returns query parameters of list requests: pagination, filtering, sorting and field selection.
+func constructListParams() []*openapi3.ParameterRef {
+	return []*openapi3.ParameterRef{
+		{
+			Value: openapi3.NewQueryParameter("limit").
+				WithRequired(false).
+				WithSchema(openapi3.NewInt64Schema()).
+				WithDescription("Maximal number of objects in the page. Default value is 500"),
+		},
+		{
+			Value: openapi3.NewQueryParameter("continue").
+				WithRequired(false).
+				WithSchema(openapi3.NewStringSchema()).
+				WithDescription("Token of the page returned in continue field of the previous page"),
+		},
+		{
+			Value: openapi3.NewQueryParameter("filter").
+				WithRequired(false).
+				WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema())).
+				WithDescription("Returns objects with field equal (path==value) or not equal (path!=value) to the " +
+					"value, e.g. spec.domain==foo. Filters are combined with AND and applied to all objects, they can't be " +
+					"used with limit and continue"),
+		},
+		{
+			Value: openapi3.NewQueryParameter("sort").
+				WithRequired(false).
+				WithSchema(openapi3.NewStringSchema()).
+				WithDescription("Comma separated fields to sort all objects by, prefix - sorts in descending order, " +
+					"e.g. -spec.port,name. Sorting can't be used with limit and continue"),
+		},
+		{
+			Value: openapi3.NewQueryParameter("fields").
+				WithRequired(false).
+				WithSchema(openapi3.NewStringSchema()).
+				WithDescription("Comma separated fields returned for every object, e.g. spec.a,status. Name is " +
+					"always returned"),
+		},
+	}
+}
+
+// constructWatchParams returns query parameters of watch requests, which stream changes of objects as Server-Sent
+// Events instead of returning them.
+func constructWatchParams() []*openapi3.ParameterRef {
+	return []*openapi3.ParameterRef{
+		{
+			Value: openapi3.NewQueryParameter("watch").
+				WithRequired(false).
+				WithSchema(openapi3.NewBoolSchema()).
+				WithDescription("If set to true, streams ADDED, MODIFIED, DELETED and BOOKMARK events of objects " +
+					"as Server-Sent Events"),
+		},
+		{
+			Value: openapi3.NewQueryParameter("resourceVersion").
+				WithRequired(false).
+				WithSchema(openapi3.NewStringSchema()).
+				WithDescription("Streams events after the resourceVersion, it's the id of the last received event. " +
+					"Last-Event-ID header takes precedence"),
+		},
+	}
+}
+
 func paramExist(param string, params [][]string) bool {
 	for _, p := range params {
 		if p[1] == param {
@@ -455,6 +538,15 @@ func Recreate() {
 	}
 }
 
+// componentName returns name of components of the CRD version, components of the default version are named by the
+// node, e.g. management.Leader, components of other versions have the version suffix, e.g. management.Leader.v2.
+func componentName(crdType, nodeName, version string) string {
+	if version == model.GetDefaultCrdVersion(crdType) {
+		return nodeName
+	}
+	return nodeName + "." + version
+}
+
 func makeKey(crd, keyType string) string {
 	return crd + "." + keyType
 }
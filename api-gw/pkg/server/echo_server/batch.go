@@ -0,0 +1,449 @@
+package echo_server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"api-gw/pkg/client"
+	"api-gw/pkg/model"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+)
+
+// BatchURI executes many PUT, PATCH and DELETE requests of nexus URIs in one POST request.
+const BatchURI = "/apis/batch"
+
+// defaultBatchConcurrency is the number of operations of a batch executed at the same time if not given in the request.
+const defaultBatchConcurrency = 10
+
+// BatchRequest is the body of batch requests. Operations are executed in parent-before-child order: PUT and PATCH
+// operations from the root of the graph down, DELETE operations from the leaves up. Operations on the same level
+// are executed concurrently, at most Concurrency of them at a time, operations on the same object one after another
+// in the order of the request. With Atomic set, a failed operation stops the batch and the executed operations,
+// including the failed one, are rolled back. Atomic batches can't delete objects with children, because the children
+// can't be restored.
+type BatchRequest struct {
+	Atomic      bool             `json:"atomic,omitempty"`
+	Concurrency int              `json:"concurrency,omitempty"`
+	Operations  []BatchOperation `json:"operations"`
+}
+
+// BatchOperation is a request of a nexus URI, e.g. PUT /root/default/leader/default with the object as body.
+type BatchOperation struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// BatchResult is the response of an operation, in the order of operations of the request. Operations which weren't
+// executed because an atomic batch failed have status 424 (Failed Dependency).
+type BatchResult struct {
+	Status     int             `json:"status"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	RolledBack bool            `json:"rolledBack,omitempty"`
+}
+
+type BatchResponse struct {
+	Results    []BatchResult `json:"results"`
+	RolledBack bool          `json:"rolledBack,omitempty"`
+}
+
+// batchStep is an operation with the nexus object it changes.
+type batchStep struct {
+	index     int
+	op        BatchOperation
+	gvr       schema.GroupVersionResource
+	crdName   string
+	crdInfo   model.NodeInfo
+	labels    map[string]string
+	name      string
+	hashed    string
+	snapshot  *unstructured.Unstructured
+	succeeded bool
+}
+
+// BatchHandler executes operations of BatchRequest through the router, so they are handled exactly like separate
+// requests of the same client.
+func (s *EchoServer) BatchHandler(c echo.Context) error {
+	var req BatchRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, DefaultResponse{Message: fmt.Sprintf("Invalid batch request: %v", err)})
+	}
+	if req.Concurrency <= 0 {
+		req.Concurrency = defaultBatchConcurrency
+	}
+
+	resp := BatchResponse{Results: make([]BatchResult, len(req.Operations))}
+	var steps []*batchStep
+	for i, op := range req.Operations {
+		step, err := s.newBatchStep(i, op)
+		if err == nil && req.Atomic && step.op.Method == http.MethodDelete {
+			err = step.checkNoChildren()
+		}
+		if err != nil {
+			resp.Results[i] = batchError(http.StatusBadRequest, err)
+			continue
+		}
+		steps = append(steps, step)
+	}
+	if req.Atomic && len(steps) != len(req.Operations) {
+		for _, step := range steps {
+			resp.Results[step.index] = batchError(http.StatusFailedDependency, fmt.Errorf("batch wasn't executed"))
+		}
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+
+	var executed []*batchStep
+	failed := false
+	for _, level := range batchLevels(steps) {
+		if failed {
+			for _, step := range level {
+				resp.Results[step.index] = batchError(http.StatusFailedDependency, fmt.Errorf("batch failed"))
+			}
+			continue
+		}
+		levelExecuted := s.executeBatchLevel(c.Request(), level, req, resp.Results)
+		executed = append(executed, levelExecuted...)
+		for _, step := range level {
+			if !step.succeeded {
+				failed = req.Atomic
+			}
+		}
+	}
+
+	if failed {
+		resp.RolledBack = true
+		for i := len(executed) - 1; i >= 0; i-- {
+			step := executed[i]
+			if err := step.rollback(); err != nil {
+				log.Errorf("Failed to roll back %s %s of batch: %v", step.op.Method, step.op.Path, err)
+				resp.RolledBack = false
+				continue
+			}
+			resp.Results[step.index].RolledBack = true
+		}
+	}
+
+	status := http.StatusOK
+	for _, result := range resp.Results {
+		if result.Status < 200 || result.Status >= 300 {
+			status = http.StatusMultiStatus
+		}
+	}
+	return c.JSON(status, resp)
+}
+
+// newBatchStep finds the nexus URI of the operation and the object it changes.
+func (s *EchoServer) newBatchStep(index int, op BatchOperation) (*batchStep, error) {
+	op.Method = strings.ToUpper(op.Method)
+	switch op.Method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return nil, fmt.Errorf("unsupported method %q, expected PUT, PATCH or DELETE", op.Method)
+	}
+	u, err := url.Parse(op.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %v", op.Path, err)
+	}
+	path := u.Path
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	req, err := http.NewRequest(op.Method, op.Path, nil)
+	if err != nil {
+		return nil, err
+	}
+	c := s.Echo.NewContext(req, nil)
+	s.Echo.Router().Find(op.Method, path, c)
+	nexusURI, ok := batchNexusURI(c.Path())
+	if !ok {
+		return nil, fmt.Errorf("%s %s is not a nexus URI", op.Method, op.Path)
+	}
+
+	crdName := model.UriToCRDType[nexusURI]
+	crdInfo := model.CrdTypeToNodeInfo[crdName]
+	nc := &NexusContext{Context: c, NexusURI: nexusURI}
+	name, msg := getNameFromParam(nc, crdInfo)
+	if name == "" {
+		return nil, fmt.Errorf("%s", msg)
+	}
+	labels := parseLabels(nc, crdInfo.ParentHierarchy)
+	parts := strings.Split(crdName, ".")
+	return &batchStep{
+		index: index,
+		op:    op,
+		gvr: schema.GroupVersionResource{
+			Group:    strings.Join(parts[1:], "."),
+			Version:  model.GetCrdVersion(crdName, nexusURI),
+			Resource: parts[0],
+		},
+		crdName: crdName,
+		crdInfo: crdInfo,
+		labels:  labels,
+		name:    name,
+		hashed:  nexus.GetHashedName(crdName, crdInfo.ParentHierarchy, labels, name),
+	}, nil
+}
+
+// batchNexusURI returns the nexus URI registered for the echo route.
+func batchNexusURI(route string) (string, bool) {
+	for uri := range model.UriToCRDType {
+		if model.ConstructEchoPathParamURL(uri) == route {
+			return uri, true
+		}
+	}
+	return "", false
+}
+
+// batchLevels groups steps which can be executed concurrently: PUT and PATCH from parents to children, then DELETE
+// from children to parents.
+func batchLevels(steps []*batchStep) (levels [][]*batchStep) {
+	key := func(step *batchStep) int {
+		depth := len(step.crdInfo.ParentHierarchy)
+		if step.op.Method == http.MethodDelete {
+			// deletes are after all writes, deepest first
+			return 1<<16 - depth
+		}
+		return depth
+	}
+	sorted := make([]*batchStep, len(steps))
+	copy(sorted, steps)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return key(sorted[i]) < key(sorted[j])
+	})
+	for i, step := range sorted {
+		if i == 0 || key(sorted[i-1]) != key(step) {
+			levels = append(levels, nil)
+		}
+		levels[len(levels)-1] = append(levels[len(levels)-1], step)
+	}
+	return
+}
+
+// executeBatchLevel executes steps of the level and returns the executed ones, including failed, in the order of
+// execution. Steps of different objects are executed concurrently, steps of the same object one after another.
+// In an atomic batch the snapshot of the object is taken before each step and steps of an object are not executed
+// after one of them failed.
+func (s *EchoServer) executeBatchLevel(parent *http.Request, level []*batchStep, req BatchRequest,
+	results []BatchResult) (executed []*batchStep) {
+	var (
+		objects [][]*batchStep
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+	objectIndex := make(map[string]int)
+	for _, step := range level {
+		key := step.gvr.GroupResource().String() + "/" + step.hashed
+		i, ok := objectIndex[key]
+		if !ok {
+			i = len(objects)
+			objectIndex[key] = i
+			objects = append(objects, nil)
+		}
+		objects[i] = append(objects[i], step)
+	}
+
+	sem := make(chan struct{}, req.Concurrency)
+	for _, steps := range objects {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(steps []*batchStep) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			failed := false
+			for _, step := range steps {
+				if failed {
+					results[step.index] = batchError(http.StatusFailedDependency, fmt.Errorf("batch failed"))
+					continue
+				}
+				if req.Atomic {
+					if err := step.takeSnapshot(); err != nil {
+						results[step.index] = batchError(http.StatusInternalServerError, err)
+						failed = true
+						continue
+					}
+				}
+				results[step.index] = s.executeBatchOperation(parent, step.op)
+				step.succeeded = results[step.index].Status >= 200 && results[step.index].Status < 300
+				mu.Lock()
+				executed = append(executed, step)
+				mu.Unlock()
+				failed = !step.succeeded && req.Atomic
+			}
+		}(steps)
+	}
+	wg.Wait()
+	return
+}
+
+// executeBatchOperation serves the operation by the router with authentication headers of the batch request.
+func (s *EchoServer) executeBatchOperation(parent *http.Request, op BatchOperation) BatchResult {
+	req, err := http.NewRequestWithContext(parent.Context(), strings.ToUpper(op.Method), op.Path, bytes.NewReader(op.Body))
+	if err != nil {
+		return batchError(http.StatusBadRequest, err)
+	}
+	for _, header := range []string{echo.HeaderAuthorization, "Cookie"} {
+		if v := parent.Header.Get(header); v != "" {
+			req.Header.Set(header, v)
+		}
+	}
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	for k, v := range op.Headers {
+		req.Header.Set(k, v)
+	}
+
+	rec := newBatchRecorder()
+	s.Echo.ServeHTTP(rec, req)
+	result := BatchResult{Status: rec.status}
+	if body := bytes.TrimSpace(rec.body.Bytes()); len(body) > 0 {
+		if json.Valid(body) {
+			result.Body = body
+		} else {
+			result.Body, _ = json.Marshal(DefaultResponse{Message: string(body)})
+		}
+	}
+	return result
+}
+
+func batchError(status int, err error) BatchResult {
+	body, _ := json.Marshal(DefaultResponse{Message: err.Error()})
+	return BatchResult{Status: status, Body: body}
+}
+
+// takeSnapshot keeps the object before the operation, it's nil if the object doesn't exist.
+func (step *batchStep) takeSnapshot() error {
+	obj, err := client.Client.Resource(step.gvr).Get(context.TODO(), step.hashed, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	step.snapshot = obj
+	return nil
+}
+
+// checkNoChildren returns an error if the object has children. Children of a deleted object are deleted by
+// Kubernetes garbage collector and can't be restored by rollback of an atomic batch.
+func (step *batchStep) checkNoChildren() error {
+	obj, err := client.Client.Resource(step.gvr).Get(context.TODO(), step.hashed, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	for _, child := range step.crdInfo.Children {
+		v, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", child.FieldNameGvk)
+		if !found || v == nil {
+			continue
+		}
+		if m, ok := v.(map[string]interface{}); ok && len(m) == 0 {
+			continue
+		}
+		return fmt.Errorf("atomic batch can't delete %s %s with children, they can't be restored on rollback",
+			step.crdInfo.Name, step.name)
+	}
+	return nil
+}
+
+// rollback restores the object to the snapshot taken before the operation. Objects created by the batch are deleted,
+// deleted objects are created again. Atomic batches don't delete objects with children.
+func (step *batchStep) rollback() error {
+	if step.snapshot == nil {
+		err := client.DeleteObject(step.gvr, step.crdName, step.crdInfo, step.hashed)
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	current, err := client.Client.Resource(step.gvr).Get(context.TODO(), step.hashed, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return step.recreate()
+	}
+	if err != nil {
+		return err
+	}
+	current.SetLabels(step.snapshot.GetLabels())
+	current.SetAnnotations(step.snapshot.GetAnnotations())
+	current.Object["spec"] = step.snapshot.Object["spec"]
+	current, err = client.Client.Resource(step.gvr).Update(context.TODO(), current, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	if status, ok := step.snapshot.Object["status"]; ok {
+		current.Object["status"] = status
+		_, err = client.Client.Resource(step.gvr).UpdateStatus(context.TODO(), current, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+func (step *batchStep) recreate() error {
+	spec, _, _ := unstructured.NestedMap(step.snapshot.Object, "spec")
+	for _, child := range step.crdInfo.Children {
+		delete(spec, child.FieldNameGvk)
+	}
+	labels := step.snapshot.GetLabels()
+
+	var ownerReferences []metav1.OwnerReference
+	if len(step.crdInfo.ParentHierarchy) > 0 {
+		parentCrdName := step.crdInfo.ParentHierarchy[len(step.crdInfo.ParentHierarchy)-1]
+		owner, err := client.GetOwnerReference(parentCrdName, model.CrdTypeToNodeInfo[parentCrdName], labels)
+		if err != nil {
+			return err
+		}
+		ownerReferences = append(ownerReferences, owner)
+	}
+	err := client.CreateObject(step.gvr, step.snapshot.GetKind(), step.hashed, labels, spec, ownerReferences)
+	if err != nil {
+		return err
+	}
+	if len(step.crdInfo.ParentHierarchy) > 0 {
+		parentCrdName := step.crdInfo.ParentHierarchy[len(step.crdInfo.ParentHierarchy)-1]
+		return client.UpdateParentWithAddedChild(parentCrdName, model.CrdTypeToNodeInfo[parentCrdName], labels,
+			step.crdInfo, step.crdName, step.name, step.hashed)
+	}
+	return nil
+}
+
+// batchRecorder collects the response of an operation.
+type batchRecorder struct {
+	header http.Header
+	body   bytes.Buffer
+	status int
+}
+
+func newBatchRecorder() *batchRecorder {
+	return &batchRecorder{header: make(http.Header), status: http.StatusOK}
+}
+
+func (r *batchRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *batchRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *batchRecorder) WriteHeader(status int) {
+	r.status = status
+}
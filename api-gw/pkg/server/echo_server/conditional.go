@@ -0,0 +1,74 @@
+package echo_server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// resourceVersion of nexus objects is exposed as ETag. Clients send it back in If-Match header of PUT and PATCH
+// requests to update the object only if it wasn't changed since they read it, other requests fail with 412.
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// setETag sets ETag header of the response to resourceVersion of the object.
+func setETag(nc *NexusContext, obj *unstructured.Unstructured) {
+	if obj == nil || obj.GetResourceVersion() == "" {
+		return
+	}
+	nc.Response().Header().Set(headerETag, strconv.Quote(obj.GetResourceVersion()))
+}
+
+// getIfMatch returns resourceVersion given in If-Match header of the request and whether the header is present.
+// Wildcard "*" matches any existing object and is returned as an empty resourceVersion.
+func getIfMatch(nc *NexusContext) (string, bool) {
+	val := strings.TrimSpace(nc.Request().Header.Get(headerIfMatch))
+	if val == "" {
+		return "", false
+	}
+	if val == "*" {
+		return "", true
+	}
+	val = strings.TrimPrefix(val, "W/")
+	if unquoted, err := strconv.Unquote(val); err == nil {
+		val = unquoted
+	}
+	return val, true
+}
+
+// checkIfMatch returns false if If-Match header of the request doesn't match the current resourceVersion of the object.
+func checkIfMatch(nc *NexusContext, obj *unstructured.Unstructured) bool {
+	resourceVersion, ok := getIfMatch(nc)
+	if !ok {
+		return true
+	}
+	if obj == nil {
+		return false
+	}
+	return resourceVersion == "" || resourceVersion == obj.GetResourceVersion()
+}
+
+func preconditionFailed(nc *NexusContext) error {
+	return nc.JSON(http.StatusPreconditionFailed, DefaultResponse{
+		Message: "Object was modified, resourceVersion doesn't match If-Match header"})
+}
+
+// isResourceVersionConflict returns true if the object was modified by someone else during the update. Updates fail
+// with Conflict, JSON patches with a failed test of resourceVersion.
+func isResourceVersionConflict(err error) bool {
+	return errors.IsConflict(err) || strings.Contains(err.Error(), "testing value /metadata/resourceVersion failed")
+}
+
+// handleWriteError responds with 412 to conflicts of conditional requests, other errors are handled by
+// handleClientError.
+func handleWriteError(nc *NexusContext, err error) error {
+	if _, ok := getIfMatch(nc); ok && isResourceVersionConflict(err) {
+		return preconditionFailed(nc)
+	}
+	return handleClientError(nc, err)
+}
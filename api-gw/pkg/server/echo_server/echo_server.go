@@ -191,6 +191,9 @@ func (s *EchoServer) RegisterNexusRoutes() {
 	s.Echo.GET("/:datamodel/docs", SwaggerUI)
 	if common.IsModeAdmin() {
 		s.RegisterCosmosAdminRoutes()
+		s.Echo.POST(BatchURI, s.BatchHandler)
+	} else {
+		s.Echo.POST(BatchURI, s.BatchHandler, authn.VerifyAuthenticationMiddleware)
 	}
 
 	_, err := authn.RegisterCallbackHandler(s.Echo)
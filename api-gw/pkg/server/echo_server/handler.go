@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	k8sLabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -65,14 +66,22 @@ func getHandler(c echo.Context) error {
 	parts := strings.Split(crdName, ".")
 	gvr := schema.GroupVersionResource{
 		Group:    strings.Join(parts[1:], "."),
-		Version:  "v1",
+		Version:  model.GetCrdVersion(crdName, nc.NexusURI),
 		Resource: parts[0],
 	}
 
+	if isWatchRequest(nc) {
+		return watchHandler(nc, gvr, metav1.ListOptions{
+			LabelSelector: k8sLabels.Set(labels).AsSelector().String(),
+			FieldSelector: fields.OneTermEqualSelector("metadata.name", hashedName).String(),
+		}, crdInfo)
+	}
+
 	obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
 	if err != nil {
 		return handleClientError(nc, err)
 	}
+	setETag(nc, obj)
 	status := make(map[string]interface{})
 	if _, ok := obj.Object["status"]; ok {
 		status = obj.Object["status"].(map[string]interface{})
@@ -168,7 +177,7 @@ func getLinkInfo(nc *NexusContext, uriType model.URIType, crdInfo model.NodeInfo
 			if val, ok := item.GetLabels()[utils.DISPLAY_NAME_LABEL]; ok {
 				l.Name = val
 			}
-			l.Group = l.Group + "/v1"
+			l.Group = l.Group + "/" + model.GetDefaultCrdVersion(crdType)
 		}
 		return nc.JSON(http.StatusOK, l)
 	}
@@ -188,6 +197,7 @@ func getLinkInfo(nc *NexusContext, uriType model.URIType, crdInfo model.NodeInfo
 		i := 0
 		hierarchy := []string{}
 		for k, link := range m {
+			crdType := utils.GetCrdType(link.Kind, link.Group)
 			// set parent hierarchy
 			if i == 0 {
 				resourceName := utils.GetGroupResourceName(link.Kind)
@@ -196,7 +206,6 @@ func getLinkInfo(nc *NexusContext, uriType model.URIType, crdInfo model.NodeInfo
 					log.Errorf("Couldn't find object, skipping... %q", link.Name)
 					continue
 				}
-				crdType := utils.GetCrdType(link.Kind, link.Group)
 				if crdNodeInfo, ok := model.GetCRDTypeToNodeInfo(crdType); ok {
 					hierarchy = utils.GetParentHierarchy(crdNodeInfo.ParentHierarchy, item.GetLabels())
 				}
@@ -204,7 +213,7 @@ func getLinkInfo(nc *NexusContext, uriType model.URIType, crdInfo model.NodeInfo
 
 			link.Hierarchy = hierarchy
 			link.Name = k
-			link.Group = link.Group + "/v1"
+			link.Group = link.Group + "/" + model.GetDefaultCrdVersion(crdType)
 			list[i] = link
 			i++
 		}
@@ -228,13 +237,26 @@ func listHandler(c echo.Context) error {
 	parts := strings.Split(crdName, ".")
 	gvr := schema.GroupVersionResource{
 		Group:    strings.Join(parts[1:], "."),
-		Version:  "v1",
+		Version:  model.GetCrdVersion(crdName, nc.NexusURI),
 		Resource: parts[0],
 	}
 	opts := metav1.ListOptions{
 		LabelSelector: labels.AsSelector().String(),
 	}
 
+	if isWatchRequest(nc) {
+		return watchHandler(nc, gvr, opts, crdInfo)
+	}
+
+	query, err := parseListQuery(nc)
+	if err != nil {
+		return badListQuery(nc, err)
+	}
+
+	if query.needsAllItems() && (c.QueryParams().Has("limit") || c.QueryParams().Has("continue")) {
+		return badListQuery(nc, fmt.Errorf("filter and sort are applied to all objects, they can't be used with limit or continue"))
+	}
+
 	if c.QueryParams().Has("limit") {
 		i, err := strconv.ParseInt(c.QueryParams().Get("limit"), 10, 64)
 		if err != nil {
@@ -250,39 +272,57 @@ func listHandler(c echo.Context) error {
 	}
 
 	resps := make([]map[string]interface{}, 0)
-	objs, err := client.Client.Resource(gvr).List(context.TODO(), opts)
-	if err != nil {
-		return handleClientError(nc, err)
-	}
-	for _, item := range objs.Items {
-		itemName := item.GetName()
-		if val, ok := item.GetLabels()[utils.DISPLAY_NAME_LABEL]; ok {
-			itemName = val
-		}
-		status := make(map[string]interface{})
-		if _, ok := item.Object["status"]; ok {
-			status = item.Object["status"].(map[string]interface{})
+	for {
+		objs, err := client.Client.Resource(gvr).List(context.TODO(), opts)
+		if err != nil {
+			return handleClientError(nc, err)
 		}
-		delete(status, "nexus")
-		spec := make(map[string]interface{})
-		if _, ok := item.Object["spec"]; ok {
-			spec = item.Object["spec"].(map[string]interface{})
+		for _, item := range objs.Items {
+			resps = append(resps, listItem(item, crdInfo))
 		}
-		for _, v := range crdInfo.Children {
-			delete(spec, v.FieldNameGvk)
+
+		if !query.needsAllItems() {
+			return nc.JSON(http.StatusOK, ListResponse{
+				Items:              query.apply(resps),
+				Continue:           objs.GetContinue(),
+				RemainingItemCount: objs.GetRemainingItemCount(),
+			})
 		}
-		for _, v := range crdInfo.Links {
-			delete(spec, v.FieldNameGvk)
+		// filtered and sorted lists are returned in a single page
+		if objs.GetContinue() == "" {
+			return nc.JSON(http.StatusOK, ListResponse{Items: query.apply(resps)})
 		}
+		opts.Continue = objs.GetContinue()
+	}
+}
 
-		r := make(map[string]interface{})
-		r["name"] = itemName
-		r["spec"] = spec
-		r["status"] = status
-		resps = append(resps, r)
+// listItem returns name, spec and status of the object, children and links are not returned.
+func listItem(item unstructured.Unstructured, crdInfo model.NodeInfo) map[string]interface{} {
+	itemName := item.GetName()
+	if val, ok := item.GetLabels()[utils.DISPLAY_NAME_LABEL]; ok {
+		itemName = val
+	}
+	status := make(map[string]interface{})
+	if _, ok := item.Object["status"]; ok {
+		status = item.Object["status"].(map[string]interface{})
+	}
+	delete(status, "nexus")
+	spec := make(map[string]interface{})
+	if _, ok := item.Object["spec"]; ok {
+		spec = item.Object["spec"].(map[string]interface{})
+	}
+	for _, v := range crdInfo.Children {
+		delete(spec, v.FieldNameGvk)
+	}
+	for _, v := range crdInfo.Links {
+		delete(spec, v.FieldNameGvk)
 	}
 
-	return nc.JSON(http.StatusOK, resps)
+	r := make(map[string]interface{})
+	r["name"] = itemName
+	r["spec"] = spec
+	r["status"] = status
+	return r
 }
 
 // getNameFromParam gets name from param if exists
@@ -347,7 +387,7 @@ func putHandler(c echo.Context) error {
 	parts := strings.Split(crdName, ".")
 	gvr := schema.GroupVersionResource{
 		Group:    strings.Join(parts[1:], "."),
-		Version:  "v1",
+		Version:  model.GetCrdVersion(crdName, nc.NexusURI),
 		Resource: parts[0],
 	}
 	//package.Struct
@@ -362,13 +402,28 @@ func putHandler(c echo.Context) error {
 	obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
+			// If-Match requires the object to exist
+			if _, ok := getIfMatch(nc); ok {
+				return preconditionFailed(nc)
+			}
 			if uriInfo, ok := model.GetUriInfo(nc.NexusURI); ok && uriInfo.TypeOfURI == model.StatusURI {
 				return c.JSON(http.StatusNotFound, DefaultResponse{Message: "Can't put status subresource as nexus object not found"})
 			}
 
+			// Children are owned by their parent, Kubernetes deletes them together with the parent
+			var ownerReferences []metav1.OwnerReference
+			if len(crdInfo.ParentHierarchy) > 0 {
+				parentCrdName := crdInfo.ParentHierarchy[len(crdInfo.ParentHierarchy)-1]
+				owner, err := client.GetOwnerReference(parentCrdName, model.CrdTypeToNodeInfo[parentCrdName], labels)
+				if err != nil {
+					return handleClientError(nc, err)
+				}
+				ownerReferences = append(ownerReferences, owner)
+			}
+
 			// Build object
 			err = client.CreateObject(gvr,
-				crdNameParts[1], hashedName, labels, body)
+				crdNameParts[1], hashedName, labels, body, ownerReferences)
 			if err != nil {
 				return handleClientError(nc, err)
 			}
@@ -387,6 +442,9 @@ func putHandler(c echo.Context) error {
 		return handleClientError(nc, err)
 	}
 
+	if !checkIfMatch(nc, obj) {
+		return preconditionFailed(nc)
+	}
 	obj.SetLabels(labels)
 	return updateResource(nc, gvr, obj, body, crdInfo)
 }
@@ -404,7 +462,7 @@ func patchHandler(c echo.Context) error {
 	}
 
 	// Construct GroupVersionResource
-	gvr := utils.ConstructGVR(crdName)
+	gvr := utils.ConstructVersionedGVR(crdName, nc.NexusURI)
 
 	// Mangle name
 	hashedName := nexus.GetHashedName(crdName, crdInfo.ParentHierarchy, parseLabels(nc, crdInfo.ParentHierarchy), name)
@@ -415,6 +473,12 @@ func patchHandler(c echo.Context) error {
 		return err
 	}
 
+	// Merge patch with resourceVersion is applied only if the object wasn't modified in the meantime
+	var metadata map[string]interface{}
+	if resourceVersion, _ := getIfMatch(nc); resourceVersion != "" {
+		metadata = map[string]interface{}{"resourceVersion": resourceVersion}
+	}
+
 	// Handle PATCH request for status subresource
 	uriInfo, ok := model.GetUriInfo(nc.NexusURI)
 	if ok && uriInfo.TypeOfURI == model.StatusURI {
@@ -423,8 +487,10 @@ func patchHandler(c echo.Context) error {
 
 		// Prepare status patch payload
 		statusPayload := struct {
-			Status map[string]interface{} `json:"status"`
+			Metadata map[string]interface{} `json:"metadata,omitempty"`
+			Status   map[string]interface{} `json:"status"`
 		}{
+			metadata,
 			body,
 		}
 		patchBytes, err := json.Marshal(statusPayload)
@@ -432,10 +498,11 @@ func patchHandler(c echo.Context) error {
 			return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: fmt.Sprintf("error while marshaling status payload: %s", err.Error())})
 		}
 		log.Debugf("user defined status subresource PatchBytes %+v for CR %q", string(patchBytes), name)
-		_, err = client.Client.Resource(gvr).Patch(context.TODO(), hashedName, types.MergePatchType, patchBytes, metav1.PatchOptions{}, "status")
+		obj, err := client.Client.Resource(gvr).Patch(context.TODO(), hashedName, types.MergePatchType, patchBytes, metav1.PatchOptions{}, "status")
 		if err != nil {
-			return handleClientError(nc, err)
+			return handleWriteError(nc, err)
 		}
+		setETag(nc, obj)
 		return nc.JSON(http.StatusOK, DefaultResponse{Message: "Status patch applied successfully"})
 	}
 
@@ -451,8 +518,10 @@ func patchHandler(c echo.Context) error {
 
 	// Prepare patch payload
 	payload := struct {
-		Spec map[string]interface{} `json:"spec"`
+		Metadata map[string]interface{} `json:"metadata,omitempty"`
+		Spec     map[string]interface{} `json:"spec"`
 	}{
+		metadata,
 		body,
 	}
 
@@ -462,11 +531,12 @@ func patchHandler(c echo.Context) error {
 	}
 
 	log.Debugf("PatchBytes %+v for CR %q", string(patchBytes), name)
-	_, err = client.Client.Resource(gvr).Patch(context.TODO(), hashedName, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	obj, err := client.Client.Resource(gvr).Patch(context.TODO(), hashedName, types.MergePatchType, patchBytes, metav1.PatchOptions{})
 	if err != nil {
-		return handleClientError(nc, err)
+		return handleWriteError(nc, err)
 	}
 
+	setETag(nc, obj)
 	return nc.JSON(http.StatusOK, DefaultResponse{Message: "Patch applied successfully"})
 }
 
@@ -516,7 +586,7 @@ func deleteHandler(c echo.Context) error {
 	parts := strings.Split(crdName, ".")
 	gvr := schema.GroupVersionResource{
 		Group:    strings.Join(parts[1:], "."),
-		Version:  "v1",
+		Version:  model.GetCrdVersion(crdName, nc.NexusURI),
 		Resource: parts[0],
 	}
 
@@ -590,7 +660,7 @@ func parseLabels(c echo.Context, parents []string) map[string]string {
 func getUnstructuredObject(apiGroup, resourceName, name string) (*unstructured.Unstructured, error) {
 	gvr := schema.GroupVersionResource{
 		Group:    apiGroup,
-		Version:  "v1",
+		Version:  model.GetDefaultCrdVersion(resourceName + "." + apiGroup),
 		Resource: resourceName,
 	}
 
@@ -617,17 +687,34 @@ func updateResource(nc *NexusContext, gvr schema.GroupVersionResource, obj *unst
 			return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: "can't update nexus status subresource, only user defined status subresource update is allowed"})
 		}
 
+		_, conditional := getIfMatch(nc)
+
 		// Make sure status field is present first
 		var err error
 		if _, ok := obj.Object["status"]; !ok {
 			m := []byte("{\"status\":{}}")
-			_, err = client.Client.Resource(gvr).Patch(context.TODO(), obj.GetName(), types.MergePatchType, m, metav1.PatchOptions{}, "status")
+			if conditional {
+				m = []byte(fmt.Sprintf("{\"metadata\":{\"resourceVersion\":%q},\"status\":{}}", obj.GetResourceVersion()))
+			}
+			var patched *unstructured.Unstructured
+			patched, err = client.Client.Resource(gvr).Patch(context.TODO(), obj.GetName(), types.MergePatchType, m, metav1.PatchOptions{}, "status")
+			if err == nil {
+				obj = patched
+			}
 		}
 		if err != nil {
-			return handleClientError(nc, err)
+			return handleWriteError(nc, err)
 		}
 
 		patch := createStatusPatch(body)
+		if conditional {
+			// resourceVersion of the object was checked against If-Match header by putHandler
+			patch = append([]PatchOp{{
+				Op:    "test",
+				Path:  "/metadata/resourceVersion",
+				Value: obj.GetResourceVersion(),
+			}}, patch...)
+		}
 		var patchBytes []byte
 		patchBytes, err = json.Marshal(patch)
 		if err != nil {
@@ -635,10 +722,11 @@ func updateResource(nc *NexusContext, gvr schema.GroupVersionResource, obj *unst
 		}
 
 		// Update status subresource
-		_, err = client.Client.Resource(gvr).Patch(context.TODO(), obj.GetName(), types.JSONPatchType, patchBytes, metav1.PatchOptions{}, "status")
+		obj, err = client.Client.Resource(gvr).Patch(context.TODO(), obj.GetName(), types.JSONPatchType, patchBytes, metav1.PatchOptions{}, "status")
 		if err != nil {
-			return handleClientError(nc, err)
+			return handleWriteError(nc, err)
 		}
+		setETag(nc, obj)
 		return nc.JSON(http.StatusOK, DefaultResponse{Message: "Status Updated successfully"})
 	}
 
@@ -659,10 +747,11 @@ func updateResource(nc *NexusContext, gvr schema.GroupVersionResource, obj *unst
 	}
 	obj.Object["spec"] = body
 
-	_, err := client.Client.Resource(gvr).Update(context.TODO(), obj, metav1.UpdateOptions{})
+	obj, err := client.Client.Resource(gvr).Update(context.TODO(), obj, metav1.UpdateOptions{})
 	if err != nil {
-		return handleClientError(nc, err)
+		return handleWriteError(nc, err)
 	}
+	setETag(nc, obj)
 	return nc.JSON(http.StatusOK, DefaultResponse{Message: "Updated successfully"})
 }
 
@@ -20,10 +20,15 @@ import (
 	runtimenexusv1 "golang-appnet.eng.vmware.com/nexus-sdk/api/build/apis/runtime.nexus.vmware.com/v1"
 	v1 "golang-appnet.eng.vmware.com/nexus-sdk/api/build/apis/user.nexus.vmware.com/v1"
 	nexus_client "golang-appnet.eng.vmware.com/nexus-sdk/api/build/nexus-client"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
+	k8stesting "k8s.io/client-go/testing"
 
 	"api-gw/controllers"
 	"api-gw/pkg/client"
@@ -32,6 +37,7 @@ import (
 	"api-gw/pkg/model"
 	"api-gw/pkg/utils"
 
+	commonnexus "github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
 )
 
@@ -354,7 +360,7 @@ var _ = Describe("Echo server tests", func() {
 		err := listHandler(nc)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(rec.Code).To(Equal(200))
-		Expect(rec.Body.String()).Should(Equal("[]\n"))
+		Expect(rec.Body.String()).Should(Equal("{\"items\":[]}\n"))
 	})
 
 	It("shouldn't handle get query for singleton object if nexus object name is empty string", func() {
@@ -420,6 +426,53 @@ var _ = Describe("Echo server tests", func() {
 		Expect(rec.Code).To(Equal(200))
 	})
 
+	It("should handle put query of CRD version served on versioned URI", func() {
+		restUri := nexus.RestURIs{
+			Uri:     "/v2/leader",
+			Methods: nexus.DefaultHTTPMethodsResponses,
+		}
+		e.RegisterRouter(restUri)
+		model.ConstructMapCRDTypeToNode(model.Upsert, "leaders.orgchart.vmware.org", "management.Leader",
+			[]string{}, nil, nil, true, "some description")
+		model.ConstructMapURIToCRDType(model.Upsert, "leaders.orgchart.vmware.org", []nexus.RestURIs{restUri})
+		model.ConstructMapUriToUriInfo(model.Upsert, map[string]model.RestUriInfo{
+			"/v2/leader": {TypeOfURI: model.DefaultURI, Version: "v2"},
+		})
+		model.ConstructMapCRDTypeToSpec(model.Upsert, "leaders.orgchart.vmware.org", apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1", Served: true, Storage: true},
+				{Name: "v2", Served: true},
+			},
+		})
+		defer model.ConstructMapCRDTypeToSpec(model.Delete, "leaders.orgchart.vmware.org",
+			apiextensionsv1.CustomResourceDefinitionSpec{})
+
+		req := httptest.NewRequest(http.MethodPut, "/v2/leader", strings.NewReader(`{"designation": "abc"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		nc := &NexusContext{
+			NexusURI:  "/v2/leader",
+			Context:   e.Echo.NewContext(req, rec),
+			CrdType:   "leaders.orgchart.vmware.org",
+			GroupName: "orgchart.vmware.org",
+			Resource:  "leaders",
+		}
+
+		err := putHandler(nc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rec.Code).To(Equal(200))
+
+		gvr := schema.GroupVersionResource{
+			Group:    "orgchart.vmware.org",
+			Version:  "v2",
+			Resource: "leaders",
+		}
+		hashedName := commonnexus.GetHashedName("leaders.orgchart.vmware.org", []string{}, map[string]string{}, "default")
+		obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.Object["spec"]).To(HaveKeyWithValue("designation", "abc"))
+	})
+
 	It("should handle put query for singleton object with default as name", func() {
 		leaderJson := `{
 			"designation": "abc",
@@ -685,7 +738,7 @@ var _ = Describe("Echo server tests", func() {
 		err := listHandler(nc)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(rec.Code).To(Equal(200))
-		Expect(rec.Body.String()).Should(Equal("[{\"name\":\"default\",\"spec\":{\"designation\":\"abc\",\"employeeID\":100,\"name\":\"xyz\"},\"status\":{}}]\n"))
+		Expect(rec.Body.String()).Should(Equal("{\"items\":[{\"name\":\"default\",\"spec\":{\"designation\":\"abc\",\"employeeID\":100,\"name\":\"xyz\"},\"status\":{}}]}\n"))
 	})
 
 	It("should handle list query with pagination parameter", func() {
@@ -713,7 +766,220 @@ var _ = Describe("Echo server tests", func() {
 		err := listHandler(nc)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(rec.Code).To(Equal(200))
-		Expect(rec.Body.String()).Should(Equal("[{\"name\":\"default\",\"spec\":{\"designation\":\"abc\",\"employeeID\":100,\"name\":\"xyz\"},\"status\":{}}]\n"))
+		Expect(rec.Body.String()).Should(Equal("{\"items\":[{\"name\":\"default\",\"spec\":{\"designation\":\"abc\",\"employeeID\":100,\"name\":\"xyz\"},\"status\":{}}]}\n"))
+	})
+
+	It("should handle list query with filter and fields parameters", func() {
+		restUri := nexus.RestURIs{
+			Uri:     "/leaders",
+			Methods: nexus.HTTPListResponse,
+		}
+		e.RegisterRouter(restUri)
+		model.ConstructMapCRDTypeToNode(model.Upsert, "leaders.orgchart.vmware.org", "management.Leader",
+			[]string{}, nil, nil, true, "some description")
+		model.ConstructMapURIToCRDType(model.Upsert, "leaders.orgchart.vmware.org", []nexus.RestURIs{restUri})
+
+		list := func(query string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/:orgchart.Leader/?"+query, nil)
+			rec := httptest.NewRecorder()
+			c := e.Echo.NewContext(req, rec)
+			nc := &NexusContext{
+				NexusURI:  "/leaders",
+				Context:   c,
+				CrdType:   "leaders.orgchart.vmware.org",
+				GroupName: "orgchart.vmware.org",
+				Resource:  "leaders",
+			}
+			Expect(listHandler(nc)).NotTo(HaveOccurred())
+			return rec
+		}
+
+		rec := list("filter=spec.employeeID==100&sort=-spec.name&fields=spec.name,status")
+		Expect(rec.Code).To(Equal(200))
+		Expect(rec.Body.String()).Should(Equal("{\"items\":[{\"name\":\"default\",\"spec\":{\"name\":\"xyz\"},\"status\":{}}]}\n"))
+
+		rec = list("filter=spec.designation!=abc")
+		Expect(rec.Code).To(Equal(200))
+		Expect(rec.Body.String()).Should(Equal("{\"items\":[]}\n"))
+
+		rec = list("filter=spec.designation")
+		Expect(rec.Code).To(Equal(400))
+
+		// filters and sorting are applied to all objects, not to a page
+		rec = list("filter=spec.employeeID==100&limit=1")
+		Expect(rec.Code).To(Equal(400))
+		rec = list("sort=spec.name&continue=token")
+		Expect(rec.Code).To(Equal(400))
+	})
+
+	It("should stream events of list query with watch parameter", func() {
+		restUri := nexus.RestURIs{
+			Uri:     "/leaders",
+			Methods: nexus.HTTPListResponse,
+		}
+		e.RegisterRouter(restUri)
+		model.ConstructMapCRDTypeToNode(model.Upsert, "leaders.orgchart.vmware.org", "management.Leader",
+			[]string{}, nil, nil, true, "some description")
+		model.ConstructMapURIToCRDType(model.Upsert, "leaders.orgchart.vmware.org", []nexus.RestURIs{restUri})
+
+		var resourceVersion string
+		fakeWatch := watch.NewFake()
+		fakeClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+		fakeClient.PrependWatchReactor("leaders", func(action k8stesting.Action) (bool, watch.Interface, error) {
+			resourceVersion = action.(k8stesting.WatchActionImpl).GetWatchRestrictions().ResourceVersion
+			return true, fakeWatch, nil
+		})
+		dynamicClient := client.Client
+		client.Client = fakeClient
+		defer func() {
+			client.Client = dynamicClient
+		}()
+
+		req := httptest.NewRequest(http.MethodGet, "/:orgchart.Leader/?watch=true", nil)
+		req.Header.Set("Last-Event-ID", "4")
+		rec := httptest.NewRecorder()
+		c := e.Echo.NewContext(req, rec)
+		nc := &NexusContext{
+			NexusURI:  "/leaders",
+			Context:   c,
+			CrdType:   "leaders.orgchart.vmware.org",
+			GroupName: "orgchart.vmware.org",
+			Resource:  "leaders",
+		}
+		done := make(chan error)
+		go func() {
+			done <- listHandler(nc)
+		}()
+
+		leader := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":            "default",
+					"resourceVersion": "5",
+				},
+				"spec": map[string]interface{}{
+					"designation": "abc",
+				},
+			},
+		}
+		fakeWatch.Add(leader)
+		fakeWatch.Action(watch.Bookmark, leader)
+		fakeWatch.Error(&metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusGone,
+			Reason:  metav1.StatusReasonGone,
+			Message: "too old resource version",
+		})
+		Expect(<-done).NotTo(HaveOccurred())
+
+		Expect(resourceVersion).To(Equal("4"))
+		Expect(rec.Header().Get(echo.HeaderContentType)).To(Equal("text/event-stream"))
+		Expect(rec.Body.String()).To(Equal(
+			"id: 5\nevent: ADDED\ndata: {\"name\":\"default\",\"spec\":{\"designation\":\"abc\"},\"status\":{}}\n\n" +
+				"id: 5\nevent: BOOKMARK\ndata: {\"resourceVersion\":\"5\"}\n\n" +
+				"event: ERROR\ndata: {\"message\":\"too old resource version\",\"code\":410}\n\n"))
+	})
+
+	It("should handle batch request and roll back atomic batch on failure", func() {
+		restUri := nexus.RestURIs{
+			Uri:     "/team/{orgchart.Team}",
+			Methods: nexus.DefaultHTTPMethodsResponses,
+		}
+		e.RegisterRouter(restUri)
+		e.Echo.POST(BatchURI, e.BatchHandler)
+		model.ConstructMapCRDTypeToNode(model.Upsert, "teams.orgchart.vmware.org", "orgchart.Team",
+			[]string{}, nil, nil, false, "some description")
+		model.ConstructMapURIToCRDType(model.Upsert, "teams.orgchart.vmware.org", []nexus.RestURIs{restUri})
+
+		dynamicClient := client.Client
+		client.Client = fake.NewSimpleDynamicClient(runtime.NewScheme())
+		defer func() {
+			client.Client = dynamicClient
+		}()
+		gvr := schema.GroupVersionResource{
+			Group:    "orgchart.vmware.org",
+			Version:  "v1",
+			Resource: "teams",
+		}
+		hashedName := func(name string) string {
+			return commonnexus.GetHashedName("teams.orgchart.vmware.org", []string{}, map[string]string{}, name)
+		}
+
+		serveBatch := func(batch string) (int, BatchResponse) {
+			req := httptest.NewRequest(http.MethodPost, BatchURI, strings.NewReader(batch))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.Echo.ServeHTTP(rec, req)
+			var resp BatchResponse
+			Expect(json.Unmarshal(rec.Body.Bytes(), &resp)).To(Succeed())
+			return rec.Code, resp
+		}
+
+		code, resp := serveBatch(`{"operations": [
+			{"method": "PUT", "path": "/team/a", "body": {"size": 1}},
+			{"method": "PUT", "path": "/team/b", "body": {"size": 2}},
+			{"method": "POST", "path": "/team/c"}
+		]}`)
+		Expect(code).To(Equal(http.StatusMultiStatus))
+		Expect(resp.Results).To(HaveLen(3))
+		Expect(resp.Results[0].Status).To(Equal(http.StatusOK))
+		Expect(resp.Results[1].Status).To(Equal(http.StatusOK))
+		Expect(resp.Results[2].Status).To(Equal(http.StatusBadRequest))
+		_, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName("b"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		code, resp = serveBatch(`{"atomic": true, "operations": [
+			{"method": "PUT", "path": "/team/a", "body": {"size": 10}},
+			{"method": "PUT", "path": "/team/c", "body": {"size": 3}},
+			{"method": "DELETE", "path": "/team/b"},
+			{"method": "DELETE", "path": "/team/d"}
+		]}`)
+		Expect(code).To(Equal(http.StatusMultiStatus))
+		Expect(resp.RolledBack).To(BeTrue())
+		Expect(resp.Results[0].RolledBack).To(BeTrue())
+		Expect(resp.Results[1].RolledBack).To(BeTrue())
+		Expect(resp.Results[2].RolledBack).To(BeTrue())
+		Expect(resp.Results[3].Status).NotTo(Equal(http.StatusOK))
+		// the failed operation is rolled back too
+		Expect(resp.Results[3].RolledBack).To(BeTrue())
+
+		obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName("a"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.Object["spec"]).To(HaveKeyWithValue("size", BeNumerically("==", 1)))
+		_, err = client.Client.Resource(gvr).Get(context.TODO(), hashedName("b"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.Client.Resource(gvr).Get(context.TODO(), hashedName("c"), metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+
+		// operations on the same object are executed one after another and rolled back in the reverse order
+		code, resp = serveBatch(`{"atomic": true, "operations": [
+			{"method": "PUT", "path": "/team/a", "body": {"size": 20}},
+			{"method": "PUT", "path": "/team/a", "body": {"size": 30}},
+			{"method": "DELETE", "path": "/team/d"}
+		]}`)
+		Expect(code).To(Equal(http.StatusMultiStatus))
+		Expect(resp.RolledBack).To(BeTrue())
+		obj, err = client.Client.Resource(gvr).Get(context.TODO(), hashedName("a"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.Object["spec"]).To(HaveKeyWithValue("size", BeNumerically("==", 1)))
+
+		// children of deleted objects can't be restored
+		model.ConstructMapCRDTypeToNode(model.Upsert, "teams.orgchart.vmware.org", "orgchart.Team",
+			[]string{}, map[string]model.NodeHelperChild{
+				"leaders.orgchart.vmware.org": {FieldName: "Leaders", FieldNameGvk: "leadersGvk", IsNamed: true},
+			}, nil, false, "some description")
+		obj.Object["spec"] = map[string]interface{}{
+			"leadersGvk": map[string]interface{}{"l": map[string]interface{}{"name": "l"}},
+		}
+		_, err = client.Client.Resource(gvr).Update(context.TODO(), obj, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		code, resp = serveBatch(`{"atomic": true, "operations": [
+			{"method": "DELETE", "path": "/team/a"}
+		]}`)
+		Expect(code).To(Equal(http.StatusBadRequest))
+		Expect(resp.Results[0].Status).To(Equal(http.StatusBadRequest))
+		_, err = client.Client.Resource(gvr).Get(context.TODO(), hashedName("a"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
 	})
 
 	It("should handle get query", func() {
@@ -841,6 +1107,76 @@ var _ = Describe("Echo server tests", func() {
 		Expect(rec.Code).To(Equal(200))
 	})
 
+	It("should handle conditional put query with If-Match header", func() {
+		restUri := nexus.RestURIs{
+			Uri:     "/mgr/{management.Mgr}",
+			Methods: nexus.DefaultHTTPMethodsResponses,
+		}
+		e.RegisterRouter(restUri)
+		model.ConstructMapCRDTypeToNode(model.Upsert, "mgrs.orgchart.vmware.org", "management.Mgr",
+			[]string{}, nil, nil, false, "some description")
+		model.ConstructMapURIToCRDType(model.Upsert, "mgrs.orgchart.vmware.org", []nexus.RestURIs{restUri})
+
+		newContext := func(method, body, ifMatch string) (*NexusContext, *httptest.ResponseRecorder) {
+			req := httptest.NewRequest(method, "/mgr/:management.Mgr", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if ifMatch != "" {
+				req.Header.Set(headerIfMatch, ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := e.Echo.NewContext(req, rec)
+			c.SetParamNames("management.Mgr")
+			c.SetParamValues("conditional")
+			return &NexusContext{
+				NexusURI:  "/mgr/{management.Mgr}",
+				Context:   c,
+				CrdType:   "mgrs.orgchart.vmware.org",
+				GroupName: "orgchart.vmware.org",
+				Resource:  "mgrs",
+			}, rec
+		}
+
+		// If-Match requires the object to exist
+		nc, rec := newContext(http.MethodPut, `{"name": "xyz"}`, "*")
+		Expect(putHandler(nc)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusPreconditionFailed))
+
+		nc, rec = newContext(http.MethodPut, `{"name": "xyz"}`, "")
+		Expect(putHandler(nc)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		// fake client doesn't set resourceVersion of objects
+		gvr := schema.GroupVersionResource{
+			Group:    "orgchart.vmware.org",
+			Version:  "v1",
+			Resource: "mgrs",
+		}
+		hashedName := commonnexus.GetHashedName("mgrs.orgchart.vmware.org", []string{}, map[string]string{}, "conditional")
+		obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		obj.SetResourceVersion("5")
+		_, err = client.Client.Resource(gvr).Update(context.TODO(), obj, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		nc, rec = newContext(http.MethodGet, "", "")
+		Expect(getHandler(nc)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get(headerETag)).To(Equal(`"5"`))
+
+		nc, rec = newContext(http.MethodPut, `{"name": "abc"}`, `"4"`)
+		Expect(putHandler(nc)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusPreconditionFailed))
+
+		nc, rec = newContext(http.MethodPut, `{"name": "abc"}`, `"5"`)
+		Expect(putHandler(nc)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get(headerETag)).To(Equal(`"5"`))
+
+		obj, err = client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.Object["spec"]).To(Equal(map[string]interface{}{"name": "abc"}))
+	})
+
 	It("should handle delete query for non-singleton object with provided name", func() {
 		restUri := nexus.RestURIs{
 			Uri:     "/mgr/{management.Mgr}",
@@ -173,6 +173,19 @@ func KubePostHandler(c echo.Context) error {
 				content["spec"] = map[string]interface{}{}
 				body.SetUnstructuredContent(content)
 			}
+
+			// Children are owned by their parent, Kubernetes deletes them together with the parent
+			if len(crdInfo.ParentHierarchy) > 0 {
+				parentCrdName := crdInfo.ParentHierarchy[len(crdInfo.ParentHierarchy)-1]
+				owner, err := client.GetOwnerReference(parentCrdName, model.CrdTypeToNodeInfo[parentCrdName], labels)
+				if err != nil {
+					if status := kerrors.APIStatus(nil); errors.As(err, &status) {
+						return c.JSON(int(status.Status().Code), status.Status())
+					}
+					return err
+				}
+				body.SetOwnerReferences(append(body.GetOwnerReferences(), owner))
+			}
 			obj, err = client.Client.Resource(gvr).Create(context.TODO(), body, metav1.CreateOptions{})
 			if err != nil {
 				if status := kerrors.APIStatus(nil); errors.As(err, &status) {
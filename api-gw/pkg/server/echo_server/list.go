@@ -0,0 +1,230 @@
+package echo_server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ListResponse is the envelope of list responses. Continue is the token of the next page, it's empty on the last
+// page. RemainingItemCount is the estimated number of objects after this page. Filtered and sorted lists are returned
+// in a single page.
+type ListResponse struct {
+	Items              []map[string]interface{} `json:"items"`
+	Continue           string                   `json:"continue,omitempty"`
+	RemainingItemCount *int64                   `json:"remainingItemCount,omitempty"`
+}
+
+// listQuery is filtering, sorting and field selection of list items given in query parameters, e.g.
+//
+//	?filter=spec.domain==foo&filter=status.state!=failed&sort=-spec.port,name&fields=spec.domain,status
+//
+// Paths are dot separated fields of the items. Filters and sorting are applied to all objects, so they can't be
+// combined with limit and continue parameters.
+type listQuery struct {
+	filters []listFilter
+	sort    []listSort
+	fields  [][]string
+}
+
+type listFilter struct {
+	path   []string
+	value  string
+	negate bool
+}
+
+type listSort struct {
+	path       []string
+	descending bool
+}
+
+// parseListQuery parses filter, sort and fields query parameters of a list request.
+func parseListQuery(nc *NexusContext) (listQuery, error) {
+	var q listQuery
+	params := nc.QueryParams()
+	for _, filter := range params["filter"] {
+		f, err := parseListFilter(filter)
+		if err != nil {
+			return q, err
+		}
+		q.filters = append(q.filters, f)
+	}
+	for _, sortParam := range params["sort"] {
+		for _, field := range strings.Split(sortParam, ",") {
+			s := listSort{}
+			if strings.HasPrefix(field, "-") {
+				s.descending = true
+				field = field[1:]
+			}
+			path, err := parseListPath(field)
+			if err != nil {
+				return q, err
+			}
+			s.path = path
+			q.sort = append(q.sort, s)
+		}
+	}
+	for _, fieldsParam := range params["fields"] {
+		for _, field := range strings.Split(fieldsParam, ",") {
+			path, err := parseListPath(field)
+			if err != nil {
+				return q, err
+			}
+			q.fields = append(q.fields, path)
+		}
+	}
+	return q, nil
+}
+
+// needsAllItems returns true if the query filters or sorts items, which can't be done page by page.
+func (q listQuery) needsAllItems() bool {
+	return len(q.filters) > 0 || len(q.sort) > 0
+}
+
+// parseListFilter parses filter in the form path==value or path!=value.
+func parseListFilter(filter string) (listFilter, error) {
+	f := listFilter{}
+	field, value, ok := strings.Cut(filter, "!=")
+	if ok {
+		f.negate = true
+	} else if field, value, ok = strings.Cut(filter, "=="); !ok {
+		return f, fmt.Errorf("invalid filter %q, expected path==value or path!=value", filter)
+	}
+	path, err := parseListPath(field)
+	if err != nil {
+		return f, err
+	}
+	f.path = path
+	f.value = value
+	return f, nil
+}
+
+func parseListPath(field string) ([]string, error) {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return nil, fmt.Errorf("empty field name")
+	}
+	path := strings.Split(field, ".")
+	for _, p := range path {
+		if p == "" {
+			return nil, fmt.Errorf("invalid field name %q", field)
+		}
+	}
+	return path, nil
+}
+
+// apply filters, sorts and selects fields of items.
+func (q listQuery) apply(items []map[string]interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if q.matches(item) {
+			result = append(result, item)
+		}
+	}
+
+	if len(q.sort) > 0 {
+		sort.SliceStable(result, func(i, j int) bool {
+			for _, s := range q.sort {
+				c := compareListValues(listValue(result[i], s.path), listValue(result[j], s.path))
+				if c == 0 {
+					continue
+				}
+				if s.descending {
+					return c > 0
+				}
+				return c < 0
+			}
+			return false
+		})
+	}
+
+	if len(q.fields) > 0 {
+		for i, item := range result {
+			result[i] = q.selectFields(item)
+		}
+	}
+	return result
+}
+
+func (q listQuery) matches(item map[string]interface{}) bool {
+	for _, f := range q.filters {
+		v, found, _ := unstructured.NestedFieldNoCopy(item, f.path...)
+		equal := found && fmt.Sprint(v) == f.value
+		if equal == f.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// selectFields returns item with the selected fields only, name of the item is always returned.
+func (q listQuery) selectFields(item map[string]interface{}) map[string]interface{} {
+	selected := map[string]interface{}{
+		"name": item["name"],
+	}
+	for _, path := range q.fields {
+		v, found, _ := unstructured.NestedFieldNoCopy(item, path...)
+		if !found {
+			continue
+		}
+		parent := selected
+		for _, p := range path[:len(path)-1] {
+			child, ok := parent[p].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				parent[p] = child
+			}
+			parent = child
+		}
+		parent[path[len(path)-1]] = v
+	}
+	return selected
+}
+
+func listValue(item map[string]interface{}, path []string) interface{} {
+	v, _, _ := unstructured.NestedFieldNoCopy(item, path...)
+	return v
+}
+
+// compareListValues compares numbers by value and other values by their string form, missing values are last.
+func compareListValues(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	if x, ok := toFloat(a); ok {
+		if y, ok := toFloat(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func badListQuery(nc *NexusContext, err error) error {
+	return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+}
@@ -0,0 +1,136 @@
+package echo_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"api-gw/pkg/client"
+	"api-gw/pkg/model"
+)
+
+// GET and LIST requests with watch=true query parameter stream changes of nexus objects as Server-Sent Events:
+//
+//	id: <resourceVersion>
+//	event: ADDED | MODIFIED | DELETED | BOOKMARK | ERROR
+//	data: <object in the form returned by list requests>
+//
+// BOOKMARK events carry only the resourceVersion. Clients resume the watch by sending id of the last received event
+// in Last-Event-ID header, browsers do it automatically, or in resourceVersion query parameter. ERROR event with code
+// 410 means the resourceVersion is too old and the client has to list objects again.
+const (
+	headerLastEventID  = "Last-Event-ID"
+	mimeEventStream    = "text/event-stream"
+	watchKeepAliveTime = 30 * time.Second
+)
+
+type watchError struct {
+	Message string `json:"message"`
+	Code    int32  `json:"code,omitempty"`
+}
+
+func isWatchRequest(nc *NexusContext) bool {
+	watch, _ := strconv.ParseBool(nc.QueryParam("watch"))
+	return watch
+}
+
+// watchHandler streams events of objects selected by opts until the client disconnects or the watch is closed by
+// the server.
+func watchHandler(nc *NexusContext, gvr schema.GroupVersionResource, opts metav1.ListOptions, crdInfo model.NodeInfo) error {
+	opts.AllowWatchBookmarks = true
+	opts.ResourceVersion = nc.QueryParam("resourceVersion")
+	if lastEventID := nc.Request().Header.Get(headerLastEventID); lastEventID != "" {
+		opts.ResourceVersion = lastEventID
+	}
+
+	ctx := nc.Request().Context()
+	w, err := client.Client.Resource(gvr).Watch(ctx, opts)
+	if err != nil {
+		return handleClientError(nc, err)
+	}
+	defer w.Stop()
+
+	res := nc.Response()
+	res.Header().Set(echo.HeaderContentType, mimeEventStream)
+	res.Header().Set("Cache-Control", "no-cache")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	keepAlive := time.NewTicker(watchKeepAliveTime)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepAlive.C:
+			// comment lines keep idle connections open through proxies
+			if _, err = fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			if err = writeWatchEvent(res, event, crdInfo); err != nil {
+				log.Debugf("Failed to write watch event of %s: %v", crdInfo.Name, err)
+				return nil
+			}
+			if event.Type == watch.Error {
+				return nil
+			}
+		}
+	}
+}
+
+func writeWatchEvent(res *echo.Response, event watch.Event, crdInfo model.NodeInfo) error {
+	var (
+		id   string
+		data interface{}
+	)
+	if event.Type == watch.Error {
+		err := errors.FromObject(event.Object)
+		werr := watchError{Message: err.Error()}
+		if status, ok := err.(errors.APIStatus); ok {
+			werr.Code = status.Status().Code
+		}
+		data = werr
+	} else {
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			log.Debugf("Unexpected object %T in watch event of %s", event.Object, crdInfo.Name)
+			return nil
+		}
+		id = obj.GetResourceVersion()
+		if event.Type == watch.Bookmark {
+			data = map[string]string{"resourceVersion": id}
+		} else {
+			data = listItem(*obj, crdInfo)
+		}
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err = fmt.Fprintf(res, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	if _, err = fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event.Type, payload); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
@@ -12,8 +12,10 @@ import (
 	"net/http/httputil"
 	"os"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	adminnexusv1 "golang-appnet.eng.vmware.com/nexus-sdk/api/build/apis/admin.nexus.vmware.com/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -55,36 +57,36 @@ func DumpReq(req *http.Request) {
 	log.Debugf(string(requestDump))
 }
 
-func GetEnvoyInitParams() (*envoy.JwtAuthnConfig, map[string]*envoy.UpstreamConfig, map[string]*envoy.HeaderMatchedUpstream, error) {
+func GetEnvoyInitParams() (*envoy.JwtAuthnConfig, map[string]*envoy.UpstreamConfig, error) {
 	var jwt *envoy.JwtAuthnConfig
 	jwts, err := client.NexusClient.Authentication().ListOIDCs(context.TODO(), metav1.ListOptions{})
 	if err != nil {
 		log.Errorln(err)
-		return nil, nil, nil, fmt.Errorf("failed to fetch OIDCs: %s", err)
+		return nil, nil, fmt.Errorf("failed to fetch OIDCs: %s", err)
 	} else {
 		if jwts != nil && len(jwts) > 0 {
 			if len(jwts) > 1 {
-				return nil, nil, nil, fmt.Errorf("more than 1 oidc objects found")
+				return nil, nil, fmt.Errorf("more than 1 oidc objects found")
 			}
 			var issuer string
 			issuer, err = authn.GetIssuer(jwts[0])
 			if err != nil {
 				log.Errorln(err)
-				return nil, nil, nil, fmt.Errorf("failed to get issuer: %s", err)
+				return nil, nil, fmt.Errorf("failed to get issuer: %s", err)
 			}
 
 			var jwksUri string
 			jwksUri, err = authn.GetJwksUri(jwts[0])
 			if err != nil {
 				log.Errorln(err)
-				return nil, nil, nil, fmt.Errorf("failed to get jwks_uri: %s", err)
+				return nil, nil, fmt.Errorf("failed to get jwks_uri: %s", err)
 			}
 
 			var callbackEndpoint string
 			callbackEndpoint, err = authn.GetCallbackEndpoint(jwts[0])
 			if err != nil {
 				log.Errorln(err)
-				return nil, nil, nil, fmt.Errorf("failed to get callback endpoint: %s", err)
+				return nil, nil, fmt.Errorf("failed to get callback endpoint: %s", err)
 			}
 
 			jwt = &envoy.JwtAuthnConfig{
@@ -100,7 +102,7 @@ func GetEnvoyInitParams() (*envoy.JwtAuthnConfig, map[string]*envoy.UpstreamConf
 	tenantConfigs, err := client.NexusClient.Tenantconfig().ListTenants(context.TODO(), v1.ListOptions{})
 	if err != nil {
 		log.Errorln(err)
-		return nil, nil, nil, fmt.Errorf("failed to get tenantConfigs: %s", err)
+		return nil, nil, fmt.Errorf("failed to get tenantConfigs: %s", err)
 	}
 	for _, tenantConfig := range tenantConfigs {
 		envoy.TenantConfigs = append(envoy.TenantConfigs, &envoy.TenantConfig{
@@ -110,37 +112,94 @@ func GetEnvoyInitParams() (*envoy.JwtAuthnConfig, map[string]*envoy.UpstreamConf
 	}
 
 	var upstreams = make(map[string]*envoy.UpstreamConfig)
-	var headerMatchedUpstreams = make(map[string]*envoy.HeaderMatchedUpstream)
 	allUpstreams, err := client.NexusClient.Admin().ListProxyRules(context.TODO(), metav1.ListOptions{})
 	if err != nil {
 		log.Errorln(err)
-		return nil, nil, nil, fmt.Errorf("failed to get proxyrules: %s", err)
+		return nil, nil, fmt.Errorf("failed to get proxyrules: %s", err)
 	} else {
 		for _, u := range allUpstreams {
-			switch u.Spec.MatchCondition.Type {
-			case "jwt":
-				upstreams[u.Name] = &envoy.UpstreamConfig{
-					Name:          u.Name,
-					JwtClaimKey:   u.Spec.MatchCondition.Key,
-					JwtClaimValue: u.Spec.MatchCondition.Value,
-					Host:          u.Spec.Upstream.Host,
-					Port:          u.Spec.Upstream.Port,
-				}
-			case "header":
-				headerMatchedUpstreams[u.Name] = &envoy.HeaderMatchedUpstream{
-					Name:        u.Name,
-					HeaderName:  u.Spec.MatchCondition.Key,
-					HeaderValue: u.Spec.MatchCondition.Value,
-					Host:        u.Spec.Upstream.Host,
-					Port:        u.Spec.Upstream.Port,
+			upstream, err := GetUpstreamConfig(u.Name, u.Spec)
+			if err != nil {
+				log.Errorln(err)
+				return nil, nil, fmt.Errorf("invalid proxyrule %s found: %s", u.Name, err)
+			}
+			upstreams[u.Name] = upstream
+		}
+	}
+	return jwt, upstreams, nil
+}
+
+// GetUpstreamConfig converts spec of the ProxyRule to the envoy upstream config, the request must satisfy
+// MatchCondition and all of MatchConditions to be proxied to the upstream.
+func GetUpstreamConfig(name string, spec adminnexusv1.ProxyRuleSpec) (*envoy.UpstreamConfig, error) {
+	upstream := &envoy.UpstreamConfig{
+		Name: name,
+		Host: spec.Upstream.Host,
+		Port: spec.Upstream.Port,
+	}
+	conditions := append([]adminnexusv1.MatchCondition{spec.MatchCondition}, spec.MatchConditions...)
+	for _, condition := range conditions {
+		switch condition.Type {
+		case "":
+			continue
+		case "jwt":
+			if upstream.JwtClaimKey != "" {
+				return nil, fmt.Errorf("more than 1 jwt match condition found")
+			}
+			upstream.JwtClaimKey = condition.Key
+			upstream.JwtClaimValue = condition.Value
+		case "header":
+			if upstream.Headers == nil {
+				upstream.Headers = make(map[string]string)
+			}
+			upstream.Headers[condition.Key] = condition.Value
+		case "pathPrefix":
+			upstream.PathPrefix = condition.Value
+		case "pathRegex":
+			upstream.PathRegex = condition.Value
+		case "method":
+			for _, method := range strings.Split(condition.Value, ",") {
+				if method = strings.TrimSpace(method); method != "" {
+					upstream.Methods = append(upstream.Methods, strings.ToUpper(method))
 				}
-			default:
-				log.Errorln("invalid proxyrule match condition found")
-				return nil, nil, nil, fmt.Errorf("invalid proxyrule match condition found")
 			}
+		case "queryParam":
+			if upstream.QueryParams == nil {
+				upstream.QueryParams = make(map[string]string)
+			}
+			upstream.QueryParams[condition.Key] = condition.Value
+		default:
+			return nil, fmt.Errorf("match type %s not supported", condition.Type)
 		}
 	}
-	return jwt, upstreams, headerMatchedUpstreams, nil
+
+	for _, u := range spec.Upstreams {
+		upstream.WeightedUpstreams = append(upstream.WeightedUpstreams, envoy.WeightedUpstream{
+			Host:   u.Upstream.Host,
+			Port:   u.Upstream.Port,
+			Weight: u.Weight,
+		})
+	}
+
+	var err error
+	if spec.Timeout != "" {
+		if upstream.Timeout, err = time.ParseDuration(spec.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid timeout %s: %s", spec.Timeout, err)
+		}
+	}
+
+	if spec.RetryPolicy.RetryOn != "" || spec.RetryPolicy.NumRetries > 0 {
+		upstream.Retry = &envoy.RetryPolicy{
+			RetryOn:    spec.RetryPolicy.RetryOn,
+			NumRetries: spec.RetryPolicy.NumRetries,
+		}
+		if spec.RetryPolicy.PerTryTimeout != "" {
+			if upstream.Retry.PerTryTimeout, err = time.ParseDuration(spec.RetryPolicy.PerTryTimeout); err != nil {
+				return nil, fmt.Errorf("invalid per try timeout %s: %s", spec.RetryPolicy.PerTryTimeout, err)
+			}
+		}
+	}
+	return upstream, nil
 }
 
 func GetDatamodelName(crdType string) string {
@@ -174,13 +233,18 @@ Eg: For a given CRD type: roots.vmware.org and ApiVersion: vmware.org/v1,
 
 	      group => vmware.org
 		  resource => roots
-		  version => v1
+		  version => v1, the default version of the CRD
 */
 func ConstructGVR(crdType string) schema.GroupVersionResource {
+	return ConstructVersionedGVR(crdType, "")
+}
+
+// ConstructVersionedGVR constructs group, version, resource for a CRD Type with the version served on the nexus URI.
+func ConstructVersionedGVR(crdType, uri string) schema.GroupVersionResource {
 	parts := strings.Split(crdType, ".")
 	return schema.GroupVersionResource{
 		Group:    strings.Join(parts[1:], "."),
-		Version:  "v1",
+		Version:  model.GetCrdVersion(crdType, uri),
 		Resource: parts[0],
 	}
 }
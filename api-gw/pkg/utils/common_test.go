@@ -88,7 +88,7 @@ var _ = Describe("Common tests", func() {
 		//		},
 		//	},
 		//})
-		_, _, _, err := utils.GetEnvoyInitParams()
+		_, _, err := utils.GetEnvoyInitParams()
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
@@ -13,22 +13,53 @@ type Upstream struct {
 type MatchType string
 
 var (
-	Jwt    MatchType = "jwt"
-	Header MatchType = "header"
+	Jwt        MatchType = "jwt"
+	Header     MatchType = "header"
+	PathPrefix MatchType = "pathPrefix"
+	PathRegex  MatchType = "pathRegex"
+	Method     MatchType = "method"
+	QueryParam MatchType = "queryParam"
 )
 
+// MatchCondition matches the Value exactly. Key is the name of the jwt claim, header or query parameter, it isn't used
+// by path and method conditions. Value of a method condition is a comma separated list of methods, e.g. "GET,HEAD".
 type MatchCondition struct {
 	Type  MatchType `json:"type"`
 	Key   string    `json:"key"`
 	Value string    `json:"value"`
 }
 
+// WeightedUpstream receives share of the requests proportional to its weight
+type WeightedUpstream struct {
+	Upstream Upstream `json:"upstream"`
+	Weight   uint32   `json:"weight"`
+}
+
+// RetryPolicy retries requests failed on envoy retry conditions, e.g. "5xx,reset"
+type RetryPolicy struct {
+	RetryOn       string `json:"retryOn,omitempty"`
+	NumRetries    uint32 `json:"numRetries,omitempty"`
+	PerTryTimeout string `json:"perTryTimeout,omitempty"`
+}
+
 type ProxyRule struct {
 	nexus.Node
 
 	// Information about what part of the request must be matched
 	MatchCondition MatchCondition `json:"matchCondition"`
 
+	// Additional conditions, the request must satisfy all of them together with MatchCondition
+	MatchConditions []MatchCondition `json:"matchConditions,omitempty"`
+
 	// If the match condition is satisfied, the namespace of the tenant api-gw we will proxy to.
 	Upstream Upstream `json:"upstream"`
+
+	// If set, the requests are split between the upstreams by their weights instead of proxied to Upstream,
+	// e.g. for canary or blue-green deployments.
+	Upstreams []WeightedUpstream `json:"upstreams,omitempty"`
+
+	RetryPolicy RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Timeout of the whole request including retries, e.g. "15s", no timeout if empty
+	Timeout string `json:"timeout,omitempty"`
 }
@@ -0,0 +1,494 @@
+package nexus_compare
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gonvenience/ytbx"
+	"github.com/homeport/dyff/pkg/dyff"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Section identifies which part of the CRD a change was detected in.
+type Section string
+
+const (
+	SpecSection     Section = "spec"
+	StatusSection   Section = "status"
+	NexusSection    Section = "nexus"
+	VersionsSection Section = "versions"
+)
+
+// ChangeKind describes the semantic meaning of a single dyff change.
+type ChangeKind string
+
+const (
+	OptionalFieldAdded       ChangeKind = "optional-field-added"
+	RequiredFieldAdded       ChangeKind = "required-field-added"
+	RequiredConstraintDrop   ChangeKind = "required-constraint-removed"
+	FieldRemoved             ChangeKind = "field-removed"
+	TypeChanged              ChangeKind = "type-changed"
+	TypeWidened              ChangeKind = "type-widened"
+	EnumValueAdded           ChangeKind = "enum-value-added"
+	EnumValueRemoved         ChangeKind = "enum-value-removed"
+	ValidationTightened      ChangeKind = "validation-tightened"
+	ValidationRelaxed        ChangeKind = "validation-relaxed"
+	DescriptionChanged       ChangeKind = "description-changed"
+	SingletonEnabled         ChangeKind = "singleton-enabled"
+	SingletonDisabled        ChangeKind = "singleton-disabled"
+	ChildOrLinkAdded         ChangeKind = "child-or-link-added"
+	AnnotationFieldAdded     ChangeKind = "annotation-field-added"
+	ChildOrLinkRemoved       ChangeKind = "child-or-link-removed"
+	ChildOrLinkChanged       ChangeKind = "child-or-link-changed"
+	ServedVersionRemoved     ChangeKind = "served-version-removed"
+	RestURIAdded             ChangeKind = "rest-uri-added"
+	RestURIRemoved           ChangeKind = "rest-uri-removed"
+	RestAPIChanged           ChangeKind = "rest-api-changed"
+	UnclassifiedSchemaChange ChangeKind = "unclassified"
+)
+
+// breakingKinds lists the change kinds which make a stored object unreadable or invalid with the new model.
+// Everything not listed here is considered backward compatible.
+var breakingKinds = map[ChangeKind]bool{
+	RequiredFieldAdded:       true,
+	FieldRemoved:             true,
+	TypeChanged:              true,
+	EnumValueRemoved:         true,
+	ValidationTightened:      true,
+	SingletonEnabled:         true,
+	ChildOrLinkRemoved:       true,
+	ChildOrLinkChanged:       true,
+	ServedVersionRemoved:     true,
+	UnclassifiedSchemaChange: true,
+}
+
+// validationKeywords are OpenAPI keywords which restrict the set of accepted values.
+var validationKeywords = map[string]bool{
+	"enum":             true,
+	"format":           true,
+	"pattern":          true,
+	"minimum":          true,
+	"maximum":          true,
+	"exclusiveMinimum": true,
+	"exclusiveMaximum": true,
+	"minLength":        true,
+	"maxLength":        true,
+	"minItems":         true,
+	"maxItems":         true,
+	"minProperties":    true,
+	"maxProperties":    true,
+	"multipleOf":       true,
+	"uniqueItems":      true,
+	// nested schemas and rules restrict the accepted values as well
+	"x-kubernetes-validations": true,
+	"items":                    true,
+	"additionalProperties":     true,
+	"allOf":                    true,
+	"anyOf":                    true,
+	"oneOf":                    true,
+	"not":                      true,
+}
+
+// lowerBoundKeywords and upperBoundKeywords are numeric validation keywords, raising a lower bound or
+// lowering an upper bound rejects values which were accepted before.
+var lowerBoundKeywords = map[string]bool{
+	"minimum":       true,
+	"minLength":     true,
+	"minItems":      true,
+	"minProperties": true,
+}
+
+var upperBoundKeywords = map[string]bool{
+	"maximum":       true,
+	"maxLength":     true,
+	"maxItems":      true,
+	"maxProperties": true,
+}
+
+// flagKeywords are boolean validation keywords which restrict the accepted values when they are true.
+var flagKeywords = map[string]bool{
+	"exclusiveMinimum": true,
+	"exclusiveMaximum": true,
+	"uniqueItems":      true,
+}
+
+// metadataKeywords are OpenAPI keywords which don't affect whether a stored object is valid.
+var metadataKeywords = map[string]bool{
+	"description": true,
+	"title":       true,
+	"example":     true,
+	"default":     true,
+}
+
+var listEntryMatch = regexp.MustCompile(`/(required|enum)/\d+$`)
+
+// Change is a single classified difference between two versions of a CRD.
+type Change struct {
+	Section  Section    `json:"section"`
+	Path     string     `json:"path"`
+	Field    string     `json:"field,omitempty"`
+	Kind     ChangeKind `json:"kind"`
+	Breaking bool       `json:"breaking"`
+	// Source is the position of the changed DSL node or field, it is set when the report has DSL sources.
+	Source *SourcePosition `json:"source,omitempty"`
+
+	path   *ytbx.Path
+	detail dyff.Detail
+}
+
+// CompareResult holds every classified change detected between two versions of a CRD.
+type CompareResult struct {
+	Name    string   `json:"name"`
+	Node    string   `json:"node,omitempty"`
+	Changes []Change `json:"changes"`
+}
+
+// IsBreaking returns true when at least one of the changes is not backward compatible.
+func (r *CompareResult) IsBreaking() bool {
+	for _, c := range r.Changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// BreakingChanges returns only the changes which are not backward compatible.
+func (r *CompareResult) BreakingChanges() []Change {
+	var res []Change
+	for _, c := range r.Changes {
+		if c.Breaking {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// breakingReport builds a dyff report containing only breaking changes of the given section,
+// so it can be rendered with the same report writer as before.
+func (r *CompareResult) breakingReport(section Section) dyff.Report {
+	var diffs []dyff.Diff
+	for _, c := range r.Changes {
+		if !c.Breaking || c.Section != section {
+			continue
+		}
+		if len(diffs) > 0 && diffs[len(diffs)-1].Path.String() == c.path.String() {
+			diffs[len(diffs)-1].Details = append(diffs[len(diffs)-1].Details, c.detail)
+			continue
+		}
+		diffs = append(diffs, dyff.Diff{Path: c.path, Details: []dyff.Detail{c.detail}})
+	}
+	return dyff.Report{Diffs: diffs}
+}
+
+func classifyReport(section Section, r dyff.Report) []Change {
+	var changes []Change
+	for _, di := range r.Diffs {
+		for _, d := range di.Details {
+			if d.Kind == dyff.ORDERCHANGE {
+				continue
+			}
+			if section == NexusSection {
+				changes = append(changes, classifyAnnotationDetail(di.Path, d)...)
+			} else {
+				changes = append(changes, classifySchemaDetail(section, di.Path, d)...)
+			}
+		}
+	}
+	return changes
+}
+
+func newChange(section Section, path *ytbx.Path, field string, kind ChangeKind, d dyff.Detail) Change {
+	return Change{
+		Section:  section,
+		Path:     path.String(),
+		Field:    field,
+		Kind:     kind,
+		Breaking: breakingKinds[kind],
+		path:     path,
+		detail:   d,
+	}
+}
+
+// classifySchemaDetail classifies a change in the openAPIV3Schema of a CRD. Additions and removals of
+// mapping keys are split up so every added or removed key is classified on its own.
+func classifySchemaDetail(section Section, path *ytbx.Path, d dyff.Detail) []Change {
+	p := path.String()
+	last := lastPathElement(p)
+
+	switch d.Kind {
+	case dyff.ADDITION, dyff.REMOVAL:
+		node := d.To
+		if d.Kind == dyff.REMOVAL {
+			node = d.From
+		}
+		if node == nil {
+			return []Change{newChange(section, path, "", UnclassifiedSchemaChange, d)}
+		}
+		if node.Kind == yamlv3.SequenceNode {
+			return []Change{newChange(section, path, "", classifyListChange(last, d.Kind), d)}
+		}
+
+		var changes []Change
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			single := singleKeyDetail(d, node, node.Content[i], node.Content[i+1])
+			changes = append(changes, newChange(section, path, key, classifyKeyChange(last, key, d.Kind), single))
+		}
+		return changes
+
+	case dyff.MODIFICATION:
+		if m := listEntryMatch.FindStringSubmatch(p); m != nil {
+			// both lists had exactly one entry which was replaced
+			if m[1] == RequiredString {
+				return []Change{newChange(section, path, "", RequiredFieldAdded, d)}
+			}
+			return []Change{newChange(section, path, "", EnumValueRemoved, d)}
+		}
+		switch {
+		case last == "enum":
+			return []Change{newChange(section, path, "", classifyEnumChange(d.From, d.To), d)}
+		case last == "type":
+			if d.From != nil && d.To != nil && d.From.Value == "integer" && d.To.Value == "number" {
+				return []Change{newChange(section, path, "", TypeWidened, d)}
+			}
+			return []Change{newChange(section, path, "", TypeChanged, d)}
+		case metadataKeywords[last]:
+			return []Change{newChange(section, path, "", DescriptionChanged, d)}
+		case validationKeywords[last]:
+			return []Change{newChange(section, path, "", classifyValidationChange(last, d.From, d.To), d)}
+		}
+	}
+
+	return []Change{newChange(section, path, "", UnclassifiedSchemaChange, d)}
+}
+
+// classifyValidationChange compares the old and new value of a modified validation keyword. Changes which can't be
+// compared, like a new pattern or format, are assumed to reject values which were accepted before.
+func classifyValidationChange(keyword string, from, to *yamlv3.Node) ChangeKind {
+	if from == nil || to == nil || from.Kind != yamlv3.ScalarNode || to.Kind != yamlv3.ScalarNode {
+		return ValidationTightened
+	}
+	switch {
+	case lowerBoundKeywords[keyword] || upperBoundKeywords[keyword] || keyword == "multipleOf":
+		a, err := strconv.ParseFloat(from.Value, 64)
+		if err != nil {
+			return ValidationTightened
+		}
+		b, err := strconv.ParseFloat(to.Value, 64)
+		if err != nil {
+			return ValidationTightened
+		}
+		switch {
+		case lowerBoundKeywords[keyword] && b <= a, upperBoundKeywords[keyword] && b >= a:
+			return ValidationRelaxed
+		case keyword == "multipleOf" && b != 0 && math.Mod(a, b) == 0:
+			// every multiple of the old value is a multiple of the new one
+			return ValidationRelaxed
+		}
+	case flagKeywords[keyword]:
+		if to.Value == strconv.FormatBool(false) {
+			return ValidationRelaxed
+		}
+	case keyword == "additionalProperties":
+		if to.Value == strconv.FormatBool(true) {
+			return ValidationRelaxed
+		}
+	}
+	return ValidationTightened
+}
+
+// classifyEnumChange compares the old and new values of a replaced enum list.
+func classifyEnumChange(from, to *yamlv3.Node) ChangeKind {
+	if from == nil || to == nil || from.Kind != yamlv3.SequenceNode || to.Kind != yamlv3.SequenceNode {
+		return EnumValueRemoved
+	}
+	values := map[string]bool{}
+	for _, v := range to.Content {
+		values[v.Value] = true
+	}
+	for _, v := range from.Content {
+		if !values[v.Value] {
+			return EnumValueRemoved
+		}
+	}
+	return EnumValueAdded
+}
+
+func classifyListChange(list string, kind rune) ChangeKind {
+	switch {
+	case list == RequiredString && kind == dyff.ADDITION:
+		return RequiredFieldAdded
+	case list == RequiredString && kind == dyff.REMOVAL:
+		return RequiredConstraintDrop
+	case list == "enum" && kind == dyff.ADDITION:
+		return EnumValueAdded
+	case list == "enum" && kind == dyff.REMOVAL:
+		return EnumValueRemoved
+	}
+	return UnclassifiedSchemaChange
+}
+
+func classifyKeyChange(parent, key string, kind rune) ChangeKind {
+	added := kind == dyff.ADDITION
+	switch {
+	case parent == "properties" && added:
+		// whether the new field is required is reported separately by the `required` list diff
+		return OptionalFieldAdded
+	case parent == "properties":
+		return FieldRemoved
+	case key == "properties" && added:
+		// the first fields of an object, each of them is optional unless listed in `required`
+		return OptionalFieldAdded
+	case key == RequiredString && added:
+		return RequiredFieldAdded
+	case key == RequiredString:
+		return RequiredConstraintDrop
+	case metadataKeywords[key]:
+		return DescriptionChanged
+	case validationKeywords[key] && added:
+		return ValidationTightened
+	case validationKeywords[key]:
+		return ValidationRelaxed
+	case key == "type":
+		return TypeChanged
+	case added:
+		// an unknown keyword may restrict the accepted values, so it is not assumed to be compatible
+		return UnclassifiedSchemaChange
+	}
+	return FieldRemoved
+}
+
+// classifyAnnotationDetail classifies a change in the nexus annotation of a CRD. Keys added to or removed from the
+// top level of the annotation are classified like a change of the key itself, e.g. an added `is_singleton`.
+func classifyAnnotationDetail(path *ytbx.Path, d dyff.Detail) []Change {
+	node := d.To
+	if d.Kind == dyff.REMOVAL {
+		node = d.From
+	}
+	if path.String() == "/" && node != nil && node.Kind == yamlv3.MappingNode {
+		var changes []Change
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			single := singleKeyDetail(d, node, key, value)
+			from, to := value, (*yamlv3.Node)(nil)
+			if d.Kind == dyff.ADDITION {
+				from, to = nil, value
+			}
+			kind := classifyAnnotationChange("/"+key.Value, d.Kind, from, to)
+			changes = append(changes, newChange(NexusSection, path, key.Value, kind, single))
+		}
+		return changes
+	}
+	return []Change{newChange(NexusSection, path, "", classifyAnnotationChange(path.String(), d.Kind, d.From, d.To), d)}
+}
+
+func classifyAnnotationChange(p string, kind rune, from, to *yamlv3.Node) ChangeKind {
+	switch {
+	case strings.HasPrefix(p, ApiGenPath):
+		// REST URIs are not part of the stored model, so they are reported but never block an upgrade
+		switch {
+		case kind == dyff.REMOVAL && p == ApiGenPath+"/uris":
+			return RestURIRemoved
+		case kind == dyff.ADDITION && p == ApiGenPath+"/uris":
+			return RestURIAdded
+		}
+		return RestAPIChanged
+	case p == SingletonPath:
+		wasSingleton := from != nil && from.Value == strconv.FormatBool(true)
+		isSingleton := to != nil && to.Value == strconv.FormatBool(true)
+		if isSingleton && !wasSingleton {
+			return SingletonEnabled
+		}
+		return SingletonDisabled
+	case p == "/description":
+		return DescriptionChanged
+	case strings.HasPrefix(p, "/children") || strings.HasPrefix(p, "/links"):
+		switch kind {
+		case dyff.ADDITION:
+			return ChildOrLinkAdded
+		case dyff.REMOVAL:
+			return ChildOrLinkRemoved
+		}
+		// a child or link which changed its type or field is stored under a different key
+		return ChildOrLinkChanged
+	case kind == dyff.ADDITION:
+		return AnnotationFieldAdded
+	}
+	return UnclassifiedSchemaChange
+}
+
+// servedVersionChanges reports every version which is served by the old CRD and not by the new one, clients and
+// stored objects using such a version can't be read anymore.
+func servedVersionChanges(data1, data2 []byte) ([]Change, error) {
+	from, err := servedVersions(data1)
+	if err != nil {
+		return nil, err
+	}
+	to, err := servedVersions(data2)
+	if err != nil {
+		return nil, err
+	}
+
+	served := map[string]bool{}
+	for _, v := range to {
+		served[v] = true
+	}
+	var changes []Change
+	for _, v := range from {
+		if served[v] {
+			continue
+		}
+		path, err := ytbx.ParseGoPatchStylePathString(fmt.Sprintf("/spec/versions/name=%s/served", v))
+		if err != nil {
+			return nil, err
+		}
+		d := dyff.Detail{
+			Kind: dyff.MODIFICATION,
+			From: &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(true)},
+			To:   &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(false)},
+		}
+		changes = append(changes, newChange(VersionsSection, &path, v, ServedVersionRemoved, d))
+	}
+	return changes, nil
+}
+
+func servedVersions(data []byte) ([]string, error) {
+	var crd struct {
+		Spec struct {
+			Versions []struct {
+				Name   string `yaml:"name"`
+				Served bool   `yaml:"served"`
+			} `yaml:"versions"`
+		} `yaml:"spec"`
+	}
+	if err := yamlv3.Unmarshal(data, &crd); err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, v := range crd.Spec.Versions {
+		if v.Served {
+			versions = append(versions, v.Name)
+		}
+	}
+	return versions, nil
+}
+
+func singleKeyDetail(d dyff.Detail, parent, key, value *yamlv3.Node) dyff.Detail {
+	node := &yamlv3.Node{
+		Kind:    parent.Kind,
+		Tag:     parent.Tag,
+		Content: []*yamlv3.Node{key, value},
+	}
+	if d.Kind == dyff.ADDITION {
+		return dyff.Detail{Kind: d.Kind, To: node}
+	}
+	return dyff.Detail{Kind: d.Kind, From: node}
+}
+
+func lastPathElement(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
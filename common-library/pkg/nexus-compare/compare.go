@@ -2,11 +2,10 @@ package nexus_compare
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
-	"strconv"
-	"strings"
 
 	"gopkg.in/yaml.v3"
 
@@ -24,85 +23,108 @@ const (
 	RequiredString = "required"
 )
 
+// CompareFiles compares two versions of a CRD and returns true together with a human readable report
+// when the new version contains changes which are not backward compatible.
 func CompareFiles(data1, data2 []byte) (bool, *bytes.Buffer, error) {
-	buffer := new(bytes.Buffer)
-	headerColor, _ := colorful.Hex("#B9311B")
-	fileColor, _ := colorful.Hex("#088F8F")
+	result, err := Compare(data1, data2)
+	if err != nil {
+		return true, nil, err
+	}
 
-	name, err := GetSpecName(data1)
+	buffer, err := WriteBreakingChanges(result)
 	if err != nil {
 		return true, nil, err
 	}
+	return result.IsBreaking(), buffer, nil
+}
+
+// Compare compares two versions of a CRD and classifies every detected change of the spec, status,
+// nexus annotation and served versions as backward compatible or breaking.
+func Compare(data1, data2 []byte) (*CompareResult, error) {
+	name, err := GetSpecName(data1)
+	if err != nil {
+		return nil, err
+	}
 
 	spec, status, nexus, err := CompareReports(data1, data2)
 	if err != nil {
-		return true, nil, err
+		return nil, err
 	}
 
-	spd := len(spec.Diffs) > 0
-	std := len(status.Diffs) > 0
-	nd := len(nexus.Diffs) > 0
-	if !spd && !std && !nd {
-		return false, buffer, nil
+	node, err := GetNodeName(data2)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = buffer.WriteString(bunt.Style(
+	result := &CompareResult{Name: name, Node: node}
+	result.Changes = append(result.Changes, classifyReport(SpecSection, spec)...)
+	result.Changes = append(result.Changes, classifyReport(StatusSection, status)...)
+	result.Changes = append(result.Changes, classifyReport(NexusSection, nexus)...)
+
+	versions, err := servedVersionChanges(data1, data2)
+	if err != nil {
+		return nil, err
+	}
+	result.Changes = append(result.Changes, versions...)
+	return result, nil
+}
+
+// WriteBreakingChanges renders the breaking changes of the result in the colored dyff format.
+func WriteBreakingChanges(result *CompareResult) (*bytes.Buffer, error) {
+	buffer := new(bytes.Buffer)
+	headerColor, _ := colorful.Hex("#B9311B")
+	fileColor, _ := colorful.Hex("#088F8F")
+
+	if !result.IsBreaking() {
+		return buffer, nil
+	}
+
+	_, err := buffer.WriteString(bunt.Style(
 		"detected changes in model stored in ",
 		bunt.EachLine(),
 		bunt.Foreground(headerColor),
 	))
 	if err != nil {
-		return true, nil, err
+		return nil, err
 	}
 	_, err = buffer.WriteString(bunt.Style(
-		name,
+		result.Name,
 		bunt.EachLine(),
 		bunt.Foreground(fileColor),
 	))
 	if err != nil {
-		return true, nil, err
+		return nil, err
 	}
 	_, err = buffer.WriteString("\n\n")
 	if err != nil {
-		return true, nil, err
+		return nil, err
 	}
 
-	if spd {
-		_, err = buffer.WriteString("spec changes: ")
-		if err != nil {
-			return true, nil, err
-		}
-		err := PrintReportDiff(spec, buffer)
-		if err != nil {
-			return true, nil, err
-		}
+	sections := []struct {
+		section Section
+		title   string
+	}{
+		{SpecSection, "spec changes: "},
+		{StatusSection, "status changes: "},
+		{NexusSection, "nexus annotation changes: "},
+		{VersionsSection, "served version changes: "},
 	}
-	if err != nil {
-		return true, nil, err
-	}
-
-	if std {
-		_, err = buffer.WriteString("status changes: ")
-		if err != nil {
-			return true, nil, err
-		}
-		err = PrintReportDiff(status, buffer)
-		if err != nil {
-			return true, nil, err
+	for _, s := range sections {
+		report := result.breakingReport(s.section)
+		if len(report.Diffs) == 0 {
+			continue
 		}
-	}
-	if nd {
-		_, err = buffer.WriteString("nexus annotation changes: ")
+		_, err = buffer.WriteString(s.title)
 		if err != nil {
-			return true, nil, err
+			return nil, err
 		}
-		err = PrintReportDiff(nexus, buffer)
+		err = PrintReportDiff(report, buffer)
 		if err != nil {
-			return true, nil, err
+			return nil, err
 		}
 	}
 
-	return true, buffer, nil
+	return buffer, nil
 }
 
 func CompareReports(data1, data2 []byte) (dyff.Report, dyff.Report, dyff.Report, error) {
@@ -132,18 +154,15 @@ func CompareReports(data1, data2 []byte) (dyff.Report, dyff.Report, dyff.Report,
 		return dyff.Report{}, dyff.Report{}, dyff.Report{}, err
 	}
 
-	sr := getSpecificReport(report, SpecMatch)
-	sd := getSpecificReport(report, StatusMatch)
-	specDiffs := filterReport(&sr)
-	statusDiffs := filterReport(&sd)
+	specDiffs := getSpecificReport(report, SpecMatch)
+	statusDiffs := getSpecificReport(report, StatusMatch)
 
-	nd, err := getAnnotationReport(data1, data2)
+	nexusDiffs, err := getAnnotationReport(data1, data2)
 	if err != nil {
 		return dyff.Report{}, dyff.Report{}, dyff.Report{}, err
 	}
-	nexusDiffs := filterReport(&nd)
 
-	return *specDiffs, *statusDiffs, *nexusDiffs, err
+	return specDiffs, statusDiffs, nexusDiffs, err
 
 }
 
@@ -159,35 +178,6 @@ func getSpecificReport(r dyff.Report, match string) dyff.Report {
 	return r
 }
 
-func filterReport(r *dyff.Report) *dyff.Report {
-	var diffs []dyff.Diff
-	for _, di := range r.Diffs {
-		var ds []dyff.Detail
-		for _, d := range di.Details {
-			if d.From == nil && d.To != nil && !strings.HasSuffix(di.Path.String(), fmt.Sprintf("/%s", RequiredString)) { // allow to add stuff, but not in required list
-				if len(d.To.Content) == 0 || d.To.Content[0].Value != RequiredString { //allow to add required map field
-					continue
-				}
-			}
-			if di.Path.String() == SingletonPath && d.From.Value == strconv.FormatBool(true) && d.To.Value == strconv.FormatBool(false) { // allow singleton change to false
-				continue
-			}
-			if strings.HasPrefix(di.Path.String(), ApiGenPath) { //allow changes in api
-				continue
-			}
-			ds = append(ds, d)
-		}
-		if ds == nil {
-			continue
-		}
-		di.Details = ds
-		diffs = append(diffs, di)
-	}
-	r.Diffs = diffs
-	return r
-
-}
-
 func getAnnotationReport(data1, data2 []byte) (dyff.Report, error) {
 	aNexus, err := GetMapNode(data1, []string{"metadata", "annotations", "nexus"})
 	if err != nil {
@@ -258,6 +248,25 @@ func GetSpecName(data []byte) (string, error) {
 	return t["metadata"].(map[string]interface{})["name"].(string), nil
 }
 
+// GetNodeName returns the DSL node name, e.g. `gns.Gns`, stored in the nexus annotation of a CRD.
+func GetNodeName(data []byte) (string, error) {
+	ann, err := GetMapNode(data, []string{"metadata", "annotations", "nexus"})
+	if err != nil {
+		return "", err
+	}
+	annStr, ok := ann.(string)
+	if !ok {
+		return "", fmt.Errorf("nexus annotation is not a string")
+	}
+	var nexusAnnotation struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(annStr), &nexusAnnotation); err != nil {
+		return "", err
+	}
+	return nexusAnnotation.Name, nil
+}
+
 func GetMapNode(data []byte, path []string) (interface{}, error) {
 	var t interface{}
 	var ok bool
@@ -1,6 +1,9 @@
 package nexus_compare
 
 import (
+	"bytes"
+	"encoding/json"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -75,8 +78,448 @@ var _ = Describe("Compare lib tests", func() {
 			Expect(text.String()).Should(ContainSubstring(v))
 		}
 	})
+	It("should classify added optional field as compatible and added required field as breaking", func() {
+		res, err := Compare([]byte(baseSpec), []byte(addedField))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Name).To(Equal("ignorechilds.gns.tsm.tanzu.vmware.com"))
+		Expect(res.IsBreaking()).To(BeTrue())
+		Expect(res.Changes).To(ContainElement(SatisfyAll(
+			HaveField("Kind", OptionalFieldAdded),
+			HaveField("Field", "addedField"),
+			HaveField("Breaking", false),
+		)))
+		Expect(res.BreakingChanges()).To(HaveLen(1))
+		Expect(res.BreakingChanges()[0].Kind).To(Equal(RequiredFieldAdded))
+		Expect(res.BreakingChanges()[0].Section).To(Equal(StatusSection))
+	})
+	It("should classify type change and field removal as breaking", func() {
+		res, err := Compare([]byte(baseSpec), []byte(changeType))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(ContainElement(HaveField("Kind", TypeChanged)))
+
+		res, err = Compare([]byte(baseSpec), []byte(fieldDeletion))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(ContainElement(SatisfyAll(
+			HaveField("Kind", FieldRemoved),
+			HaveField("Field", "remoteGeneration"),
+		)))
+	})
+	It("should classify nexus annotation changes", func() {
+		res, err := Compare([]byte(other), []byte(other2))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.IsBreaking()).To(BeFalse())
+		Expect(res.Changes).To(ContainElement(HaveField("Kind", SingletonDisabled)))
+		Expect(res.Changes).To(ContainElement(HaveField("Kind", ChildOrLinkAdded)))
+
+		res, err = Compare([]byte(other2), []byte(other))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(ContainElement(HaveField("Kind", SingletonEnabled)))
+		Expect(res.BreakingChanges()).To(ContainElement(HaveField("Kind", ChildOrLinkRemoved)))
+	})
+	It("should classify enum value removal as breaking and enum value addition as compatible", func() {
+		res, err := Compare([]byte(enumSpec), []byte(enumValueAdded))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.IsBreaking()).To(BeFalse())
+		Expect(res.Changes).To(ContainElement(HaveField("Kind", EnumValueAdded)))
+
+		res, err = Compare([]byte(enumValueAdded), []byte(enumSpec))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(ContainElement(HaveField("Kind", EnumValueRemoved)))
+
+		ans, text, err := CompareFiles([]byte(enumValueAdded), []byte(enumSpec))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ans).To(BeTrue())
+		Expect(text.String()).Should(ContainSubstring("/properties/spec/properties/mode/enum"))
+	})
+	It("should classify added validation rules and unknown keywords as breaking", func() {
+		res, err := Compare([]byte(enumSpec), []byte(validationRuleAdded))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(ContainElement(SatisfyAll(
+			HaveField("Kind", ValidationTightened),
+			HaveField("Field", "x-kubernetes-validations"),
+		)))
+		Expect(res.BreakingChanges()).To(ContainElement(SatisfyAll(
+			HaveField("Kind", UnclassifiedSchemaChange),
+			HaveField("Field", "x-kubernetes-unknown"),
+		)))
+
+		res, err = Compare([]byte(validationRuleAdded), []byte(enumSpec))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Changes).To(ContainElement(SatisfyAll(
+			HaveField("Kind", ValidationRelaxed),
+			HaveField("Field", "x-kubernetes-validations"),
+		)))
+	})
+	It("should compare old and new values of modified validation keywords", func() {
+		res, err := Compare([]byte(limitsSpec), []byte(limitsRelaxed))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.IsBreaking()).To(BeFalse())
+		Expect(res.Changes).To(HaveLen(4))
+		Expect(res.Changes).To(HaveEach(HaveField("Kind", ValidationRelaxed)))
+
+		res, err = Compare([]byte(limitsRelaxed), []byte(limitsSpec))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(HaveLen(4))
+		Expect(res.BreakingChanges()).To(HaveEach(HaveField("Kind", ValidationTightened)))
+	})
+	It("should classify singleton and children added to the top level of the nexus annotation", func() {
+		res, err := Compare([]byte(noSingletonSpec), []byte(singletonAdded))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(ContainElement(SatisfyAll(
+			HaveField("Kind", SingletonEnabled),
+			HaveField("Field", "is_singleton"),
+		)))
+
+		res, err = Compare([]byte(singletonAdded), []byte(noSingletonSpec))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(ContainElement(SatisfyAll(
+			HaveField("Kind", ChildOrLinkRemoved),
+			HaveField("Field", "children"),
+		)))
+		Expect(res.Changes).To(ContainElement(SatisfyAll(
+			HaveField("Kind", SingletonDisabled),
+			HaveField("Field", "is_singleton"),
+		)))
+	})
+	It("should classify removed served versions as breaking", func() {
+		res, err := Compare([]byte(twoVersionsSpec), []byte(oneVersionSpec))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BreakingChanges()).To(ConsistOf(SatisfyAll(
+			HaveField("Kind", ServedVersionRemoved),
+			HaveField("Section", VersionsSection),
+			HaveField("Field", "v1alpha1"),
+		)))
+
+		ans, text, err := CompareFiles([]byte(twoVersionsSpec), []byte(oneVersionSpec))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ans).To(BeTrue())
+		Expect(text.String()).Should(ContainSubstring("/spec/versions/name=v1alpha1/served"))
+
+		res, err = Compare([]byte(oneVersionSpec), []byte(twoVersionsSpec))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.IsBreaking()).To(BeFalse())
+	})
+	It("should write json compatibility report", func() {
+		res, err := Compare([]byte(baseSpec), []byte(addedField))
+		Expect(err).NotTo(HaveOccurred())
+		var report CompatibilityReport
+		report.AddResult("crds/gns_ignorechild.yaml", res)
+		report.AddRemoved("crds/gns_foo.yaml", "foos.gns.tsm.tanzu.vmware.com")
+		report.AddAdded("crds/gns_bar.yaml", "bars.gns.tsm.tanzu.vmware.com")
+		Expect(report.IsBreaking()).To(BeTrue())
+
+		buffer := new(bytes.Buffer)
+		Expect(report.Write(buffer, JSONFormat)).To(Succeed())
+		var decoded CompatibilityReport
+		Expect(json.Unmarshal(buffer.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded.CRDs).To(HaveLen(3))
+		Expect(decoded.CRDs[0].Node).To(Equal("gns.IgnoreChild"))
+		Expect(decoded.CRDs[0].Changes).To(ContainElement(SatisfyAll(
+			HaveField("Path", "/spec/versions/name=v1/schema/openAPIV3Schema/properties/status/properties/nexus/required"),
+			HaveField("Kind", RequiredFieldAdded),
+			HaveField("Breaking", true),
+		)))
+		Expect(decoded.CRDs[1].Removed).To(BeTrue())
+		Expect(decoded.CRDs[2].Added).To(BeTrue())
+		Expect(decoded.CRDs[2].Breaking).To(BeFalse())
+	})
+	It("should write sarif compatibility report", func() {
+		res, err := Compare([]byte(baseSpec), []byte(changeType))
+		Expect(err).NotTo(HaveOccurred())
+		var report CompatibilityReport
+		report.AddResult("crds/gns_ignorechild.yaml", res)
+		report.AddAdded("crds/gns_bar.yaml", "bars.gns.tsm.tanzu.vmware.com")
+		Expect(report.IsBreaking()).To(BeTrue())
+
+		buffer := new(bytes.Buffer)
+		Expect(report.Write(buffer, SARIFFormat)).To(Succeed())
+		Expect(buffer.String()).To(ContainSubstring(`"version": "2.1.0"`))
+		Expect(buffer.String()).To(ContainSubstring(`"ruleId": "type-changed"`))
+		Expect(buffer.String()).To(ContainSubstring(`"level": "error"`))
+		Expect(buffer.String()).To(ContainSubstring(`"uri": "crds/gns_ignorechild.yaml"`))
+		Expect(buffer.String()).To(ContainSubstring(`"ruleId": "crd-added"`))
+
+		Expect(report.Write(buffer, TextFormat)).NotTo(Succeed())
+		_, err = ParseReportFormat("xml")
+		Expect(err).To(HaveOccurred())
+	})
+	It("should point changes of the compatibility report to DSL sources", func() {
+		res, err := Compare([]byte(baseSpec), []byte(addedField))
+		Expect(err).NotTo(HaveOccurred())
+		var report CompatibilityReport
+		report.AddResult("crds/gns_ignorechild.yaml", res)
+		res, err = Compare([]byte(baseSpec), []byte(changeType))
+		Expect(err).NotTo(HaveOccurred())
+		report.AddResult("crds/gns_ignorechild.yaml", res)
+		report.AddRemoved("crds/gns_foo.yaml", "foos.gns.tsm.tanzu.vmware.com")
+
+		node := SourcePosition{File: "gns/gns.go", Line: 10, Column: 6}
+		name := SourcePosition{File: "gns/gns.go", Line: 12, Column: 2}
+		addedField := SourcePosition{File: "gns/gns.go", Line: 13, Column: 2}
+		status := SourcePosition{File: "gns/gns.go", Line: 14, Column: 2}
+		report.AddSources(SourceMap{
+			"ignorechilds.gns.tsm.tanzu.vmware.com": {
+				Node:   node,
+				Spec:   map[string]SourcePosition{"name": name, "addedField": addedField},
+				Status: &status,
+			},
+		})
+
+		Expect(report.CRDs[0].Source).To(Equal(&node))
+		Expect(report.CRDs[0].Changes).To(ContainElement(SatisfyAll(
+			HaveField("Kind", RequiredFieldAdded),
+			HaveField("Source", Equal(&status)),
+		)))
+		Expect(report.CRDs[1].Changes).To(ContainElement(SatisfyAll(
+			HaveField("Kind", TypeChanged),
+			HaveField("Source", Equal(&name)),
+		)))
+		Expect(report.CRDs[2].Source).To(BeNil())
+
+		buffer := new(bytes.Buffer)
+		Expect(report.Write(buffer, SARIFFormat)).To(Succeed())
+		Expect(buffer.String()).To(ContainSubstring(`"uri": "gns/gns.go"`))
+		Expect(buffer.String()).To(ContainSubstring(`"startLine": 12`))
+		Expect(buffer.String()).To(ContainSubstring(`"uri": "crds/gns_foo.yaml"`))
+	})
 })
 
+var enumSpec = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"is_singleton":false,"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1
+      schema:
+        openAPIV3Schema:
+          properties:
+            spec:
+              properties:
+                mode:
+                  enum:
+                    - Active
+                    - Passive
+                  type: string
+              type: object
+`
+var validationRuleAdded = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"is_singleton":false,"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1
+      schema:
+        openAPIV3Schema:
+          properties:
+            spec:
+              properties:
+                mode:
+                  enum:
+                    - Active
+                    - Passive
+                  type: string
+              type: object
+              x-kubernetes-unknown: true
+              x-kubernetes-validations:
+                - rule: self.mode != 'Passive'
+`
+var enumValueAdded = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"is_singleton":false,"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1
+      schema:
+        openAPIV3Schema:
+          properties:
+            spec:
+              properties:
+                mode:
+                  enum:
+                    - Active
+                    - Passive
+                    - Standby
+                  type: string
+              type: object
+`
+var limitsSpec = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"is_singleton":false,"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1
+      schema:
+        openAPIV3Schema:
+          properties:
+            spec:
+              properties:
+                port:
+                  maximum: 1024
+                  minimum: 10
+                  multipleOf: 4
+                  type: integer
+                name:
+                  minLength: 3
+                  type: string
+              type: object
+`
+var limitsRelaxed = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"is_singleton":false,"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1
+      schema:
+        openAPIV3Schema:
+          properties:
+            spec:
+              properties:
+                port:
+                  maximum: 65535
+                  minimum: 1
+                  multipleOf: 2
+                  type: integer
+                name:
+                  minLength: 1
+                  type: string
+              type: object
+`
+var noSingletonSpec = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1
+      schema:
+        openAPIV3Schema:
+          properties:
+            spec:
+              properties:
+                mode:
+                  enum:
+                    - Active
+                    - Passive
+                  type: string
+              type: object
+`
+var singletonAdded = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"children":{"foos.gns.tsm.tanzu.vmware.com":{"fieldName":"Foo","fieldNameGvk":"fooGvk","isNamed":false}},"is_singleton":true,"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1
+      schema:
+        openAPIV3Schema:
+          properties:
+            spec:
+              properties:
+                mode:
+                  enum:
+                    - Active
+                    - Passive
+                  type: string
+              type: object
+`
+var twoVersionsSpec = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"is_singleton":false,"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1alpha1
+      served: true
+      storage: false
+      schema:
+        openAPIV3Schema:
+          type: object
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+`
+var oneVersionSpec = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    nexus: |
+      {"name":"gns.Mode","hierarchy":[],"is_singleton":false,"nexus-rest-api-gen":{"uris":null}}
+  name: modes.gns.tsm.tanzu.vmware.com
+spec:
+  group: gns.tsm.tanzu.vmware.com
+  versions:
+    - name: v1alpha1
+      served: false
+      storage: false
+      schema:
+        openAPIV3Schema:
+          type: object
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+`
+
 var baseSpec = `
 ---
 apiVersion: apiextensions.k8s.io/v1
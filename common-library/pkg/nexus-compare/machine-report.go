@@ -0,0 +1,285 @@
+package nexus_compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ReportFormat selects how a CompatibilityReport is rendered.
+type ReportFormat string
+
+const (
+	TextFormat  ReportFormat = "text"
+	JSONFormat  ReportFormat = "json"
+	SARIFFormat ReportFormat = "sarif"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	toolName     = "nexus-compare"
+	removedRule  = "crd-removed"
+	addedRule    = "crd-added"
+)
+
+// ParseReportFormat validates a report format given on the command line.
+func ParseReportFormat(format string) (ReportFormat, error) {
+	switch f := ReportFormat(format); f {
+	case "":
+		return TextFormat, nil
+	case TextFormat, JSONFormat, SARIFFormat:
+		return f, nil
+	}
+	return "", fmt.Errorf("unsupported report format %q, expected one of: %s, %s, %s", format, TextFormat, JSONFormat, SARIFFormat)
+}
+
+// CRDReport describes the compatibility of a single CRD with its previous version.
+type CRDReport struct {
+	Name     string          `json:"name"`
+	Node     string          `json:"node,omitempty"`
+	File     string          `json:"file"`
+	Source   *SourcePosition `json:"source,omitempty"`
+	Added    bool            `json:"added"`
+	Removed  bool            `json:"removed"`
+	Breaking bool            `json:"breaking"`
+	Changes  []Change        `json:"changes"`
+}
+
+// CompatibilityReport collects the compatibility results of every CRD of a datamodel.
+type CompatibilityReport struct {
+	CRDs []CRDReport `json:"crds"`
+}
+
+// AddResult adds the classified changes of a CRD stored in the given file to the report.
+func (r *CompatibilityReport) AddResult(file string, result *CompareResult) {
+	changes := result.Changes
+	if changes == nil {
+		changes = []Change{}
+	}
+	r.CRDs = append(r.CRDs, CRDReport{
+		Name:     result.Name,
+		Node:     result.Node,
+		File:     file,
+		Breaking: result.IsBreaking(),
+		Changes:  changes,
+	})
+}
+
+// AddRemoved adds a CRD which exists in the previous version only.
+func (r *CompatibilityReport) AddRemoved(file, name string) {
+	r.CRDs = append(r.CRDs, CRDReport{
+		Name:     name,
+		File:     file,
+		Removed:  true,
+		Breaking: true,
+		Changes:  []Change{},
+	})
+}
+
+// AddAdded adds a CRD which exists in the new version only.
+func (r *CompatibilityReport) AddAdded(file, name string) {
+	r.CRDs = append(r.CRDs, CRDReport{
+		Name:    name,
+		File:    file,
+		Added:   true,
+		Changes: []Change{},
+	})
+}
+
+// AddSources sets DSL positions of the nodes and changes of the report. CRDs which don't exist in the DSL anymore
+// keep pointing to their CRD file only.
+func (r *CompatibilityReport) AddSources(sources SourceMap) {
+	for i := range r.CRDs {
+		crd := &r.CRDs[i]
+		node, ok := sources[crd.Name]
+		if !ok {
+			continue
+		}
+		pos := node.Node
+		crd.Source = &pos
+		for j := range crd.Changes {
+			crd.Changes[j].Source = sources.Locate(crd.Name, crd.Changes[j])
+		}
+	}
+}
+
+// IsBreaking returns true when any CRD of the report was removed or changed incompatibly.
+func (r *CompatibilityReport) IsBreaking() bool {
+	for _, crd := range r.CRDs {
+		if crd.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// Write renders the report in the given machine-readable format.
+func (r *CompatibilityReport) Write(out io.Writer, format ReportFormat) error {
+	switch format {
+	case JSONFormat:
+		return r.WriteJSON(out)
+	case SARIFFormat:
+		return r.WriteSARIF(out)
+	}
+	return fmt.Errorf("report format %q is not machine-readable", format)
+}
+
+// WriteJSON renders the report as a JSON document.
+func (r *CompatibilityReport) WriteJSON(out io.Writer) error {
+	crds := r.CRDs
+	if crds == nil {
+		crds = []CRDReport{}
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(CompatibilityReport{CRDs: crds})
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name,omitempty"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// WriteSARIF renders the report as a SARIF 2.1.0 log. Breaking changes are reported as errors and
+// compatible changes and added CRDs as notes, the rule id of a result is the change kind.
+func (r *CompatibilityReport) WriteSARIF(out io.Writer) error {
+	rules := map[string]bool{}
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: toolName, Rules: []sarifRule{}}},
+		Results: []sarifResult{},
+	}
+	addRule := func(id string) {
+		if rules[id] {
+			return
+		}
+		rules[id] = true
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:               id,
+			ShortDescription: sarifMessage{Text: id},
+		})
+	}
+
+	for _, crd := range r.CRDs {
+		if crd.Removed {
+			addRule(removedRule)
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    removedRule,
+				Level:     "error",
+				Message:   sarifMessage{Text: fmt.Sprintf("%q is deleted", crd.Name)},
+				Locations: []sarifLocation{{PhysicalLocation: physicalLocation(crd.File, nil)}},
+			})
+			continue
+		}
+		if crd.Added {
+			addRule(addedRule)
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    addedRule,
+				Level:     "note",
+				Message:   sarifMessage{Text: fmt.Sprintf("%q is added", crd.Name)},
+				Locations: []sarifLocation{{PhysicalLocation: physicalLocation(crd.File, crd.Source)}},
+			})
+			continue
+		}
+		for _, c := range crd.Changes {
+			addRule(string(c.Kind))
+			level := "note"
+			if c.Breaking {
+				level = "error"
+			}
+			logical := sarifLogicalLocation{Name: c.Field, FullyQualifiedName: crd.Name + c.Path}
+			if crd.Node != "" {
+				logical.FullyQualifiedName = crd.Node + c.Path
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  string(c.Kind),
+				Level:   level,
+				Message: sarifMessage{Text: fmt.Sprintf("%s %s change at %s of %s", c.Kind, c.Section, c.Path, crd.Name)},
+				Locations: []sarifLocation{{
+					PhysicalLocation: physicalLocation(crd.File, c.Source),
+					LogicalLocations: []sarifLogicalLocation{logical},
+				}},
+				Properties: map[string]string{
+					"crd":     crd.Name,
+					"section": string(c.Section),
+					"path":    c.Path,
+				},
+			})
+		}
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	})
+}
+
+// physicalLocation points to the DSL source of a result when it is known and to the CRD file otherwise.
+func physicalLocation(file string, source *SourcePosition) sarifPhysicalLocation {
+	if source == nil {
+		return sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: file}}
+	}
+	return sarifPhysicalLocation{
+		ArtifactLocation: sarifArtifactLocation{URI: source.File},
+		Region:           &sarifRegion{StartLine: source.Line, StartColumn: source.Column},
+	}
+}
@@ -0,0 +1,70 @@
+package nexus_compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var specFieldMatch = regexp.MustCompile(`/properties/spec/properties/([^/]+)`)
+
+// SourcePosition is a position in a DSL source file, the file is relative to the datamodel directory.
+type SourcePosition struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column,omitempty"`
+}
+
+// NodeSources holds DSL positions of the node a CRD is generated from, of the fields of its spec, keyed by
+// their json name in the CRD, and of its status field.
+type NodeSources struct {
+	Node   SourcePosition            `json:"node"`
+	Spec   map[string]SourcePosition `json:"spec,omitempty"`
+	Status *SourcePosition           `json:"status,omitempty"`
+}
+
+// SourceMap maps names of CRDs to DSL sources of their nodes. It is written by the compiler together with the CRDs.
+type SourceMap map[string]NodeSources
+
+// LoadSourceMap reads a source map written by the compiler.
+func LoadSourceMap(path string) (SourceMap, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading DSL sources %q: %v", path, err)
+	}
+	sources := SourceMap{}
+	if err = json.Unmarshal(data, &sources); err != nil {
+		return nil, fmt.Errorf("error parsing DSL sources %q: %v", path, err)
+	}
+	return sources, nil
+}
+
+// Locate returns the DSL position of a change of the given CRD. Changes of a spec field which still exists in
+// the DSL point to the field, changes of the status to the status field and all other changes to the node.
+func (s SourceMap) Locate(crd string, c Change) *SourcePosition {
+	node, ok := s[crd]
+	if !ok {
+		return nil
+	}
+	switch c.Section {
+	case SpecSection:
+		field := c.Field
+		if m := specFieldMatch.FindStringSubmatch(c.Path); m != nil {
+			field = m[1]
+		} else if !strings.HasSuffix(c.Path, "/properties/spec/properties") {
+			field = ""
+		}
+		if pos, ok := node.Spec[field]; ok {
+			return &pos
+		}
+	case StatusSection:
+		if node.Status != nil {
+			pos := *node.Status
+			return &pos
+		}
+	}
+	pos := node.Node
+	return &pos
+}
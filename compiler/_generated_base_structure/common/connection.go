@@ -0,0 +1,114 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// EncodeCursor returns the opaque cursor of a node of a connection, it holds the given fields of the node key so that
+// the next page resumes after the node in the same order.
+func EncodeCursor(key interface{}, fields []string) string {
+	data, _ := json.Marshal(key)
+	var values map[string]json.RawMessage
+	_ = json.Unmarshal(data, &values)
+	cursor := make(map[string]json.RawMessage, len(fields))
+	for _, f := range fields {
+		if v, ok := values[f]; ok {
+			cursor[f] = v
+		}
+	}
+	data, _ = json.Marshal(cursor)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor sets the fields of key held by the cursor, a cursor always holds the Id of its node.
+func DecodeCursor(cursor string, key interface{}) error {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor %q", cursor)
+	}
+	var id struct {
+		Id *string
+	}
+	if err := json.Unmarshal(data, &id); err != nil || id.Id == nil {
+		return fmt.Errorf("invalid cursor %q", cursor)
+	}
+	if err := json.Unmarshal(data, key); err != nil {
+		return fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return nil
+}
+
+// Paginate returns the range of positions of a connection of total nodes, which starts at start and holds at most
+// first nodes.
+func Paginate(total int, start int, first *int) (int, int, error) {
+	if start > total {
+		start = total
+	}
+	end := total
+	if first != nil {
+		if *first < 0 {
+			return 0, 0, fmt.Errorf("first must not be negative, got %d", *first)
+		}
+		if start+*first < end {
+			end = start + *first
+		}
+	}
+	return start, end, nil
+}
+
+// MatchFilter checks that the value of a node field is equal to the value of the filter, fields without a value in
+// the filter match every node. Both value and filter are pointers to the field type.
+func MatchFilter(value, filter interface{}) bool {
+	f := reflect.ValueOf(filter)
+	if f.IsNil() {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	if v.IsNil() {
+		return false
+	}
+	return v.Elem().Interface() == f.Elem().Interface()
+}
+
+// CompareFields compares the values of a field of two nodes, a node without the value is lower. Both a and b are
+// pointers to the field type.
+func CompareFields(a, b interface{}) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch {
+	case va.IsNil() && vb.IsNil():
+		return 0
+	case va.IsNil():
+		return -1
+	case vb.IsNil():
+		return 1
+	}
+	va, vb = va.Elem(), vb.Elem()
+	switch va.Kind() {
+	case reflect.String:
+		return strings.Compare(va.String(), vb.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if va.Int() != vb.Int() {
+			return compareLess(va.Int() < vb.Int())
+		}
+	case reflect.Float32, reflect.Float64:
+		if va.Float() != vb.Float() {
+			return compareLess(va.Float() < vb.Float())
+		}
+	case reflect.Bool:
+		if va.Bool() != vb.Bool() {
+			return compareLess(vb.Bool())
+		}
+	}
+	return 0
+}
+
+func compareLess(less bool) int {
+	if less {
+		return -1
+	}
+	return 1
+}
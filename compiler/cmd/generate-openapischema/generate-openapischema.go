@@ -3,9 +3,11 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
+	nexus_compare "github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus-compare"
 	generator "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/openapi_generator"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/openapi_generator/openapi"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/kube-openapi/pkg/validation/spec"
@@ -16,10 +18,18 @@ func main() {
 		yamlsPath        string
 		existingCRDsPath string
 		forceUpgrade     string // Used to denote the forced upgrade of a data model.
+		reportFormat     string
+		reportPath       string
+		sourcesPath      string
 	)
 	flag.StringVar(&yamlsPath, "yamls-path", "", "Path to directory containing CRD YAML definitions")
 	flag.StringVar(&existingCRDsPath, "existing-CRDs-Path", "", "Path to directory containing existing CRD YAML definitions")
 	flag.StringVar(&forceUpgrade, "force", "", "Set to true to force the nexus datamodel upgrade. \"+\n\t\t\t\"Defaults to `false`")
+	flag.StringVar(&reportFormat, "compatibility-report-format", "text", "Format of the datamodel compatibility report: text, json or sarif")
+	flag.StringVar(&reportPath, "compatibility-report-path", "", "Path of the file the json or sarif compatibility report is written to. "+
+		"Defaults to stdout")
+	flag.StringVar(&sourcesPath, "dsl-sources-path", "", "Path of the DSL sources file written by nexus-sdk, "+
+		"changes of the json or sarif compatibility report point to the DSL when it is set")
 	flag.Parse()
 	if yamlsPath == "" {
 		panic("yamls-path is empty. Run with -h for help")
@@ -30,6 +40,23 @@ func main() {
 		panic(fmt.Sprintf("parsing command line argument: force, failed with error: %v", err))
 	}
 
+	format, err := nexus_compare.ParseReportFormat(reportFormat)
+	if err != nil {
+		panic(fmt.Sprintf("parsing command line argument: compatibility-report-format, failed with error: %v", err))
+	}
+	if reportPath != "" && format == nexus_compare.TextFormat {
+		panic("compatibility-report-path can't be used with the text compatibility-report-format. Use json or sarif")
+	}
+	reportOpts := generator.CompatibilityReportOptions{Format: format, Output: os.Stdout, SourcesPath: sourcesPath}
+	if reportPath != "" {
+		f, err := os.Create(reportPath)
+		if err != nil {
+			panic(fmt.Sprintf("creating compatibility report file %q failed with error: %v", reportPath, err))
+		}
+		defer f.Close()
+		reportOpts.Output = f
+	}
+
 	ref := func(pkg string) spec.Ref {
 		r, err := spec.NewRef(strings.ToLower(pkg))
 		if err != nil {
@@ -61,7 +88,7 @@ func main() {
 		panic(err)
 	}
 
-	if err = generator.CheckBackwardCompatibility(existingCRDsPath, yamlsPath, force); err != nil {
+	if err = generator.CheckBackwardCompatibilityWithReport(existingCRDsPath, yamlsPath, force, reportOpts); err != nil {
 		panic(fmt.Sprintf("Datamodel backward compatibility check failed with error: %v", err))
 	}
 }
@@ -2,14 +2,17 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser/rest"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/generator"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/preparser"
 )
 
 func main() {
@@ -17,6 +20,9 @@ func main() {
 	dslDir := flag.String("dsl", "datamodel", "DSL file location.")
 	crdDir := flag.String("crd-output", "_generated", "CRD file location.")
 	logLevel := flag.String("log-level", "ERROR", "Log level")
+	diagnosticsFormat := flag.String("diagnostics-format", "text", "Format of DSL errors and warnings: text or json.")
+	cacheDir := flag.String("cache-dir", "", "Build cache location, packages which didn't change are not rendered again.")
+	watch := flag.Bool("watch", false, "Watch the DSL directory and compile it again on every change.")
 	flag.Parse()
 
 	lvl, err := log.ParseLevel(*logLevel)
@@ -24,6 +30,10 @@ func main() {
 		log.Fatalf("Failed to configure logging: %v\n", err)
 	}
 	log.SetLevel(lvl)
+	if err = diagnostics.Configure(*diagnosticsFormat); err != nil {
+		log.Fatalf("Failed to configure diagnostics: %v\n", err)
+	}
+	defer diagnostics.Flush()
 
 	conf := &config.Config{}
 	if *configFile != "" {
@@ -45,15 +55,48 @@ func main() {
 		log.Fatalf("failed to determine CRD group name, please add to config file as" +
 			" groupName or as GROUP_NAME enviroment variable")
 	}
+	if *cacheDir != "" {
+		conf.CacheDir = *cacheDir
+	}
 
 	config.ConfigInstance = conf
-	pkgs := parser.ParseDSLPkg(*dslDir)
+	if *watch {
+		watchDSL(conf, *dslDir, *crdDir)
+		return
+	}
+	if err = compile(conf, *dslDir, *crdDir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// compile checks the DSL with the preparser, parses it and renders the nexus-sdk templates. It doesn't merge
+// packages with the preparser and doesn't run generation of kubernetes clients, openapi schemas and gqlgen, those
+// are steps of `make generate_code`. Errors in the DSL are recorded as diagnostics and returned.
+func compile(conf *config.Config, dslDir, crdDir string) error {
+	rest.ResetURIs()
+	if _, err := preparser.ParsePackages(dslDir); err != nil {
+		return err
+	}
+	pkgs, err := parser.ParseDSLPackages(dslDir)
+	if err != nil {
+		return err
+	}
 	graphlqQueries := parser.ParseGraphqlQuerySpecs(pkgs)
-	graph, nonNexusTypes, fileset := parser.ParseDSLNodes(*dslDir, conf.GroupName, pkgs, graphlqQueries)
+	graph, nonNexusTypes, fileset, err := parser.ParseDSLGraph(dslDir, conf.GroupName, pkgs, graphlqQueries)
+	if err != nil {
+		return err
+	}
 	methods, codes := rest.ParseResponses(pkgs)
-	graphqlFiles := parser.ParseGraphQLFiles(*dslDir)
+	graphqlFiles := parser.ParseGraphQLFiles(dslDir)
+	if err = diagnostics.Err(); err != nil {
+		return err
+	}
 	if err = generator.RenderCRDTemplate(conf.GroupName, conf.CrdModulePath, pkgs, graph,
-		*crdDir, methods, codes, nonNexusTypes, fileset, graphqlFiles); err != nil {
-		log.Fatalf("Error rendering crd template: %v", err)
+		crdDir, methods, codes, nonNexusTypes, fileset, graphqlFiles); err != nil {
+		return fmt.Errorf("error rendering crd template: %v", err)
+	}
+	if err = generator.RenderDSLSources(conf.GroupName, dslDir, crdDir, pkgs); err != nil {
+		return fmt.Errorf("error rendering DSL sources: %v", err)
 	}
+	return nil
 }
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/watcher"
+)
+
+// watchDSL compiles the DSL and compiles it again on every change until the process is interrupted.
+// A summary of diagnostics is printed after every compilation, errors in the DSL don't stop watching.
+func watchDSL(conf *config.Config, dslDir, crdDir string) {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	watchCompile(conf, dslDir, crdDir, nil)
+	w := watcher.New(dslDir, append(conf.IgnoredDirs, filepath.Base(crdDir)))
+	err := w.Run(ctx, func(changed []string) {
+		watchCompile(conf, dslDir, crdDir, changed)
+	})
+	if err != nil {
+		log.Fatalf("Failed to watch DSL directory %s: %v", dslDir, err)
+	}
+}
+
+// watchCompile compiles the DSL and prints the diagnostics and a summary of the compilation.
+func watchCompile(conf *config.Config, dslDir, crdDir string, changed []string) {
+	start := time.Now()
+	if len(changed) == 1 {
+		fmt.Fprintf(os.Stderr, "%s changed, compiling...\n", changed[0])
+	} else if len(changed) > 1 {
+		fmt.Fprintf(os.Stderr, "%d files changed, compiling...\n", len(changed))
+	}
+
+	err := compile(conf, dslDir, crdDir)
+	warnings := 0
+	for _, d := range diagnostics.Default.Diagnostics() {
+		if d.Severity == diagnostics.Warning {
+			warnings++
+		}
+	}
+	diagnostics.Flush()
+
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Compilation failed after %v: %v, waiting for changes...\n", elapsed, err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Compiled %s in %v with %d warning(s), waiting for changes...\n", dslDir, elapsed, warnings)
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/preparser"
@@ -15,6 +16,7 @@ func main() {
 	outputDir := flag.String("output", "_generated", "output dir location.")
 	modPath := flag.String("modpath", "datamodel", "ModPath for rendered imports")
 	logLevel := flag.String("log-level", "ERROR", "Log level")
+	diagnosticsFormat := flag.String("diagnostics-format", "text", "Format of DSL errors and warnings: text or json.")
 	flag.Parse()
 
 	lvl, err := log.ParseLevel(*logLevel)
@@ -22,6 +24,10 @@ func main() {
 		log.Fatalf("Failed to configure logging: %v\n", err)
 	}
 	log.SetLevel(lvl)
+	if err = diagnostics.Configure(*diagnosticsFormat); err != nil {
+		log.Fatalf("Failed to configure diagnostics: %v\n", err)
+	}
+	defer diagnostics.Flush()
 
 	conf := &config.Config{}
 	if *configFile != "" {
@@ -170,6 +170,7 @@ type Gns struct {
 	nexus.Node
 	//nexus-validation: MaxLength=8, MinLength=2
 	//nexus-validation: Pattern=abc
+	// nexus-unique
 	Domain                 string
 	UseSharedGateway       bool
 	Annotations            string             `nexus-graphql-jsonencoded:""`
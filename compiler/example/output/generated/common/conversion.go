@@ -0,0 +1,127 @@
+package common
+
+import (
+	"encoding/json"
+)
+
+// CONVERSION_DATA_ANNOTATION_PREFIX prefixes annotations keeping fields of an object which don't exist in the
+// version it was converted to, the prefix is followed by the version the fields belong to.
+const CONVERSION_DATA_ANNOTATION_PREFIX = "nexus/conversion-data-"
+
+// ConvertPreservingFields converts src of version fromVersion to dst of version toVersion by copying all fields
+// with matching names. Fields of src which don't exist in toVersion are stored in an annotation of dst, fields
+// of toVersion stored in an annotation of src by a previous conversion are restored, so converting an object to
+// another version and back doesn't lose any data.
+func ConvertPreservingFields(src, dst interface{}, fromVersion, toVersion string) error {
+	srcFields, err := toFields(src)
+	if err != nil {
+		return err
+	}
+	stored := removeAnnotation(srcFields, CONVERSION_DATA_ANNOTATION_PREFIX+toVersion)
+
+	if err = fromFields(srcFields, dst); err != nil {
+		return err
+	}
+	dstFields, err := toFields(dst)
+	if err != nil {
+		return err
+	}
+
+	lost := missingFields(srcFields, dstFields)
+	for _, key := range []string{"apiVersion", "kind", "metadata"} {
+		delete(lost, key)
+	}
+	if stored != "" {
+		restored := map[string]interface{}{}
+		if err = json.Unmarshal([]byte(stored), &restored); err != nil {
+			return err
+		}
+		mergeMissingFields(dstFields, restored)
+	}
+	if len(lost) > 0 {
+		data, err := json.Marshal(lost)
+		if err != nil {
+			return err
+		}
+		setAnnotation(dstFields, CONVERSION_DATA_ANNOTATION_PREFIX+fromVersion, string(data))
+	}
+	return fromFields(dstFields, dst)
+}
+
+func toFields(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	return fields, json.Unmarshal(data, &fields)
+}
+
+func fromFields(fields map[string]interface{}, obj interface{}) error {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, obj)
+}
+
+// missingFields returns fields of src, recursively, which don't exist in dst.
+func missingFields(src, dst map[string]interface{}) map[string]interface{} {
+	missing := map[string]interface{}{}
+	for key, value := range src {
+		dstValue, ok := dst[key]
+		if !ok {
+			missing[key] = value
+			continue
+		}
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dstValue.(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			if nested := missingFields(srcMap, dstMap); len(nested) > 0 {
+				missing[key] = nested
+			}
+		}
+	}
+	return missing
+}
+
+// mergeMissingFields adds fields of src, recursively, which don't exist in dst.
+func mergeMissingFields(dst, src map[string]interface{}) {
+	for key, value := range src {
+		dstValue, ok := dst[key]
+		if !ok {
+			dst[key] = value
+			continue
+		}
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dstValue.(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeMissingFields(dstMap, srcMap)
+		}
+	}
+}
+
+func removeAnnotation(fields map[string]interface{}, key string) string {
+	metadata, _ := fields["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	value, _ := annotations[key].(string)
+	delete(annotations, key)
+	if annotations != nil && len(annotations) == 0 {
+		delete(metadata, "annotations")
+	}
+	return value
+}
+
+func setAnnotation(fields map[string]interface{}, key, value string) {
+	metadata, ok := fields["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{}
+		fields["metadata"] = metadata
+	}
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		annotations = map[string]interface{}{}
+		metadata["annotations"] = annotations
+	}
+	annotations[key] = value
+}
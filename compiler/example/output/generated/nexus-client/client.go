@@ -6,7 +6,7 @@
 // - name hashing to avoid name collision between objects with same name but different parents,
 // - ability to get, create and delete child of given parent object,
 // - ability to add link and remove link to given object,
-// - recursive delete of object and all it's children.
+// - cascading delete of object and all it's children, children are owned by their parents.
 // To initialize client use NewForConfig function with Rest Config as a parameter. After that you can start using
 // nexus client. You can check example in: https://gitlab.eng.vmware.com/nsx-allspark_users/nexus-sdk/docs/-/tree/master/example/crudapp
 
@@ -17,9 +17,12 @@ import (
 	"encoding/json"
 	customerrors "errors"
 	"fmt"
+	"math/rand"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -50,8 +53,6 @@ import (
 
 var log = logrus.New()
 
-const maxRetryCount = 12
-const sleepTime = 5
 const ownershipAnnotation string = "Ownership"
 
 // informerResyncPeriod is in second, default value is 10 Hrs(36000 Sec). INFORMER_RESYNC_PERIOD is os env to set Resync Period for informers
@@ -59,6 +60,7 @@ var informerResyncPeriod time.Duration = 36000
 
 type Clientset struct {
 	baseClient        baseClientset.Interface
+	retryPolicy       RetryPolicy
 	rootTsmV1         *RootTsmV1
 	configTsmV1       *ConfigTsmV1
 	gnsTsmV1          *GnsTsmV1
@@ -84,90 +86,111 @@ func subscribe(key string, informer cache.SharedIndexInformer) {
 	subscriptionMap.Store(key, s)
 }
 
+// uniqueIndexKey returns the key of an object in the index of unique fields.
+func uniqueIndexKey(values ...interface{}) string {
+	key, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Sprint(values...)
+	}
+	return string(key)
+}
+
+// hashedParentName returns the name of the parent object based on labels of its child.
+func hashedParentName(labels map[string]string, parentCrdName string) string {
+	name, ok := labels[parentCrdName]
+	if !ok {
+		name = helper.DEFAULT_KEY
+	}
+	if labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		name = helper.GetHashedName(parentCrdName, labels, name)
+	}
+	return name
+}
+
 func (c *Clientset) SubscribeAll() {
 	var key string
 
 	key = "roots.root.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "configs.config.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "footypeabcs.config.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.baseClient, informerResyncPeriod*time.Second, footypeabcConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "domains.config.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.baseClient, informerResyncPeriod*time.Second, domainConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "foos.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.baseClient, informerResyncPeriod*time.Second, fooGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "gnses.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.baseClient, informerResyncPeriod*time.Second, gnsGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "barchilds.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.baseClient, informerResyncPeriod*time.Second, barchildGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "ignorechilds.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.baseClient, informerResyncPeriod*time.Second, ignorechildGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "dnses.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.baseClient, informerResyncPeriod*time.Second, dnsGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "svcgroups.servicegroup.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.baseClient, informerResyncPeriod*time.Second, svcgroupServicegroupTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.baseClient, informerResyncPeriod*time.Second, svcgrouplinkinfoServicegroupTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.baseClient, informerResyncPeriod*time.Second, accesscontrolpolicyPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "acpconfigs.policypkg.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.baseClient, informerResyncPeriod*time.Second, acpconfigPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "vmpolicies.policypkg.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.baseClient, informerResyncPeriod*time.Second, vmpolicyPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
@@ -181,8 +204,92 @@ func (c *Clientset) UnsubscribeAll() {
 	})
 }
 
+// RetryPolicy decides which failed requests to the database are retried and how long the client waits between
+// attempts. The wait grows exponentially from InitialBackoff up to MaxBackoff and is randomized by Jitter, so that
+// clients failing at the same time don't retry at the same time.
+type RetryPolicy struct {
+	// MaxAttempts is the maximal number of attempts of a request, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the wait after the first failed attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the wait between attempts.
+	MaxBackoff time.Duration
+	// Multiplier is the growth of the wait after every failed attempt.
+	Multiplier float64
+	// Jitter is the fraction of the wait added or subtracted randomly, e.g. 0.2 for +-20%.
+	Jitter float64
+	// Retryable returns true for errors which may not occur when the request is retried.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy returns the policy used by clients created without WithRetryPolicy option.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    13,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		Retryable:      IsRetryable,
+	}
+}
+
+// IsRetryable returns true for timeouts and throttling of requests, it's the default classifier of RetryPolicy.
+func IsRetryable(err error) bool {
+	return errors.IsTimeout(err) || errors.IsServerTimeout(err) || errors.IsTooManyRequests(err) ||
+		customerrors.Is(err, context.DeadlineExceeded)
+}
+
+func (p RetryPolicy) isRetryable(err error) bool {
+	if p.Retryable == nil {
+		return IsRetryable(err)
+	}
+	return p.Retryable(err)
+}
+
+// exhausted returns true if the request can't be retried after retryCount retries.
+func (p RetryPolicy) exhausted(retryCount int) bool {
+	return retryCount+1 >= p.MaxAttempts
+}
+
+// backoff returns the wait before the retry with the given number, starting from 1.
+func (p RetryPolicy) backoff(retryCount int) time.Duration {
+	backoff := float64(p.InitialBackoff)
+	for i := 1; i < retryCount && backoff < float64(p.MaxBackoff); i++ {
+		backoff *= p.Multiplier
+	}
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		backoff += backoff * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(backoff)
+}
+
+// wait sleeps before the retry with the given number. It returns early when the context is done, the next attempt
+// then fails with the error of the context.
+func (p RetryPolicy) wait(ctx context.Context, retryCount int) {
+	timer := time.NewTimer(p.backoff(retryCount))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// Option configures Clientset created by NewForConfig or NewFakeClient.
+type Option func(*Clientset)
+
+// WithRetryPolicy sets the policy of retries of failed requests to the database.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Clientset) {
+		c.retryPolicy = policy
+	}
+}
+
 // NewForConfig returns Client which can be which can be used to connect to database
-func NewForConfig(config *rest.Config) (*Clientset, error) {
+func NewForConfig(config *rest.Config, opts ...Option) (*Clientset, error) {
 	baseClient, err := baseClientset.NewForConfig(config)
 	if err != nil {
 		return nil, err
@@ -211,6 +318,10 @@ func NewForConfig(config *rest.Config) (*Clientset, error) {
 
 	client := &Clientset{}
 	client.baseClient = baseClient
+	client.retryPolicy = DefaultRetryPolicy()
+	for _, opt := range opts {
+		opt(client)
+	}
 	client.rootTsmV1 = newRootTsmV1(client)
 	client.configTsmV1 = newConfigTsmV1(client)
 	client.gnsTsmV1 = newGnsTsmV1(client)
@@ -221,9 +332,13 @@ func NewForConfig(config *rest.Config) (*Clientset, error) {
 }
 
 // NewFakeClient creates simple client which can be used for unit tests
-func NewFakeClient() *Clientset {
+func NewFakeClient(opts ...Option) *Clientset {
 	client := &Clientset{}
 	client.baseClient = fakeBaseClienset.NewSimpleClientset()
+	client.retryPolicy = DefaultRetryPolicy()
+	for _, opt := range opts {
+		opt(client)
+	}
 	client.rootTsmV1 = newRootTsmV1(client)
 	client.configTsmV1 = newConfigTsmV1(client)
 	client.gnsTsmV1 = newGnsTsmV1(client)
@@ -245,6 +360,182 @@ func (p Patch) Marshal() ([]byte, error) {
 	return json.Marshal(p)
 }
 
+type optimisticConcurrencyKey struct{}
+
+// WithOptimisticConcurrency returns a context for Update<Node>ByName calls which update the object only if its
+// resourceVersion is still the one of the updated object. Otherwise the call fails with ResourceVersionConflict
+// instead of overwriting changes made since the object was read.
+func WithOptimisticConcurrency(ctx context.Context) context.Context {
+	return context.WithValue(ctx, optimisticConcurrencyKey{}, true)
+}
+
+func optimisticConcurrencyEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(optimisticConcurrencyKey{}).(bool)
+	return enabled
+}
+
+// setOwner makes the parent owner of the object. Kubernetes garbage collector deletes objects when their owner is
+// deleted, so the subtree of a deleted object is removed even if the client stops in the middle.
+func setOwner(obj metav1.Object, apiVersion, kind string, parent metav1.Object) {
+	owner := metav1.OwnerReference{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       parent.GetName(),
+		UID:        parent.GetUID(),
+	}
+	refs := obj.GetOwnerReferences()
+	for i, ref := range refs {
+		if ownerGroup(ref.APIVersion) == ownerGroup(apiVersion) && ref.Kind == kind {
+			refs[i] = owner
+			obj.SetOwnerReferences(refs)
+			return
+		}
+	}
+	obj.SetOwnerReferences(append(refs, owner))
+}
+
+// ownerGroup returns group of the apiVersion, so owner references of older versions of the parent are replaced.
+func ownerGroup(apiVersion string) string {
+	return strings.SplitN(apiVersion, "/", 2)[0]
+}
+
+// isOwnedBy returns true if the object has owner reference to the parent. Objects created before children were owned
+// by their parents have no owner references and aren't deleted by Kubernetes garbage collector.
+func isOwnedBy(obj metav1.Object, parent metav1.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Name == parent.GetName() && ref.UID == parent.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceVersionTest returns a patch operation which fails if resourceVersion of the object changed.
+func resourceVersionTest(resourceVersion string) PatchOp {
+	return PatchOp{
+		Op:    "test",
+		Path:  "/metadata/resourceVersion",
+		Value: resourceVersion,
+	}
+}
+
+// isResourceVersionTestFailed returns true if the patch failed because of the resourceVersionTest operation.
+func isResourceVersionTestFailed(err error) bool {
+	return errors.IsConflict(err) || strings.Contains(err.Error(), "testing value /metadata/resourceVersion failed")
+}
+
+// Tx records writes of a transaction started by Clientset.Tx. Typed handles of objects in the transaction are
+// returned by Add<Node> methods of Tx and by InTx methods of objects.
+type Tx struct {
+	client *Clientset
+	steps  []*txStep
+	err    error
+}
+
+// txPhase orders steps of a transaction. Objects are created before they are updated or linked and links are
+// removed before objects are deleted. Links and unlinks share a phase, so they are applied in the recorded order.
+type txPhase int
+
+const (
+	txPhaseCreate txPhase = iota
+	txPhaseUpdate
+	txPhaseLink
+	txPhaseDelete
+)
+
+type txStep struct {
+	phase txPhase
+	// depth is the number of parents of the object, parents are created before and deleted after their children.
+	depth    int
+	name     string
+	apply    func(ctx context.Context) error
+	rollback func(ctx context.Context) error
+}
+
+func (tx *Tx) record(step *txStep) {
+	tx.steps = append(tx.steps, step)
+}
+
+// fail records an error found while recording steps, the transaction is then not applied.
+func (tx *Tx) fail(err error) {
+	if tx.err == nil {
+		tx.err = err
+	}
+}
+
+// Tx calls fn to record creates, updates, links and deletes of objects and applies them together. Steps are applied
+// in dependency order: creates from parents to children, updates, links and unlinks and deletes from children to
+// parents, steps of the same kind in the order in which they were recorded. If a step fails, the already applied
+// steps are compensated in the reverse order and TxError with the error of the step is returned. Nothing is applied
+// if fn returns an error.
+//
+// Compensation is best effort, other clients may change the graph during the transaction. Deleted objects are
+// created again without their children and links, children are removed by Kubernetes garbage collector.
+func (c *Clientset) Tx(ctx context.Context, fn func(tx *Tx) error) error {
+	tx := &Tx{client: c}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if tx.err != nil {
+		return tx.err
+	}
+	return tx.commit(ctx)
+}
+
+func (tx *Tx) commit(ctx context.Context) error {
+	steps := make([]*txStep, len(tx.steps))
+	copy(steps, tx.steps)
+	sort.SliceStable(steps, func(i, j int) bool {
+		if steps[i].phase != steps[j].phase {
+			return steps[i].phase < steps[j].phase
+		}
+		switch steps[i].phase {
+		case txPhaseCreate:
+			return steps[i].depth < steps[j].depth
+		case txPhaseDelete:
+			return steps[i].depth > steps[j].depth
+		}
+		return false
+	})
+
+	for i, step := range steps {
+		log.Debugf("[Tx] Applying %s", step.name)
+		if err := step.apply(ctx); err != nil {
+			log.Errorf("[Tx] Failed to apply %s, rolling back %d applied steps: %+v", step.name, i, err)
+			return NewTxError(step.name, err, tx.rollback(steps[:i]))
+		}
+	}
+	log.Debugf("[Tx] Applied %d steps successfully", len(steps))
+	return nil
+}
+
+// rollback compensates applied steps in the reverse order. Failed compensations don't stop the rollback, so that as
+// little as possible of the transaction is left in the graph.
+func (tx *Tx) rollback(applied []*txStep) (errs []error) {
+	// ctx of the transaction may be already cancelled
+	ctx := context.Background()
+	for i := len(applied) - 1; i >= 0; i-- {
+		log.Debugf("[Tx] Rolling back %s", applied[i].name)
+		if err := applied[i].rollback(ctx); err != nil {
+			log.Errorf("[Tx] Failed to roll back %s: %+v", applied[i].name, err)
+			errs = append(errs, fmt.Errorf("%s: %w", applied[i].name, err))
+		}
+	}
+	return
+}
+
+// restoreGvkPatch returns a merge patch which sets the link of the field back to value, nil value removes the link.
+// key is the display name of the link in named fields, empty in single ones.
+func restoreGvkPatch(field, key string, value interface{}) ([]byte, error) {
+	fieldValue := value
+	if key != "" {
+		fieldValue = map[string]interface{}{key: value}
+	}
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{field: fieldValue},
+	})
+}
+
 func (c *Clientset) Root() *RootTsmV1 {
 	return c.rootTsmV1
 }
@@ -334,14 +625,14 @@ func (group *RootTsmV1) GetRootByName(ctx context.Context, hashedName string) (*
 			Roots().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetRootByName] Failed to Get Roots: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Roots: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetRootByName]: %+v", err)
 				return nil, context.Canceled
@@ -369,14 +660,14 @@ func (group *RootTsmV1) ForceReadRootByName(ctx context.Context, hashedName stri
 			Roots().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadRootByName] Failed to Get Roots: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Roots: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadRootByName]: %+v", err)
 				return nil, context.Canceled
@@ -410,14 +701,14 @@ func (group *RootTsmV1) DeleteRootByName(ctx context.Context, hashedName string)
 			Roots().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteRootByName] Failed to get Roots: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Roots: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteRootByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -438,29 +729,29 @@ func (group *RootTsmV1) DeleteRootByName(ctx context.Context, hashedName string)
 	}
 
 	if result.Spec.ConfigGvk != nil {
-		err := group.client.
-			Config().
-			DeleteConfigByName(ctx, result.Spec.ConfigGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteConfigIfNotOwned(ctx, result, result.Spec.ConfigGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			RootTsmV1().
-			Roots().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Roots().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteRootByName] failed to delete Roots: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Roots: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteRootByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -480,6 +771,31 @@ func (group *RootTsmV1) DeleteRootByName(ctx context.Context, hashedName string)
 	return
 }
 
+// deleteConfigIfNotOwned deletes child Config of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *RootTsmV1) deleteConfigIfNotOwned(ctx context.Context,
+	parent *baseroottsmtanzuvmwarecomv1.Root, hashedName string) error {
+	child, err := group.client.baseClient.
+		ConfigTsmV1().
+		Configs().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Config().DeleteConfigByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 // CreateRootByName creates object in the database without hashing the name.
 // Use it directly ONLY when objToCreate.Name is hashed name of the object.
 func (group *RootTsmV1) CreateRootByName(ctx context.Context,
@@ -505,6 +821,7 @@ func (group *RootTsmV1) CreateRootByName(ctx context.Context,
 		result     *baseroottsmtanzuvmwarecomv1.Root
 		err        error
 	)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -512,14 +829,14 @@ func (group *RootTsmV1) CreateRootByName(ctx context.Context,
 			Roots().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateRootByName] Failed to create Root: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Root: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateRootByName] context canceled while creating Root: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -551,6 +868,11 @@ func (group *RootTsmV1) UpdateRootByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Root().GetRootByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -599,33 +921,22 @@ func (group *RootTsmV1) UpdateRootByName(ctx context.Context,
 			Roots().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateRootByName] Failed to patch Root gvk in parent node[]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("roots.root.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Root Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteRootByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Root: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Root deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateRootByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateRootByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Root Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteRootByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Root: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Root Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -672,6 +983,12 @@ func (group *RootTsmV1) ListRoots(ctx context.Context,
 	return
 }
 
+// rootRootTsmV1Indexers returns indexers of Root informers, which index objects by values
+// of unique fields.
+func rootRootTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type RootRoot struct {
 	client *Clientset
 	*baseroottsmtanzuvmwarecomv1.Root
@@ -801,6 +1118,144 @@ func (obj *RootRoot) DeleteConfig(ctx context.Context) (err error) {
 	return
 }
 
+// TxRootRoot is Root object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxRootRoot struct {
+	tx *Tx
+	*baseroottsmtanzuvmwarecomv1.Root
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *RootRoot) InTx(tx *Tx) *TxRootRoot {
+	return &TxRootRoot{
+		tx:   tx,
+		Root: obj.Root,
+	}
+}
+
+// createRootRoot records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createRootRoot(objToCreate *baseroottsmtanzuvmwarecomv1.Root) *TxRootRoot {
+	handle := &TxRootRoot{
+		tx:   tx,
+		Root: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["roots.root.tsm.tanzu.vmware.com"]),
+		name:  "create of roots.root.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Root().CreateRootByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Root = result.Root
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Root().DeleteRootByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxRootRoot) Update() {
+	var previous *baseroottsmtanzuvmwarecomv1.Root
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["roots.root.tsm.tanzu.vmware.com"]),
+		name:  "update of roots.root.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Root().GetRootByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Root.DeepCopy()
+			result, err := obj.tx.client.Root().UpdateRootByName(ctx, obj.Root)
+			if err != nil {
+				return err
+			}
+			obj.Root = result.Root
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Root().UpdateRootByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxRootRoot) Delete() {
+	var deleted *baseroottsmtanzuvmwarecomv1.Root
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["roots.root.tsm.tanzu.vmware.com"]),
+		name:  "delete of roots.root.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Root().GetRootByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Root.DeepCopy()
+			return obj.tx.client.Root().DeleteRootByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Root().CreateRootByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
+// AddRootRoot records creation of objToCreate, its name is hashed the same way
+// as by Clientset.AddRootRoot.
+func (tx *Tx) AddRootRoot(objToCreate *baseroottsmtanzuvmwarecomv1.Root) *TxRootRoot {
+	if objToCreate.GetName() == "" {
+		objToCreate.SetName(helper.DEFAULT_KEY)
+	}
+	if objToCreate.GetName() != helper.DEFAULT_KEY {
+		tx.fail(NewSingletonNameError(objToCreate.GetName()))
+	}
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), nil, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return tx.createRootRoot(objToCreate)
+}
+
+// AddConfig records creation of the child, its name is hashed the same way as by
+// RootRoot.AddConfig.
+func (obj *TxRootRoot) AddConfig(
+	objToCreate *baseconfigtsmtanzuvmwarecomv1.Config) *TxConfigConfig {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["roots.root.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["roots.root.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createConfigConfig(objToCreate)
+}
+
 type rootRootTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -810,7 +1265,7 @@ type rootRootTsmV1Chainer struct {
 func (c *rootRootTsmV1Chainer) Subscribe() {
 	key := "roots.root.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -843,7 +1298,7 @@ func (c *rootRootTsmV1Chainer) RegisterEventHandler(addCB func(obj *RootRoot), u
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for RootRoot, so creating a new one")
-		informer = informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -869,9 +1324,18 @@ func (c *rootRootTsmV1Chainer) RegisterEventHandler(addCB func(obj *RootRoot), u
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseroottsmtanzuvmwarecomv1.Root)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &RootRoot{
 				client: c.client,
-				Root:   obj.(*baseroottsmtanzuvmwarecomv1.Root),
+				Root:   deleted,
 			}
 
 			deleteCB(nc)
@@ -903,7 +1367,7 @@ func (c *rootRootTsmV1Chainer) RegisterAddCallback(cbfn func(obj *RootRoot)) (ca
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] RootRoot Create New Informer")
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &RootRoot{
@@ -945,7 +1409,7 @@ func (c *rootRootTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newObj *
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] RootRoot Create New Informer")
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &RootRoot{
@@ -977,9 +1441,18 @@ func (c *rootRootTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *RootRoot))
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseroottsmtanzuvmwarecomv1.Root)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &RootRoot{
 					client: c.client,
-					Root:   obj.(*baseroottsmtanzuvmwarecomv1.Root),
+					Root:   deleted,
 				}
 
 				cbfn(nc)
@@ -987,12 +1460,21 @@ func (c *rootRootTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *RootRoot))
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] RootRoot Create New Informer")
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseroottsmtanzuvmwarecomv1.Root)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &RootRoot{
 					client: c.client,
-					Root:   obj.(*baseroottsmtanzuvmwarecomv1.Root),
+					Root:   deleted,
 				}
 
 				cbfn(nc)
@@ -1003,6 +1485,15 @@ func (c *rootRootTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *RootRoot))
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of RootRoot
+func (c *rootRootTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "roots.root.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 func (c *rootRootTsmV1Chainer) Config(name string) *configConfigTsmV1Chainer {
 	parentLabels := c.parentLabels
 	parentLabels["configs.config.tsm.tanzu.vmware.com"] = name
@@ -1073,14 +1564,14 @@ func (group *ConfigTsmV1) GetConfigByName(ctx context.Context, hashedName string
 			Configs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetConfigByName] Failed to Get Configs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Configs: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetConfigByName]: %+v", err)
 				return nil, context.Canceled
@@ -1108,14 +1599,14 @@ func (group *ConfigTsmV1) ForceReadConfigByName(ctx context.Context, hashedName
 			Configs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadConfigByName] Failed to Get Configs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Configs: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadConfigByName]: %+v", err)
 				return nil, context.Canceled
@@ -1149,14 +1640,14 @@ func (group *ConfigTsmV1) DeleteConfigByName(ctx context.Context, hashedName str
 			Configs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteConfigByName] Failed to get Configs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Configs: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteConfigByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -1177,77 +1668,64 @@ func (group *ConfigTsmV1) DeleteConfigByName(ctx context.Context, hashedName str
 	}
 
 	if result.Spec.GNSGvk != nil {
-		err := group.client.
-			Gns().
-			DeleteGnsByName(ctx, result.Spec.GNSGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteGNSIfNotOwned(ctx, result, result.Spec.GNSGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
 	if result.Spec.DNSGvk != nil {
-		err := group.client.
-			Gns().
-			DeleteDnsByName(ctx, result.Spec.DNSGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteDNSIfNotOwned(ctx, result, result.Spec.DNSGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
 	if result.Spec.VMPPoliciesGvk != nil {
-		err := group.client.
-			Policypkg().
-			DeleteVMpolicyByName(ctx, result.Spec.VMPPoliciesGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteVMPPoliciesIfNotOwned(ctx, result, result.Spec.VMPPoliciesGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
 	if result.Spec.DomainGvk != nil {
-		err := group.client.
-			Config().
-			DeleteDomainByName(ctx, result.Spec.DomainGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteDomainIfNotOwned(ctx, result, result.Spec.DomainGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
 	for _, v := range result.Spec.FooExampleGvk {
-		err := group.client.
-			Config().DeleteFooTypeABCByName(ctx, v.Name)
+		err := group.deleteFooExampleIfNotOwned(ctx, result, v.Name)
 		if err != nil {
-			if errors.IsNotFound(err) {
-				continue
-			} else {
-				return err
-			}
+			return err
 		}
 	}
 
 	if result.Spec.SvcGrpInfoGvk != nil {
-		err := group.client.
-			Servicegroup().
-			DeleteSvcGroupLinkInfoByName(ctx, result.Spec.SvcGrpInfoGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteSvcGrpInfoIfNotOwned(ctx, result, result.Spec.SvcGrpInfoGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			ConfigTsmV1().
-			Configs().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Configs().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteConfigByName] failed to delete Configs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Configs: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteConfigByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -1320,14 +1798,14 @@ func (group *ConfigTsmV1) DeleteConfigByName(ctx context.Context, hashedName str
 				Roots().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteConfigByName] Failed to patch Config gvk in parent node[Roots]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteConfigByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -1346,33 +1824,198 @@ func (group *ConfigTsmV1) DeleteConfigByName(ctx context.Context, hashedName str
 	return
 }
 
-// CreateConfigByName creates object in the database without hashing the name.
-// Use it directly ONLY when objToCreate.Name is hashed name of the object.
-func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
-	objToCreate *baseconfigtsmtanzuvmwarecomv1.Config) (*ConfigConfig, error) {
-	log.Debugf("[CreateConfigByName] Received objToCreate: %s", objToCreate.GetName())
-	if objToCreate.GetLabels() == nil {
-		objToCreate.Labels = make(map[string]string)
+// deleteGNSIfNotOwned deletes child GNS of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *ConfigTsmV1) deleteGNSIfNotOwned(ctx context.Context,
+	parent *baseconfigtsmtanzuvmwarecomv1.Config, hashedName string) error {
+	child, err := group.client.baseClient.
+		GnsTsmV1().
+		Gnses().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
 	}
-	if _, ok := objToCreate.Labels[common.DISPLAY_NAME_LABEL]; !ok {
-		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Gns().DeleteGnsByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
 	}
+	return nil
+}
 
-	objToCreate.Spec.GNSGvk = nil
-	objToCreate.Spec.DNSGvk = nil
-	objToCreate.Spec.VMPPoliciesGvk = nil
-	objToCreate.Spec.DomainGvk = nil
-	objToCreate.Spec.FooExampleGvk = nil
-	objToCreate.Spec.SvcGrpInfoGvk = nil
-	objToCreate.Spec.ACPPoliciesGvk = nil
+// deleteDNSIfNotOwned deletes child DNS of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *ConfigTsmV1) deleteDNSIfNotOwned(ctx context.Context,
+	parent *baseconfigtsmtanzuvmwarecomv1.Config, hashedName string) error {
+	child, err := group.client.baseClient.
+		GnsTsmV1().
+		Dnses().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Gns().DeleteDnsByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteVMPPoliciesIfNotOwned deletes child VMPPolicies of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *ConfigTsmV1) deleteVMPPoliciesIfNotOwned(ctx context.Context,
+	parent *baseconfigtsmtanzuvmwarecomv1.Config, hashedName string) error {
+	child, err := group.client.baseClient.
+		PolicypkgTsmV1().
+		VMpolicies().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Policypkg().DeleteVMpolicyByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteDomainIfNotOwned deletes child Domain of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *ConfigTsmV1) deleteDomainIfNotOwned(ctx context.Context,
+	parent *baseconfigtsmtanzuvmwarecomv1.Config, hashedName string) error {
+	child, err := group.client.baseClient.
+		ConfigTsmV1().
+		Domains().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Config().DeleteDomainByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteFooExampleIfNotOwned deletes child FooExample of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *ConfigTsmV1) deleteFooExampleIfNotOwned(ctx context.Context,
+	parent *baseconfigtsmtanzuvmwarecomv1.Config, hashedName string) error {
+	child, err := group.client.baseClient.
+		ConfigTsmV1().
+		FooTypeABCs().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Config().DeleteFooTypeABCByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteSvcGrpInfoIfNotOwned deletes child SvcGrpInfo of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *ConfigTsmV1) deleteSvcGrpInfoIfNotOwned(ctx context.Context,
+	parent *baseconfigtsmtanzuvmwarecomv1.Config, hashedName string) error {
+	child, err := group.client.baseClient.
+		ServicegroupTsmV1().
+		SvcGroupLinkInfos().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Servicegroup().DeleteSvcGroupLinkInfoByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// CreateConfigByName creates object in the database without hashing the name.
+// Use it directly ONLY when objToCreate.Name is hashed name of the object.
+func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
+	objToCreate *baseconfigtsmtanzuvmwarecomv1.Config) (*ConfigConfig, error) {
+	log.Debugf("[CreateConfigByName] Received objToCreate: %s", objToCreate.GetName())
+	if objToCreate.GetLabels() == nil {
+		objToCreate.Labels = make(map[string]string)
+	}
+	if _, ok := objToCreate.Labels[common.DISPLAY_NAME_LABEL]; !ok {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+	}
+
+	objToCreate.Spec.GNSGvk = nil
+	objToCreate.Spec.DNSGvk = nil
+	objToCreate.Spec.VMPPoliciesGvk = nil
+	objToCreate.Spec.DomainGvk = nil
+	objToCreate.Spec.FooExampleGvk = nil
+	objToCreate.Spec.SvcGrpInfoGvk = nil
+	objToCreate.Spec.ACPPoliciesGvk = nil
+
+	var (
+		retryCount int
+		result     *baseconfigtsmtanzuvmwarecomv1.Config
+		err        error
+		exists     bool
+		existsErr  error
+	)
+
+	parentName, ok := objToCreate.GetLabels()["roots.root.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("roots.root.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Root().GetRootByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateConfigByName] Failed to get parent of Config: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseroottsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Root", owner)
 
-	var (
-		retryCount int
-		result     *baseconfigtsmtanzuvmwarecomv1.Config
-		err        error
-		exists     bool
-		existsErr  error
-	)
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -1380,14 +2023,14 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 			Configs().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateConfigByName] Failed to create Config: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Config: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateConfigByName] context canceled while creating Config: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -1406,14 +2049,6 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["roots.root.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("roots.root.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Root().GetRootByName(context.Background(), parentName)
 		if err2 != nil {
@@ -1450,9 +2085,9 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 			Roots().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateConfigByName] Failed to patch Config gvk in parent node[Roots]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger Config delete: %s", objToCreate.GetName())
 					delErr := group.DeleteConfigByName(newCtx, objToCreate.GetName())
@@ -1464,7 +2099,7 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateConfigByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -1500,6 +2135,11 @@ func (group *ConfigTsmV1) UpdateConfigByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Config().GetConfigByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -1739,33 +2379,22 @@ func (group *ConfigTsmV1) UpdateConfigByName(ctx context.Context,
 			Configs().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateConfigByName] Failed to patch Config gvk in parent node[Roots]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("configs.config.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Config Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteConfigByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Config: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Config deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateConfigByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateConfigByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Config Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteConfigByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Config: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Config Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -1812,6 +2441,12 @@ func (group *ConfigTsmV1) ListConfigs(ctx context.Context,
 	return
 }
 
+// configConfigTsmV1Indexers returns indexers of Config informers, which index objects by values
+// of unique fields.
+func configConfigTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type ConfigConfig struct {
 	client *Clientset
 	*baseconfigtsmtanzuvmwarecomv1.Config
@@ -2272,6 +2907,292 @@ func (obj *ConfigConfig) UnlinkACPPolicies(ctx context.Context,
 
 }
 
+// TxConfigConfig is Config object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxConfigConfig struct {
+	tx *Tx
+	*baseconfigtsmtanzuvmwarecomv1.Config
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *ConfigConfig) InTx(tx *Tx) *TxConfigConfig {
+	return &TxConfigConfig{
+		tx:     tx,
+		Config: obj.Config,
+	}
+}
+
+// createConfigConfig records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createConfigConfig(objToCreate *baseconfigtsmtanzuvmwarecomv1.Config) *TxConfigConfig {
+	handle := &TxConfigConfig{
+		tx:     tx,
+		Config: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"]),
+		name:  "create of configs.config.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Config().CreateConfigByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Config = result.Config
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Config().DeleteConfigByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxConfigConfig) Update() {
+	var previous *baseconfigtsmtanzuvmwarecomv1.Config
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"]),
+		name:  "update of configs.config.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Config.DeepCopy()
+			result, err := obj.tx.client.Config().UpdateConfigByName(ctx, obj.Config)
+			if err != nil {
+				return err
+			}
+			obj.Config = result.Config
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Config().UpdateConfigByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxConfigConfig) Delete() {
+	var deleted *baseconfigtsmtanzuvmwarecomv1.Config
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"]),
+		name:  "delete of configs.config.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Config.DeepCopy()
+			return obj.tx.client.Config().DeleteConfigByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Config().CreateConfigByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
+// AddGNS records creation of the child, its name is hashed the same way as by
+// ConfigConfig.AddGNS.
+func (obj *TxConfigConfig) AddGNS(
+	objToCreate *basegnstsmtanzuvmwarecomv1.Gns) *TxGnsGns {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["configs.config.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createGnsGns(objToCreate)
+}
+
+// AddDNS records creation of the child, its name is hashed the same way as by
+// ConfigConfig.AddDNS.
+func (obj *TxConfigConfig) AddDNS(
+	objToCreate *basegnstsmtanzuvmwarecomv1.Dns) *TxGnsDns {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["configs.config.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		if objToCreate.GetName() == "" {
+			objToCreate.SetName(helper.DEFAULT_KEY)
+		}
+		if objToCreate.GetName() != helper.DEFAULT_KEY {
+			obj.tx.fail(NewSingletonNameError(objToCreate.GetName()))
+		}
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createGnsDns(objToCreate)
+}
+
+// AddVMPPolicies records creation of the child, its name is hashed the same way as by
+// ConfigConfig.AddVMPPolicies.
+func (obj *TxConfigConfig) AddVMPPolicies(
+	objToCreate *basepolicypkgtsmtanzuvmwarecomv1.VMpolicy) *TxPolicypkgVMpolicy {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["configs.config.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createPolicypkgVMpolicy(objToCreate)
+}
+
+// AddDomain records creation of the child, its name is hashed the same way as by
+// ConfigConfig.AddDomain.
+func (obj *TxConfigConfig) AddDomain(
+	objToCreate *baseconfigtsmtanzuvmwarecomv1.Domain) *TxConfigDomain {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["configs.config.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createConfigDomain(objToCreate)
+}
+
+// AddFooExample records creation of the child, its name is hashed the same way as by
+// ConfigConfig.AddFooExample.
+func (obj *TxConfigConfig) AddFooExample(
+	objToCreate *baseconfigtsmtanzuvmwarecomv1.FooTypeABC) *TxConfigFooTypeABC {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["configs.config.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createConfigFooTypeABC(objToCreate)
+}
+
+// AddSvcGrpInfo records creation of the child, its name is hashed the same way as by
+// ConfigConfig.AddSvcGrpInfo.
+func (obj *TxConfigConfig) AddSvcGrpInfo(
+	objToCreate *baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo) *TxServicegroupSvcGroupLinkInfo {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["configs.config.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createServicegroupSvcGroupLinkInfo(objToCreate)
+}
+
+// LinkACPPolicies records linking obj with linkToAdd, which can be created in the same transaction.
+// The previous value of the link is restored on rollback.
+func (obj *TxConfigConfig) LinkACPPolicies(linkToAdd *TxPolicypkgAccessControlPolicy) {
+	obj.recordACPPolicies(false, linkToAdd)
+}
+
+// UnlinkACPPolicies records unlinking linkToRemove from obj. The link is restored on rollback.
+func (obj *TxConfigConfig) UnlinkACPPolicies(linkToRemove *TxPolicypkgAccessControlPolicy) {
+	obj.recordACPPolicies(true, linkToRemove)
+}
+
+func (obj *TxConfigConfig) recordACPPolicies(unlink bool,
+	target *TxPolicypkgAccessControlPolicy) {
+	var (
+		key      string
+		previous interface{}
+	)
+	op := "link"
+	if unlink {
+		op = "unlink"
+	}
+	obj.tx.record(&txStep{
+		phase: txPhaseLink,
+		depth: len(helper.GetCRDParentsMap()["configs.config.tsm.tanzu.vmware.com"]),
+		name:  op + " of ACPPolicies of configs.config.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			var link *PolicypkgAccessControlPolicy
+			if target != nil {
+				link = &PolicypkgAccessControlPolicy{
+					client:              obj.tx.client,
+					AccessControlPolicy: target.AccessControlPolicy,
+				}
+			}
+			key = link.DisplayName()
+			if l, ok := current.Spec.ACPPoliciesGvk[key]; ok {
+				previous = l
+			}
+			if unlink {
+				err = current.UnlinkACPPolicies(ctx, link)
+			} else {
+				err = current.LinkACPPolicies(ctx, link)
+			}
+			if err != nil {
+				return err
+			}
+			obj.Config = current.Config
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			payload, err := restoreGvkPatch("aCPPoliciesGvk", key, previous)
+			if err != nil {
+				return err
+			}
+			_, err = obj.tx.client.baseClient.ConfigTsmV1().Configs().Patch(ctx, obj.GetName(), types.MergePatchType, payload, metav1.PatchOptions{})
+			return err
+		},
+	})
+}
+
 type configConfigTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -2281,7 +3202,7 @@ type configConfigTsmV1Chainer struct {
 func (c *configConfigTsmV1Chainer) Subscribe() {
 	key := "configs.config.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -2314,7 +3235,7 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for ConfigConfig, so creating a new one")
-		informer = informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -2351,9 +3272,11 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -2362,7 +3285,8 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -2381,9 +3305,18 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Config)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &ConfigConfig{
 				client: c.client,
-				Config: obj.(*baseconfigtsmtanzuvmwarecomv1.Config),
+				Config: deleted,
 			}
 
 			var parent *RootRoot
@@ -2413,9 +3346,11 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -2424,7 +3359,8 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -2482,9 +3418,11 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -2494,7 +3432,8 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -2502,7 +3441,7 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] ConfigConfig Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &ConfigConfig{
@@ -2541,9 +3480,11 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -2553,7 +3494,8 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -2590,7 +3532,7 @@ func (c *configConfigTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newO
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] ConfigConfig Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &ConfigConfig{
@@ -2622,9 +3564,18 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Config)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ConfigConfig{
 					client: c.client,
-					Config: obj.(*baseconfigtsmtanzuvmwarecomv1.Config),
+					Config: deleted,
 				}
 
 				var parent *RootRoot
@@ -2657,9 +3608,11 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -2669,7 +3622,8 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -2677,12 +3631,21 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] ConfigConfig Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Config)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ConfigConfig{
 					client: c.client,
-					Config: obj.(*baseconfigtsmtanzuvmwarecomv1.Config),
+					Config: deleted,
 				}
 
 				var parent *RootRoot
@@ -2716,9 +3679,11 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -2728,7 +3693,8 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -2739,6 +3705,15 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of ConfigConfig
+func (c *configConfigTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "configs.config.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 func (c *configConfigTsmV1Chainer) GNS(name string) *gnsGnsTsmV1Chainer {
 	parentLabels := c.parentLabels
 	parentLabels["gnses.gns.tsm.tanzu.vmware.com"] = name
@@ -3050,14 +4025,14 @@ func (group *ConfigTsmV1) GetFooTypeABCByName(ctx context.Context, hashedName st
 			FooTypeABCs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetFooTypeABCByName] Failed to Get FooTypeABCs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get FooTypeABCs: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetFooTypeABCByName]: %+v", err)
 				return nil, context.Canceled
@@ -3085,14 +4060,14 @@ func (group *ConfigTsmV1) ForceReadFooTypeABCByName(ctx context.Context, hashedN
 			FooTypeABCs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadFooTypeABCByName] Failed to Get FooTypeABCs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get FooTypeABCs: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadFooTypeABCByName]: %+v", err)
 				return nil, context.Canceled
@@ -3126,14 +4101,14 @@ func (group *ConfigTsmV1) DeleteFooTypeABCByName(ctx context.Context, hashedName
 			FooTypeABCs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteFooTypeABCByName] Failed to get FooTypeABCs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get FooTypeABCs: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteFooTypeABCByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -3153,21 +4128,23 @@ func (group *ConfigTsmV1) DeleteFooTypeABCByName(ctx context.Context, hashedName
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			ConfigTsmV1().
-			FooTypeABCs().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			FooTypeABCs().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteFooTypeABCByName] failed to delete FooTypeABCs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete FooTypeABCs: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteFooTypeABCByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -3245,14 +4222,14 @@ func (group *ConfigTsmV1) DeleteFooTypeABCByName(ctx context.Context, hashedName
 				Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteFooTypeABCByName] Failed to patch FooTypeABC gvk in parent node[Configs]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteFooTypeABCByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -3290,6 +4267,21 @@ func (group *ConfigTsmV1) CreateFooTypeABCByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Config().GetConfigByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateFooTypeABCByName] Failed to get parent of FooTypeABC: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseconfigtsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Config", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -3297,14 +4289,14 @@ func (group *ConfigTsmV1) CreateFooTypeABCByName(ctx context.Context,
 			FooTypeABCs().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateFooTypeABCByName] Failed to create FooTypeABC: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create FooTypeABC: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateFooTypeABCByName] context canceled while creating FooTypeABC: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -3323,14 +4315,6 @@ func (group *ConfigTsmV1) CreateFooTypeABCByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Config().GetConfigByName(context.Background(), parentName)
 		if err2 != nil {
@@ -3355,9 +4339,9 @@ func (group *ConfigTsmV1) CreateFooTypeABCByName(ctx context.Context,
 			Configs().Patch(newCtx, parentName, types.MergePatchType, []byte(payload), metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateFooTypeABCByName] Failed to patch FooTypeABC gvk in parent node[Configs] %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("[CreateFooTypeABCByName] Trigger FooTypeABC delete: %s", objToCreate.GetName())
 					delErr := group.DeleteFooTypeABCByName(newCtx, objToCreate.GetName())
@@ -3369,7 +4353,7 @@ func (group *ConfigTsmV1) CreateFooTypeABCByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateFooTypeABCByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -3405,6 +4389,11 @@ func (group *ConfigTsmV1) UpdateFooTypeABCByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Config().GetFooTypeABCByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -3581,33 +4570,22 @@ func (group *ConfigTsmV1) UpdateFooTypeABCByName(ctx context.Context,
 			FooTypeABCs().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateFooTypeABCByName] Failed to patch FooTypeABC gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("footypeabcs.config.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger FooTypeABC Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteFooTypeABCByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting FooTypeABC: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("FooTypeABC deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateFooTypeABCByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateFooTypeABCByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger FooTypeABC Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteFooTypeABCByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting FooTypeABC: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("FooTypeABC Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -3654,6 +4632,12 @@ func (group *ConfigTsmV1) ListFooTypeABCs(ctx context.Context,
 	return
 }
 
+// footypeabcConfigTsmV1Indexers returns indexers of FooTypeABC informers, which index objects by values
+// of unique fields.
+func footypeabcConfigTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type ConfigFooTypeABC struct {
 	client *Clientset
 	*baseconfigtsmtanzuvmwarecomv1.FooTypeABC
@@ -3684,6 +4668,103 @@ func (obj *ConfigFooTypeABC) GetParent(ctx context.Context) (result *ConfigConfi
 	return obj.client.Config().GetConfigByName(ctx, hashedName)
 }
 
+// TxConfigFooTypeABC is FooTypeABC object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxConfigFooTypeABC struct {
+	tx *Tx
+	*baseconfigtsmtanzuvmwarecomv1.FooTypeABC
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *ConfigFooTypeABC) InTx(tx *Tx) *TxConfigFooTypeABC {
+	return &TxConfigFooTypeABC{
+		tx:         tx,
+		FooTypeABC: obj.FooTypeABC,
+	}
+}
+
+// createConfigFooTypeABC records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createConfigFooTypeABC(objToCreate *baseconfigtsmtanzuvmwarecomv1.FooTypeABC) *TxConfigFooTypeABC {
+	handle := &TxConfigFooTypeABC{
+		tx:         tx,
+		FooTypeABC: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["footypeabcs.config.tsm.tanzu.vmware.com"]),
+		name:  "create of footypeabcs.config.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Config().CreateFooTypeABCByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.FooTypeABC = result.FooTypeABC
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Config().DeleteFooTypeABCByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxConfigFooTypeABC) Update() {
+	var previous *baseconfigtsmtanzuvmwarecomv1.FooTypeABC
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["footypeabcs.config.tsm.tanzu.vmware.com"]),
+		name:  "update of footypeabcs.config.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetFooTypeABCByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.FooTypeABC.DeepCopy()
+			result, err := obj.tx.client.Config().UpdateFooTypeABCByName(ctx, obj.FooTypeABC)
+			if err != nil {
+				return err
+			}
+			obj.FooTypeABC = result.FooTypeABC
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Config().UpdateFooTypeABCByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxConfigFooTypeABC) Delete() {
+	var deleted *baseconfigtsmtanzuvmwarecomv1.FooTypeABC
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["footypeabcs.config.tsm.tanzu.vmware.com"]),
+		name:  "delete of footypeabcs.config.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetFooTypeABCByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.FooTypeABC.DeepCopy()
+			return obj.tx.client.Config().DeleteFooTypeABCByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Config().CreateFooTypeABCByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type footypeabcConfigTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -3693,7 +4774,7 @@ type footypeabcConfigTsmV1Chainer struct {
 func (c *footypeabcConfigTsmV1Chainer) Subscribe() {
 	key := "footypeabcs.config.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, footypeabcConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -3726,7 +4807,7 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *Conf
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for ConfigFooTypeABC, so creating a new one")
-		informer = informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, footypeabcConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -3763,9 +4844,11 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *Conf
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -3774,7 +4857,8 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *Conf
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -3793,9 +4877,18 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *Conf
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.FooTypeABC)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &ConfigFooTypeABC{
 				client:     c.client,
-				FooTypeABC: obj.(*baseconfigtsmtanzuvmwarecomv1.FooTypeABC),
+				FooTypeABC: deleted,
 			}
 
 			var parent *ConfigConfig
@@ -3825,9 +4918,11 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *Conf
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -3836,7 +4931,8 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *Conf
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -3894,9 +4990,11 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Config
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -3906,7 +5004,8 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Config
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -3914,7 +5013,7 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Config
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] ConfigFooTypeABC Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, footypeabcConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &ConfigFooTypeABC{
@@ -3953,9 +5052,11 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Config
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -3965,7 +5066,8 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Config
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -4002,7 +5104,7 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj,
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] ConfigFooTypeABC Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, footypeabcConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &ConfigFooTypeABC{
@@ -4034,9 +5136,18 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Con
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.FooTypeABC)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ConfigFooTypeABC{
 					client:     c.client,
-					FooTypeABC: obj.(*baseconfigtsmtanzuvmwarecomv1.FooTypeABC),
+					FooTypeABC: deleted,
 				}
 
 				var parent *ConfigConfig
@@ -4069,9 +5180,11 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Con
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -4081,7 +5194,8 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Con
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -4089,12 +5203,21 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Con
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] ConfigFooTypeABC Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewFooTypeABCInformer(c.client.baseClient, informerResyncPeriod*time.Second, footypeabcConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.FooTypeABC)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ConfigFooTypeABC{
 					client:     c.client,
-					FooTypeABC: obj.(*baseconfigtsmtanzuvmwarecomv1.FooTypeABC),
+					FooTypeABC: deleted,
 				}
 
 				var parent *ConfigConfig
@@ -4128,9 +5251,11 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Con
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -4140,7 +5265,8 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Con
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -4151,6 +5277,15 @@ func (c *footypeabcConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Con
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of ConfigFooTypeABC
+func (c *footypeabcConfigTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "footypeabcs.config.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetDomainByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *ConfigTsmV1) GetDomainByName(ctx context.Context, hashedName string) (*ConfigDomain, error) {
@@ -4174,14 +5309,14 @@ func (group *ConfigTsmV1) GetDomainByName(ctx context.Context, hashedName string
 			Domains().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetDomainByName] Failed to Get Domains: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Domains: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetDomainByName]: %+v", err)
 				return nil, context.Canceled
@@ -4209,14 +5344,14 @@ func (group *ConfigTsmV1) ForceReadDomainByName(ctx context.Context, hashedName
 			Domains().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadDomainByName] Failed to Get Domains: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Domains: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadDomainByName]: %+v", err)
 				return nil, context.Canceled
@@ -4250,14 +5385,14 @@ func (group *ConfigTsmV1) DeleteDomainByName(ctx context.Context, hashedName str
 			Domains().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteDomainByName] Failed to get Domains: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Domains: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteDomainByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -4277,21 +5412,23 @@ func (group *ConfigTsmV1) DeleteDomainByName(ctx context.Context, hashedName str
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			ConfigTsmV1().
-			Domains().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Domains().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteDomainByName] failed to delete Domains: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Domains: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteDomainByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -4364,14 +5501,14 @@ func (group *ConfigTsmV1) DeleteDomainByName(ctx context.Context, hashedName str
 				Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteDomainByName] Failed to patch Domain gvk in parent node[Configs]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteDomainByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -4409,6 +5546,21 @@ func (group *ConfigTsmV1) CreateDomainByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Config().GetConfigByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateDomainByName] Failed to get parent of Domain: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseconfigtsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Config", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -4416,14 +5568,14 @@ func (group *ConfigTsmV1) CreateDomainByName(ctx context.Context,
 			Domains().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateDomainByName] Failed to create Domain: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Domain: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateDomainByName] context canceled while creating Domain: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -4442,14 +5594,6 @@ func (group *ConfigTsmV1) CreateDomainByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Config().GetConfigByName(context.Background(), parentName)
 		if err2 != nil {
@@ -4486,9 +5630,9 @@ func (group *ConfigTsmV1) CreateDomainByName(ctx context.Context,
 			Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateDomainByName] Failed to patch Domain gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger Domain delete: %s", objToCreate.GetName())
 					delErr := group.DeleteDomainByName(newCtx, objToCreate.GetName())
@@ -4500,7 +5644,7 @@ func (group *ConfigTsmV1) CreateDomainByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateDomainByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -4536,6 +5680,11 @@ func (group *ConfigTsmV1) UpdateDomainByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Config().GetDomainByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -4775,33 +5924,22 @@ func (group *ConfigTsmV1) UpdateDomainByName(ctx context.Context,
 			Domains().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateDomainByName] Failed to patch Domain gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("domains.config.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Domain Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteDomainByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Domain: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Domain deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateDomainByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateDomainByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Domain Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteDomainByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Domain: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Domain Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -4848,6 +5986,12 @@ func (group *ConfigTsmV1) ListDomains(ctx context.Context,
 	return
 }
 
+// domainConfigTsmV1Indexers returns indexers of Domain informers, which index objects by values
+// of unique fields.
+func domainConfigTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type ConfigDomain struct {
 	client *Clientset
 	*baseconfigtsmtanzuvmwarecomv1.Domain
@@ -4878,6 +6022,103 @@ func (obj *ConfigDomain) GetParent(ctx context.Context) (result *ConfigConfig, e
 	return obj.client.Config().GetConfigByName(ctx, hashedName)
 }
 
+// TxConfigDomain is Domain object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxConfigDomain struct {
+	tx *Tx
+	*baseconfigtsmtanzuvmwarecomv1.Domain
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *ConfigDomain) InTx(tx *Tx) *TxConfigDomain {
+	return &TxConfigDomain{
+		tx:     tx,
+		Domain: obj.Domain,
+	}
+}
+
+// createConfigDomain records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createConfigDomain(objToCreate *baseconfigtsmtanzuvmwarecomv1.Domain) *TxConfigDomain {
+	handle := &TxConfigDomain{
+		tx:     tx,
+		Domain: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["domains.config.tsm.tanzu.vmware.com"]),
+		name:  "create of domains.config.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Config().CreateDomainByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Domain = result.Domain
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Config().DeleteDomainByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxConfigDomain) Update() {
+	var previous *baseconfigtsmtanzuvmwarecomv1.Domain
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["domains.config.tsm.tanzu.vmware.com"]),
+		name:  "update of domains.config.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetDomainByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Domain.DeepCopy()
+			result, err := obj.tx.client.Config().UpdateDomainByName(ctx, obj.Domain)
+			if err != nil {
+				return err
+			}
+			obj.Domain = result.Domain
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Config().UpdateDomainByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxConfigDomain) Delete() {
+	var deleted *baseconfigtsmtanzuvmwarecomv1.Domain
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["domains.config.tsm.tanzu.vmware.com"]),
+		name:  "delete of domains.config.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetDomainByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Domain.DeepCopy()
+			return obj.tx.client.Config().DeleteDomainByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Config().CreateDomainByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type domainConfigTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -4887,7 +6128,7 @@ type domainConfigTsmV1Chainer struct {
 func (c *domainConfigTsmV1Chainer) Subscribe() {
 	key := "domains.config.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, domainConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -4920,7 +6161,7 @@ func (c *domainConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigDo
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for ConfigDomain, so creating a new one")
-		informer = informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, domainConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -4957,9 +6198,11 @@ func (c *domainConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigDo
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -4968,7 +6211,8 @@ func (c *domainConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigDo
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -4987,9 +6231,18 @@ func (c *domainConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigDo
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Domain)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &ConfigDomain{
 				client: c.client,
-				Domain: obj.(*baseconfigtsmtanzuvmwarecomv1.Domain),
+				Domain: deleted,
 			}
 
 			var parent *ConfigConfig
@@ -5019,9 +6272,11 @@ func (c *domainConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigDo
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -5030,7 +6285,8 @@ func (c *domainConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigDo
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -5088,9 +6344,11 @@ func (c *domainConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigDoma
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -5100,7 +6358,8 @@ func (c *domainConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigDoma
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -5108,7 +6367,7 @@ func (c *domainConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigDoma
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] ConfigDomain Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, domainConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &ConfigDomain{
@@ -5147,9 +6406,11 @@ func (c *domainConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigDoma
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -5159,7 +6420,8 @@ func (c *domainConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigDoma
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -5196,7 +6458,7 @@ func (c *domainConfigTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newO
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] ConfigDomain Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, domainConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &ConfigDomain{
@@ -5228,9 +6490,18 @@ func (c *domainConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigD
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Domain)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ConfigDomain{
 					client: c.client,
-					Domain: obj.(*baseconfigtsmtanzuvmwarecomv1.Domain),
+					Domain: deleted,
 				}
 
 				var parent *ConfigConfig
@@ -5263,9 +6534,11 @@ func (c *domainConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigD
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -5275,7 +6548,8 @@ func (c *domainConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigD
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -5283,12 +6557,21 @@ func (c *domainConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigD
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] ConfigDomain Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewDomainInformer(c.client.baseClient, informerResyncPeriod*time.Second, domainConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Domain)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ConfigDomain{
 					client: c.client,
-					Domain: obj.(*baseconfigtsmtanzuvmwarecomv1.Domain),
+					Domain: deleted,
 				}
 
 				var parent *ConfigConfig
@@ -5322,9 +6605,11 @@ func (c *domainConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigD
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -5334,7 +6619,8 @@ func (c *domainConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigD
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -5345,6 +6631,15 @@ func (c *domainConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigD
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of ConfigDomain
+func (c *domainConfigTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "domains.config.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetFooByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *GnsTsmV1) GetFooByName(ctx context.Context, hashedName string) (*GnsFoo, error) {
@@ -5368,14 +6663,14 @@ func (group *GnsTsmV1) GetFooByName(ctx context.Context, hashedName string) (*Gn
 			Foos().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetFooByName] Failed to Get Foos: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Foos: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetFooByName]: %+v", err)
 				return nil, context.Canceled
@@ -5403,14 +6698,14 @@ func (group *GnsTsmV1) ForceReadFooByName(ctx context.Context, hashedName string
 			Foos().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadFooByName] Failed to Get Foos: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Foos: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadFooByName]: %+v", err)
 				return nil, context.Canceled
@@ -5444,14 +6739,14 @@ func (group *GnsTsmV1) DeleteFooByName(ctx context.Context, hashedName string) (
 			Foos().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteFooByName] Failed to get Foos: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Foos: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteFooByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -5471,21 +6766,23 @@ func (group *GnsTsmV1) DeleteFooByName(ctx context.Context, hashedName string) (
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			GnsTsmV1().
-			Foos().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Foos().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteFooByName] failed to delete Foos: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Foos: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteFooByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -5558,14 +6855,14 @@ func (group *GnsTsmV1) DeleteFooByName(ctx context.Context, hashedName string) (
 				Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteFooByName] Failed to patch Foo gvk in parent node[Gnses]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteFooByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -5603,6 +6900,21 @@ func (group *GnsTsmV1) CreateFooByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Gns().GetGnsByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateFooByName] Failed to get parent of Foo: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, basegnstsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Gns", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -5610,14 +6922,14 @@ func (group *GnsTsmV1) CreateFooByName(ctx context.Context,
 			Foos().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateFooByName] Failed to create Foo: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Foo: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateFooByName] context canceled while creating Foo: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -5636,14 +6948,6 @@ func (group *GnsTsmV1) CreateFooByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Gns().GetGnsByName(context.Background(), parentName)
 		if err2 != nil {
@@ -5680,9 +6984,9 @@ func (group *GnsTsmV1) CreateFooByName(ctx context.Context,
 			Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateFooByName] Failed to patch Foo gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger Foo delete: %s", objToCreate.GetName())
 					delErr := group.DeleteFooByName(newCtx, objToCreate.GetName())
@@ -5694,7 +6998,7 @@ func (group *GnsTsmV1) CreateFooByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateFooByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -5730,6 +7034,11 @@ func (group *GnsTsmV1) UpdateFooByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Gns().GetFooByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -5801,33 +7110,22 @@ func (group *GnsTsmV1) UpdateFooByName(ctx context.Context,
 			Foos().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateFooByName] Failed to patch Foo gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("foos.gns.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Foo Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteFooByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Foo: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Foo deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateFooByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateFooByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Foo Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteFooByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Foo: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Foo Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -5874,6 +7172,12 @@ func (group *GnsTsmV1) ListFoos(ctx context.Context,
 	return
 }
 
+// fooGnsTsmV1Indexers returns indexers of Foo informers, which index objects by values
+// of unique fields.
+func fooGnsTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type GnsFoo struct {
 	client *Clientset
 	*basegnstsmtanzuvmwarecomv1.Foo
@@ -5904,6 +7208,103 @@ func (obj *GnsFoo) GetParent(ctx context.Context) (result *GnsGns, err error) {
 	return obj.client.Gns().GetGnsByName(ctx, hashedName)
 }
 
+// TxGnsFoo is Foo object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxGnsFoo struct {
+	tx *Tx
+	*basegnstsmtanzuvmwarecomv1.Foo
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *GnsFoo) InTx(tx *Tx) *TxGnsFoo {
+	return &TxGnsFoo{
+		tx:  tx,
+		Foo: obj.Foo,
+	}
+}
+
+// createGnsFoo records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createGnsFoo(objToCreate *basegnstsmtanzuvmwarecomv1.Foo) *TxGnsFoo {
+	handle := &TxGnsFoo{
+		tx:  tx,
+		Foo: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["foos.gns.tsm.tanzu.vmware.com"]),
+		name:  "create of foos.gns.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Gns().CreateFooByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Foo = result.Foo
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Gns().DeleteFooByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxGnsFoo) Update() {
+	var previous *basegnstsmtanzuvmwarecomv1.Foo
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["foos.gns.tsm.tanzu.vmware.com"]),
+		name:  "update of foos.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetFooByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Foo.DeepCopy()
+			result, err := obj.tx.client.Gns().UpdateFooByName(ctx, obj.Foo)
+			if err != nil {
+				return err
+			}
+			obj.Foo = result.Foo
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Gns().UpdateFooByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxGnsFoo) Delete() {
+	var deleted *basegnstsmtanzuvmwarecomv1.Foo
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["foos.gns.tsm.tanzu.vmware.com"]),
+		name:  "delete of foos.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetFooByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Foo.DeepCopy()
+			return obj.tx.client.Gns().DeleteFooByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Gns().CreateFooByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type fooGnsTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -5913,7 +7314,7 @@ type fooGnsTsmV1Chainer struct {
 func (c *fooGnsTsmV1Chainer) Subscribe() {
 	key := "foos.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, fooGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -5946,7 +7347,7 @@ func (c *fooGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsFoo), updat
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for GnsFoo, so creating a new one")
-		informer = informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, fooGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -5983,9 +7384,11 @@ func (c *fooGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsFoo), updat
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -5994,7 +7397,8 @@ func (c *fooGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsFoo), updat
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -6013,9 +7417,18 @@ func (c *fooGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsFoo), updat
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Foo)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &GnsFoo{
 				client: c.client,
-				Foo:    obj.(*basegnstsmtanzuvmwarecomv1.Foo),
+				Foo:    deleted,
 			}
 
 			var parent *GnsGns
@@ -6045,9 +7458,11 @@ func (c *fooGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsFoo), updat
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -6056,7 +7471,8 @@ func (c *fooGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsFoo), updat
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -6114,9 +7530,11 @@ func (c *fooGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsFoo)) (cache.
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -6126,7 +7544,8 @@ func (c *fooGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsFoo)) (cache.
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -6134,7 +7553,7 @@ func (c *fooGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsFoo)) (cache.
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] GnsFoo Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, fooGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &GnsFoo{
@@ -6173,9 +7592,11 @@ func (c *fooGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsFoo)) (cache.
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -6185,7 +7606,8 @@ func (c *fooGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsFoo)) (cache.
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -6222,7 +7644,7 @@ func (c *fooGnsTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newObj *Gn
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] GnsFoo Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, fooGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &GnsFoo{
@@ -6254,9 +7676,18 @@ func (c *fooGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsFoo)) (cac
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Foo)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsFoo{
 					client: c.client,
-					Foo:    obj.(*basegnstsmtanzuvmwarecomv1.Foo),
+					Foo:    deleted,
 				}
 
 				var parent *GnsGns
@@ -6289,9 +7720,11 @@ func (c *fooGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsFoo)) (cac
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -6301,7 +7734,8 @@ func (c *fooGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsFoo)) (cac
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -6309,12 +7743,21 @@ func (c *fooGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsFoo)) (cac
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] GnsFoo Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewFooInformer(c.client.baseClient, informerResyncPeriod*time.Second, fooGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Foo)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsFoo{
 					client: c.client,
-					Foo:    obj.(*basegnstsmtanzuvmwarecomv1.Foo),
+					Foo:    deleted,
 				}
 
 				var parent *GnsGns
@@ -6348,9 +7791,11 @@ func (c *fooGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsFoo)) (cac
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -6360,7 +7805,8 @@ func (c *fooGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsFoo)) (cac
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -6371,6 +7817,15 @@ func (c *fooGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsFoo)) (cac
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of GnsFoo
+func (c *fooGnsTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "foos.gns.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetGnsByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *GnsTsmV1) GetGnsByName(ctx context.Context, hashedName string) (*GnsGns, error) {
@@ -6394,14 +7849,14 @@ func (group *GnsTsmV1) GetGnsByName(ctx context.Context, hashedName string) (*Gn
 			Gnses().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetGnsByName] Failed to Get Gnses: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Gnses: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetGnsByName]: %+v", err)
 				return nil, context.Canceled
@@ -6429,14 +7884,14 @@ func (group *GnsTsmV1) ForceReadGnsByName(ctx context.Context, hashedName string
 			Gnses().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadGnsByName] Failed to Get Gnses: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Gnses: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadGnsByName]: %+v", err)
 				return nil, context.Canceled
@@ -6470,14 +7925,14 @@ func (group *GnsTsmV1) DeleteGnsByName(ctx context.Context, hashedName string) (
 			Gnses().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteGnsByName] Failed to get Gnses: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Gnses: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteGnsByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -6498,68 +7953,57 @@ func (group *GnsTsmV1) DeleteGnsByName(ctx context.Context, hashedName string) (
 	}
 
 	for _, v := range result.Spec.GnsServiceGroupsGvk {
-		err := group.client.
-			Servicegroup().DeleteSvcGroupByName(ctx, v.Name)
+		err := group.deleteGnsServiceGroupsIfNotOwned(ctx, result, v.Name)
 		if err != nil {
-			if errors.IsNotFound(err) {
-				continue
-			} else {
-				return err
-			}
+			return err
 		}
 	}
 
 	if result.Spec.GnsAccessControlPolicyGvk != nil {
-		err := group.client.
-			Policypkg().
-			DeleteAccessControlPolicyByName(ctx, result.Spec.GnsAccessControlPolicyGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteGnsAccessControlPolicyIfNotOwned(ctx, result, result.Spec.GnsAccessControlPolicyGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
 	if result.Spec.FooChildGvk != nil {
-		err := group.client.
-			Gns().
-			DeleteBarChildByName(ctx, result.Spec.FooChildGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteFooChildIfNotOwned(ctx, result, result.Spec.FooChildGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
 	if result.Spec.IgnoreChildGvk != nil {
-		err := group.client.
-			Gns().
-			DeleteIgnoreChildByName(ctx, result.Spec.IgnoreChildGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteIgnoreChildIfNotOwned(ctx, result, result.Spec.IgnoreChildGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
 	if result.Spec.FooGvk != nil {
-		err := group.client.
-			Gns().
-			DeleteFooByName(ctx, result.Spec.FooGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteFooIfNotOwned(ctx, result, result.Spec.FooGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			GnsTsmV1().
-			Gnses().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Gnses().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteGnsByName] failed to delete Gnses: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Gnses: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteGnsByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -6632,14 +8076,14 @@ func (group *GnsTsmV1) DeleteGnsByName(ctx context.Context, hashedName string) (
 				Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteGnsByName] Failed to patch Gns gvk in parent node[Configs]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteGnsByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -6652,10 +8096,135 @@ func (group *GnsTsmV1) DeleteGnsByName(ctx context.Context, hashedName string) (
 				break
 			}
 		}
-		log.Debugf("[DeleteGnsByName] Executed Successfully: %s", hashedName)
+		log.Debugf("[DeleteGnsByName] Executed Successfully: %s", hashedName)
+	}
+
+	return
+}
+
+// deleteGnsServiceGroupsIfNotOwned deletes child GnsServiceGroups of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *GnsTsmV1) deleteGnsServiceGroupsIfNotOwned(ctx context.Context,
+	parent *basegnstsmtanzuvmwarecomv1.Gns, hashedName string) error {
+	child, err := group.client.baseClient.
+		ServicegroupTsmV1().
+		SvcGroups().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Servicegroup().DeleteSvcGroupByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteGnsAccessControlPolicyIfNotOwned deletes child GnsAccessControlPolicy of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *GnsTsmV1) deleteGnsAccessControlPolicyIfNotOwned(ctx context.Context,
+	parent *basegnstsmtanzuvmwarecomv1.Gns, hashedName string) error {
+	child, err := group.client.baseClient.
+		PolicypkgTsmV1().
+		AccessControlPolicies().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Policypkg().DeleteAccessControlPolicyByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteFooChildIfNotOwned deletes child FooChild of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *GnsTsmV1) deleteFooChildIfNotOwned(ctx context.Context,
+	parent *basegnstsmtanzuvmwarecomv1.Gns, hashedName string) error {
+	child, err := group.client.baseClient.
+		GnsTsmV1().
+		BarChilds().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Gns().DeleteBarChildByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteIgnoreChildIfNotOwned deletes child IgnoreChild of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *GnsTsmV1) deleteIgnoreChildIfNotOwned(ctx context.Context,
+	parent *basegnstsmtanzuvmwarecomv1.Gns, hashedName string) error {
+	child, err := group.client.baseClient.
+		GnsTsmV1().
+		IgnoreChilds().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Gns().DeleteIgnoreChildByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteFooIfNotOwned deletes child Foo of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *GnsTsmV1) deleteFooIfNotOwned(ctx context.Context,
+	parent *basegnstsmtanzuvmwarecomv1.Gns, hashedName string) error {
+	child, err := group.client.baseClient.
+		GnsTsmV1().
+		Foos().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
 	}
-
-	return
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Gns().DeleteFooByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
 }
 
 // CreateGnsByName creates object in the database without hashing the name.
@@ -6684,6 +8253,21 @@ func (group *GnsTsmV1) CreateGnsByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Config().GetConfigByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateGnsByName] Failed to get parent of Gns: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseconfigtsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Config", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -6691,14 +8275,14 @@ func (group *GnsTsmV1) CreateGnsByName(ctx context.Context,
 			Gnses().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateGnsByName] Failed to create Gns: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Gns: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateGnsByName] context canceled while creating Gns: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -6717,14 +8301,6 @@ func (group *GnsTsmV1) CreateGnsByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Config().GetConfigByName(context.Background(), parentName)
 		if err2 != nil {
@@ -6761,9 +8337,9 @@ func (group *GnsTsmV1) CreateGnsByName(ctx context.Context,
 			Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateGnsByName] Failed to patch Gns gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger Gns delete: %s", objToCreate.GetName())
 					delErr := group.DeleteGnsByName(newCtx, objToCreate.GetName())
@@ -6775,7 +8351,7 @@ func (group *GnsTsmV1) CreateGnsByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateGnsByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -6848,6 +8424,11 @@ func (group *GnsTsmV1) UpdateGnsByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Gns().GetGnsByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -7255,33 +8836,22 @@ func (group *GnsTsmV1) UpdateGnsByName(ctx context.Context,
 			Gnses().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateGnsByName] Failed to patch Gns gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("gnses.gns.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Gns Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteGnsByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Gns: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Gns deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateGnsByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateGnsByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Gns Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteGnsByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Gns: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Gns Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -7328,6 +8898,61 @@ func (group *GnsTsmV1) ListGnses(ctx context.Context,
 	return
 }
 
+// gnsGnsTsmV1Indexers returns indexers of Gns informers, which index objects by values
+// of unique fields.
+func gnsGnsTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{
+		"unique:domain": func(obj interface{}) ([]string, error) {
+			item, ok := obj.(*basegnstsmtanzuvmwarecomv1.Gns)
+			if !ok {
+				return nil, nil
+			}
+			return []string{gnsGnsTsmV1DomainIndexKey(item)}, nil
+		},
+	}
+}
+
+func gnsGnsTsmV1DomainIndexKey(obj *basegnstsmtanzuvmwarecomv1.Gns) string {
+	return uniqueIndexKey(hashedParentName(obj.GetLabels(), "configs.config.tsm.tanzu.vmware.com"), obj.Spec.Domain)
+}
+
+// GetGnsByDomain returns Gns which has given Domain.
+// Values of Domain are unique among Gnses of the same parent, parentName is the
+// hashed name of the parent.
+// Objects are looked up in the informer cache if the client is subscribed to Gnses.
+func (group *GnsTsmV1) GetGnsByDomain(ctx context.Context, parentName string, domain string) (*GnsGns, error) {
+	indexKey := uniqueIndexKey(parentName, domain)
+	var items []*basegnstsmtanzuvmwarecomv1.Gns
+	key := "gnses.gns.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		objs, err := s.(subscription).informer.GetIndexer().ByIndex("unique:domain", indexKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			items = append(items, obj.(*basegnstsmtanzuvmwarecomv1.Gns))
+		}
+	} else {
+		list, err := group.client.baseClient.GnsTsmV1().
+			Gnses().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			if gnsGnsTsmV1DomainIndexKey(&list.Items[i]) == indexKey {
+				items = append(items, &list.Items[i])
+			}
+		}
+	}
+	if len(items) == 0 {
+		return nil, NewUniqueNotFound("Gns", "Domain", indexKey)
+	}
+	return &GnsGns{
+		client: group.client,
+		Gns:    items[0],
+	}, nil
+}
+
 type GnsGns struct {
 	client *Clientset
 	*basegnstsmtanzuvmwarecomv1.Gns
@@ -7667,94 +9292,357 @@ func (obj *GnsGns) AddFoo(ctx context.Context,
 		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
 		objToCreate.Name = hashedName
 	}
-	result, err = obj.client.Gns().CreateFooByName(ctx, objToCreate)
-	log.Debugf("[AddFoo] Foo created successfully: %s", objToCreate.GetName())
-	updatedObj, getErr := obj.client.Gns().GetGnsByName(ctx, obj.GetName())
-	if getErr == nil {
-		obj.Gns = updatedObj.Gns
-	}
-	log.Debugf("[AddFoo] Executed Successfully: %s", objToCreate.GetName())
-	return
+	result, err = obj.client.Gns().CreateFooByName(ctx, objToCreate)
+	log.Debugf("[AddFoo] Foo created successfully: %s", objToCreate.GetName())
+	updatedObj, getErr := obj.client.Gns().GetGnsByName(ctx, obj.GetName())
+	if getErr == nil {
+		obj.Gns = updatedObj.Gns
+	}
+	log.Debugf("[AddFoo] Executed Successfully: %s", objToCreate.GetName())
+	return
+}
+
+// DeleteFoo calculates hashed name of the child to delete based on displayName
+// and parents names and deletes it.
+
+func (obj *GnsGns) DeleteFoo(ctx context.Context) (err error) {
+	if obj.Spec.FooGvk != nil {
+		err = obj.client.
+			Gns().DeleteFooByName(ctx, obj.Spec.FooGvk.Name)
+		if err != nil {
+			return err
+		}
+	}
+	updatedObj, err := obj.client.
+		Gns().GetGnsByName(ctx, obj.GetName())
+	if err == nil {
+		obj.Gns = updatedObj.Gns
+	}
+	return
+}
+
+// GetDns returns link of given type
+func (obj *GnsGns) GetDns(ctx context.Context) (
+	result *GnsDns, err error) {
+	if obj.Spec.DnsGvk == nil {
+		return nil, NewLinkNotFound(obj.DisplayName(), "Gns.Gns", "Dns")
+	}
+	return obj.client.Gns().GetDnsByName(ctx, obj.Spec.DnsGvk.Name)
+}
+
+// LinkDns links obj with linkToAdd object. This function doesn't create linked object, it must be
+// already created.
+func (obj *GnsGns) LinkDns(ctx context.Context,
+	linkToAdd *GnsDns) error {
+
+	var patch Patch
+	patchOp := PatchOp{
+		Op:   "replace",
+		Path: "/spec/dnsGvk",
+		Value: basegnstsmtanzuvmwarecomv1.Child{
+			Group: "gns.tsm.tanzu.vmware.com",
+			Kind:  "Dns",
+			Name:  linkToAdd.Name,
+		},
+	}
+	patch = append(patch, patchOp)
+	marshaled, err := patch.Marshal()
+	if err != nil {
+		return err
+	}
+	result, err := obj.client.baseClient.GnsTsmV1().Gnses().Patch(ctx, obj.Name, types.JSONPatchType, marshaled, metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+
+	obj.Gns = result
+	return nil
+}
+
+// UnlinkDns unlinks linkToRemove object from obj. This function doesn't delete linked object.
+func (obj *GnsGns) UnlinkDns(ctx context.Context) (err error) {
+	var patch Patch
+
+	patchOp := PatchOp{
+		Op:   "remove",
+		Path: "/spec/dnsGvk",
+	}
+
+	patch = append(patch, patchOp)
+	marshaled, err := patch.Marshal()
+	if err != nil {
+		return err
+	}
+	result, err := obj.client.baseClient.GnsTsmV1().Gnses().Patch(ctx, obj.Name, types.JSONPatchType, marshaled, metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+	obj.Gns = result
+	return nil
+
+}
+
+// TxGnsGns is Gns object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxGnsGns struct {
+	tx *Tx
+	*basegnstsmtanzuvmwarecomv1.Gns
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *GnsGns) InTx(tx *Tx) *TxGnsGns {
+	return &TxGnsGns{
+		tx:  tx,
+		Gns: obj.Gns,
+	}
+}
+
+// createGnsGns records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createGnsGns(objToCreate *basegnstsmtanzuvmwarecomv1.Gns) *TxGnsGns {
+	handle := &TxGnsGns{
+		tx:  tx,
+		Gns: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"]),
+		name:  "create of gnses.gns.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Gns().CreateGnsByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Gns = result.Gns
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Gns().DeleteGnsByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxGnsGns) Update() {
+	var previous *basegnstsmtanzuvmwarecomv1.Gns
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"]),
+		name:  "update of gnses.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetGnsByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Gns.DeepCopy()
+			result, err := obj.tx.client.Gns().UpdateGnsByName(ctx, obj.Gns)
+			if err != nil {
+				return err
+			}
+			obj.Gns = result.Gns
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Gns().UpdateGnsByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxGnsGns) Delete() {
+	var deleted *basegnstsmtanzuvmwarecomv1.Gns
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"]),
+		name:  "delete of gnses.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetGnsByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Gns.DeepCopy()
+			return obj.tx.client.Gns().DeleteGnsByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Gns().CreateGnsByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
+// AddGnsServiceGroups records creation of the child, its name is hashed the same way as by
+// GnsGns.AddGnsServiceGroups.
+func (obj *TxGnsGns) AddGnsServiceGroups(
+	objToCreate *baseservicegrouptsmtanzuvmwarecomv1.SvcGroup) *TxServicegroupSvcGroup {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["gnses.gns.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createServicegroupSvcGroup(objToCreate)
+}
+
+// AddGnsAccessControlPolicy records creation of the child, its name is hashed the same way as by
+// GnsGns.AddGnsAccessControlPolicy.
+func (obj *TxGnsGns) AddGnsAccessControlPolicy(
+	objToCreate *basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy) *TxPolicypkgAccessControlPolicy {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["gnses.gns.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createPolicypkgAccessControlPolicy(objToCreate)
+}
+
+// AddFooChild records creation of the child, its name is hashed the same way as by
+// GnsGns.AddFooChild.
+func (obj *TxGnsGns) AddFooChild(
+	objToCreate *basegnstsmtanzuvmwarecomv1.BarChild) *TxGnsBarChild {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["gnses.gns.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		if objToCreate.GetName() == "" {
+			objToCreate.SetName(helper.DEFAULT_KEY)
+		}
+		if objToCreate.GetName() != helper.DEFAULT_KEY {
+			obj.tx.fail(NewSingletonNameError(objToCreate.GetName()))
+		}
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createGnsBarChild(objToCreate)
+}
+
+// AddIgnoreChild records creation of the child, its name is hashed the same way as by
+// GnsGns.AddIgnoreChild.
+func (obj *TxGnsGns) AddIgnoreChild(
+	objToCreate *basegnstsmtanzuvmwarecomv1.IgnoreChild) *TxGnsIgnoreChild {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["gnses.gns.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createGnsIgnoreChild(objToCreate)
 }
 
-// DeleteFoo calculates hashed name of the child to delete based on displayName
-// and parents names and deletes it.
-
-func (obj *GnsGns) DeleteFoo(ctx context.Context) (err error) {
-	if obj.Spec.FooGvk != nil {
-		err = obj.client.
-			Gns().DeleteFooByName(ctx, obj.Spec.FooGvk.Name)
-		if err != nil {
-			return err
-		}
+// AddFoo records creation of the child, its name is hashed the same way as by
+// GnsGns.AddFoo.
+func (obj *TxGnsGns) AddFoo(
+	objToCreate *basegnstsmtanzuvmwarecomv1.Foo) *TxGnsFoo {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
 	}
-	updatedObj, err := obj.client.
-		Gns().GetGnsByName(ctx, obj.GetName())
-	if err == nil {
-		obj.Gns = updatedObj.Gns
+	for _, v := range helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
 	}
-	return
-}
-
-// GetDns returns link of given type
-func (obj *GnsGns) GetDns(ctx context.Context) (
-	result *GnsDns, err error) {
-	if obj.Spec.DnsGvk == nil {
-		return nil, NewLinkNotFound(obj.DisplayName(), "Gns.Gns", "Dns")
+	objToCreate.Labels["gnses.gns.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
 	}
-	return obj.client.Gns().GetDnsByName(ctx, obj.Spec.DnsGvk.Name)
+	return obj.tx.createGnsFoo(objToCreate)
 }
 
-// LinkDns links obj with linkToAdd object. This function doesn't create linked object, it must be
-// already created.
-func (obj *GnsGns) LinkDns(ctx context.Context,
-	linkToAdd *GnsDns) error {
-
-	var patch Patch
-	patchOp := PatchOp{
-		Op:   "replace",
-		Path: "/spec/dnsGvk",
-		Value: basegnstsmtanzuvmwarecomv1.Child{
-			Group: "gns.tsm.tanzu.vmware.com",
-			Kind:  "Dns",
-			Name:  linkToAdd.Name,
-		},
-	}
-	patch = append(patch, patchOp)
-	marshaled, err := patch.Marshal()
-	if err != nil {
-		return err
-	}
-	result, err := obj.client.baseClient.GnsTsmV1().Gnses().Patch(ctx, obj.Name, types.JSONPatchType, marshaled, metav1.PatchOptions{})
-	if err != nil {
-		return err
-	}
-
-	obj.Gns = result
-	return nil
+// LinkDns records linking obj with linkToAdd, which can be created in the same transaction.
+// The previous value of the link is restored on rollback.
+func (obj *TxGnsGns) LinkDns(linkToAdd *TxGnsDns) {
+	obj.recordDns(false, linkToAdd)
 }
 
-// UnlinkDns unlinks linkToRemove object from obj. This function doesn't delete linked object.
-func (obj *GnsGns) UnlinkDns(ctx context.Context) (err error) {
-	var patch Patch
-
-	patchOp := PatchOp{
-		Op:   "remove",
-		Path: "/spec/dnsGvk",
-	}
-
-	patch = append(patch, patchOp)
-	marshaled, err := patch.Marshal()
-	if err != nil {
-		return err
-	}
-	result, err := obj.client.baseClient.GnsTsmV1().Gnses().Patch(ctx, obj.Name, types.JSONPatchType, marshaled, metav1.PatchOptions{})
-	if err != nil {
-		return err
-	}
-	obj.Gns = result
-	return nil
+// UnlinkDns records unlinking obj. The link is restored on rollback.
+func (obj *TxGnsGns) UnlinkDns() {
+	obj.recordDns(true, nil)
+}
 
+func (obj *TxGnsGns) recordDns(unlink bool,
+	target *TxGnsDns) {
+	var (
+		key      string
+		previous interface{}
+	)
+	op := "link"
+	if unlink {
+		op = "unlink"
+	}
+	obj.tx.record(&txStep{
+		phase: txPhaseLink,
+		depth: len(helper.GetCRDParentsMap()["gnses.gns.tsm.tanzu.vmware.com"]),
+		name:  op + " of Dns of gnses.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetGnsByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			var link *GnsDns
+			if target != nil {
+				link = &GnsDns{
+					client: obj.tx.client,
+					Dns:    target.Dns,
+				}
+			}
+			previous = current.Spec.DnsGvk
+			if unlink {
+				err = current.UnlinkDns(ctx)
+			} else {
+				err = current.LinkDns(ctx, link)
+			}
+			if err != nil {
+				return err
+			}
+			obj.Gns = current.Gns
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			payload, err := restoreGvkPatch("dnsGvk", key, previous)
+			if err != nil {
+				return err
+			}
+			_, err = obj.tx.client.baseClient.GnsTsmV1().Gnses().Patch(ctx, obj.GetName(), types.MergePatchType, payload, metav1.PatchOptions{})
+			return err
+		},
+	})
 }
 
 type gnsGnsTsmV1Chainer struct {
@@ -7766,7 +9654,7 @@ type gnsGnsTsmV1Chainer struct {
 func (c *gnsGnsTsmV1Chainer) Subscribe() {
 	key := "gnses.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, gnsGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -7799,7 +9687,7 @@ func (c *gnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsGns), updat
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for GnsGns, so creating a new one")
-		informer = informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, gnsGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -7836,9 +9724,11 @@ func (c *gnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsGns), updat
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -7847,7 +9737,8 @@ func (c *gnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsGns), updat
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -7866,9 +9757,18 @@ func (c *gnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsGns), updat
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Gns)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &GnsGns{
 				client: c.client,
-				Gns:    obj.(*basegnstsmtanzuvmwarecomv1.Gns),
+				Gns:    deleted,
 			}
 
 			var parent *ConfigConfig
@@ -7898,9 +9798,11 @@ func (c *gnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsGns), updat
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -7909,7 +9811,8 @@ func (c *gnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsGns), updat
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -7967,9 +9870,11 @@ func (c *gnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsGns)) (cache.
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -7979,7 +9884,8 @@ func (c *gnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsGns)) (cache.
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -7987,7 +9893,7 @@ func (c *gnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsGns)) (cache.
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] GnsGns Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, gnsGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &GnsGns{
@@ -8026,9 +9932,11 @@ func (c *gnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsGns)) (cache.
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -8038,7 +9946,8 @@ func (c *gnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsGns)) (cache.
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -8075,7 +9984,7 @@ func (c *gnsGnsTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newObj *Gn
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] GnsGns Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, gnsGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &GnsGns{
@@ -8107,9 +10016,18 @@ func (c *gnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsGns)) (cac
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Gns)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsGns{
 					client: c.client,
-					Gns:    obj.(*basegnstsmtanzuvmwarecomv1.Gns),
+					Gns:    deleted,
 				}
 
 				var parent *ConfigConfig
@@ -8142,9 +10060,11 @@ func (c *gnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsGns)) (cac
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -8154,7 +10074,8 @@ func (c *gnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsGns)) (cac
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -8162,12 +10083,21 @@ func (c *gnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsGns)) (cac
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] GnsGns Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewGnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, gnsGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Gns)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsGns{
 					client: c.client,
-					Gns:    obj.(*basegnstsmtanzuvmwarecomv1.Gns),
+					Gns:    deleted,
 				}
 
 				var parent *ConfigConfig
@@ -8201,9 +10131,11 @@ func (c *gnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsGns)) (cac
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -8213,7 +10145,8 @@ func (c *gnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsGns)) (cac
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -8224,6 +10157,15 @@ func (c *gnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsGns)) (cac
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of GnsGns
+func (c *gnsGnsTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "gnses.gns.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // ClearState to clear user defined status
 func (c *gnsGnsTsmV1Chainer) ClearState(ctx context.Context) (err error) {
 	hashedName := helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", c.parentLabels, c.name)
@@ -8520,14 +10462,14 @@ func (group *GnsTsmV1) GetBarChildByName(ctx context.Context, hashedName string)
 			BarChilds().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetBarChildByName] Failed to Get BarChilds: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get BarChilds: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetBarChildByName]: %+v", err)
 				return nil, context.Canceled
@@ -8555,14 +10497,14 @@ func (group *GnsTsmV1) ForceReadBarChildByName(ctx context.Context, hashedName s
 			BarChilds().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadBarChildByName] Failed to Get BarChilds: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get BarChilds: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadBarChildByName]: %+v", err)
 				return nil, context.Canceled
@@ -8596,14 +10538,14 @@ func (group *GnsTsmV1) DeleteBarChildByName(ctx context.Context, hashedName stri
 			BarChilds().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteBarChildByName] Failed to get BarChilds: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get BarChilds: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteBarChildByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -8623,21 +10565,23 @@ func (group *GnsTsmV1) DeleteBarChildByName(ctx context.Context, hashedName stri
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			GnsTsmV1().
-			BarChilds().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			BarChilds().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteBarChildByName] failed to delete BarChilds: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete BarChilds: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteBarChildByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -8710,14 +10654,14 @@ func (group *GnsTsmV1) DeleteBarChildByName(ctx context.Context, hashedName stri
 				Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteBarChildByName] Failed to patch BarChild gvk in parent node[Gnses]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteBarChildByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -8761,6 +10705,21 @@ func (group *GnsTsmV1) CreateBarChildByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Gns().GetGnsByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateBarChildByName] Failed to get parent of BarChild: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, basegnstsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Gns", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -8768,14 +10727,14 @@ func (group *GnsTsmV1) CreateBarChildByName(ctx context.Context,
 			BarChilds().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateBarChildByName] Failed to create BarChild: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create BarChild: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateBarChildByName] context canceled while creating BarChild: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -8794,14 +10753,6 @@ func (group *GnsTsmV1) CreateBarChildByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Gns().GetGnsByName(context.Background(), parentName)
 		if err2 != nil {
@@ -8838,9 +10789,9 @@ func (group *GnsTsmV1) CreateBarChildByName(ctx context.Context,
 			Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateBarChildByName] Failed to patch BarChild gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger BarChild delete: %s", objToCreate.GetName())
 					delErr := group.DeleteBarChildByName(newCtx, objToCreate.GetName())
@@ -8852,7 +10803,7 @@ func (group *GnsTsmV1) CreateBarChildByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateBarChildByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -8891,6 +10842,11 @@ func (group *GnsTsmV1) UpdateBarChildByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Gns().GetBarChildByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -8962,33 +10918,22 @@ func (group *GnsTsmV1) UpdateBarChildByName(ctx context.Context,
 			BarChilds().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateBarChildByName] Failed to patch BarChild gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("barchilds.gns.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger BarChild Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteBarChildByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting BarChild: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("BarChild deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateBarChildByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateBarChildByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger BarChild Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteBarChildByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting BarChild: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("BarChild Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -9035,6 +10980,12 @@ func (group *GnsTsmV1) ListBarChilds(ctx context.Context,
 	return
 }
 
+// barchildGnsTsmV1Indexers returns indexers of BarChild informers, which index objects by values
+// of unique fields.
+func barchildGnsTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type GnsBarChild struct {
 	client *Clientset
 	*basegnstsmtanzuvmwarecomv1.BarChild
@@ -9065,6 +11016,103 @@ func (obj *GnsBarChild) GetParent(ctx context.Context) (result *GnsGns, err erro
 	return obj.client.Gns().GetGnsByName(ctx, hashedName)
 }
 
+// TxGnsBarChild is BarChild object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxGnsBarChild struct {
+	tx *Tx
+	*basegnstsmtanzuvmwarecomv1.BarChild
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *GnsBarChild) InTx(tx *Tx) *TxGnsBarChild {
+	return &TxGnsBarChild{
+		tx:       tx,
+		BarChild: obj.BarChild,
+	}
+}
+
+// createGnsBarChild records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createGnsBarChild(objToCreate *basegnstsmtanzuvmwarecomv1.BarChild) *TxGnsBarChild {
+	handle := &TxGnsBarChild{
+		tx:       tx,
+		BarChild: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["barchilds.gns.tsm.tanzu.vmware.com"]),
+		name:  "create of barchilds.gns.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Gns().CreateBarChildByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.BarChild = result.BarChild
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Gns().DeleteBarChildByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxGnsBarChild) Update() {
+	var previous *basegnstsmtanzuvmwarecomv1.BarChild
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["barchilds.gns.tsm.tanzu.vmware.com"]),
+		name:  "update of barchilds.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetBarChildByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.BarChild.DeepCopy()
+			result, err := obj.tx.client.Gns().UpdateBarChildByName(ctx, obj.BarChild)
+			if err != nil {
+				return err
+			}
+			obj.BarChild = result.BarChild
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Gns().UpdateBarChildByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxGnsBarChild) Delete() {
+	var deleted *basegnstsmtanzuvmwarecomv1.BarChild
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["barchilds.gns.tsm.tanzu.vmware.com"]),
+		name:  "delete of barchilds.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetBarChildByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.BarChild.DeepCopy()
+			return obj.tx.client.Gns().DeleteBarChildByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Gns().CreateBarChildByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type barchildGnsTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -9074,7 +11122,7 @@ type barchildGnsTsmV1Chainer struct {
 func (c *barchildGnsTsmV1Chainer) Subscribe() {
 	key := "barchilds.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, barchildGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -9107,7 +11155,7 @@ func (c *barchildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsBarChi
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for GnsBarChild, so creating a new one")
-		informer = informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, barchildGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -9144,9 +11192,11 @@ func (c *barchildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsBarChi
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -9155,7 +11205,8 @@ func (c *barchildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsBarChi
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -9174,9 +11225,18 @@ func (c *barchildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsBarChi
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.BarChild)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &GnsBarChild{
 				client:   c.client,
-				BarChild: obj.(*basegnstsmtanzuvmwarecomv1.BarChild),
+				BarChild: deleted,
 			}
 
 			var parent *GnsGns
@@ -9206,9 +11266,11 @@ func (c *barchildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsBarChi
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -9217,7 +11279,8 @@ func (c *barchildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsBarChi
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -9275,9 +11338,11 @@ func (c *barchildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsBarChild
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -9287,7 +11352,8 @@ func (c *barchildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsBarChild
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -9295,7 +11361,7 @@ func (c *barchildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsBarChild
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] GnsBarChild Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, barchildGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &GnsBarChild{
@@ -9334,9 +11400,11 @@ func (c *barchildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsBarChild
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -9346,7 +11414,8 @@ func (c *barchildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsBarChild
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -9383,7 +11452,7 @@ func (c *barchildGnsTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newOb
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] GnsBarChild Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, barchildGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &GnsBarChild{
@@ -9415,9 +11484,18 @@ func (c *barchildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsBarCh
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.BarChild)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsBarChild{
 					client:   c.client,
-					BarChild: obj.(*basegnstsmtanzuvmwarecomv1.BarChild),
+					BarChild: deleted,
 				}
 
 				var parent *GnsGns
@@ -9450,9 +11528,11 @@ func (c *barchildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsBarCh
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -9462,7 +11542,8 @@ func (c *barchildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsBarCh
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -9470,12 +11551,21 @@ func (c *barchildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsBarCh
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] GnsBarChild Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewBarChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, barchildGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.BarChild)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsBarChild{
 					client:   c.client,
-					BarChild: obj.(*basegnstsmtanzuvmwarecomv1.BarChild),
+					BarChild: deleted,
 				}
 
 				var parent *GnsGns
@@ -9509,9 +11599,11 @@ func (c *barchildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsBarCh
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -9521,7 +11613,8 @@ func (c *barchildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsBarCh
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -9532,6 +11625,15 @@ func (c *barchildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsBarCh
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of GnsBarChild
+func (c *barchildGnsTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "barchilds.gns.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetIgnoreChildByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *GnsTsmV1) GetIgnoreChildByName(ctx context.Context, hashedName string) (*GnsIgnoreChild, error) {
@@ -9555,14 +11657,14 @@ func (group *GnsTsmV1) GetIgnoreChildByName(ctx context.Context, hashedName stri
 			IgnoreChilds().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetIgnoreChildByName] Failed to Get IgnoreChilds: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get IgnoreChilds: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetIgnoreChildByName]: %+v", err)
 				return nil, context.Canceled
@@ -9590,14 +11692,14 @@ func (group *GnsTsmV1) ForceReadIgnoreChildByName(ctx context.Context, hashedNam
 			IgnoreChilds().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadIgnoreChildByName] Failed to Get IgnoreChilds: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get IgnoreChilds: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadIgnoreChildByName]: %+v", err)
 				return nil, context.Canceled
@@ -9631,14 +11733,14 @@ func (group *GnsTsmV1) DeleteIgnoreChildByName(ctx context.Context, hashedName s
 			IgnoreChilds().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteIgnoreChildByName] Failed to get IgnoreChilds: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get IgnoreChilds: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteIgnoreChildByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -9658,21 +11760,23 @@ func (group *GnsTsmV1) DeleteIgnoreChildByName(ctx context.Context, hashedName s
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			GnsTsmV1().
-			IgnoreChilds().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			IgnoreChilds().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteIgnoreChildByName] failed to delete IgnoreChilds: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete IgnoreChilds: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteIgnoreChildByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -9745,14 +11849,14 @@ func (group *GnsTsmV1) DeleteIgnoreChildByName(ctx context.Context, hashedName s
 				Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteIgnoreChildByName] Failed to patch IgnoreChild gvk in parent node[Gnses]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteIgnoreChildByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -9783,13 +11887,28 @@ func (group *GnsTsmV1) CreateIgnoreChildByName(ctx context.Context,
 		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
 	}
 
-	var (
-		retryCount int
-		result     *basegnstsmtanzuvmwarecomv1.IgnoreChild
-		err        error
-		exists     bool
-		existsErr  error
-	)
+	var (
+		retryCount int
+		result     *basegnstsmtanzuvmwarecomv1.IgnoreChild
+		err        error
+		exists     bool
+		existsErr  error
+	)
+
+	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Gns().GetGnsByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateIgnoreChildByName] Failed to get parent of IgnoreChild: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, basegnstsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Gns", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -9797,14 +11916,14 @@ func (group *GnsTsmV1) CreateIgnoreChildByName(ctx context.Context,
 			IgnoreChilds().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateIgnoreChildByName] Failed to create IgnoreChild: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create IgnoreChild: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateIgnoreChildByName] context canceled while creating IgnoreChild: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -9823,14 +11942,6 @@ func (group *GnsTsmV1) CreateIgnoreChildByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Gns().GetGnsByName(context.Background(), parentName)
 		if err2 != nil {
@@ -9867,9 +11978,9 @@ func (group *GnsTsmV1) CreateIgnoreChildByName(ctx context.Context,
 			Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateIgnoreChildByName] Failed to patch IgnoreChild gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger IgnoreChild delete: %s", objToCreate.GetName())
 					delErr := group.DeleteIgnoreChildByName(newCtx, objToCreate.GetName())
@@ -9881,7 +11992,7 @@ func (group *GnsTsmV1) CreateIgnoreChildByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateIgnoreChildByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -9917,6 +12028,11 @@ func (group *GnsTsmV1) UpdateIgnoreChildByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Gns().GetIgnoreChildByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -9988,33 +12104,22 @@ func (group *GnsTsmV1) UpdateIgnoreChildByName(ctx context.Context,
 			IgnoreChilds().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateIgnoreChildByName] Failed to patch IgnoreChild gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("ignorechilds.gns.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger IgnoreChild Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteIgnoreChildByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting IgnoreChild: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("IgnoreChild deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateIgnoreChildByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateIgnoreChildByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger IgnoreChild Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteIgnoreChildByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting IgnoreChild: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("IgnoreChild Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -10061,6 +12166,12 @@ func (group *GnsTsmV1) ListIgnoreChilds(ctx context.Context,
 	return
 }
 
+// ignorechildGnsTsmV1Indexers returns indexers of IgnoreChild informers, which index objects by values
+// of unique fields.
+func ignorechildGnsTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type GnsIgnoreChild struct {
 	client *Clientset
 	*basegnstsmtanzuvmwarecomv1.IgnoreChild
@@ -10091,6 +12202,103 @@ func (obj *GnsIgnoreChild) GetParent(ctx context.Context) (result *GnsGns, err e
 	return obj.client.Gns().GetGnsByName(ctx, hashedName)
 }
 
+// TxGnsIgnoreChild is IgnoreChild object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxGnsIgnoreChild struct {
+	tx *Tx
+	*basegnstsmtanzuvmwarecomv1.IgnoreChild
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *GnsIgnoreChild) InTx(tx *Tx) *TxGnsIgnoreChild {
+	return &TxGnsIgnoreChild{
+		tx:          tx,
+		IgnoreChild: obj.IgnoreChild,
+	}
+}
+
+// createGnsIgnoreChild records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createGnsIgnoreChild(objToCreate *basegnstsmtanzuvmwarecomv1.IgnoreChild) *TxGnsIgnoreChild {
+	handle := &TxGnsIgnoreChild{
+		tx:          tx,
+		IgnoreChild: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["ignorechilds.gns.tsm.tanzu.vmware.com"]),
+		name:  "create of ignorechilds.gns.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Gns().CreateIgnoreChildByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.IgnoreChild = result.IgnoreChild
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Gns().DeleteIgnoreChildByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxGnsIgnoreChild) Update() {
+	var previous *basegnstsmtanzuvmwarecomv1.IgnoreChild
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["ignorechilds.gns.tsm.tanzu.vmware.com"]),
+		name:  "update of ignorechilds.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetIgnoreChildByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.IgnoreChild.DeepCopy()
+			result, err := obj.tx.client.Gns().UpdateIgnoreChildByName(ctx, obj.IgnoreChild)
+			if err != nil {
+				return err
+			}
+			obj.IgnoreChild = result.IgnoreChild
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Gns().UpdateIgnoreChildByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxGnsIgnoreChild) Delete() {
+	var deleted *basegnstsmtanzuvmwarecomv1.IgnoreChild
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["ignorechilds.gns.tsm.tanzu.vmware.com"]),
+		name:  "delete of ignorechilds.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetIgnoreChildByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.IgnoreChild.DeepCopy()
+			return obj.tx.client.Gns().DeleteIgnoreChildByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Gns().CreateIgnoreChildByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type ignorechildGnsTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -10100,7 +12308,7 @@ type ignorechildGnsTsmV1Chainer struct {
 func (c *ignorechildGnsTsmV1Chainer) Subscribe() {
 	key := "ignorechilds.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, ignorechildGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -10133,7 +12341,7 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsIgn
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for GnsIgnoreChild, so creating a new one")
-		informer = informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, ignorechildGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -10170,9 +12378,11 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsIgn
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -10181,7 +12391,8 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsIgn
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -10200,9 +12411,18 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsIgn
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.IgnoreChild)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &GnsIgnoreChild{
 				client:      c.client,
-				IgnoreChild: obj.(*basegnstsmtanzuvmwarecomv1.IgnoreChild),
+				IgnoreChild: deleted,
 			}
 
 			var parent *GnsGns
@@ -10232,9 +12452,11 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsIgn
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -10243,7 +12465,8 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsIgn
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -10301,9 +12524,11 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsIgnor
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -10313,7 +12538,8 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsIgnor
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -10321,7 +12547,7 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsIgnor
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] GnsIgnoreChild Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, ignorechildGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &GnsIgnoreChild{
@@ -10360,9 +12586,11 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsIgnor
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -10372,7 +12600,8 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsIgnor
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -10409,7 +12638,7 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, ne
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] GnsIgnoreChild Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, ignorechildGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &GnsIgnoreChild{
@@ -10441,9 +12670,18 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsIg
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.IgnoreChild)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsIgnoreChild{
 					client:      c.client,
-					IgnoreChild: obj.(*basegnstsmtanzuvmwarecomv1.IgnoreChild),
+					IgnoreChild: deleted,
 				}
 
 				var parent *GnsGns
@@ -10476,9 +12714,11 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsIg
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -10488,7 +12728,8 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsIg
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -10496,12 +12737,21 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsIg
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] GnsIgnoreChild Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewIgnoreChildInformer(c.client.baseClient, informerResyncPeriod*time.Second, ignorechildGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.IgnoreChild)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsIgnoreChild{
 					client:      c.client,
-					IgnoreChild: obj.(*basegnstsmtanzuvmwarecomv1.IgnoreChild),
+					IgnoreChild: deleted,
 				}
 
 				var parent *GnsGns
@@ -10535,9 +12785,11 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsIg
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -10547,7 +12799,8 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsIg
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -10558,6 +12811,15 @@ func (c *ignorechildGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsIg
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of GnsIgnoreChild
+func (c *ignorechildGnsTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "ignorechilds.gns.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetDnsByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *GnsTsmV1) GetDnsByName(ctx context.Context, hashedName string) (*GnsDns, error) {
@@ -10581,14 +12843,14 @@ func (group *GnsTsmV1) GetDnsByName(ctx context.Context, hashedName string) (*Gn
 			Dnses().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetDnsByName] Failed to Get Dnses: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Dnses: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetDnsByName]: %+v", err)
 				return nil, context.Canceled
@@ -10616,14 +12878,14 @@ func (group *GnsTsmV1) ForceReadDnsByName(ctx context.Context, hashedName string
 			Dnses().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadDnsByName] Failed to Get Dnses: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Dnses: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadDnsByName]: %+v", err)
 				return nil, context.Canceled
@@ -10657,14 +12919,14 @@ func (group *GnsTsmV1) DeleteDnsByName(ctx context.Context, hashedName string) (
 			Dnses().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteDnsByName] Failed to get Dnses: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Dnses: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteDnsByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -10684,21 +12946,23 @@ func (group *GnsTsmV1) DeleteDnsByName(ctx context.Context, hashedName string) (
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			GnsTsmV1().
-			Dnses().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Dnses().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteDnsByName] failed to delete Dnses: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Dnses: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteDnsByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -10771,14 +13035,14 @@ func (group *GnsTsmV1) DeleteDnsByName(ctx context.Context, hashedName string) (
 				Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteDnsByName] Failed to patch Dns gvk in parent node[Configs]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteDnsByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -10822,6 +13086,21 @@ func (group *GnsTsmV1) CreateDnsByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Config().GetConfigByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateDnsByName] Failed to get parent of Dns: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseconfigtsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Config", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -10829,14 +13108,14 @@ func (group *GnsTsmV1) CreateDnsByName(ctx context.Context,
 			Dnses().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateDnsByName] Failed to create Dns: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Dns: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateDnsByName] context canceled while creating Dns: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -10855,14 +13134,6 @@ func (group *GnsTsmV1) CreateDnsByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Config().GetConfigByName(context.Background(), parentName)
 		if err2 != nil {
@@ -10899,9 +13170,9 @@ func (group *GnsTsmV1) CreateDnsByName(ctx context.Context,
 			Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateDnsByName] Failed to patch Dns gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger Dns delete: %s", objToCreate.GetName())
 					delErr := group.DeleteDnsByName(newCtx, objToCreate.GetName())
@@ -10913,7 +13184,7 @@ func (group *GnsTsmV1) CreateDnsByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateDnsByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -10952,6 +13223,11 @@ func (group *GnsTsmV1) UpdateDnsByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Gns().GetDnsByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -11000,33 +13276,22 @@ func (group *GnsTsmV1) UpdateDnsByName(ctx context.Context,
 			Dnses().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateDnsByName] Failed to patch Dns gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("dnses.gns.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Dns Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteDnsByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Dns: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Dns deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateDnsByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateDnsByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Dns Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteDnsByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Dns: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Dns Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -11073,6 +13338,12 @@ func (group *GnsTsmV1) ListDnses(ctx context.Context,
 	return
 }
 
+// dnsGnsTsmV1Indexers returns indexers of Dns informers, which index objects by values
+// of unique fields.
+func dnsGnsTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type GnsDns struct {
 	client *Clientset
 	*basegnstsmtanzuvmwarecomv1.Dns
@@ -11103,6 +13374,103 @@ func (obj *GnsDns) GetParent(ctx context.Context) (result *ConfigConfig, err err
 	return obj.client.Config().GetConfigByName(ctx, hashedName)
 }
 
+// TxGnsDns is Dns object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxGnsDns struct {
+	tx *Tx
+	*basegnstsmtanzuvmwarecomv1.Dns
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *GnsDns) InTx(tx *Tx) *TxGnsDns {
+	return &TxGnsDns{
+		tx:  tx,
+		Dns: obj.Dns,
+	}
+}
+
+// createGnsDns records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createGnsDns(objToCreate *basegnstsmtanzuvmwarecomv1.Dns) *TxGnsDns {
+	handle := &TxGnsDns{
+		tx:  tx,
+		Dns: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["dnses.gns.tsm.tanzu.vmware.com"]),
+		name:  "create of dnses.gns.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Gns().CreateDnsByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Dns = result.Dns
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Gns().DeleteDnsByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxGnsDns) Update() {
+	var previous *basegnstsmtanzuvmwarecomv1.Dns
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["dnses.gns.tsm.tanzu.vmware.com"]),
+		name:  "update of dnses.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetDnsByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Dns.DeepCopy()
+			result, err := obj.tx.client.Gns().UpdateDnsByName(ctx, obj.Dns)
+			if err != nil {
+				return err
+			}
+			obj.Dns = result.Dns
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Gns().UpdateDnsByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxGnsDns) Delete() {
+	var deleted *basegnstsmtanzuvmwarecomv1.Dns
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["dnses.gns.tsm.tanzu.vmware.com"]),
+		name:  "delete of dnses.gns.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Gns().GetDnsByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Dns.DeepCopy()
+			return obj.tx.client.Gns().DeleteDnsByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Gns().CreateDnsByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type dnsGnsTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -11112,7 +13480,7 @@ type dnsGnsTsmV1Chainer struct {
 func (c *dnsGnsTsmV1Chainer) Subscribe() {
 	key := "dnses.gns.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, dnsGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -11145,7 +13513,7 @@ func (c *dnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsDns), updat
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for GnsDns, so creating a new one")
-		informer = informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, dnsGnsTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -11182,9 +13550,11 @@ func (c *dnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsDns), updat
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -11193,7 +13563,8 @@ func (c *dnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsDns), updat
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -11212,9 +13583,18 @@ func (c *dnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsDns), updat
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Dns)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &GnsDns{
 				client: c.client,
-				Dns:    obj.(*basegnstsmtanzuvmwarecomv1.Dns),
+				Dns:    deleted,
 			}
 
 			var parent *ConfigConfig
@@ -11244,9 +13624,11 @@ func (c *dnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsDns), updat
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -11255,7 +13637,8 @@ func (c *dnsGnsTsmV1Chainer) RegisterEventHandler(addCB func(obj *GnsDns), updat
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -11313,9 +13696,11 @@ func (c *dnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsDns)) (cache.
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -11325,7 +13710,8 @@ func (c *dnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsDns)) (cache.
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -11333,7 +13719,7 @@ func (c *dnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsDns)) (cache.
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] GnsDns Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, dnsGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &GnsDns{
@@ -11372,9 +13758,11 @@ func (c *dnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsDns)) (cache.
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -11384,7 +13772,8 @@ func (c *dnsGnsTsmV1Chainer) RegisterAddCallback(cbfn func(obj *GnsDns)) (cache.
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -11421,7 +13810,7 @@ func (c *dnsGnsTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newObj *Gn
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] GnsDns Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, dnsGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &GnsDns{
@@ -11453,9 +13842,18 @@ func (c *dnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsDns)) (cac
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Dns)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsDns{
 					client: c.client,
-					Dns:    obj.(*basegnstsmtanzuvmwarecomv1.Dns),
+					Dns:    deleted,
 				}
 
 				var parent *ConfigConfig
@@ -11488,9 +13886,11 @@ func (c *dnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsDns)) (cac
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -11500,7 +13900,8 @@ func (c *dnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsDns)) (cac
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -11508,12 +13909,21 @@ func (c *dnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsDns)) (cac
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] GnsDns Create New Informer")
-		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informergnstsmtanzuvmwarecomv1.NewDnsInformer(c.client.baseClient, informerResyncPeriod*time.Second, dnsGnsTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basegnstsmtanzuvmwarecomv1.Dns)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &GnsDns{
 					client: c.client,
-					Dns:    obj.(*basegnstsmtanzuvmwarecomv1.Dns),
+					Dns:    deleted,
 				}
 
 				var parent *ConfigConfig
@@ -11547,9 +13957,11 @@ func (c *dnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsDns)) (cac
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -11559,7 +13971,8 @@ func (c *dnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsDns)) (cac
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -11570,6 +13983,15 @@ func (c *dnsGnsTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *GnsDns)) (cac
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of GnsDns
+func (c *dnsGnsTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "dnses.gns.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetSvcGroupByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *ServicegroupTsmV1) GetSvcGroupByName(ctx context.Context, hashedName string) (*ServicegroupSvcGroup, error) {
@@ -11593,14 +14015,14 @@ func (group *ServicegroupTsmV1) GetSvcGroupByName(ctx context.Context, hashedNam
 			SvcGroups().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetSvcGroupByName] Failed to Get SvcGroups: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get SvcGroups: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetSvcGroupByName]: %+v", err)
 				return nil, context.Canceled
@@ -11628,14 +14050,14 @@ func (group *ServicegroupTsmV1) ForceReadSvcGroupByName(ctx context.Context, has
 			SvcGroups().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadSvcGroupByName] Failed to Get SvcGroups: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get SvcGroups: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadSvcGroupByName]: %+v", err)
 				return nil, context.Canceled
@@ -11669,14 +14091,14 @@ func (group *ServicegroupTsmV1) DeleteSvcGroupByName(ctx context.Context, hashed
 			SvcGroups().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteSvcGroupByName] Failed to get SvcGroups: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get SvcGroups: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteSvcGroupByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -11696,21 +14118,23 @@ func (group *ServicegroupTsmV1) DeleteSvcGroupByName(ctx context.Context, hashed
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			ServicegroupTsmV1().
-			SvcGroups().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			SvcGroups().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteSvcGroupByName] failed to delete SvcGroups: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete SvcGroups: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteSvcGroupByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -11788,14 +14212,14 @@ func (group *ServicegroupTsmV1) DeleteSvcGroupByName(ctx context.Context, hashed
 				Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteSvcGroupByName] Failed to patch SvcGroup gvk in parent node[Gnses]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteSvcGroupByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -11833,6 +14257,21 @@ func (group *ServicegroupTsmV1) CreateSvcGroupByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Gns().GetGnsByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateSvcGroupByName] Failed to get parent of SvcGroup: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, basegnstsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Gns", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -11840,14 +14279,14 @@ func (group *ServicegroupTsmV1) CreateSvcGroupByName(ctx context.Context,
 			SvcGroups().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateSvcGroupByName] Failed to create SvcGroup: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create SvcGroup: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateSvcGroupByName] context canceled while creating SvcGroup: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -11866,14 +14305,6 @@ func (group *ServicegroupTsmV1) CreateSvcGroupByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Gns().GetGnsByName(context.Background(), parentName)
 		if err2 != nil {
@@ -11898,9 +14329,9 @@ func (group *ServicegroupTsmV1) CreateSvcGroupByName(ctx context.Context,
 			Gnses().Patch(newCtx, parentName, types.MergePatchType, []byte(payload), metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateSvcGroupByName] Failed to patch SvcGroup gvk in parent node[Gnses] %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("[CreateSvcGroupByName] Trigger SvcGroup delete: %s", objToCreate.GetName())
 					delErr := group.DeleteSvcGroupByName(newCtx, objToCreate.GetName())
@@ -11912,7 +14343,7 @@ func (group *ServicegroupTsmV1) CreateSvcGroupByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateSvcGroupByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -11948,6 +14379,11 @@ func (group *ServicegroupTsmV1) UpdateSvcGroupByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Servicegroup().GetSvcGroupByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -12061,33 +14497,22 @@ func (group *ServicegroupTsmV1) UpdateSvcGroupByName(ctx context.Context,
 			SvcGroups().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateSvcGroupByName] Failed to patch SvcGroup gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("svcgroups.servicegroup.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger SvcGroup Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteSvcGroupByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting SvcGroup: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("SvcGroup deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateSvcGroupByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateSvcGroupByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger SvcGroup Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteSvcGroupByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting SvcGroup: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("SvcGroup Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -12134,6 +14559,12 @@ func (group *ServicegroupTsmV1) ListSvcGroups(ctx context.Context,
 	return
 }
 
+// svcgroupServicegroupTsmV1Indexers returns indexers of SvcGroup informers, which index objects by values
+// of unique fields.
+func svcgroupServicegroupTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type ServicegroupSvcGroup struct {
 	client *Clientset
 	*baseservicegrouptsmtanzuvmwarecomv1.SvcGroup
@@ -12164,6 +14595,103 @@ func (obj *ServicegroupSvcGroup) GetParent(ctx context.Context) (result *GnsGns,
 	return obj.client.Gns().GetGnsByName(ctx, hashedName)
 }
 
+// TxServicegroupSvcGroup is SvcGroup object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxServicegroupSvcGroup struct {
+	tx *Tx
+	*baseservicegrouptsmtanzuvmwarecomv1.SvcGroup
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *ServicegroupSvcGroup) InTx(tx *Tx) *TxServicegroupSvcGroup {
+	return &TxServicegroupSvcGroup{
+		tx:       tx,
+		SvcGroup: obj.SvcGroup,
+	}
+}
+
+// createServicegroupSvcGroup records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createServicegroupSvcGroup(objToCreate *baseservicegrouptsmtanzuvmwarecomv1.SvcGroup) *TxServicegroupSvcGroup {
+	handle := &TxServicegroupSvcGroup{
+		tx:       tx,
+		SvcGroup: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["svcgroups.servicegroup.tsm.tanzu.vmware.com"]),
+		name:  "create of svcgroups.servicegroup.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Servicegroup().CreateSvcGroupByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.SvcGroup = result.SvcGroup
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Servicegroup().DeleteSvcGroupByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxServicegroupSvcGroup) Update() {
+	var previous *baseservicegrouptsmtanzuvmwarecomv1.SvcGroup
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["svcgroups.servicegroup.tsm.tanzu.vmware.com"]),
+		name:  "update of svcgroups.servicegroup.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Servicegroup().GetSvcGroupByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.SvcGroup.DeepCopy()
+			result, err := obj.tx.client.Servicegroup().UpdateSvcGroupByName(ctx, obj.SvcGroup)
+			if err != nil {
+				return err
+			}
+			obj.SvcGroup = result.SvcGroup
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Servicegroup().UpdateSvcGroupByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxServicegroupSvcGroup) Delete() {
+	var deleted *baseservicegrouptsmtanzuvmwarecomv1.SvcGroup
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["svcgroups.servicegroup.tsm.tanzu.vmware.com"]),
+		name:  "delete of svcgroups.servicegroup.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Servicegroup().GetSvcGroupByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.SvcGroup.DeepCopy()
+			return obj.tx.client.Servicegroup().DeleteSvcGroupByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Servicegroup().CreateSvcGroupByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type svcgroupServicegroupTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -12173,7 +14701,7 @@ type svcgroupServicegroupTsmV1Chainer struct {
 func (c *svcgroupServicegroupTsmV1Chainer) Subscribe() {
 	key := "svcgroups.servicegroup.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgroupServicegroupTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -12206,7 +14734,7 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterEventHandler(addCB func(obj *
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for ServicegroupSvcGroup, so creating a new one")
-		informer = informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgroupServicegroupTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -12243,9 +14771,11 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterEventHandler(addCB func(obj *
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -12254,7 +14784,8 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterEventHandler(addCB func(obj *
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -12273,9 +14804,18 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterEventHandler(addCB func(obj *
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroup)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &ServicegroupSvcGroup{
 				client:   c.client,
-				SvcGroup: obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroup),
+				SvcGroup: deleted,
 			}
 
 			var parent *GnsGns
@@ -12305,9 +14845,11 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterEventHandler(addCB func(obj *
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -12316,7 +14858,8 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterEventHandler(addCB func(obj *
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -12374,9 +14917,11 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Se
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -12386,7 +14931,8 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Se
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -12394,7 +14940,7 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Se
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] ServicegroupSvcGroup Create New Informer")
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgroupServicegroupTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &ServicegroupSvcGroup{
@@ -12433,9 +14979,11 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Se
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -12445,7 +14993,8 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Se
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -12482,7 +15031,7 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldO
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] ServicegroupSvcGroup Create New Informer")
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgroupServicegroupTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &ServicegroupSvcGroup{
@@ -12514,9 +15063,18 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroup)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ServicegroupSvcGroup{
 					client:   c.client,
-					SvcGroup: obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroup),
+					SvcGroup: deleted,
 				}
 
 				var parent *GnsGns
@@ -12549,9 +15107,11 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -12561,7 +15121,8 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -12569,12 +15130,21 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] ServicegroupSvcGroup Create New Informer")
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgroupServicegroupTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroup)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ServicegroupSvcGroup{
 					client:   c.client,
-					SvcGroup: obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroup),
+					SvcGroup: deleted,
 				}
 
 				var parent *GnsGns
@@ -12608,9 +15178,11 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -12620,7 +15192,8 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -12631,6 +15204,15 @@ func (c *svcgroupServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of ServicegroupSvcGroup
+func (c *svcgroupServicegroupTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "svcgroups.servicegroup.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetSvcGroupLinkInfoByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *ServicegroupTsmV1) GetSvcGroupLinkInfoByName(ctx context.Context, hashedName string) (*ServicegroupSvcGroupLinkInfo, error) {
@@ -12654,14 +15236,14 @@ func (group *ServicegroupTsmV1) GetSvcGroupLinkInfoByName(ctx context.Context, h
 			SvcGroupLinkInfos().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetSvcGroupLinkInfoByName] Failed to Get SvcGroupLinkInfos: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get SvcGroupLinkInfos: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetSvcGroupLinkInfoByName]: %+v", err)
 				return nil, context.Canceled
@@ -12689,14 +15271,14 @@ func (group *ServicegroupTsmV1) ForceReadSvcGroupLinkInfoByName(ctx context.Cont
 			SvcGroupLinkInfos().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadSvcGroupLinkInfoByName] Failed to Get SvcGroupLinkInfos: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get SvcGroupLinkInfos: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadSvcGroupLinkInfoByName]: %+v", err)
 				return nil, context.Canceled
@@ -12730,14 +15312,14 @@ func (group *ServicegroupTsmV1) DeleteSvcGroupLinkInfoByName(ctx context.Context
 			SvcGroupLinkInfos().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteSvcGroupLinkInfoByName] Failed to get SvcGroupLinkInfos: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get SvcGroupLinkInfos: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteSvcGroupLinkInfoByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -12757,21 +15339,23 @@ func (group *ServicegroupTsmV1) DeleteSvcGroupLinkInfoByName(ctx context.Context
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			ServicegroupTsmV1().
-			SvcGroupLinkInfos().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			SvcGroupLinkInfos().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteSvcGroupLinkInfoByName] failed to delete SvcGroupLinkInfos: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete SvcGroupLinkInfos: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteSvcGroupLinkInfoByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -12844,14 +15428,14 @@ func (group *ServicegroupTsmV1) DeleteSvcGroupLinkInfoByName(ctx context.Context
 				Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteSvcGroupLinkInfoByName] Failed to patch SvcGroupLinkInfo gvk in parent node[Configs]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteSvcGroupLinkInfoByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -12889,6 +15473,21 @@ func (group *ServicegroupTsmV1) CreateSvcGroupLinkInfoByName(ctx context.Context
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Config().GetConfigByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateSvcGroupLinkInfoByName] Failed to get parent of SvcGroupLinkInfo: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseconfigtsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Config", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -12896,14 +15495,14 @@ func (group *ServicegroupTsmV1) CreateSvcGroupLinkInfoByName(ctx context.Context
 			SvcGroupLinkInfos().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateSvcGroupLinkInfoByName] Failed to create SvcGroupLinkInfo: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create SvcGroupLinkInfo: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateSvcGroupLinkInfoByName] context canceled while creating SvcGroupLinkInfo: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -12922,14 +15521,6 @@ func (group *ServicegroupTsmV1) CreateSvcGroupLinkInfoByName(ctx context.Context
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Config().GetConfigByName(context.Background(), parentName)
 		if err2 != nil {
@@ -12966,9 +15557,9 @@ func (group *ServicegroupTsmV1) CreateSvcGroupLinkInfoByName(ctx context.Context
 			Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateSvcGroupLinkInfoByName] Failed to patch SvcGroupLinkInfo gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger SvcGroupLinkInfo delete: %s", objToCreate.GetName())
 					delErr := group.DeleteSvcGroupLinkInfoByName(newCtx, objToCreate.GetName())
@@ -12980,7 +15571,7 @@ func (group *ServicegroupTsmV1) CreateSvcGroupLinkInfoByName(ctx context.Context
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateSvcGroupLinkInfoByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -13016,6 +15607,11 @@ func (group *ServicegroupTsmV1) UpdateSvcGroupLinkInfoByName(ctx context.Context
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Servicegroup().GetSvcGroupLinkInfoByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -13150,33 +15746,22 @@ func (group *ServicegroupTsmV1) UpdateSvcGroupLinkInfoByName(ctx context.Context
 			SvcGroupLinkInfos().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateSvcGroupLinkInfoByName] Failed to patch SvcGroupLinkInfo gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger SvcGroupLinkInfo Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteSvcGroupLinkInfoByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting SvcGroupLinkInfo: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("SvcGroupLinkInfo deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateSvcGroupLinkInfoByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateSvcGroupLinkInfoByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger SvcGroupLinkInfo Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteSvcGroupLinkInfoByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting SvcGroupLinkInfo: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("SvcGroupLinkInfo Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -13223,6 +15808,12 @@ func (group *ServicegroupTsmV1) ListSvcGroupLinkInfos(ctx context.Context,
 	return
 }
 
+// svcgrouplinkinfoServicegroupTsmV1Indexers returns indexers of SvcGroupLinkInfo informers, which index objects by values
+// of unique fields.
+func svcgrouplinkinfoServicegroupTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type ServicegroupSvcGroupLinkInfo struct {
 	client *Clientset
 	*baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo
@@ -13253,6 +15844,103 @@ func (obj *ServicegroupSvcGroupLinkInfo) GetParent(ctx context.Context) (result
 	return obj.client.Config().GetConfigByName(ctx, hashedName)
 }
 
+// TxServicegroupSvcGroupLinkInfo is SvcGroupLinkInfo object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxServicegroupSvcGroupLinkInfo struct {
+	tx *Tx
+	*baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *ServicegroupSvcGroupLinkInfo) InTx(tx *Tx) *TxServicegroupSvcGroupLinkInfo {
+	return &TxServicegroupSvcGroupLinkInfo{
+		tx:               tx,
+		SvcGroupLinkInfo: obj.SvcGroupLinkInfo,
+	}
+}
+
+// createServicegroupSvcGroupLinkInfo records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createServicegroupSvcGroupLinkInfo(objToCreate *baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo) *TxServicegroupSvcGroupLinkInfo {
+	handle := &TxServicegroupSvcGroupLinkInfo{
+		tx:               tx,
+		SvcGroupLinkInfo: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com"]),
+		name:  "create of svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Servicegroup().CreateSvcGroupLinkInfoByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.SvcGroupLinkInfo = result.SvcGroupLinkInfo
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Servicegroup().DeleteSvcGroupLinkInfoByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxServicegroupSvcGroupLinkInfo) Update() {
+	var previous *baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com"]),
+		name:  "update of svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Servicegroup().GetSvcGroupLinkInfoByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.SvcGroupLinkInfo.DeepCopy()
+			result, err := obj.tx.client.Servicegroup().UpdateSvcGroupLinkInfoByName(ctx, obj.SvcGroupLinkInfo)
+			if err != nil {
+				return err
+			}
+			obj.SvcGroupLinkInfo = result.SvcGroupLinkInfo
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Servicegroup().UpdateSvcGroupLinkInfoByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxServicegroupSvcGroupLinkInfo) Delete() {
+	var deleted *baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com"]),
+		name:  "delete of svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Servicegroup().GetSvcGroupLinkInfoByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.SvcGroupLinkInfo.DeepCopy()
+			return obj.tx.client.Servicegroup().DeleteSvcGroupLinkInfoByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Servicegroup().CreateSvcGroupLinkInfoByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type svcgrouplinkinfoServicegroupTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -13262,7 +15950,7 @@ type svcgrouplinkinfoServicegroupTsmV1Chainer struct {
 func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) Subscribe() {
 	key := "svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgrouplinkinfoServicegroupTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -13295,7 +15983,7 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterEventHandler(addCB fu
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for ServicegroupSvcGroupLinkInfo, so creating a new one")
-		informer = informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgrouplinkinfoServicegroupTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -13332,9 +16020,11 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterEventHandler(addCB fu
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -13343,7 +16033,8 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterEventHandler(addCB fu
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -13362,9 +16053,18 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterEventHandler(addCB fu
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &ServicegroupSvcGroupLinkInfo{
 				client:           c.client,
-				SvcGroupLinkInfo: obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo),
+				SvcGroupLinkInfo: deleted,
 			}
 
 			var parent *ConfigConfig
@@ -13394,9 +16094,11 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterEventHandler(addCB fu
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -13405,7 +16107,8 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterEventHandler(addCB fu
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -13463,9 +16166,11 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -13475,7 +16180,8 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -13483,7 +16189,7 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] ServicegroupSvcGroupLinkInfo Create New Informer")
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgrouplinkinfoServicegroupTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &ServicegroupSvcGroupLinkInfo{
@@ -13522,9 +16228,11 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -13534,7 +16242,8 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterAddCallback(cbfn func
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -13571,7 +16280,7 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterUpdateCallback(cbfn f
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] ServicegroupSvcGroupLinkInfo Create New Informer")
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgrouplinkinfoServicegroupTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &ServicegroupSvcGroupLinkInfo{
@@ -13603,9 +16312,18 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn f
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ServicegroupSvcGroupLinkInfo{
 					client:           c.client,
-					SvcGroupLinkInfo: obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo),
+					SvcGroupLinkInfo: deleted,
 				}
 
 				var parent *ConfigConfig
@@ -13638,9 +16356,11 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn f
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -13650,7 +16370,8 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn f
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -13658,12 +16379,21 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn f
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] ServicegroupSvcGroupLinkInfo Create New Informer")
-		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerservicegrouptsmtanzuvmwarecomv1.NewSvcGroupLinkInfoInformer(c.client.baseClient, informerResyncPeriod*time.Second, svcgrouplinkinfoServicegroupTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ServicegroupSvcGroupLinkInfo{
 					client:           c.client,
-					SvcGroupLinkInfo: obj.(*baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo),
+					SvcGroupLinkInfo: deleted,
 				}
 
 				var parent *ConfigConfig
@@ -13697,9 +16427,11 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn f
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -13709,7 +16441,8 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn f
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -13720,6 +16453,15 @@ func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) RegisterDeleteCallback(cbfn f
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of ServicegroupSvcGroupLinkInfo
+func (c *svcgrouplinkinfoServicegroupTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetAccessControlPolicyByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *PolicypkgTsmV1) GetAccessControlPolicyByName(ctx context.Context, hashedName string) (*PolicypkgAccessControlPolicy, error) {
@@ -13743,14 +16485,14 @@ func (group *PolicypkgTsmV1) GetAccessControlPolicyByName(ctx context.Context, h
 			AccessControlPolicies().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetAccessControlPolicyByName] Failed to Get AccessControlPolicies: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get AccessControlPolicies: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetAccessControlPolicyByName]: %+v", err)
 				return nil, context.Canceled
@@ -13778,14 +16520,14 @@ func (group *PolicypkgTsmV1) ForceReadAccessControlPolicyByName(ctx context.Cont
 			AccessControlPolicies().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadAccessControlPolicyByName] Failed to Get AccessControlPolicies: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get AccessControlPolicies: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadAccessControlPolicyByName]: %+v", err)
 				return nil, context.Canceled
@@ -13819,14 +16561,14 @@ func (group *PolicypkgTsmV1) DeleteAccessControlPolicyByName(ctx context.Context
 			AccessControlPolicies().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteAccessControlPolicyByName] Failed to get AccessControlPolicies: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get AccessControlPolicies: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteAccessControlPolicyByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -13847,32 +16589,29 @@ func (group *PolicypkgTsmV1) DeleteAccessControlPolicyByName(ctx context.Context
 	}
 
 	for _, v := range result.Spec.PolicyConfigsGvk {
-		err := group.client.
-			Policypkg().DeleteACPConfigByName(ctx, v.Name)
+		err := group.deletePolicyConfigsIfNotOwned(ctx, result, v.Name)
 		if err != nil {
-			if errors.IsNotFound(err) {
-				continue
-			} else {
-				return err
-			}
+			return err
 		}
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			PolicypkgTsmV1().
-			AccessControlPolicies().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			AccessControlPolicies().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteAccessControlPolicyByName] failed to delete AccessControlPolicies: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete AccessControlPolicies: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteAccessControlPolicyByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -13945,14 +16684,14 @@ func (group *PolicypkgTsmV1) DeleteAccessControlPolicyByName(ctx context.Context
 				Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteAccessControlPolicyByName] Failed to patch AccessControlPolicy gvk in parent node[Gnses]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteAccessControlPolicyByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -13971,6 +16710,31 @@ func (group *PolicypkgTsmV1) DeleteAccessControlPolicyByName(ctx context.Context
 	return
 }
 
+// deletePolicyConfigsIfNotOwned deletes child PolicyConfigs of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *PolicypkgTsmV1) deletePolicyConfigsIfNotOwned(ctx context.Context,
+	parent *basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy, hashedName string) error {
+	child, err := group.client.baseClient.
+		PolicypkgTsmV1().
+		ACPConfigs().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Policypkg().DeleteACPConfigByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 // CreateAccessControlPolicyByName creates object in the database without hashing the name.
 // Use it directly ONLY when objToCreate.Name is hashed name of the object.
 func (group *PolicypkgTsmV1) CreateAccessControlPolicyByName(ctx context.Context,
@@ -13992,6 +16756,21 @@ func (group *PolicypkgTsmV1) CreateAccessControlPolicyByName(ctx context.Context
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Gns().GetGnsByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateAccessControlPolicyByName] Failed to get parent of AccessControlPolicy: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, basegnstsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Gns", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -13999,14 +16778,14 @@ func (group *PolicypkgTsmV1) CreateAccessControlPolicyByName(ctx context.Context
 			AccessControlPolicies().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateAccessControlPolicyByName] Failed to create AccessControlPolicy: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create AccessControlPolicy: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateAccessControlPolicyByName] context canceled while creating AccessControlPolicy: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -14025,14 +16804,6 @@ func (group *PolicypkgTsmV1) CreateAccessControlPolicyByName(ctx context.Context
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["gnses.gns.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("gnses.gns.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Gns().GetGnsByName(context.Background(), parentName)
 		if err2 != nil {
@@ -14069,9 +16840,9 @@ func (group *PolicypkgTsmV1) CreateAccessControlPolicyByName(ctx context.Context
 			Gnses().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateAccessControlPolicyByName] Failed to patch AccessControlPolicy gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger AccessControlPolicy delete: %s", objToCreate.GetName())
 					delErr := group.DeleteAccessControlPolicyByName(newCtx, objToCreate.GetName())
@@ -14083,7 +16854,7 @@ func (group *PolicypkgTsmV1) CreateAccessControlPolicyByName(ctx context.Context
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateAccessControlPolicyByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -14119,6 +16890,11 @@ func (group *PolicypkgTsmV1) UpdateAccessControlPolicyByName(ctx context.Context
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Policypkg().GetAccessControlPolicyByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -14167,33 +16943,22 @@ func (group *PolicypkgTsmV1) UpdateAccessControlPolicyByName(ctx context.Context
 			AccessControlPolicies().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateAccessControlPolicyByName] Failed to patch AccessControlPolicy gvk in parent node[Gnses]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger AccessControlPolicy Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteAccessControlPolicyByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting AccessControlPolicy: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("AccessControlPolicy deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateAccessControlPolicyByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateAccessControlPolicyByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger AccessControlPolicy Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteAccessControlPolicyByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting AccessControlPolicy: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("AccessControlPolicy Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -14240,6 +17005,12 @@ func (group *PolicypkgTsmV1) ListAccessControlPolicies(ctx context.Context,
 	return
 }
 
+// accesscontrolpolicyPolicypkgTsmV1Indexers returns indexers of AccessControlPolicy informers, which index objects by values
+// of unique fields.
+func accesscontrolpolicyPolicypkgTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type PolicypkgAccessControlPolicy struct {
 	client *Clientset
 	*basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy
@@ -14345,6 +17116,123 @@ func (obj *PolicypkgAccessControlPolicy) DeletePolicyConfigs(ctx context.Context
 	return
 }
 
+// TxPolicypkgAccessControlPolicy is AccessControlPolicy object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxPolicypkgAccessControlPolicy struct {
+	tx *Tx
+	*basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *PolicypkgAccessControlPolicy) InTx(tx *Tx) *TxPolicypkgAccessControlPolicy {
+	return &TxPolicypkgAccessControlPolicy{
+		tx:                  tx,
+		AccessControlPolicy: obj.AccessControlPolicy,
+	}
+}
+
+// createPolicypkgAccessControlPolicy records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createPolicypkgAccessControlPolicy(objToCreate *basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy) *TxPolicypkgAccessControlPolicy {
+	handle := &TxPolicypkgAccessControlPolicy{
+		tx:                  tx,
+		AccessControlPolicy: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "create of accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Policypkg().CreateAccessControlPolicyByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.AccessControlPolicy = result.AccessControlPolicy
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Policypkg().DeleteAccessControlPolicyByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxPolicypkgAccessControlPolicy) Update() {
+	var previous *basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "update of accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Policypkg().GetAccessControlPolicyByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.AccessControlPolicy.DeepCopy()
+			result, err := obj.tx.client.Policypkg().UpdateAccessControlPolicyByName(ctx, obj.AccessControlPolicy)
+			if err != nil {
+				return err
+			}
+			obj.AccessControlPolicy = result.AccessControlPolicy
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Policypkg().UpdateAccessControlPolicyByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxPolicypkgAccessControlPolicy) Delete() {
+	var deleted *basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "delete of accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Policypkg().GetAccessControlPolicyByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.AccessControlPolicy.DeepCopy()
+			return obj.tx.client.Policypkg().DeleteAccessControlPolicyByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Policypkg().CreateAccessControlPolicyByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
+// AddPolicyConfigs records creation of the child, its name is hashed the same way as by
+// PolicypkgAccessControlPolicy.AddPolicyConfigs.
+func (obj *TxPolicypkgAccessControlPolicy) AddPolicyConfigs(
+	objToCreate *basepolicypkgtsmtanzuvmwarecomv1.ACPConfig) *TxPolicypkgACPConfig {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createPolicypkgACPConfig(objToCreate)
+}
+
 type accesscontrolpolicyPolicypkgTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -14354,7 +17242,7 @@ type accesscontrolpolicyPolicypkgTsmV1Chainer struct {
 func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) Subscribe() {
 	key := "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, accesscontrolpolicyPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -14387,7 +17275,7 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB fu
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for PolicypkgAccessControlPolicy, so creating a new one")
-		informer = informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, accesscontrolpolicyPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -14424,9 +17312,11 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB fu
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -14435,7 +17325,8 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB fu
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -14454,9 +17345,18 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB fu
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &PolicypkgAccessControlPolicy{
 				client:              c.client,
-				AccessControlPolicy: obj.(*basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy),
+				AccessControlPolicy: deleted,
 			}
 
 			var parent *GnsGns
@@ -14486,9 +17386,11 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB fu
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -14497,7 +17399,8 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB fu
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -14555,9 +17458,11 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -14567,7 +17472,8 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -14575,7 +17481,7 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] PolicypkgAccessControlPolicy Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, accesscontrolpolicyPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &PolicypkgAccessControlPolicy{
@@ -14614,9 +17520,11 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -14626,7 +17534,8 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -14663,7 +17572,7 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterUpdateCallback(cbfn f
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] PolicypkgAccessControlPolicy Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, accesscontrolpolicyPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &PolicypkgAccessControlPolicy{
@@ -14695,9 +17604,18 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn f
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &PolicypkgAccessControlPolicy{
 					client:              c.client,
-					AccessControlPolicy: obj.(*basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy),
+					AccessControlPolicy: deleted,
 				}
 
 				var parent *GnsGns
@@ -14730,9 +17648,11 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn f
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -14742,7 +17662,8 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn f
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -14750,12 +17671,21 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn f
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] PolicypkgAccessControlPolicy Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewAccessControlPolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, accesscontrolpolicyPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &PolicypkgAccessControlPolicy{
 					client:              c.client,
-					AccessControlPolicy: obj.(*basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy),
+					AccessControlPolicy: deleted,
 				}
 
 				var parent *GnsGns
@@ -14789,9 +17719,11 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn f
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -14801,7 +17733,8 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn f
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -14812,6 +17745,15 @@ func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn f
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of PolicypkgAccessControlPolicy
+func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 func (c *accesscontrolpolicyPolicypkgTsmV1Chainer) PolicyConfigs(name string) *acpconfigPolicypkgTsmV1Chainer {
 	parentLabels := c.parentLabels
 	parentLabels["acpconfigs.policypkg.tsm.tanzu.vmware.com"] = name
@@ -14882,14 +17824,14 @@ func (group *PolicypkgTsmV1) GetACPConfigByName(ctx context.Context, hashedName
 			ACPConfigs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetACPConfigByName] Failed to Get ACPConfigs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get ACPConfigs: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetACPConfigByName]: %+v", err)
 				return nil, context.Canceled
@@ -14917,14 +17859,14 @@ func (group *PolicypkgTsmV1) ForceReadACPConfigByName(ctx context.Context, hashe
 			ACPConfigs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadACPConfigByName] Failed to Get ACPConfigs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get ACPConfigs: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadACPConfigByName]: %+v", err)
 				return nil, context.Canceled
@@ -14958,14 +17900,14 @@ func (group *PolicypkgTsmV1) DeleteACPConfigByName(ctx context.Context, hashedNa
 			ACPConfigs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteACPConfigByName] Failed to get ACPConfigs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get ACPConfigs: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteACPConfigByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -14985,21 +17927,23 @@ func (group *PolicypkgTsmV1) DeleteACPConfigByName(ctx context.Context, hashedNa
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			PolicypkgTsmV1().
-			ACPConfigs().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			ACPConfigs().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteACPConfigByName] failed to delete ACPConfigs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete ACPConfigs: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteACPConfigByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -15077,14 +18021,14 @@ func (group *PolicypkgTsmV1) DeleteACPConfigByName(ctx context.Context, hashedNa
 				AccessControlPolicies().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteACPConfigByName] Failed to patch ACPConfig gvk in parent node[AccessControlPolicies]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteACPConfigByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -15125,6 +18069,21 @@ func (group *PolicypkgTsmV1) CreateACPConfigByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Policypkg().GetAccessControlPolicyByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateACPConfigByName] Failed to get parent of ACPConfig: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, basepolicypkgtsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "AccessControlPolicy", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -15132,14 +18091,14 @@ func (group *PolicypkgTsmV1) CreateACPConfigByName(ctx context.Context,
 			ACPConfigs().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateACPConfigByName] Failed to create ACPConfig: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create ACPConfig: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateACPConfigByName] context canceled while creating ACPConfig: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -15158,14 +18117,6 @@ func (group *PolicypkgTsmV1) CreateACPConfigByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Policypkg().GetAccessControlPolicyByName(context.Background(), parentName)
 		if err2 != nil {
@@ -15190,9 +18141,9 @@ func (group *PolicypkgTsmV1) CreateACPConfigByName(ctx context.Context,
 			AccessControlPolicies().Patch(newCtx, parentName, types.MergePatchType, []byte(payload), metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateACPConfigByName] Failed to patch ACPConfig gvk in parent node[AccessControlPolicies] %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("[CreateACPConfigByName] Trigger ACPConfig delete: %s", objToCreate.GetName())
 					delErr := group.DeleteACPConfigByName(newCtx, objToCreate.GetName())
@@ -15204,7 +18155,7 @@ func (group *PolicypkgTsmV1) CreateACPConfigByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateACPConfigByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -15277,6 +18228,11 @@ func (group *PolicypkgTsmV1) UpdateACPConfigByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Policypkg().GetACPConfigByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -15453,33 +18409,22 @@ func (group *PolicypkgTsmV1) UpdateACPConfigByName(ctx context.Context,
 			ACPConfigs().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateACPConfigByName] Failed to patch ACPConfig gvk in parent node[AccessControlPolicies]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("acpconfigs.policypkg.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger ACPConfig Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteACPConfigByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting ACPConfig: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("ACPConfig deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateACPConfigByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateACPConfigByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger ACPConfig Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteACPConfigByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting ACPConfig: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("ACPConfig Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -15526,6 +18471,12 @@ func (group *PolicypkgTsmV1) ListACPConfigs(ctx context.Context,
 	return
 }
 
+// acpconfigPolicypkgTsmV1Indexers returns indexers of ACPConfig informers, which index objects by values
+// of unique fields.
+func acpconfigPolicypkgTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type PolicypkgACPConfig struct {
 	client *Clientset
 	*basepolicypkgtsmtanzuvmwarecomv1.ACPConfig
@@ -15713,6 +18664,229 @@ func (obj *PolicypkgACPConfig) UnlinkSourceSvcGroups(ctx context.Context,
 
 }
 
+// TxPolicypkgACPConfig is ACPConfig object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxPolicypkgACPConfig struct {
+	tx *Tx
+	*basepolicypkgtsmtanzuvmwarecomv1.ACPConfig
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *PolicypkgACPConfig) InTx(tx *Tx) *TxPolicypkgACPConfig {
+	return &TxPolicypkgACPConfig{
+		tx:        tx,
+		ACPConfig: obj.ACPConfig,
+	}
+}
+
+// createPolicypkgACPConfig records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createPolicypkgACPConfig(objToCreate *basepolicypkgtsmtanzuvmwarecomv1.ACPConfig) *TxPolicypkgACPConfig {
+	handle := &TxPolicypkgACPConfig{
+		tx:        tx,
+		ACPConfig: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["acpconfigs.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "create of acpconfigs.policypkg.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Policypkg().CreateACPConfigByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.ACPConfig = result.ACPConfig
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Policypkg().DeleteACPConfigByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxPolicypkgACPConfig) Update() {
+	var previous *basepolicypkgtsmtanzuvmwarecomv1.ACPConfig
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["acpconfigs.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "update of acpconfigs.policypkg.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Policypkg().GetACPConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.ACPConfig.DeepCopy()
+			result, err := obj.tx.client.Policypkg().UpdateACPConfigByName(ctx, obj.ACPConfig)
+			if err != nil {
+				return err
+			}
+			obj.ACPConfig = result.ACPConfig
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Policypkg().UpdateACPConfigByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxPolicypkgACPConfig) Delete() {
+	var deleted *basepolicypkgtsmtanzuvmwarecomv1.ACPConfig
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["acpconfigs.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "delete of acpconfigs.policypkg.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Policypkg().GetACPConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.ACPConfig.DeepCopy()
+			return obj.tx.client.Policypkg().DeleteACPConfigByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Policypkg().CreateACPConfigByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
+// LinkDestSvcGroups records linking obj with linkToAdd, which can be created in the same transaction.
+// The previous value of the link is restored on rollback.
+func (obj *TxPolicypkgACPConfig) LinkDestSvcGroups(linkToAdd *TxServicegroupSvcGroup) {
+	obj.recordDestSvcGroups(false, linkToAdd)
+}
+
+// UnlinkDestSvcGroups records unlinking linkToRemove from obj. The link is restored on rollback.
+func (obj *TxPolicypkgACPConfig) UnlinkDestSvcGroups(linkToRemove *TxServicegroupSvcGroup) {
+	obj.recordDestSvcGroups(true, linkToRemove)
+}
+
+func (obj *TxPolicypkgACPConfig) recordDestSvcGroups(unlink bool,
+	target *TxServicegroupSvcGroup) {
+	var (
+		key      string
+		previous interface{}
+	)
+	op := "link"
+	if unlink {
+		op = "unlink"
+	}
+	obj.tx.record(&txStep{
+		phase: txPhaseLink,
+		depth: len(helper.GetCRDParentsMap()["acpconfigs.policypkg.tsm.tanzu.vmware.com"]),
+		name:  op + " of DestSvcGroups of acpconfigs.policypkg.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Policypkg().GetACPConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			var link *ServicegroupSvcGroup
+			if target != nil {
+				link = &ServicegroupSvcGroup{
+					client:   obj.tx.client,
+					SvcGroup: target.SvcGroup,
+				}
+			}
+			key = link.DisplayName()
+			if l, ok := current.Spec.DestSvcGroupsGvk[key]; ok {
+				previous = l
+			}
+			if unlink {
+				err = current.UnlinkDestSvcGroups(ctx, link)
+			} else {
+				err = current.LinkDestSvcGroups(ctx, link)
+			}
+			if err != nil {
+				return err
+			}
+			obj.ACPConfig = current.ACPConfig
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			payload, err := restoreGvkPatch("destSvcGroupsGvk", key, previous)
+			if err != nil {
+				return err
+			}
+			_, err = obj.tx.client.baseClient.PolicypkgTsmV1().ACPConfigs().Patch(ctx, obj.GetName(), types.MergePatchType, payload, metav1.PatchOptions{})
+			return err
+		},
+	})
+}
+
+// LinkSourceSvcGroups records linking obj with linkToAdd, which can be created in the same transaction.
+// The previous value of the link is restored on rollback.
+func (obj *TxPolicypkgACPConfig) LinkSourceSvcGroups(linkToAdd *TxServicegroupSvcGroup) {
+	obj.recordSourceSvcGroups(false, linkToAdd)
+}
+
+// UnlinkSourceSvcGroups records unlinking linkToRemove from obj. The link is restored on rollback.
+func (obj *TxPolicypkgACPConfig) UnlinkSourceSvcGroups(linkToRemove *TxServicegroupSvcGroup) {
+	obj.recordSourceSvcGroups(true, linkToRemove)
+}
+
+func (obj *TxPolicypkgACPConfig) recordSourceSvcGroups(unlink bool,
+	target *TxServicegroupSvcGroup) {
+	var (
+		key      string
+		previous interface{}
+	)
+	op := "link"
+	if unlink {
+		op = "unlink"
+	}
+	obj.tx.record(&txStep{
+		phase: txPhaseLink,
+		depth: len(helper.GetCRDParentsMap()["acpconfigs.policypkg.tsm.tanzu.vmware.com"]),
+		name:  op + " of SourceSvcGroups of acpconfigs.policypkg.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Policypkg().GetACPConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			var link *ServicegroupSvcGroup
+			if target != nil {
+				link = &ServicegroupSvcGroup{
+					client:   obj.tx.client,
+					SvcGroup: target.SvcGroup,
+				}
+			}
+			key = link.DisplayName()
+			if l, ok := current.Spec.SourceSvcGroupsGvk[key]; ok {
+				previous = l
+			}
+			if unlink {
+				err = current.UnlinkSourceSvcGroups(ctx, link)
+			} else {
+				err = current.LinkSourceSvcGroups(ctx, link)
+			}
+			if err != nil {
+				return err
+			}
+			obj.ACPConfig = current.ACPConfig
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			payload, err := restoreGvkPatch("sourceSvcGroupsGvk", key, previous)
+			if err != nil {
+				return err
+			}
+			_, err = obj.tx.client.baseClient.PolicypkgTsmV1().ACPConfigs().Patch(ctx, obj.GetName(), types.MergePatchType, payload, metav1.PatchOptions{})
+			return err
+		},
+	})
+}
+
 type acpconfigPolicypkgTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -15722,7 +18896,7 @@ type acpconfigPolicypkgTsmV1Chainer struct {
 func (c *acpconfigPolicypkgTsmV1Chainer) Subscribe() {
 	key := "acpconfigs.policypkg.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, acpconfigPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -15755,7 +18929,7 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Po
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for PolicypkgACPConfig, so creating a new one")
-		informer = informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, acpconfigPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -15792,9 +18966,11 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Po
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -15803,7 +18979,8 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Po
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -15822,9 +18999,18 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Po
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.ACPConfig)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &PolicypkgACPConfig{
 				client:    c.client,
-				ACPConfig: obj.(*basepolicypkgtsmtanzuvmwarecomv1.ACPConfig),
+				ACPConfig: deleted,
 			}
 
 			var parent *PolicypkgAccessControlPolicy
@@ -15854,9 +19040,11 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Po
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -15865,7 +19053,8 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Po
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -15923,9 +19112,11 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Poli
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -15935,7 +19126,8 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Poli
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -15943,7 +19135,7 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Poli
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] PolicypkgACPConfig Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, acpconfigPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &PolicypkgACPConfig{
@@ -15982,9 +19174,11 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Poli
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -15994,7 +19188,8 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Poli
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -16031,7 +19226,7 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] PolicypkgACPConfig Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, acpconfigPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &PolicypkgACPConfig{
@@ -16063,9 +19258,18 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *P
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.ACPConfig)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &PolicypkgACPConfig{
 					client:    c.client,
-					ACPConfig: obj.(*basepolicypkgtsmtanzuvmwarecomv1.ACPConfig),
+					ACPConfig: deleted,
 				}
 
 				var parent *PolicypkgAccessControlPolicy
@@ -16098,9 +19302,11 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *P
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -16110,7 +19316,8 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *P
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -16118,12 +19325,21 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *P
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] PolicypkgACPConfig Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewACPConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, acpconfigPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.ACPConfig)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &PolicypkgACPConfig{
 					client:    c.client,
-					ACPConfig: obj.(*basepolicypkgtsmtanzuvmwarecomv1.ACPConfig),
+					ACPConfig: deleted,
 				}
 
 				var parent *PolicypkgAccessControlPolicy
@@ -16157,9 +19373,11 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *P
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -16169,7 +19387,8 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *P
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -16180,6 +19399,15 @@ func (c *acpconfigPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *P
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of PolicypkgACPConfig
+func (c *acpconfigPolicypkgTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "acpconfigs.policypkg.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // ClearStatus to clear user defined status
 func (c *acpconfigPolicypkgTsmV1Chainer) ClearStatus(ctx context.Context) (err error) {
 	hashedName := helper.GetHashedName("acpconfigs.policypkg.tsm.tanzu.vmware.com", c.parentLabels, c.name)
@@ -16235,14 +19463,14 @@ func (group *PolicypkgTsmV1) GetVMpolicyByName(ctx context.Context, hashedName s
 			VMpolicies().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetVMpolicyByName] Failed to Get VMpolicies: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get VMpolicies: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetVMpolicyByName]: %+v", err)
 				return nil, context.Canceled
@@ -16270,14 +19498,14 @@ func (group *PolicypkgTsmV1) ForceReadVMpolicyByName(ctx context.Context, hashed
 			VMpolicies().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadVMpolicyByName] Failed to Get VMpolicies: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get VMpolicies: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadVMpolicyByName]: %+v", err)
 				return nil, context.Canceled
@@ -16311,14 +19539,14 @@ func (group *PolicypkgTsmV1) DeleteVMpolicyByName(ctx context.Context, hashedNam
 			VMpolicies().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteVMpolicyByName] Failed to get VMpolicies: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get VMpolicies: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteVMpolicyByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -16338,21 +19566,23 @@ func (group *PolicypkgTsmV1) DeleteVMpolicyByName(ctx context.Context, hashedNam
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			PolicypkgTsmV1().
-			VMpolicies().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			VMpolicies().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteVMpolicyByName] failed to delete VMpolicies: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete VMpolicies: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteVMpolicyByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -16425,14 +19655,14 @@ func (group *PolicypkgTsmV1) DeleteVMpolicyByName(ctx context.Context, hashedNam
 				Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteVMpolicyByName] Failed to patch VMpolicy gvk in parent node[Configs]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteVMpolicyByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -16470,6 +19700,21 @@ func (group *PolicypkgTsmV1) CreateVMpolicyByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Config().GetConfigByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateVMpolicyByName] Failed to get parent of VMpolicy: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseconfigtsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Config", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -16477,14 +19722,14 @@ func (group *PolicypkgTsmV1) CreateVMpolicyByName(ctx context.Context,
 			VMpolicies().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateVMpolicyByName] Failed to create VMpolicy: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create VMpolicy: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateVMpolicyByName] context canceled while creating VMpolicy: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -16503,14 +19748,6 @@ func (group *PolicypkgTsmV1) CreateVMpolicyByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["configs.config.tsm.tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("configs.config.tsm.tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Config().GetConfigByName(context.Background(), parentName)
 		if err2 != nil {
@@ -16547,9 +19784,9 @@ func (group *PolicypkgTsmV1) CreateVMpolicyByName(ctx context.Context,
 			Configs().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateVMpolicyByName] Failed to patch VMpolicy gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger VMpolicy delete: %s", objToCreate.GetName())
 					delErr := group.DeleteVMpolicyByName(newCtx, objToCreate.GetName())
@@ -16561,7 +19798,7 @@ func (group *PolicypkgTsmV1) CreateVMpolicyByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateVMpolicyByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -16597,6 +19834,11 @@ func (group *PolicypkgTsmV1) UpdateVMpolicyByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Policypkg().GetVMpolicyByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -16645,33 +19887,22 @@ func (group *PolicypkgTsmV1) UpdateVMpolicyByName(ctx context.Context,
 			VMpolicies().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateVMpolicyByName] Failed to patch VMpolicy gvk in parent node[Configs]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("vmpolicies.policypkg.tsm.tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger VMpolicy Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteVMpolicyByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting VMpolicy: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("VMpolicy deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateVMpolicyByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateVMpolicyByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger VMpolicy Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteVMpolicyByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting VMpolicy: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("VMpolicy Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -16718,6 +19949,12 @@ func (group *PolicypkgTsmV1) ListVMpolicies(ctx context.Context,
 	return
 }
 
+// vmpolicyPolicypkgTsmV1Indexers returns indexers of VMpolicy informers, which index objects by values
+// of unique fields.
+func vmpolicyPolicypkgTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type PolicypkgVMpolicy struct {
 	client *Clientset
 	*basepolicypkgtsmtanzuvmwarecomv1.VMpolicy
@@ -16748,6 +19985,103 @@ func (obj *PolicypkgVMpolicy) GetParent(ctx context.Context) (result *ConfigConf
 	return obj.client.Config().GetConfigByName(ctx, hashedName)
 }
 
+// TxPolicypkgVMpolicy is VMpolicy object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxPolicypkgVMpolicy struct {
+	tx *Tx
+	*basepolicypkgtsmtanzuvmwarecomv1.VMpolicy
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *PolicypkgVMpolicy) InTx(tx *Tx) *TxPolicypkgVMpolicy {
+	return &TxPolicypkgVMpolicy{
+		tx:       tx,
+		VMpolicy: obj.VMpolicy,
+	}
+}
+
+// createPolicypkgVMpolicy records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createPolicypkgVMpolicy(objToCreate *basepolicypkgtsmtanzuvmwarecomv1.VMpolicy) *TxPolicypkgVMpolicy {
+	handle := &TxPolicypkgVMpolicy{
+		tx:       tx,
+		VMpolicy: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["vmpolicies.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "create of vmpolicies.policypkg.tsm.tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Policypkg().CreateVMpolicyByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.VMpolicy = result.VMpolicy
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Policypkg().DeleteVMpolicyByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxPolicypkgVMpolicy) Update() {
+	var previous *basepolicypkgtsmtanzuvmwarecomv1.VMpolicy
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["vmpolicies.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "update of vmpolicies.policypkg.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Policypkg().GetVMpolicyByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.VMpolicy.DeepCopy()
+			result, err := obj.tx.client.Policypkg().UpdateVMpolicyByName(ctx, obj.VMpolicy)
+			if err != nil {
+				return err
+			}
+			obj.VMpolicy = result.VMpolicy
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Policypkg().UpdateVMpolicyByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxPolicypkgVMpolicy) Delete() {
+	var deleted *basepolicypkgtsmtanzuvmwarecomv1.VMpolicy
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["vmpolicies.policypkg.tsm.tanzu.vmware.com"]),
+		name:  "delete of vmpolicies.policypkg.tsm.tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Policypkg().GetVMpolicyByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.VMpolicy.DeepCopy()
+			return obj.tx.client.Policypkg().DeleteVMpolicyByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Policypkg().CreateVMpolicyByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type vmpolicyPolicypkgTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -16757,7 +20091,7 @@ type vmpolicyPolicypkgTsmV1Chainer struct {
 func (c *vmpolicyPolicypkgTsmV1Chainer) Subscribe() {
 	key := "vmpolicies.policypkg.tsm.tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, vmpolicyPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -16790,7 +20124,7 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Pol
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for PolicypkgVMpolicy, so creating a new one")
-		informer = informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, vmpolicyPolicypkgTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -16827,9 +20161,11 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Pol
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -16838,7 +20174,8 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Pol
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -16857,9 +20194,18 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Pol
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.VMpolicy)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &PolicypkgVMpolicy{
 				client:   c.client,
-				VMpolicy: obj.(*basepolicypkgtsmtanzuvmwarecomv1.VMpolicy),
+				VMpolicy: deleted,
 			}
 
 			var parent *ConfigConfig
@@ -16889,9 +20235,11 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Pol
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -16900,7 +20248,8 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterEventHandler(addCB func(obj *Pol
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -16958,9 +20307,11 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Polic
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -16970,7 +20321,8 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Polic
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -16978,7 +20330,7 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Polic
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] PolicypkgVMpolicy Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, vmpolicyPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &PolicypkgVMpolicy{
@@ -17017,9 +20369,11 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Polic
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -17029,7 +20383,8 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterAddCallback(cbfn func(obj *Polic
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -17066,7 +20421,7 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj,
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] PolicypkgVMpolicy Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, vmpolicyPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &PolicypkgVMpolicy{
@@ -17098,9 +20453,18 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Po
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.VMpolicy)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &PolicypkgVMpolicy{
 					client:   c.client,
-					VMpolicy: obj.(*basepolicypkgtsmtanzuvmwarecomv1.VMpolicy),
+					VMpolicy: deleted,
 				}
 
 				var parent *ConfigConfig
@@ -17133,9 +20497,11 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Po
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -17145,7 +20511,8 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Po
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -17153,12 +20520,21 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Po
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] PolicypkgVMpolicy Create New Informer")
-		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerpolicypkgtsmtanzuvmwarecomv1.NewVMpolicyInformer(c.client.baseClient, informerResyncPeriod*time.Second, vmpolicyPolicypkgTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*basepolicypkgtsmtanzuvmwarecomv1.VMpolicy)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &PolicypkgVMpolicy{
 					client:   c.client,
-					VMpolicy: obj.(*basepolicypkgtsmtanzuvmwarecomv1.VMpolicy),
+					VMpolicy: deleted,
 				}
 
 				var parent *ConfigConfig
@@ -17192,9 +20568,11 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Po
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -17204,7 +20582,8 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Po
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -17214,3 +20593,12 @@ func (c *vmpolicyPolicypkgTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Po
 	}
 	return registrationId, err
 }
+
+// UnregisterCallback removes a callback registered on the subscription informer of PolicypkgVMpolicy
+func (c *vmpolicyPolicypkgTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "vmpolicies.policypkg.tsm.tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
@@ -21,7 +21,7 @@ func IsAlreadyExists(err error) bool {
 }
 
 func IsConflict(err error) bool {
-	return k8serrors.IsConflict(err)
+	return k8serrors.IsConflict(err) || IsResourceVersionConflict(err)
 }
 
 func IsInvalid(err error) bool {
@@ -174,3 +174,68 @@ func (p SingletonNameError) Error() string {
 func IsSingletonNameError(err error) bool {
 	return errors.As(err, &SingletonNameError{})
 }
+
+type UniqueNotFound struct {
+	errMessage string
+}
+
+func NewUniqueNotFound(objectType string, uniqueName string, key string) UniqueNotFound {
+	return UniqueNotFound{
+		errMessage: fmt.Sprintf("%s not found by %s: %s", objectType, uniqueName, key),
+	}
+}
+
+func (p UniqueNotFound) Error() string {
+	return p.errMessage
+}
+
+func IsUniqueNotFound(err error) bool {
+	return errors.As(err, &UniqueNotFound{})
+}
+
+type ResourceVersionConflict struct {
+	errMessage string
+}
+
+func NewResourceVersionConflict(objectType string, name string, resourceVersion string) ResourceVersionConflict {
+	return ResourceVersionConflict{
+		errMessage: fmt.Sprintf("%s %s was modified, resourceVersion %s is outdated", objectType, name, resourceVersion),
+	}
+}
+
+func (p ResourceVersionConflict) Error() string {
+	return p.errMessage
+}
+
+func IsResourceVersionConflict(err error) bool {
+	return errors.As(err, &ResourceVersionConflict{})
+}
+
+type TxError struct {
+	errMessage string
+	err        error
+}
+
+func NewTxError(step string, err error, rollbackErrs []error) TxError {
+	errMessage := fmt.Sprintf("transaction failed on %s: %v", step, err)
+	if len(rollbackErrs) > 0 {
+		errMessage += fmt.Sprintf(", rollback failed: %v", rollbackErrs)
+	}
+	return TxError{
+		errMessage: errMessage,
+		err:        err,
+	}
+}
+
+func (p TxError) Error() string {
+	return p.errMessage
+}
+
+// Unwrap returns the error of the failed step of the transaction.
+func (p TxError) Unwrap() error {
+	return p.err
+}
+
+func IsTxError(err error) bool {
+	return errors.As(err, &TxError{})
+}
@@ -3,15 +3,25 @@ package graph
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/flowcontrol"
 
+	baseconfigtsmtanzuvmwarecomv1 "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/apis/config.tsm.tanzu.vmware.com/v1"
+	basegnstsmtanzuvmwarecomv1 "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/apis/gns.tsm.tanzu.vmware.com/v1"
+	basepolicypkgtsmtanzuvmwarecomv1 "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/apis/policypkg.tsm.tanzu.vmware.com/v1"
+	baseroottsmtanzuvmwarecomv1 "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/apis/root.tsm.tanzu.vmware.com/v1"
+	baseservicegrouptsmtanzuvmwarecomv1 "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/apis/servicegroup.tsm.tanzu.vmware.com/v1"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/common"
 	nexus_client "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/nexus-client"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/nexus-gql/graph/model"
 
@@ -26,6 +36,10 @@ var c = GrpcClients{
 }
 var nc *nexus_client.Clientset
 
+// subscriptionBufferSize is the number of events buffered for each subscription, events of a subscription with a full
+// buffer are dropped, so a slow subscriber doesn't block the informers
+const subscriptionBufferSize = 100
+
 func getParentName(parentLabels map[string]interface{}, key string) string {
 	if v, ok := parentLabels[key]; ok && v != nil {
 		return v.(string)
@@ -67,26 +81,106 @@ func getK8sAPIEndpointConfig() *rest.Config {
 	return config
 }
 
-// ////////////////////////////////////
-// Singleton Resolver for Parent Node
-// PKG: Root, NODE: Root
-// ////////////////////////////////////
-func getRootResolver() (*model.RootRoot, error) {
+func initNexusClient() error {
 	if nc == nil {
 		k8sApiConfig := getK8sAPIEndpointConfig()
 		nexusClient, err := nexus_client.NewForConfig(k8sApiConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get k8s client config: %s", err)
+			return codedError{code: ErrorCodeUnavailable, err: fmt.Errorf("failed to get k8s client config: %s", err)}
 		}
 		nc = nexusClient
 		nc.SubscribeAll()
 		log.Debugf("Subscribed to all nodes in datamodel")
 	}
+	return nil
+}
+
+// loaderKey is the key of the loader in the context of a request
+type loaderKey struct{}
+
+// loader deduplicates the lookups of nexus nodes done by the resolvers of a single request. Every node is stored
+// under the key of its type and parent labels, so that the resolvers of its fields reuse it instead of fetching it
+// again, and concurrent resolvers looking up the same node wait for a single fetch.
+type loader struct {
+	mtx   sync.Mutex
+	calls map[string]*loaderCall
+}
+
+type loaderCall struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+}
+
+// WithLoader returns a copy of ctx carrying a new loader, it is called once for every GraphQL operation
+func WithLoader(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loaderKey{}, &loader{calls: map[string]*loaderCall{}})
+}
+
+// loaderCacheKey returns the key of a node of the given type identified by its parent labels
+func loaderCacheKey(nodeType string, parentLabels map[string]interface{}) string {
+	keys := make([]string, 0, len(parentLabels))
+	for k := range parentLabels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	key := nodeType
+	for _, k := range keys {
+		key += fmt.Sprintf("/%s=%v", k, parentLabels[k])
+	}
+	return key
+}
+
+// load returns the node stored under key in the loader of the request, fetch is called if it isn't loaded yet
+// or if there is no loader in ctx
+func load(ctx context.Context, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	l, ok := ctx.Value(loaderKey{}).(*loader)
+	if !ok {
+		return fetch()
+	}
+	l.mtx.Lock()
+	if call, ok := l.calls[key]; ok {
+		l.mtx.Unlock()
+		<-call.done
+		return call.val, call.err
+	}
+	call := &loaderCall{done: make(chan struct{})}
+	l.calls[key] = call
+	l.mtx.Unlock()
+
+	call.val, call.err = fetch()
+	close(call.done)
+	return call.val, call.err
+}
+
+// prime stores a node which was already fetched in the loader of the request
+func prime(ctx context.Context, key string, val interface{}) {
+	l, ok := ctx.Value(loaderKey{}).(*loader)
+	if !ok {
+		return
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if _, ok := l.calls[key]; !ok {
+		call := &loaderCall{done: make(chan struct{}), val: val}
+		close(call.done)
+		l.calls[key] = call
+	}
+}
+
+// ////////////////////////////////////
+// Singleton Resolver for Parent Node
+// PKG: Root, NODE: Root
+// ////////////////////////////////////
+func getRootResolver(ctx context.Context) (*model.RootRoot, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
 
-	vRoot, err := nc.GetRootRoot(context.TODO())
+	vRoot, err := nc.GetRootRoot(ctx)
 	if err != nil {
 		log.Errorf("[getRootResolver]Error getting Root node %s", err)
-		return nil, nil
+		return nil, err
 	}
 	dn := vRoot.DisplayName()
 	parentLabels := map[string]interface{}{"roots.root.tsm.tanzu.vmware.com": dn}
@@ -95,12 +189,13 @@ func getRootResolver() (*model.RootRoot, error) {
 		Id:           &dn,
 		ParentLabels: parentLabels,
 	}
+	prime(ctx, loaderCacheKey("RootRoot", ret.ParentLabels), vRoot)
 	log.Debugf("[getRootResolver]Output Root object %+v", ret)
 	return ret, nil
 }
 
 // Custom query
-func getConfigConfigQueryExampleResolver(obj *model.ConfigConfig, StartTime *string, EndTime *string, Interval *string, IsServiceDeployment *bool, StartVal *int) (*model.NexusGraphqlResponse, error) {
+func getConfigConfigQueryExampleResolver(ctx context.Context, obj *model.ConfigConfig, StartTime *string, EndTime *string, Interval *string, IsServiceDeployment *bool, StartVal *int) (*model.NexusGraphqlResponse, error) {
 	parentLabels := make(map[string]string)
 	if obj != nil {
 		for k, v := range obj.ParentLabels {
@@ -130,7 +225,7 @@ func getConfigConfigQueryExampleResolver(obj *model.ConfigConfig, StartTime *str
 }
 
 // Custom query
-func getGnsGnsqueryGns1Resolver(obj *model.GnsGns, StartTime *string, EndTime *string, Interval *string, IsServiceDeployment *bool, StartVal *int) (*model.NexusGraphqlResponse, error) {
+func getGnsGnsqueryGns1Resolver(ctx context.Context, obj *model.GnsGns, StartTime *string, EndTime *string, Interval *string, IsServiceDeployment *bool, StartVal *int) (*model.NexusGraphqlResponse, error) {
 	parentLabels := make(map[string]string)
 	if obj != nil {
 		for k, v := range obj.ParentLabels {
@@ -160,7 +255,7 @@ func getGnsGnsqueryGns1Resolver(obj *model.GnsGns, StartTime *string, EndTime *s
 }
 
 // Custom query
-func getGnsGnsqueryGnsQM1Resolver(obj *model.GnsGns) (*model.TimeSeriesData, error) {
+func getGnsGnsqueryGnsQM1Resolver(ctx context.Context, obj *model.GnsGns) (*model.TimeSeriesData, error) {
 	parentLabels := make(map[string]string)
 	if obj != nil {
 		for k, v := range obj.ParentLabels {
@@ -183,7 +278,7 @@ func getGnsGnsqueryGnsQM1Resolver(obj *model.GnsGns) (*model.TimeSeriesData, err
 }
 
 // Custom query
-func getGnsGnsqueryGnsQMResolver(obj *model.GnsGns, StartTime *string, EndTime *string, TimeInterval *string, SomeUserArg1 *string, SomeUserArg2 *int, SomeUserArg3 *bool) (*model.TimeSeriesData, error) {
+func getGnsGnsqueryGnsQMResolver(ctx context.Context, obj *model.GnsGns, StartTime *string, EndTime *string, TimeInterval *string, SomeUserArg1 *string, SomeUserArg2 *int, SomeUserArg3 *bool) (*model.TimeSeriesData, error) {
 	parentLabels := make(map[string]string)
 	if obj != nil {
 		for k, v := range obj.ParentLabels {
@@ -213,7 +308,7 @@ func getGnsGnsqueryGnsQMResolver(obj *model.GnsGns, StartTime *string, EndTime *
 }
 
 // Custom query
-func getPolicypkgVMpolicyqueryGns1Resolver(obj *model.PolicypkgVMpolicy, StartTime *string, EndTime *string, Interval *string, IsServiceDeployment *bool, StartVal *int) (*model.NexusGraphqlResponse, error) {
+func getPolicypkgVMpolicyqueryGns1Resolver(ctx context.Context, obj *model.PolicypkgVMpolicy, StartTime *string, EndTime *string, Interval *string, IsServiceDeployment *bool, StartVal *int) (*model.NexusGraphqlResponse, error) {
 	parentLabels := make(map[string]string)
 	if obj != nil {
 		for k, v := range obj.ParentLabels {
@@ -243,7 +338,7 @@ func getPolicypkgVMpolicyqueryGns1Resolver(obj *model.PolicypkgVMpolicy, StartTi
 }
 
 // Custom query
-func getPolicypkgVMpolicyqueryGnsQM1Resolver(obj *model.PolicypkgVMpolicy) (*model.TimeSeriesData, error) {
+func getPolicypkgVMpolicyqueryGnsQM1Resolver(ctx context.Context, obj *model.PolicypkgVMpolicy) (*model.TimeSeriesData, error) {
 	parentLabels := make(map[string]string)
 	if obj != nil {
 		for k, v := range obj.ParentLabels {
@@ -266,7 +361,7 @@ func getPolicypkgVMpolicyqueryGnsQM1Resolver(obj *model.PolicypkgVMpolicy) (*mod
 }
 
 // Custom query
-func getPolicypkgVMpolicyqueryGnsQMResolver(obj *model.PolicypkgVMpolicy, StartTime *string, EndTime *string, TimeInterval *string, SomeUserArg1 *string, SomeUserArg2 *int, SomeUserArg3 *bool) (*model.TimeSeriesData, error) {
+func getPolicypkgVMpolicyqueryGnsQMResolver(ctx context.Context, obj *model.PolicypkgVMpolicy, StartTime *string, EndTime *string, TimeInterval *string, SomeUserArg1 *string, SomeUserArg2 *int, SomeUserArg3 *bool) (*model.TimeSeriesData, error) {
 	parentLabels := make(map[string]string)
 	if obj != nil {
 		for k, v := range obj.ParentLabels {
@@ -299,14 +394,14 @@ func getPolicypkgVMpolicyqueryGnsQMResolver(obj *model.PolicypkgVMpolicy, StartT
 // CHILD RESOLVER (Non Singleton)
 // FieldName: Config Node: Root PKG: Root
 // ////////////////////////////////////
-func getRootRootConfigResolver(obj *model.RootRoot, id *string) (*model.ConfigConfig, error) {
+func getRootRootConfigResolver(ctx context.Context, obj *model.RootRoot, id *string) (*model.ConfigConfig, error) {
 	log.Debugf("[getRootRootConfigResolver]Parent Object %+v", obj)
 	if id != nil && *id != "" {
 		log.Debugf("[getRootRootConfigResolver]Id %q", *id)
-		vConfig, err := nc.RootRoot().GetConfig(context.TODO(), *id)
+		vConfig, err := nc.RootRoot().GetConfig(ctx, *id)
 		if err != nil {
 			log.Errorf("[getRootRootConfigResolver]Error getting Config node %q : %s", *id, err)
-			return &model.ConfigConfig{}, nil
+			return nil, err
 		}
 		dn := vConfig.DisplayName()
 		parentLabels := map[string]interface{}{"configs.config.tsm.tanzu.vmware.com": dn}
@@ -343,20 +438,21 @@ func getRootRootConfigResolver(obj *model.RootRoot, id *string) (*model.ConfigCo
 			Instance:          &vInstance,
 			CuOption:          &vCuOption,
 		}
+		prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
 
 		log.Debugf("[getRootRootConfigResolver]Output object %v", ret)
 		return ret, nil
 	}
 	log.Debug("[getRootRootConfigResolver]Id is empty, process all Configs")
-	vConfigParent, err := nc.GetRootRoot(context.TODO())
+	vConfigParent, err := loadRootRootObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getRootRootConfigResolver]Failed to get parent node %s", err)
-		return &model.ConfigConfig{}, nil
+		return nil, err
 	}
-	vConfig, err := vConfigParent.GetConfig(context.TODO())
+	vConfig, err := vConfigParent.GetConfig(ctx)
 	if err != nil {
 		log.Errorf("[getRootRootConfigResolver]Error getting Config node %s", err)
-		return &model.ConfigConfig{}, nil
+		return nil, err
 	}
 	dn := vConfig.DisplayName()
 	parentLabels := map[string]interface{}{"configs.config.tsm.tanzu.vmware.com": dn}
@@ -393,6 +489,7 @@ func getRootRootConfigResolver(obj *model.RootRoot, id *string) (*model.ConfigCo
 		Instance:          &vInstance,
 		CuOption:          &vCuOption,
 	}
+	prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
 
 	log.Debugf("[getRootRootConfigResolver]Output object %v", ret)
 
@@ -403,14 +500,14 @@ func getRootRootConfigResolver(obj *model.RootRoot, id *string) (*model.ConfigCo
 // CHILD RESOLVER (Non Singleton)
 // FieldName: GNS Node: Config PKG: Config
 // ////////////////////////////////////
-func getConfigConfigGNSResolver(obj *model.ConfigConfig, id *string) (*model.GnsGns, error) {
+func getConfigConfigGNSResolver(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.GnsGns, error) {
 	log.Debugf("[getConfigConfigGNSResolver]Parent Object %+v", obj)
 	if id != nil && *id != "" {
 		log.Debugf("[getConfigConfigGNSResolver]Id %q", *id)
-		vGns, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetGNS(context.TODO(), *id)
+		vGns, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetGNS(ctx, *id)
 		if err != nil {
 			log.Errorf("[getConfigConfigGNSResolver]Error getting GNS node %q : %s", *id, err)
-			return &model.GnsGns{}, nil
+			return nil, err
 		}
 		dn := vGns.DisplayName()
 		parentLabels := map[string]interface{}{"gnses.gns.tsm.tanzu.vmware.com": dn}
@@ -425,6 +522,11 @@ func getConfigConfigGNSResolver(obj *model.ConfigConfig, id *string) (*model.Gns
 		vMeta := string(vGns.Spec.Meta)
 		IntOrString, _ := json.Marshal(vGns.Spec.IntOrString)
 		IntOrStringData := string(IntOrString)
+		var vPort *int
+		if vGns.Spec.Port != nil {
+			v := int(*vGns.Spec.Port)
+			vPort = &v
+		}
 		OtherDescription, _ := json.Marshal(vGns.Spec.OtherDescription)
 		OtherDescriptionData := string(OtherDescription)
 		MapPointer, _ := json.Marshal(vGns.Spec.MapPointer)
@@ -457,6 +559,7 @@ func getConfigConfigGNSResolver(obj *model.ConfigConfig, id *string) (*model.Gns
 			Description:              &DescriptionData,
 			Meta:                     &vMeta,
 			IntOrString:              &IntOrStringData,
+			Port:                     vPort,
 			OtherDescription:         &OtherDescriptionData,
 			MapPointer:               &MapPointerData,
 			SlicePointer:             &SlicePointerData,
@@ -467,20 +570,21 @@ func getConfigConfigGNSResolver(obj *model.ConfigConfig, id *string) (*model.Gns
 			ServiceSegmentRefs:       &ServiceSegmentRefsData,
 			ServiceSegmentRefMap:     &ServiceSegmentRefMapData,
 		}
+		prime(ctx, loaderCacheKey("GnsGns", ret.ParentLabels), vGns)
 
 		log.Debugf("[getConfigConfigGNSResolver]Output object %v", ret)
 		return ret, nil
 	}
 	log.Debug("[getConfigConfigGNSResolver]Id is empty, process all GNSs")
-	vGnsParent, err := nc.RootRoot().GetConfig(context.TODO(), getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
+	vGnsParent, err := loadConfigConfigObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getConfigConfigGNSResolver]Failed to get parent node %s", err)
-		return &model.GnsGns{}, nil
+		return nil, err
 	}
-	vGns, err := vGnsParent.GetGNS(context.TODO())
+	vGns, err := vGnsParent.GetGNS(ctx)
 	if err != nil {
 		log.Errorf("[getConfigConfigGNSResolver]Error getting GNS node %s", err)
-		return &model.GnsGns{}, nil
+		return nil, err
 	}
 	dn := vGns.DisplayName()
 	parentLabels := map[string]interface{}{"gnses.gns.tsm.tanzu.vmware.com": dn}
@@ -495,6 +599,11 @@ func getConfigConfigGNSResolver(obj *model.ConfigConfig, id *string) (*model.Gns
 	vMeta := string(vGns.Spec.Meta)
 	IntOrString, _ := json.Marshal(vGns.Spec.IntOrString)
 	IntOrStringData := string(IntOrString)
+	var vPort *int
+	if vGns.Spec.Port != nil {
+		v := int(*vGns.Spec.Port)
+		vPort = &v
+	}
 	OtherDescription, _ := json.Marshal(vGns.Spec.OtherDescription)
 	OtherDescriptionData := string(OtherDescription)
 	MapPointer, _ := json.Marshal(vGns.Spec.MapPointer)
@@ -527,6 +636,7 @@ func getConfigConfigGNSResolver(obj *model.ConfigConfig, id *string) (*model.Gns
 		Description:              &DescriptionData,
 		Meta:                     &vMeta,
 		IntOrString:              &IntOrStringData,
+		Port:                     vPort,
 		OtherDescription:         &OtherDescriptionData,
 		MapPointer:               &MapPointerData,
 		SlicePointer:             &SlicePointerData,
@@ -537,6 +647,7 @@ func getConfigConfigGNSResolver(obj *model.ConfigConfig, id *string) (*model.Gns
 		ServiceSegmentRefs:       &ServiceSegmentRefsData,
 		ServiceSegmentRefMap:     &ServiceSegmentRefMapData,
 	}
+	prime(ctx, loaderCacheKey("GnsGns", ret.ParentLabels), vGns)
 
 	log.Debugf("[getConfigConfigGNSResolver]Output object %v", ret)
 
@@ -547,12 +658,12 @@ func getConfigConfigGNSResolver(obj *model.ConfigConfig, id *string) (*model.Gns
 // CHILD RESOLVER (Singleton)
 // FieldName: DNS Node: Config PKG: Config
 // ////////////////////////////////////
-func getConfigConfigDNSResolver(obj *model.ConfigConfig) (*model.GnsDns, error) {
+func getConfigConfigDNSResolver(ctx context.Context, obj *model.ConfigConfig) (*model.GnsDns, error) {
 	log.Debugf("[getConfigConfigDNSResolver]Parent Object %+v", obj)
-	vDns, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetDNS(context.TODO())
+	vDns, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetDNS(ctx)
 	if err != nil {
 		log.Errorf("[getConfigConfigDNSResolver]Error getting Config node %s", err)
-		return &model.GnsDns{}, nil
+		return nil, err
 	}
 	dn := vDns.DisplayName()
 	parentLabels := map[string]interface{}{"dnses.gns.tsm.tanzu.vmware.com": dn}
@@ -564,6 +675,7 @@ func getConfigConfigDNSResolver(obj *model.ConfigConfig) (*model.GnsDns, error)
 		Id:           &dn,
 		ParentLabels: parentLabels,
 	}
+	prime(ctx, loaderCacheKey("GnsDns", ret.ParentLabels), vDns)
 
 	log.Debugf("[getConfigConfigDNSResolver]Output object %+v", ret)
 	return ret, nil
@@ -573,14 +685,14 @@ func getConfigConfigDNSResolver(obj *model.ConfigConfig) (*model.GnsDns, error)
 // CHILD RESOLVER (Non Singleton)
 // FieldName: VMPPolicies Node: Config PKG: Config
 // ////////////////////////////////////
-func getConfigConfigVMPPoliciesResolver(obj *model.ConfigConfig, id *string) (*model.PolicypkgVMpolicy, error) {
+func getConfigConfigVMPPoliciesResolver(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.PolicypkgVMpolicy, error) {
 	log.Debugf("[getConfigConfigVMPPoliciesResolver]Parent Object %+v", obj)
 	if id != nil && *id != "" {
 		log.Debugf("[getConfigConfigVMPPoliciesResolver]Id %q", *id)
-		vVMpolicy, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetVMPPolicies(context.TODO(), *id)
+		vVMpolicy, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetVMPPolicies(ctx, *id)
 		if err != nil {
 			log.Errorf("[getConfigConfigVMPPoliciesResolver]Error getting VMPPolicies node %q : %s", *id, err)
-			return &model.PolicypkgVMpolicy{}, nil
+			return nil, err
 		}
 		dn := vVMpolicy.DisplayName()
 		parentLabels := map[string]interface{}{"vmpolicies.policypkg.tsm.tanzu.vmware.com": dn}
@@ -592,20 +704,21 @@ func getConfigConfigVMPPoliciesResolver(obj *model.ConfigConfig, id *string) (*m
 			Id:           &dn,
 			ParentLabels: parentLabels,
 		}
+		prime(ctx, loaderCacheKey("PolicypkgVMpolicy", ret.ParentLabels), vVMpolicy)
 
 		log.Debugf("[getConfigConfigVMPPoliciesResolver]Output object %v", ret)
 		return ret, nil
 	}
 	log.Debug("[getConfigConfigVMPPoliciesResolver]Id is empty, process all VMPPoliciess")
-	vVMpolicyParent, err := nc.RootRoot().GetConfig(context.TODO(), getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
+	vVMpolicyParent, err := loadConfigConfigObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getConfigConfigVMPPoliciesResolver]Failed to get parent node %s", err)
-		return &model.PolicypkgVMpolicy{}, nil
+		return nil, err
 	}
-	vVMpolicy, err := vVMpolicyParent.GetVMPPolicies(context.TODO())
+	vVMpolicy, err := vVMpolicyParent.GetVMPPolicies(ctx)
 	if err != nil {
 		log.Errorf("[getConfigConfigVMPPoliciesResolver]Error getting VMPPolicies node %s", err)
-		return &model.PolicypkgVMpolicy{}, nil
+		return nil, err
 	}
 	dn := vVMpolicy.DisplayName()
 	parentLabels := map[string]interface{}{"vmpolicies.policypkg.tsm.tanzu.vmware.com": dn}
@@ -617,6 +730,7 @@ func getConfigConfigVMPPoliciesResolver(obj *model.ConfigConfig, id *string) (*m
 		Id:           &dn,
 		ParentLabels: parentLabels,
 	}
+	prime(ctx, loaderCacheKey("PolicypkgVMpolicy", ret.ParentLabels), vVMpolicy)
 
 	log.Debugf("[getConfigConfigVMPPoliciesResolver]Output object %v", ret)
 
@@ -627,19 +741,29 @@ func getConfigConfigVMPPoliciesResolver(obj *model.ConfigConfig, id *string) (*m
 // CHILD RESOLVER (Non Singleton)
 // FieldName: Domain Node: Config PKG: Config
 // ////////////////////////////////////
-func getConfigConfigDomainResolver(obj *model.ConfigConfig, id *string) (*model.ConfigDomain, error) {
+func getConfigConfigDomainResolver(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.ConfigDomain, error) {
 	log.Debugf("[getConfigConfigDomainResolver]Parent Object %+v", obj)
 	if id != nil && *id != "" {
 		log.Debugf("[getConfigConfigDomainResolver]Id %q", *id)
-		vDomain, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetDomain(context.TODO(), *id)
+		vDomain, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetDomain(ctx, *id)
 		if err != nil {
 			log.Errorf("[getConfigConfigDomainResolver]Error getting Domain node %q : %s", *id, err)
-			return &model.ConfigDomain{}, nil
+			return nil, err
 		}
 		dn := vDomain.DisplayName()
 		parentLabels := map[string]interface{}{"domains.config.tsm.tanzu.vmware.com": dn}
 		PointPort, _ := json.Marshal(vDomain.Spec.PointPort)
 		PointPortData := string(PointPort)
+		var vPointString *string
+		if vDomain.Spec.PointString != nil {
+			v := string(*vDomain.Spec.PointString)
+			vPointString = &v
+		}
+		var vPointInt *int
+		if vDomain.Spec.PointInt != nil {
+			v := int(*vDomain.Spec.PointInt)
+			vPointInt = &v
+		}
 		PointMap, _ := json.Marshal(vDomain.Spec.PointMap)
 		PointMapData := string(PointMap)
 		PointSlice, _ := json.Marshal(vDomain.Spec.PointSlice)
@@ -660,6 +784,8 @@ func getConfigConfigDomainResolver(obj *model.ConfigConfig, id *string) (*model.
 			Id:               &dn,
 			ParentLabels:     parentLabels,
 			PointPort:        &PointPortData,
+			PointString:      vPointString,
+			PointInt:         vPointInt,
 			PointMap:         &PointMapData,
 			PointSlice:       &PointSliceData,
 			SliceOfPoints:    &SliceOfPointsData,
@@ -667,25 +793,36 @@ func getConfigConfigDomainResolver(obj *model.ConfigConfig, id *string) (*model.
 			MapOfArrsPoints:  &MapOfArrsPointsData,
 			PointStruct:      &PointStructData,
 		}
+		prime(ctx, loaderCacheKey("ConfigDomain", ret.ParentLabels), vDomain)
 
 		log.Debugf("[getConfigConfigDomainResolver]Output object %v", ret)
 		return ret, nil
 	}
 	log.Debug("[getConfigConfigDomainResolver]Id is empty, process all Domains")
-	vDomainParent, err := nc.RootRoot().GetConfig(context.TODO(), getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
+	vDomainParent, err := loadConfigConfigObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getConfigConfigDomainResolver]Failed to get parent node %s", err)
-		return &model.ConfigDomain{}, nil
+		return nil, err
 	}
-	vDomain, err := vDomainParent.GetDomain(context.TODO())
+	vDomain, err := vDomainParent.GetDomain(ctx)
 	if err != nil {
 		log.Errorf("[getConfigConfigDomainResolver]Error getting Domain node %s", err)
-		return &model.ConfigDomain{}, nil
+		return nil, err
 	}
 	dn := vDomain.DisplayName()
 	parentLabels := map[string]interface{}{"domains.config.tsm.tanzu.vmware.com": dn}
 	PointPort, _ := json.Marshal(vDomain.Spec.PointPort)
 	PointPortData := string(PointPort)
+	var vPointString *string
+	if vDomain.Spec.PointString != nil {
+		v := string(*vDomain.Spec.PointString)
+		vPointString = &v
+	}
+	var vPointInt *int
+	if vDomain.Spec.PointInt != nil {
+		v := int(*vDomain.Spec.PointInt)
+		vPointInt = &v
+	}
 	PointMap, _ := json.Marshal(vDomain.Spec.PointMap)
 	PointMapData := string(PointMap)
 	PointSlice, _ := json.Marshal(vDomain.Spec.PointSlice)
@@ -706,6 +843,8 @@ func getConfigConfigDomainResolver(obj *model.ConfigConfig, id *string) (*model.
 		Id:               &dn,
 		ParentLabels:     parentLabels,
 		PointPort:        &PointPortData,
+		PointString:      vPointString,
+		PointInt:         vPointInt,
 		PointMap:         &PointMapData,
 		PointSlice:       &PointSliceData,
 		SliceOfPoints:    &SliceOfPointsData,
@@ -713,6 +852,7 @@ func getConfigConfigDomainResolver(obj *model.ConfigConfig, id *string) (*model.
 		MapOfArrsPoints:  &MapOfArrsPointsData,
 		PointStruct:      &PointStructData,
 	}
+	prime(ctx, loaderCacheKey("ConfigDomain", ret.ParentLabels), vDomain)
 
 	log.Debugf("[getConfigConfigDomainResolver]Output object %v", ret)
 
@@ -723,14 +863,14 @@ func getConfigConfigDomainResolver(obj *model.ConfigConfig, id *string) (*model.
 // CHILD RESOLVER (Non Singleton)
 // FieldName: SvcGrpInfo Node: Config PKG: Config
 // ////////////////////////////////////
-func getConfigConfigSvcGrpInfoResolver(obj *model.ConfigConfig, id *string) (*model.ServicegroupSvcGroupLinkInfo, error) {
+func getConfigConfigSvcGrpInfoResolver(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.ServicegroupSvcGroupLinkInfo, error) {
 	log.Debugf("[getConfigConfigSvcGrpInfoResolver]Parent Object %+v", obj)
 	if id != nil && *id != "" {
 		log.Debugf("[getConfigConfigSvcGrpInfoResolver]Id %q", *id)
-		vSvcGroupLinkInfo, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetSvcGrpInfo(context.TODO(), *id)
+		vSvcGroupLinkInfo, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetSvcGrpInfo(ctx, *id)
 		if err != nil {
 			log.Errorf("[getConfigConfigSvcGrpInfoResolver]Error getting SvcGrpInfo node %q : %s", *id, err)
-			return &model.ServicegroupSvcGroupLinkInfo{}, nil
+			return nil, err
 		}
 		dn := vSvcGroupLinkInfo.DisplayName()
 		parentLabels := map[string]interface{}{"svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com": dn}
@@ -750,20 +890,21 @@ func getConfigConfigSvcGrpInfoResolver(obj *model.ConfigConfig, id *string) (*mo
 			ServiceName:  &vServiceName,
 			ServiceType:  &vServiceType,
 		}
+		prime(ctx, loaderCacheKey("ServicegroupSvcGroupLinkInfo", ret.ParentLabels), vSvcGroupLinkInfo)
 
 		log.Debugf("[getConfigConfigSvcGrpInfoResolver]Output object %v", ret)
 		return ret, nil
 	}
 	log.Debug("[getConfigConfigSvcGrpInfoResolver]Id is empty, process all SvcGrpInfos")
-	vSvcGroupLinkInfoParent, err := nc.RootRoot().GetConfig(context.TODO(), getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
+	vSvcGroupLinkInfoParent, err := loadConfigConfigObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getConfigConfigSvcGrpInfoResolver]Failed to get parent node %s", err)
-		return &model.ServicegroupSvcGroupLinkInfo{}, nil
+		return nil, err
 	}
-	vSvcGroupLinkInfo, err := vSvcGroupLinkInfoParent.GetSvcGrpInfo(context.TODO())
+	vSvcGroupLinkInfo, err := vSvcGroupLinkInfoParent.GetSvcGrpInfo(ctx)
 	if err != nil {
 		log.Errorf("[getConfigConfigSvcGrpInfoResolver]Error getting SvcGrpInfo node %s", err)
-		return &model.ServicegroupSvcGroupLinkInfo{}, nil
+		return nil, err
 	}
 	dn := vSvcGroupLinkInfo.DisplayName()
 	parentLabels := map[string]interface{}{"svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com": dn}
@@ -783,6 +924,7 @@ func getConfigConfigSvcGrpInfoResolver(obj *model.ConfigConfig, id *string) (*mo
 		ServiceName:  &vServiceName,
 		ServiceType:  &vServiceType,
 	}
+	prime(ctx, loaderCacheKey("ServicegroupSvcGroupLinkInfo", ret.ParentLabels), vSvcGroupLinkInfo)
 
 	log.Debugf("[getConfigConfigSvcGrpInfoResolver]Output object %v", ret)
 
@@ -793,15 +935,15 @@ func getConfigConfigSvcGrpInfoResolver(obj *model.ConfigConfig, id *string) (*mo
 // CHILDREN RESOLVER
 // FieldName: FooExample Node: Config PKG: Config
 // ////////////////////////////////////
-func getConfigConfigFooExampleResolver(obj *model.ConfigConfig, id *string) ([]*model.ConfigFooTypeABC, error) {
+func getConfigConfigFooExampleResolver(ctx context.Context, obj *model.ConfigConfig, id *string) ([]*model.ConfigFooTypeABC, error) {
 	log.Debugf("[getConfigConfigFooExampleResolver]Parent Object %+v", obj)
 	var vConfigFooTypeABCList []*model.ConfigFooTypeABC
 	if id != nil && *id != "" {
 		log.Debugf("[getConfigConfigFooExampleResolver]Id %q", *id)
-		vFooTypeABC, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetFooExample(context.TODO(), *id)
+		vFooTypeABC, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetFooExample(ctx, *id)
 		if err != nil {
 			log.Errorf("[getConfigConfigFooExampleResolver]Error getting FooExample node %q : %s", *id, err)
-			return vConfigFooTypeABCList, nil
+			return nil, err
 		}
 		dn := vFooTypeABC.DisplayName()
 		parentLabels := map[string]interface{}{"footypeabcs.config.tsm.tanzu.vmware.com": dn}
@@ -825,6 +967,7 @@ func getConfigConfigFooExampleResolver(obj *model.ConfigConfig, id *string) ([]*
 			FooD:         &FooDData,
 			FooF:         &FooFData,
 		}
+		prime(ctx, loaderCacheKey("ConfigFooTypeABC", ret.ParentLabels), vFooTypeABC)
 		vConfigFooTypeABCList = append(vConfigFooTypeABCList, ret)
 
 		log.Debugf("[getConfigConfigFooExampleResolver]Output FooExample objects %v", vConfigFooTypeABCList)
@@ -834,22 +977,17 @@ func getConfigConfigFooExampleResolver(obj *model.ConfigConfig, id *string) ([]*
 
 	log.Debug("[getConfigConfigFooExampleResolver]Id is empty, process all FooExamples")
 
-	vFooTypeABCParent, err := nc.RootRoot().GetConfig(context.TODO(), getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
+	vFooTypeABCParent, err := loadConfigConfigObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getConfigConfigFooExampleResolver]Error getting parent node %s", err)
-		return vConfigFooTypeABCList, nil
+		return nil, err
 	}
-	vFooTypeABCAllObj, err := vFooTypeABCParent.GetAllFooExample(context.TODO())
+	vFooTypeABCAllObj, err := vFooTypeABCParent.GetAllFooExample(ctx)
 	if err != nil {
 		log.Errorf("[getConfigConfigFooExampleResolver]Error getting FooExample objects %s", err)
-		return vConfigFooTypeABCList, nil
+		return nil, err
 	}
-	for _, i := range vFooTypeABCAllObj {
-		vFooTypeABC, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetFooExample(context.TODO(), i.DisplayName())
-		if err != nil {
-			log.Errorf("[getConfigConfigFooExampleResolver]Error getting FooExample node %q : %s", i.DisplayName(), err)
-			continue
-		}
+	for _, vFooTypeABC := range vFooTypeABCAllObj {
 		dn := vFooTypeABC.DisplayName()
 		parentLabels := map[string]interface{}{"footypeabcs.config.tsm.tanzu.vmware.com": dn}
 		FooA, _ := json.Marshal(vFooTypeABC.Spec.FooA)
@@ -872,6 +1010,7 @@ func getConfigConfigFooExampleResolver(obj *model.ConfigConfig, id *string) ([]*
 			FooD:         &FooDData,
 			FooF:         &FooFData,
 		}
+		prime(ctx, loaderCacheKey("ConfigFooTypeABC", ret.ParentLabels), vFooTypeABC)
 		vConfigFooTypeABCList = append(vConfigFooTypeABCList, ret)
 	}
 
@@ -880,24 +1019,63 @@ func getConfigConfigFooExampleResolver(obj *model.ConfigConfig, id *string) ([]*
 	return vConfigFooTypeABCList, nil
 }
 
+func getConfigConfigFooExampleConnectionResolver(ctx context.Context, obj *model.ConfigConfig, first *int, after *string, filter *model.ConfigFooTypeABCFilter, orderBy []*model.ConfigFooTypeABCOrderBy) (*model.ConfigFooTypeABCConnection, error) {
+	vFooTypeABCParent, err := loadConfigConfigObject(ctx, obj)
+	if err != nil {
+		log.Errorf("[getConfigConfigFooExampleConnectionResolver]Error getting parent node %s", err)
+		return nil, err
+	}
+	vFooTypeABCAllObj, err := vFooTypeABCParent.GetAllFooExample(ctx)
+	if err != nil {
+		log.Errorf("[getConfigConfigFooExampleConnectionResolver]Error getting FooExample objects %s", err)
+		return nil, err
+	}
+	return getConfigFooTypeABCConnection(vFooTypeABCAllObj, func(vFooTypeABC *nexus_client.ConfigFooTypeABC) *model.ConfigFooTypeABC {
+		dn := vFooTypeABC.DisplayName()
+		parentLabels := map[string]interface{}{"footypeabcs.config.tsm.tanzu.vmware.com": dn}
+		FooA, _ := json.Marshal(vFooTypeABC.Spec.FooA)
+		FooAData := string(FooA)
+		FooB, _ := json.Marshal(vFooTypeABC.Spec.FooB)
+		FooBData := string(FooB)
+		FooD, _ := json.Marshal(vFooTypeABC.Spec.FooD)
+		FooDData := string(FooD)
+		FooF, _ := json.Marshal(vFooTypeABC.Spec.FooF)
+		FooFData := string(FooF)
+
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.ConfigFooTypeABC{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+			FooA:         &FooAData,
+			FooB:         &FooBData,
+			FooD:         &FooDData,
+			FooF:         &FooFData,
+		}
+		prime(ctx, loaderCacheKey("ConfigFooTypeABC", ret.ParentLabels), vFooTypeABC)
+		return ret
+	}, first, after, filter, orderBy)
+}
+
 // ////////////////////////////////////
 // LINKS RESOLVER
 // FieldName: ACPPolicies Node: Config PKG: Config
 // ////////////////////////////////////
-func getConfigConfigACPPoliciesResolver(obj *model.ConfigConfig, id *string) ([]*model.PolicypkgAccessControlPolicy, error) {
+func getConfigConfigACPPoliciesResolver(ctx context.Context, obj *model.ConfigConfig, id *string) ([]*model.PolicypkgAccessControlPolicy, error) {
 	log.Debugf("[getConfigConfigACPPoliciesResolver]Parent Object %+v", obj)
 	var vPolicypkgAccessControlPolicyList []*model.PolicypkgAccessControlPolicy
 	if id != nil && *id != "" {
 		log.Debugf("[getConfigConfigACPPoliciesResolver]Id %q", *id)
-		vAccessControlPolicyParent, err := nc.RootRoot().GetConfig(context.TODO(), getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
+		vAccessControlPolicyParent, err := loadConfigConfigObject(ctx, obj)
 		if err != nil {
 			log.Errorf("[getConfigConfigACPPoliciesResolver]Error getting ACPPolicies %q : %s", *id, err)
-			return vPolicypkgAccessControlPolicyList, nil
+			return nil, err
 		}
-		vAccessControlPolicy, err := vAccessControlPolicyParent.GetACPPolicies(context.TODO(), *id)
+		vAccessControlPolicy, err := vAccessControlPolicyParent.GetACPPolicies(ctx, *id)
 		if err != nil {
 			log.Errorf("[getConfigConfigACPPoliciesResolver]Error getting ACPPolicies %q : %s", *id, err)
-			return vPolicypkgAccessControlPolicyList, nil
+			return nil, err
 		}
 		dn := vAccessControlPolicy.DisplayName()
 		parentLabels := map[string]interface{}{"accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com": dn}
@@ -909,6 +1087,7 @@ func getConfigConfigACPPoliciesResolver(obj *model.ConfigConfig, id *string) ([]
 			Id:           &dn,
 			ParentLabels: parentLabels,
 		}
+		prime(ctx, loaderCacheKey("PolicypkgAccessControlPolicy", ret.ParentLabels), vAccessControlPolicy)
 		vPolicypkgAccessControlPolicyList = append(vPolicypkgAccessControlPolicyList, ret)
 
 		log.Debugf("[getConfigConfigACPPoliciesResolver]Output ACPPolicies objects %v", vPolicypkgAccessControlPolicyList)
@@ -918,27 +1097,17 @@ func getConfigConfigACPPoliciesResolver(obj *model.ConfigConfig, id *string) ([]
 
 	log.Debug("[getConfigConfigACPPoliciesResolver]Id is empty, process all ACPPoliciess")
 
-	vAccessControlPolicyParent, err := nc.RootRoot().GetConfig(context.TODO(), getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
+	vAccessControlPolicyParent, err := loadConfigConfigObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getConfigConfigACPPoliciesResolver]Error getting parent node %s", err)
-		return vPolicypkgAccessControlPolicyList, nil
+		return nil, err
 	}
-	vAccessControlPolicyAllObj, err := vAccessControlPolicyParent.GetAllACPPolicies(context.TODO())
+	vAccessControlPolicyAllObj, err := vAccessControlPolicyParent.GetAllACPPolicies(ctx)
 	if err != nil {
 		log.Errorf("[getConfigConfigACPPoliciesResolver]Error getting ACPPolicies %s", err)
-		return vPolicypkgAccessControlPolicyList, nil
+		return nil, err
 	}
-	for _, i := range vAccessControlPolicyAllObj {
-		vAccessControlPolicyParent, err := nc.RootRoot().GetConfig(context.TODO(), getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
-		if err != nil {
-			log.Errorf("[getConfigConfigACPPoliciesResolver]Error getting parent node %s, skipping...", err)
-			continue
-		}
-		vAccessControlPolicy, err := vAccessControlPolicyParent.GetACPPolicies(context.TODO(), i.DisplayName())
-		if err != nil {
-			log.Errorf("[getConfigConfigACPPoliciesResolver]Error getting ACPPolicies node %q : %s, skipping...", i.DisplayName(), err)
-			continue
-		}
+	for _, vAccessControlPolicy := range vAccessControlPolicyAllObj {
 		dn := vAccessControlPolicy.DisplayName()
 		parentLabels := map[string]interface{}{"accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com": dn}
 
@@ -949,24 +1118,52 @@ func getConfigConfigACPPoliciesResolver(obj *model.ConfigConfig, id *string) ([]
 			Id:           &dn,
 			ParentLabels: parentLabels,
 		}
+		prime(ctx, loaderCacheKey("PolicypkgAccessControlPolicy", ret.ParentLabels), vAccessControlPolicy)
 		vPolicypkgAccessControlPolicyList = append(vPolicypkgAccessControlPolicyList, ret)
 	}
 	log.Debugf("[getConfigConfigACPPoliciesResolver]List of ACPPolicies object %v", vPolicypkgAccessControlPolicyList)
 	return vPolicypkgAccessControlPolicyList, nil
 }
 
+func getConfigConfigACPPoliciesConnectionResolver(ctx context.Context, obj *model.ConfigConfig, first *int, after *string, filter *model.PolicypkgAccessControlPolicyFilter, orderBy []*model.PolicypkgAccessControlPolicyOrderBy) (*model.PolicypkgAccessControlPolicyConnection, error) {
+	vAccessControlPolicyParent, err := loadConfigConfigObject(ctx, obj)
+	if err != nil {
+		log.Errorf("[getConfigConfigACPPoliciesConnectionResolver]Error getting parent node %s", err)
+		return nil, err
+	}
+	vAccessControlPolicyAllObj, err := vAccessControlPolicyParent.GetAllACPPolicies(ctx)
+	if err != nil {
+		log.Errorf("[getConfigConfigACPPoliciesConnectionResolver]Error getting ACPPolicies %s", err)
+		return nil, err
+	}
+	return getPolicypkgAccessControlPolicyConnection(vAccessControlPolicyAllObj, func(vAccessControlPolicy *nexus_client.PolicypkgAccessControlPolicy) *model.PolicypkgAccessControlPolicy {
+		dn := vAccessControlPolicy.DisplayName()
+		parentLabels := map[string]interface{}{"accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com": dn}
+
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.PolicypkgAccessControlPolicy{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+		}
+		prime(ctx, loaderCacheKey("PolicypkgAccessControlPolicy", ret.ParentLabels), vAccessControlPolicy)
+		return ret
+	}, first, after, filter, orderBy)
+}
+
 // ////////////////////////////////////
 // CHILD RESOLVER (Non Singleton)
 // FieldName: GnsAccessControlPolicy Node: Gns PKG: Gns
 // ////////////////////////////////////
-func getGnsGnsGnsAccessControlPolicyResolver(obj *model.GnsGns, id *string) (*model.PolicypkgAccessControlPolicy, error) {
+func getGnsGnsGnsAccessControlPolicyResolver(ctx context.Context, obj *model.GnsGns, id *string) (*model.PolicypkgAccessControlPolicy, error) {
 	log.Debugf("[getGnsGnsGnsAccessControlPolicyResolver]Parent Object %+v", obj)
 	if id != nil && *id != "" {
 		log.Debugf("[getGnsGnsGnsAccessControlPolicyResolver]Id %q", *id)
-		vAccessControlPolicy, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetGnsAccessControlPolicy(context.TODO(), *id)
+		vAccessControlPolicy, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetGnsAccessControlPolicy(ctx, *id)
 		if err != nil {
 			log.Errorf("[getGnsGnsGnsAccessControlPolicyResolver]Error getting GnsAccessControlPolicy node %q : %s", *id, err)
-			return &model.PolicypkgAccessControlPolicy{}, nil
+			return nil, err
 		}
 		dn := vAccessControlPolicy.DisplayName()
 		parentLabels := map[string]interface{}{"accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com": dn}
@@ -978,20 +1175,21 @@ func getGnsGnsGnsAccessControlPolicyResolver(obj *model.GnsGns, id *string) (*mo
 			Id:           &dn,
 			ParentLabels: parentLabels,
 		}
+		prime(ctx, loaderCacheKey("PolicypkgAccessControlPolicy", ret.ParentLabels), vAccessControlPolicy)
 
 		log.Debugf("[getGnsGnsGnsAccessControlPolicyResolver]Output object %v", ret)
 		return ret, nil
 	}
 	log.Debug("[getGnsGnsGnsAccessControlPolicyResolver]Id is empty, process all GnsAccessControlPolicys")
-	vAccessControlPolicyParent, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetGNS(context.TODO(), getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com"))
+	vAccessControlPolicyParent, err := loadGnsGnsObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getGnsGnsGnsAccessControlPolicyResolver]Failed to get parent node %s", err)
-		return &model.PolicypkgAccessControlPolicy{}, nil
+		return nil, err
 	}
-	vAccessControlPolicy, err := vAccessControlPolicyParent.GetGnsAccessControlPolicy(context.TODO())
+	vAccessControlPolicy, err := vAccessControlPolicyParent.GetGnsAccessControlPolicy(ctx)
 	if err != nil {
 		log.Errorf("[getGnsGnsGnsAccessControlPolicyResolver]Error getting GnsAccessControlPolicy node %s", err)
-		return &model.PolicypkgAccessControlPolicy{}, nil
+		return nil, err
 	}
 	dn := vAccessControlPolicy.DisplayName()
 	parentLabels := map[string]interface{}{"accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com": dn}
@@ -1003,6 +1201,7 @@ func getGnsGnsGnsAccessControlPolicyResolver(obj *model.GnsGns, id *string) (*mo
 		Id:           &dn,
 		ParentLabels: parentLabels,
 	}
+	prime(ctx, loaderCacheKey("PolicypkgAccessControlPolicy", ret.ParentLabels), vAccessControlPolicy)
 
 	log.Debugf("[getGnsGnsGnsAccessControlPolicyResolver]Output object %v", ret)
 
@@ -1013,12 +1212,12 @@ func getGnsGnsGnsAccessControlPolicyResolver(obj *model.GnsGns, id *string) (*mo
 // CHILD RESOLVER (Singleton)
 // FieldName: FooChild Node: Gns PKG: Gns
 // ////////////////////////////////////
-func getGnsGnsFooChildResolver(obj *model.GnsGns) (*model.GnsBarChild, error) {
+func getGnsGnsFooChildResolver(ctx context.Context, obj *model.GnsGns) (*model.GnsBarChild, error) {
 	log.Debugf("[getGnsGnsFooChildResolver]Parent Object %+v", obj)
-	vBarChild, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetFooChild(context.TODO())
+	vBarChild, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetFooChild(ctx)
 	if err != nil {
 		log.Errorf("[getGnsGnsFooChildResolver]Error getting Gns node %s", err)
-		return &model.GnsBarChild{}, nil
+		return nil, err
 	}
 	dn := vBarChild.DisplayName()
 	parentLabels := map[string]interface{}{"barchilds.gns.tsm.tanzu.vmware.com": dn}
@@ -1032,6 +1231,7 @@ func getGnsGnsFooChildResolver(obj *model.GnsGns) (*model.GnsBarChild, error) {
 		ParentLabels: parentLabels,
 		Name:         &vName,
 	}
+	prime(ctx, loaderCacheKey("GnsBarChild", ret.ParentLabels), vBarChild)
 
 	log.Debugf("[getGnsGnsFooChildResolver]Output object %+v", ret)
 	return ret, nil
@@ -1041,15 +1241,15 @@ func getGnsGnsFooChildResolver(obj *model.GnsGns) (*model.GnsBarChild, error) {
 // CHILDREN RESOLVER
 // FieldName: PolicyConfigs Node: AccessControlPolicy PKG: Policypkg
 // ////////////////////////////////////
-func getPolicypkgAccessControlPolicyPolicyConfigsResolver(obj *model.PolicypkgAccessControlPolicy, id *string) ([]*model.PolicypkgACPConfig, error) {
+func getPolicypkgAccessControlPolicyPolicyConfigsResolver(ctx context.Context, obj *model.PolicypkgAccessControlPolicy, id *string) ([]*model.PolicypkgACPConfig, error) {
 	log.Debugf("[getPolicypkgAccessControlPolicyPolicyConfigsResolver]Parent Object %+v", obj)
 	var vPolicypkgACPConfigList []*model.PolicypkgACPConfig
 	if id != nil && *id != "" {
 		log.Debugf("[getPolicypkgAccessControlPolicyPolicyConfigsResolver]Id %q", *id)
-		vACPConfig, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GnsAccessControlPolicy(getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com")).GetPolicyConfigs(context.TODO(), *id)
+		vACPConfig, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GnsAccessControlPolicy(getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com")).GetPolicyConfigs(ctx, *id)
 		if err != nil {
 			log.Errorf("[getPolicypkgAccessControlPolicyPolicyConfigsResolver]Error getting PolicyConfigs node %q : %s", *id, err)
-			return vPolicypkgACPConfigList, nil
+			return nil, err
 		}
 		dn := vACPConfig.DisplayName()
 		parentLabels := map[string]interface{}{"acpconfigs.policypkg.tsm.tanzu.vmware.com": dn}
@@ -1075,6 +1275,7 @@ func getPolicypkgAccessControlPolicyPolicyConfigsResolver(obj *model.PolicypkgAc
 			ProjectId:    &vProjectId,
 			Conditions:   &ConditionsData,
 		}
+		prime(ctx, loaderCacheKey("PolicypkgACPConfig", ret.ParentLabels), vACPConfig)
 		vPolicypkgACPConfigList = append(vPolicypkgACPConfigList, ret)
 
 		log.Debugf("[getPolicypkgAccessControlPolicyPolicyConfigsResolver]Output PolicyConfigs objects %v", vPolicypkgACPConfigList)
@@ -1084,22 +1285,17 @@ func getPolicypkgAccessControlPolicyPolicyConfigsResolver(obj *model.PolicypkgAc
 
 	log.Debug("[getPolicypkgAccessControlPolicyPolicyConfigsResolver]Id is empty, process all PolicyConfigss")
 
-	vACPConfigParent, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetGnsAccessControlPolicy(context.TODO(), getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"))
+	vACPConfigParent, err := loadPolicypkgAccessControlPolicyObject(ctx, obj)
 	if err != nil {
 		log.Errorf("[getPolicypkgAccessControlPolicyPolicyConfigsResolver]Error getting parent node %s", err)
-		return vPolicypkgACPConfigList, nil
+		return nil, err
 	}
-	vACPConfigAllObj, err := vACPConfigParent.GetAllPolicyConfigs(context.TODO())
+	vACPConfigAllObj, err := vACPConfigParent.GetAllPolicyConfigs(ctx)
 	if err != nil {
 		log.Errorf("[getPolicypkgAccessControlPolicyPolicyConfigsResolver]Error getting PolicyConfigs objects %s", err)
-		return vPolicypkgACPConfigList, nil
+		return nil, err
 	}
-	for _, i := range vACPConfigAllObj {
-		vACPConfig, err := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GnsAccessControlPolicy(getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com")).GetPolicyConfigs(context.TODO(), i.DisplayName())
-		if err != nil {
-			log.Errorf("[getPolicypkgAccessControlPolicyPolicyConfigsResolver]Error getting PolicyConfigs node %q : %s", i.DisplayName(), err)
-			continue
-		}
+	for _, vACPConfig := range vACPConfigAllObj {
 		dn := vACPConfig.DisplayName()
 		parentLabels := map[string]interface{}{"acpconfigs.policypkg.tsm.tanzu.vmware.com": dn}
 		vDisplayName := string(vACPConfig.Spec.DisplayName)
@@ -1124,6 +1320,7 @@ func getPolicypkgAccessControlPolicyPolicyConfigsResolver(obj *model.PolicypkgAc
 			ProjectId:    &vProjectId,
 			Conditions:   &ConditionsData,
 		}
+		prime(ctx, loaderCacheKey("PolicypkgACPConfig", ret.ParentLabels), vACPConfig)
 		vPolicypkgACPConfigList = append(vPolicypkgACPConfigList, ret)
 	}
 
@@ -1131,3 +1328,4308 @@ func getPolicypkgAccessControlPolicyPolicyConfigsResolver(obj *model.PolicypkgAc
 
 	return vPolicypkgACPConfigList, nil
 }
+
+func getPolicypkgAccessControlPolicyPolicyConfigsConnectionResolver(ctx context.Context, obj *model.PolicypkgAccessControlPolicy, first *int, after *string, filter *model.PolicypkgACPConfigFilter, orderBy []*model.PolicypkgACPConfigOrderBy) (*model.PolicypkgACPConfigConnection, error) {
+	vACPConfigParent, err := loadPolicypkgAccessControlPolicyObject(ctx, obj)
+	if err != nil {
+		log.Errorf("[getPolicypkgAccessControlPolicyPolicyConfigsConnectionResolver]Error getting parent node %s", err)
+		return nil, err
+	}
+	vACPConfigAllObj, err := vACPConfigParent.GetAllPolicyConfigs(ctx)
+	if err != nil {
+		log.Errorf("[getPolicypkgAccessControlPolicyPolicyConfigsConnectionResolver]Error getting PolicyConfigs objects %s", err)
+		return nil, err
+	}
+	return getPolicypkgACPConfigConnection(vACPConfigAllObj, func(vACPConfig *nexus_client.PolicypkgACPConfig) *model.PolicypkgACPConfig {
+		dn := vACPConfig.DisplayName()
+		parentLabels := map[string]interface{}{"acpconfigs.policypkg.tsm.tanzu.vmware.com": dn}
+		vDisplayName := string(vACPConfig.Spec.DisplayName)
+		vGns := string(vACPConfig.Spec.Gns)
+		vDescription := string(vACPConfig.Spec.Description)
+		Tags, _ := json.Marshal(vACPConfig.Spec.Tags)
+		TagsData := string(Tags)
+		vProjectId := string(vACPConfig.Spec.ProjectId)
+		Conditions, _ := json.Marshal(vACPConfig.Spec.Conditions)
+		ConditionsData := string(Conditions)
+
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.PolicypkgACPConfig{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+			DisplayName:  &vDisplayName,
+			Gns:          &vGns,
+			Description:  &vDescription,
+			Tags:         &TagsData,
+			ProjectId:    &vProjectId,
+			Conditions:   &ConditionsData,
+		}
+		prime(ctx, loaderCacheKey("PolicypkgACPConfig", ret.ParentLabels), vACPConfig)
+		return ret
+	}, first, after, filter, orderBy)
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: Root PKG: Root
+// ////////////////////////////////////
+// getRootRootConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getRootRootConnection(list []*nexus_client.RootRoot, toModel func(*nexus_client.RootRoot) *model.RootRoot, first *int, after *string, filter *model.RootRootFilter, orderBy []*model.RootRootOrderBy) (*model.RootRootConnection, error) {
+	type item struct {
+		obj *nexus_client.RootRoot
+		key *model.RootRoot
+	}
+	var items []item
+	for _, v := range list {
+		key := getRootRootConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareRootRootConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.RootRoot{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareRootRootConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.RootRootConnection{
+		Edges:      make([]*model.RootRootEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.RootRootEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getRootRootConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getRootRootConnectionKey(v *nexus_client.RootRoot) *model.RootRoot {
+	dn := v.DisplayName()
+	key := &model.RootRoot{Id: &dn}
+	return key
+}
+
+// compareRootRootConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareRootRootConnectionKeys(a, b *model.RootRoot, orderBy []*model.RootRootOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: Root PKG: Root
+// ////////////////////////////////////
+func getRootRootObject(ctx context.Context, obj *model.RootRoot, id string) (*nexus_client.RootRoot, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.GetRootRoot(ctx)
+}
+
+// loadRootRootObject returns the node of obj from the loader of the request
+func loadRootRootObject(ctx context.Context, obj *model.RootRoot) (*nexus_client.RootRoot, error) {
+	v, err := load(ctx, loaderCacheKey("RootRoot", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.GetRootRoot(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.RootRoot), nil
+}
+
+func addRootRootObject(ctx context.Context, obj *model.RootRoot, objToCreate *baseroottsmtanzuvmwarecomv1.Root) (*nexus_client.RootRoot, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.AddRootRoot(ctx, objToCreate)
+}
+
+func getCreateRootRootResolver(ctx context.Context, ParentLabels map[string]interface{}) (*model.RootRoot, error) {
+	objToCreate := &baseroottsmtanzuvmwarecomv1.Root{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+	}
+	vRoot, err := addRootRootObject(ctx, &model.RootRoot{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateRootRootResolver]Error creating Root node %s", err)
+		return nil, err
+	}
+	dn := vRoot.DisplayName()
+	parentLabels := map[string]interface{}{"roots.root.tsm.tanzu.vmware.com": dn}
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.RootRoot{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+	}
+	prime(ctx, loaderCacheKey("RootRoot", ret.ParentLabels), vRoot)
+	log.Debugf("[getCreateRootRootResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteRootRootResolver(ctx context.Context, ParentLabels map[string]interface{}) (bool, error) {
+	vRoot, err := getRootRootObject(ctx, &model.RootRoot{ParentLabels: ParentLabels}, "")
+	if err != nil {
+		log.Errorf("[getDeleteRootRootResolver]Error getting Root node %s", err)
+		return false, err
+	}
+	if err := vRoot.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteRootRootResolver]Error deleting Root node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: Root PKG: Root
+// ////////////////////////////////////
+func getWatchRootRootResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.RootRootEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.RootRoot{ParentLabels: ParentLabels}
+	events := make(chan *model.RootRootEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vRoot *nexus_client.RootRoot) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchRootRootResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vRoot.DisplayName()
+		parentLabels := map[string]interface{}{"roots.root.tsm.tanzu.vmware.com": dn}
+
+		if !matchParentLabels(obj.ParentLabels, vRoot.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.RootRoot{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+		}
+		select {
+		case events <- &model.RootRootEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchRootRootResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot()
+	addId, err := chainer.RegisterAddCallback(func(vRoot *nexus_client.RootRoot) {
+		send(model.NexusEventTypeAdded, vRoot)
+	})
+	if err != nil {
+		log.Errorf("[getWatchRootRootResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vRoot *nexus_client.RootRoot) {
+		send(model.NexusEventTypeUpdated, vRoot)
+	})
+	if err != nil {
+		log.Errorf("[getWatchRootRootResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vRoot *nexus_client.RootRoot) {
+		send(model.NexusEventTypeDeleted, vRoot)
+	})
+	if err != nil {
+		log.Errorf("[getWatchRootRootResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchRootRootResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchRootRootResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: Config PKG: Config
+// ////////////////////////////////////
+// getConfigConfigConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getConfigConfigConnection(list []*nexus_client.ConfigConfig, toModel func(*nexus_client.ConfigConfig) *model.ConfigConfig, first *int, after *string, filter *model.ConfigConfigFilter, orderBy []*model.ConfigConfigOrderBy) (*model.ConfigConfigConnection, error) {
+	type item struct {
+		obj *nexus_client.ConfigConfig
+		key *model.ConfigConfig
+	}
+	var items []item
+	for _, v := range list {
+		key := getConfigConfigConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.Instance, filter.Instance) {
+				continue
+			}
+			if !common.MatchFilter(key.CuOption, filter.CuOption) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareConfigConfigConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.ConfigConfig{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareConfigConfigConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.ConfigConfigConnection{
+		Edges:      make([]*model.ConfigConfigEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.ConfigConfigEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getConfigConfigConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getConfigConfigConnectionKey(v *nexus_client.ConfigConfig) *model.ConfigConfig {
+	dn := v.DisplayName()
+	key := &model.ConfigConfig{Id: &dn}
+	vInstance := float64(v.Spec.Instance)
+	key.Instance = &vInstance
+	vCuOption := string(v.Spec.CuOption)
+	key.CuOption = &vCuOption
+	return key
+}
+
+// compareConfigConfigConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareConfigConfigConnectionKeys(a, b *model.ConfigConfig, orderBy []*model.ConfigConfigOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "Instance":
+			c = common.CompareFields(a.Instance, b.Instance)
+		case "CuOption":
+			c = common.CompareFields(a.CuOption, b.CuOption)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: Config PKG: Config
+// ////////////////////////////////////
+func getConfigConfigObject(ctx context.Context, obj *model.ConfigConfig, id string) (*nexus_client.ConfigConfig, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().GetConfig(ctx, id)
+}
+
+// loadConfigConfigObject returns the node of obj from the loader of the request
+func loadConfigConfigObject(ctx context.Context, obj *model.ConfigConfig) (*nexus_client.ConfigConfig, error) {
+	v, err := load(ctx, loaderCacheKey("ConfigConfig", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().GetConfig(ctx, getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.ConfigConfig), nil
+}
+
+func addConfigConfigObject(ctx context.Context, obj *model.ConfigConfig, objToCreate *baseconfigtsmtanzuvmwarecomv1.Config) (*nexus_client.ConfigConfig, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().AddConfig(ctx, objToCreate)
+}
+
+func setConfigConfigInput(spec *baseconfigtsmtanzuvmwarecomv1.ConfigSpec, input *model.ConfigConfigInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.MyStr0 != nil {
+		if err := json.Unmarshal([]byte(*input.MyStr0), &spec.MyStr0); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of MyStr0: %s", err)}
+		}
+	}
+	if input.MyStr1 != nil {
+		if err := json.Unmarshal([]byte(*input.MyStr1), &spec.MyStr1); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of MyStr1: %s", err)}
+		}
+	}
+	if input.MyStr2 != nil {
+		if err := json.Unmarshal([]byte(*input.MyStr2), &spec.MyStr2); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of MyStr2: %s", err)}
+		}
+	}
+	if input.XYZPort != nil {
+		if err := json.Unmarshal([]byte(*input.XYZPort), &spec.XYZPort); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of XYZPort: %s", err)}
+		}
+	}
+	if input.ABCHost != nil {
+		if err := json.Unmarshal([]byte(*input.ABCHost), &spec.ABCHost); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of ABCHost: %s", err)}
+		}
+	}
+	if input.ClusterNamespaces != nil {
+		if err := json.Unmarshal([]byte(*input.ClusterNamespaces), &spec.ClusterNamespaces); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of ClusterNamespaces: %s", err)}
+		}
+	}
+	if input.TestValMarkers != nil {
+		if err := json.Unmarshal([]byte(*input.TestValMarkers), &spec.TestValMarkers); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of TestValMarkers: %s", err)}
+		}
+	}
+	if input.Instance != nil {
+		spec.Instance = float32(*input.Instance)
+	}
+	if input.CuOption != nil {
+		spec.CuOption = string(*input.CuOption)
+	}
+	return nil
+}
+
+func getCreateConfigConfigResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.ConfigConfigInput) (*model.ConfigConfig, error) {
+	objToCreate := &baseconfigtsmtanzuvmwarecomv1.Config{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	if err := setConfigConfigInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateConfigConfigResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vConfig, err := addConfigConfigObject(ctx, &model.ConfigConfig{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateConfigConfigResolver]Error creating Config node %s", err)
+		return nil, err
+	}
+	dn := vConfig.DisplayName()
+	parentLabels := map[string]interface{}{"configs.config.tsm.tanzu.vmware.com": dn}
+	MyStr0, _ := json.Marshal(vConfig.Spec.MyStr0)
+	MyStr0Data := string(MyStr0)
+	MyStr1, _ := json.Marshal(vConfig.Spec.MyStr1)
+	MyStr1Data := string(MyStr1)
+	MyStr2, _ := json.Marshal(vConfig.Spec.MyStr2)
+	MyStr2Data := string(MyStr2)
+	XYZPort, _ := json.Marshal(vConfig.Spec.XYZPort)
+	XYZPortData := string(XYZPort)
+	ABCHost, _ := json.Marshal(vConfig.Spec.ABCHost)
+	ABCHostData := string(ABCHost)
+	ClusterNamespaces, _ := json.Marshal(vConfig.Spec.ClusterNamespaces)
+	ClusterNamespacesData := string(ClusterNamespaces)
+	TestValMarkers, _ := json.Marshal(vConfig.Spec.TestValMarkers)
+	TestValMarkersData := string(TestValMarkers)
+	vInstance := float64(vConfig.Spec.Instance)
+	vCuOption := string(vConfig.Spec.CuOption)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigConfig{
+		Id:                &dn,
+		ParentLabels:      parentLabels,
+		MyStr0:            &MyStr0Data,
+		MyStr1:            &MyStr1Data,
+		MyStr2:            &MyStr2Data,
+		XYZPort:           &XYZPortData,
+		ABCHost:           &ABCHostData,
+		ClusterNamespaces: &ClusterNamespacesData,
+		TestValMarkers:    &TestValMarkersData,
+		Instance:          &vInstance,
+		CuOption:          &vCuOption,
+	}
+	prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
+	log.Debugf("[getCreateConfigConfigResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateConfigConfigResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input model.ConfigConfigInput) (*model.ConfigConfig, error) {
+	vConfig, err := getConfigConfigObject(ctx, &model.ConfigConfig{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUpdateConfigConfigResolver]Error getting Config node %s", err)
+		return nil, err
+	}
+	if err := setConfigConfigInput(&vConfig.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateConfigConfigResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vConfig.Update(ctx); err != nil {
+		log.Errorf("[getUpdateConfigConfigResolver]Error updating Config node %s", err)
+		return nil, err
+	}
+	dn := vConfig.DisplayName()
+	parentLabels := map[string]interface{}{"configs.config.tsm.tanzu.vmware.com": dn}
+	MyStr0, _ := json.Marshal(vConfig.Spec.MyStr0)
+	MyStr0Data := string(MyStr0)
+	MyStr1, _ := json.Marshal(vConfig.Spec.MyStr1)
+	MyStr1Data := string(MyStr1)
+	MyStr2, _ := json.Marshal(vConfig.Spec.MyStr2)
+	MyStr2Data := string(MyStr2)
+	XYZPort, _ := json.Marshal(vConfig.Spec.XYZPort)
+	XYZPortData := string(XYZPort)
+	ABCHost, _ := json.Marshal(vConfig.Spec.ABCHost)
+	ABCHostData := string(ABCHost)
+	ClusterNamespaces, _ := json.Marshal(vConfig.Spec.ClusterNamespaces)
+	ClusterNamespacesData := string(ClusterNamespaces)
+	TestValMarkers, _ := json.Marshal(vConfig.Spec.TestValMarkers)
+	TestValMarkersData := string(TestValMarkers)
+	vInstance := float64(vConfig.Spec.Instance)
+	vCuOption := string(vConfig.Spec.CuOption)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigConfig{
+		Id:                &dn,
+		ParentLabels:      parentLabels,
+		MyStr0:            &MyStr0Data,
+		MyStr1:            &MyStr1Data,
+		MyStr2:            &MyStr2Data,
+		XYZPort:           &XYZPortData,
+		ABCHost:           &ABCHostData,
+		ClusterNamespaces: &ClusterNamespacesData,
+		TestValMarkers:    &TestValMarkersData,
+		Instance:          &vInstance,
+		CuOption:          &vCuOption,
+	}
+	prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
+	log.Debugf("[getUpdateConfigConfigResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteConfigConfigResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vConfig, err := getConfigConfigObject(ctx, &model.ConfigConfig{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeleteConfigConfigResolver]Error getting Config node %s", err)
+		return false, err
+	}
+	if err := vConfig.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteConfigConfigResolver]Error deleting Config node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+func getLinkConfigConfigACPPoliciesResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, LinkParentLabels map[string]interface{}, LinkId string) (*model.ConfigConfig, error) {
+	vConfig, err := getConfigConfigObject(ctx, &model.ConfigConfig{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getLinkConfigConfigACPPoliciesResolver]Error getting Config node %s", err)
+		return nil, err
+	}
+	linkObj, err := getPolicypkgAccessControlPolicyObject(ctx, &model.PolicypkgAccessControlPolicy{ParentLabels: LinkParentLabels}, LinkId)
+	if err != nil {
+		log.Errorf("[getLinkConfigConfigACPPoliciesResolver]Error getting ACPPolicies node %s", err)
+		return nil, err
+	}
+	if err := vConfig.LinkACPPolicies(ctx, linkObj); err != nil {
+		log.Errorf("[getLinkConfigConfigACPPoliciesResolver]Error linking ACPPolicies %s", err)
+		return nil, err
+	}
+	dn := vConfig.DisplayName()
+	parentLabels := map[string]interface{}{"configs.config.tsm.tanzu.vmware.com": dn}
+	MyStr0, _ := json.Marshal(vConfig.Spec.MyStr0)
+	MyStr0Data := string(MyStr0)
+	MyStr1, _ := json.Marshal(vConfig.Spec.MyStr1)
+	MyStr1Data := string(MyStr1)
+	MyStr2, _ := json.Marshal(vConfig.Spec.MyStr2)
+	MyStr2Data := string(MyStr2)
+	XYZPort, _ := json.Marshal(vConfig.Spec.XYZPort)
+	XYZPortData := string(XYZPort)
+	ABCHost, _ := json.Marshal(vConfig.Spec.ABCHost)
+	ABCHostData := string(ABCHost)
+	ClusterNamespaces, _ := json.Marshal(vConfig.Spec.ClusterNamespaces)
+	ClusterNamespacesData := string(ClusterNamespaces)
+	TestValMarkers, _ := json.Marshal(vConfig.Spec.TestValMarkers)
+	TestValMarkersData := string(TestValMarkers)
+	vInstance := float64(vConfig.Spec.Instance)
+	vCuOption := string(vConfig.Spec.CuOption)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigConfig{
+		Id:                &dn,
+		ParentLabels:      parentLabels,
+		MyStr0:            &MyStr0Data,
+		MyStr1:            &MyStr1Data,
+		MyStr2:            &MyStr2Data,
+		XYZPort:           &XYZPortData,
+		ABCHost:           &ABCHostData,
+		ClusterNamespaces: &ClusterNamespacesData,
+		TestValMarkers:    &TestValMarkersData,
+		Instance:          &vInstance,
+		CuOption:          &vCuOption,
+	}
+	prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
+	return ret, nil
+}
+
+func getUnlinkConfigConfigACPPoliciesResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, LinkParentLabels map[string]interface{}, LinkId string) (*model.ConfigConfig, error) {
+	vConfig, err := getConfigConfigObject(ctx, &model.ConfigConfig{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUnlinkConfigConfigACPPoliciesResolver]Error getting Config node %s", err)
+		return nil, err
+	}
+	linkObj, err := getPolicypkgAccessControlPolicyObject(ctx, &model.PolicypkgAccessControlPolicy{ParentLabels: LinkParentLabels}, LinkId)
+	if err != nil {
+		log.Errorf("[getUnlinkConfigConfigACPPoliciesResolver]Error getting ACPPolicies node %s", err)
+		return nil, err
+	}
+	if err := vConfig.UnlinkACPPolicies(ctx, linkObj); err != nil {
+		log.Errorf("[getUnlinkConfigConfigACPPoliciesResolver]Error unlinking ACPPolicies %s", err)
+		return nil, err
+	}
+	dn := vConfig.DisplayName()
+	parentLabels := map[string]interface{}{"configs.config.tsm.tanzu.vmware.com": dn}
+	MyStr0, _ := json.Marshal(vConfig.Spec.MyStr0)
+	MyStr0Data := string(MyStr0)
+	MyStr1, _ := json.Marshal(vConfig.Spec.MyStr1)
+	MyStr1Data := string(MyStr1)
+	MyStr2, _ := json.Marshal(vConfig.Spec.MyStr2)
+	MyStr2Data := string(MyStr2)
+	XYZPort, _ := json.Marshal(vConfig.Spec.XYZPort)
+	XYZPortData := string(XYZPort)
+	ABCHost, _ := json.Marshal(vConfig.Spec.ABCHost)
+	ABCHostData := string(ABCHost)
+	ClusterNamespaces, _ := json.Marshal(vConfig.Spec.ClusterNamespaces)
+	ClusterNamespacesData := string(ClusterNamespaces)
+	TestValMarkers, _ := json.Marshal(vConfig.Spec.TestValMarkers)
+	TestValMarkersData := string(TestValMarkers)
+	vInstance := float64(vConfig.Spec.Instance)
+	vCuOption := string(vConfig.Spec.CuOption)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigConfig{
+		Id:                &dn,
+		ParentLabels:      parentLabels,
+		MyStr0:            &MyStr0Data,
+		MyStr1:            &MyStr1Data,
+		MyStr2:            &MyStr2Data,
+		XYZPort:           &XYZPortData,
+		ABCHost:           &ABCHostData,
+		ClusterNamespaces: &ClusterNamespacesData,
+		TestValMarkers:    &TestValMarkersData,
+		Instance:          &vInstance,
+		CuOption:          &vCuOption,
+	}
+	prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
+	return ret, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: Config PKG: Config
+// ////////////////////////////////////
+func getWatchConfigConfigResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.ConfigConfigEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.ConfigConfig{ParentLabels: ParentLabels}
+	events := make(chan *model.ConfigConfigEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vConfig *nexus_client.ConfigConfig) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchConfigConfigResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vConfig.DisplayName()
+		parentLabels := map[string]interface{}{"configs.config.tsm.tanzu.vmware.com": dn}
+		MyStr0, _ := json.Marshal(vConfig.Spec.MyStr0)
+		MyStr0Data := string(MyStr0)
+		MyStr1, _ := json.Marshal(vConfig.Spec.MyStr1)
+		MyStr1Data := string(MyStr1)
+		MyStr2, _ := json.Marshal(vConfig.Spec.MyStr2)
+		MyStr2Data := string(MyStr2)
+		XYZPort, _ := json.Marshal(vConfig.Spec.XYZPort)
+		XYZPortData := string(XYZPort)
+		ABCHost, _ := json.Marshal(vConfig.Spec.ABCHost)
+		ABCHostData := string(ABCHost)
+		ClusterNamespaces, _ := json.Marshal(vConfig.Spec.ClusterNamespaces)
+		ClusterNamespacesData := string(ClusterNamespaces)
+		TestValMarkers, _ := json.Marshal(vConfig.Spec.TestValMarkers)
+		TestValMarkersData := string(TestValMarkers)
+		vInstance := float64(vConfig.Spec.Instance)
+		vCuOption := string(vConfig.Spec.CuOption)
+
+		if !matchParentLabels(obj.ParentLabels, vConfig.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.ConfigConfig{
+			Id:                &dn,
+			ParentLabels:      parentLabels,
+			MyStr0:            &MyStr0Data,
+			MyStr1:            &MyStr1Data,
+			MyStr2:            &MyStr2Data,
+			XYZPort:           &XYZPortData,
+			ABCHost:           &ABCHostData,
+			ClusterNamespaces: &ClusterNamespacesData,
+			TestValMarkers:    &TestValMarkersData,
+			Instance:          &vInstance,
+			CuOption:          &vCuOption,
+		}
+		select {
+		case events <- &model.ConfigConfigEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchConfigConfigResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config("")
+	addId, err := chainer.RegisterAddCallback(func(vConfig *nexus_client.ConfigConfig) {
+		send(model.NexusEventTypeAdded, vConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigConfigResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vConfig *nexus_client.ConfigConfig) {
+		send(model.NexusEventTypeUpdated, vConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigConfigResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vConfig *nexus_client.ConfigConfig) {
+		send(model.NexusEventTypeDeleted, vConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigConfigResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchConfigConfigResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchConfigConfigResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: FooTypeABC PKG: Config
+// ////////////////////////////////////
+// getConfigFooTypeABCConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getConfigFooTypeABCConnection(list []*nexus_client.ConfigFooTypeABC, toModel func(*nexus_client.ConfigFooTypeABC) *model.ConfigFooTypeABC, first *int, after *string, filter *model.ConfigFooTypeABCFilter, orderBy []*model.ConfigFooTypeABCOrderBy) (*model.ConfigFooTypeABCConnection, error) {
+	type item struct {
+		obj *nexus_client.ConfigFooTypeABC
+		key *model.ConfigFooTypeABC
+	}
+	var items []item
+	for _, v := range list {
+		key := getConfigFooTypeABCConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareConfigFooTypeABCConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.ConfigFooTypeABC{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareConfigFooTypeABCConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.ConfigFooTypeABCConnection{
+		Edges:      make([]*model.ConfigFooTypeABCEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.ConfigFooTypeABCEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getConfigFooTypeABCConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getConfigFooTypeABCConnectionKey(v *nexus_client.ConfigFooTypeABC) *model.ConfigFooTypeABC {
+	dn := v.DisplayName()
+	key := &model.ConfigFooTypeABC{Id: &dn}
+	return key
+}
+
+// compareConfigFooTypeABCConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareConfigFooTypeABCConnectionKeys(a, b *model.ConfigFooTypeABC, orderBy []*model.ConfigFooTypeABCOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: FooTypeABC PKG: Config
+// ////////////////////////////////////
+func getConfigFooTypeABCObject(ctx context.Context, obj *model.ConfigFooTypeABC, id string) (*nexus_client.ConfigFooTypeABC, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetFooExample(ctx, id)
+}
+
+// loadConfigFooTypeABCObject returns the node of obj from the loader of the request
+func loadConfigFooTypeABCObject(ctx context.Context, obj *model.ConfigFooTypeABC) (*nexus_client.ConfigFooTypeABC, error) {
+	v, err := load(ctx, loaderCacheKey("ConfigFooTypeABC", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetFooExample(ctx, getParentName(obj.ParentLabels, "footypeabcs.config.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.ConfigFooTypeABC), nil
+}
+
+func addConfigFooTypeABCObject(ctx context.Context, obj *model.ConfigFooTypeABC, objToCreate *baseconfigtsmtanzuvmwarecomv1.FooTypeABC) (*nexus_client.ConfigFooTypeABC, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).AddFooExample(ctx, objToCreate)
+}
+
+func setConfigFooTypeABCInput(spec *baseconfigtsmtanzuvmwarecomv1.FooTypeABCSpec, input *model.ConfigFooTypeABCInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.FooA != nil {
+		if err := json.Unmarshal([]byte(*input.FooA), &spec.FooA); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of FooA: %s", err)}
+		}
+	}
+	if input.FooB != nil {
+		if err := json.Unmarshal([]byte(*input.FooB), &spec.FooB); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of FooB: %s", err)}
+		}
+	}
+	if input.FooD != nil {
+		if err := json.Unmarshal([]byte(*input.FooD), &spec.FooD); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of FooD: %s", err)}
+		}
+	}
+	if input.FooF != nil {
+		if err := json.Unmarshal([]byte(*input.FooF), &spec.FooF); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of FooF: %s", err)}
+		}
+	}
+	return nil
+}
+
+func getCreateConfigFooTypeABCResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.ConfigFooTypeABCInput) (*model.ConfigFooTypeABC, error) {
+	objToCreate := &baseconfigtsmtanzuvmwarecomv1.FooTypeABC{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	if err := setConfigFooTypeABCInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateConfigFooTypeABCResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vFooTypeABC, err := addConfigFooTypeABCObject(ctx, &model.ConfigFooTypeABC{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateConfigFooTypeABCResolver]Error creating FooTypeABC node %s", err)
+		return nil, err
+	}
+	dn := vFooTypeABC.DisplayName()
+	parentLabels := map[string]interface{}{"footypeabcs.config.tsm.tanzu.vmware.com": dn}
+	FooA, _ := json.Marshal(vFooTypeABC.Spec.FooA)
+	FooAData := string(FooA)
+	FooB, _ := json.Marshal(vFooTypeABC.Spec.FooB)
+	FooBData := string(FooB)
+	FooD, _ := json.Marshal(vFooTypeABC.Spec.FooD)
+	FooDData := string(FooD)
+	FooF, _ := json.Marshal(vFooTypeABC.Spec.FooF)
+	FooFData := string(FooF)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigFooTypeABC{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		FooA:         &FooAData,
+		FooB:         &FooBData,
+		FooD:         &FooDData,
+		FooF:         &FooFData,
+	}
+	prime(ctx, loaderCacheKey("ConfigFooTypeABC", ret.ParentLabels), vFooTypeABC)
+	log.Debugf("[getCreateConfigFooTypeABCResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateConfigFooTypeABCResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input model.ConfigFooTypeABCInput) (*model.ConfigFooTypeABC, error) {
+	vFooTypeABC, err := getConfigFooTypeABCObject(ctx, &model.ConfigFooTypeABC{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUpdateConfigFooTypeABCResolver]Error getting FooTypeABC node %s", err)
+		return nil, err
+	}
+	if err := setConfigFooTypeABCInput(&vFooTypeABC.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateConfigFooTypeABCResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vFooTypeABC.Update(ctx); err != nil {
+		log.Errorf("[getUpdateConfigFooTypeABCResolver]Error updating FooTypeABC node %s", err)
+		return nil, err
+	}
+	dn := vFooTypeABC.DisplayName()
+	parentLabels := map[string]interface{}{"footypeabcs.config.tsm.tanzu.vmware.com": dn}
+	FooA, _ := json.Marshal(vFooTypeABC.Spec.FooA)
+	FooAData := string(FooA)
+	FooB, _ := json.Marshal(vFooTypeABC.Spec.FooB)
+	FooBData := string(FooB)
+	FooD, _ := json.Marshal(vFooTypeABC.Spec.FooD)
+	FooDData := string(FooD)
+	FooF, _ := json.Marshal(vFooTypeABC.Spec.FooF)
+	FooFData := string(FooF)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigFooTypeABC{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		FooA:         &FooAData,
+		FooB:         &FooBData,
+		FooD:         &FooDData,
+		FooF:         &FooFData,
+	}
+	prime(ctx, loaderCacheKey("ConfigFooTypeABC", ret.ParentLabels), vFooTypeABC)
+	log.Debugf("[getUpdateConfigFooTypeABCResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteConfigFooTypeABCResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vFooTypeABC, err := getConfigFooTypeABCObject(ctx, &model.ConfigFooTypeABC{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeleteConfigFooTypeABCResolver]Error getting FooTypeABC node %s", err)
+		return false, err
+	}
+	if err := vFooTypeABC.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteConfigFooTypeABCResolver]Error deleting FooTypeABC node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: FooTypeABC PKG: Config
+// ////////////////////////////////////
+func getWatchConfigFooTypeABCResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.ConfigFooTypeABCEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.ConfigFooTypeABC{ParentLabels: ParentLabels}
+	events := make(chan *model.ConfigFooTypeABCEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vFooTypeABC *nexus_client.ConfigFooTypeABC) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchConfigFooTypeABCResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vFooTypeABC.DisplayName()
+		parentLabels := map[string]interface{}{"footypeabcs.config.tsm.tanzu.vmware.com": dn}
+		FooA, _ := json.Marshal(vFooTypeABC.Spec.FooA)
+		FooAData := string(FooA)
+		FooB, _ := json.Marshal(vFooTypeABC.Spec.FooB)
+		FooBData := string(FooB)
+		FooD, _ := json.Marshal(vFooTypeABC.Spec.FooD)
+		FooDData := string(FooD)
+		FooF, _ := json.Marshal(vFooTypeABC.Spec.FooF)
+		FooFData := string(FooF)
+
+		if !matchParentLabels(obj.ParentLabels, vFooTypeABC.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.ConfigFooTypeABC{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+			FooA:         &FooAData,
+			FooB:         &FooBData,
+			FooD:         &FooDData,
+			FooF:         &FooFData,
+		}
+		select {
+		case events <- &model.ConfigFooTypeABCEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchConfigFooTypeABCResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).FooExample("")
+	addId, err := chainer.RegisterAddCallback(func(vFooTypeABC *nexus_client.ConfigFooTypeABC) {
+		send(model.NexusEventTypeAdded, vFooTypeABC)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigFooTypeABCResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vFooTypeABC *nexus_client.ConfigFooTypeABC) {
+		send(model.NexusEventTypeUpdated, vFooTypeABC)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigFooTypeABCResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vFooTypeABC *nexus_client.ConfigFooTypeABC) {
+		send(model.NexusEventTypeDeleted, vFooTypeABC)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigFooTypeABCResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchConfigFooTypeABCResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchConfigFooTypeABCResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: Domain PKG: Config
+// ////////////////////////////////////
+// getConfigDomainConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getConfigDomainConnection(list []*nexus_client.ConfigDomain, toModel func(*nexus_client.ConfigDomain) *model.ConfigDomain, first *int, after *string, filter *model.ConfigDomainFilter, orderBy []*model.ConfigDomainOrderBy) (*model.ConfigDomainConnection, error) {
+	type item struct {
+		obj *nexus_client.ConfigDomain
+		key *model.ConfigDomain
+	}
+	var items []item
+	for _, v := range list {
+		key := getConfigDomainConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareConfigDomainConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.ConfigDomain{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareConfigDomainConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.ConfigDomainConnection{
+		Edges:      make([]*model.ConfigDomainEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.ConfigDomainEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getConfigDomainConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getConfigDomainConnectionKey(v *nexus_client.ConfigDomain) *model.ConfigDomain {
+	dn := v.DisplayName()
+	key := &model.ConfigDomain{Id: &dn}
+	return key
+}
+
+// compareConfigDomainConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareConfigDomainConnectionKeys(a, b *model.ConfigDomain, orderBy []*model.ConfigDomainOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: Domain PKG: Config
+// ////////////////////////////////////
+func getConfigDomainObject(ctx context.Context, obj *model.ConfigDomain, id string) (*nexus_client.ConfigDomain, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetDomain(ctx, id)
+}
+
+// loadConfigDomainObject returns the node of obj from the loader of the request
+func loadConfigDomainObject(ctx context.Context, obj *model.ConfigDomain) (*nexus_client.ConfigDomain, error) {
+	v, err := load(ctx, loaderCacheKey("ConfigDomain", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetDomain(ctx, getParentName(obj.ParentLabels, "domains.config.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.ConfigDomain), nil
+}
+
+func addConfigDomainObject(ctx context.Context, obj *model.ConfigDomain, objToCreate *baseconfigtsmtanzuvmwarecomv1.Domain) (*nexus_client.ConfigDomain, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).AddDomain(ctx, objToCreate)
+}
+
+func setConfigDomainInput(spec *baseconfigtsmtanzuvmwarecomv1.DomainSpec, input *model.ConfigDomainInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.PointPort != nil {
+		if err := json.Unmarshal([]byte(*input.PointPort), &spec.PointPort); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of PointPort: %s", err)}
+		}
+	}
+	if input.PointString != nil {
+		vPointString := string(*input.PointString)
+		spec.PointString = &vPointString
+	}
+	if input.PointInt != nil {
+		vPointInt := int(*input.PointInt)
+		spec.PointInt = &vPointInt
+	}
+	if input.PointMap != nil {
+		if err := json.Unmarshal([]byte(*input.PointMap), &spec.PointMap); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of PointMap: %s", err)}
+		}
+	}
+	if input.PointSlice != nil {
+		if err := json.Unmarshal([]byte(*input.PointSlice), &spec.PointSlice); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of PointSlice: %s", err)}
+		}
+	}
+	if input.SliceOfPoints != nil {
+		if err := json.Unmarshal([]byte(*input.SliceOfPoints), &spec.SliceOfPoints); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of SliceOfPoints: %s", err)}
+		}
+	}
+	if input.SliceOfArrPoints != nil {
+		if err := json.Unmarshal([]byte(*input.SliceOfArrPoints), &spec.SliceOfArrPoints); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of SliceOfArrPoints: %s", err)}
+		}
+	}
+	if input.MapOfArrsPoints != nil {
+		if err := json.Unmarshal([]byte(*input.MapOfArrsPoints), &spec.MapOfArrsPoints); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of MapOfArrsPoints: %s", err)}
+		}
+	}
+	if input.PointStruct != nil {
+		if err := json.Unmarshal([]byte(*input.PointStruct), &spec.PointStruct); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of PointStruct: %s", err)}
+		}
+	}
+	return nil
+}
+
+func getCreateConfigDomainResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.ConfigDomainInput) (*model.ConfigDomain, error) {
+	objToCreate := &baseconfigtsmtanzuvmwarecomv1.Domain{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	if err := setConfigDomainInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateConfigDomainResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vDomain, err := addConfigDomainObject(ctx, &model.ConfigDomain{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateConfigDomainResolver]Error creating Domain node %s", err)
+		return nil, err
+	}
+	dn := vDomain.DisplayName()
+	parentLabels := map[string]interface{}{"domains.config.tsm.tanzu.vmware.com": dn}
+	PointPort, _ := json.Marshal(vDomain.Spec.PointPort)
+	PointPortData := string(PointPort)
+	var vPointString *string
+	if vDomain.Spec.PointString != nil {
+		v := string(*vDomain.Spec.PointString)
+		vPointString = &v
+	}
+	var vPointInt *int
+	if vDomain.Spec.PointInt != nil {
+		v := int(*vDomain.Spec.PointInt)
+		vPointInt = &v
+	}
+	PointMap, _ := json.Marshal(vDomain.Spec.PointMap)
+	PointMapData := string(PointMap)
+	PointSlice, _ := json.Marshal(vDomain.Spec.PointSlice)
+	PointSliceData := string(PointSlice)
+	SliceOfPoints, _ := json.Marshal(vDomain.Spec.SliceOfPoints)
+	SliceOfPointsData := string(SliceOfPoints)
+	SliceOfArrPoints, _ := json.Marshal(vDomain.Spec.SliceOfArrPoints)
+	SliceOfArrPointsData := string(SliceOfArrPoints)
+	MapOfArrsPoints, _ := json.Marshal(vDomain.Spec.MapOfArrsPoints)
+	MapOfArrsPointsData := string(MapOfArrsPoints)
+	PointStruct, _ := json.Marshal(vDomain.Spec.PointStruct)
+	PointStructData := string(PointStruct)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigDomain{
+		Id:               &dn,
+		ParentLabels:     parentLabels,
+		PointPort:        &PointPortData,
+		PointString:      vPointString,
+		PointInt:         vPointInt,
+		PointMap:         &PointMapData,
+		PointSlice:       &PointSliceData,
+		SliceOfPoints:    &SliceOfPointsData,
+		SliceOfArrPoints: &SliceOfArrPointsData,
+		MapOfArrsPoints:  &MapOfArrsPointsData,
+		PointStruct:      &PointStructData,
+	}
+	prime(ctx, loaderCacheKey("ConfigDomain", ret.ParentLabels), vDomain)
+	log.Debugf("[getCreateConfigDomainResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateConfigDomainResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input model.ConfigDomainInput) (*model.ConfigDomain, error) {
+	vDomain, err := getConfigDomainObject(ctx, &model.ConfigDomain{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUpdateConfigDomainResolver]Error getting Domain node %s", err)
+		return nil, err
+	}
+	if err := setConfigDomainInput(&vDomain.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateConfigDomainResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vDomain.Update(ctx); err != nil {
+		log.Errorf("[getUpdateConfigDomainResolver]Error updating Domain node %s", err)
+		return nil, err
+	}
+	dn := vDomain.DisplayName()
+	parentLabels := map[string]interface{}{"domains.config.tsm.tanzu.vmware.com": dn}
+	PointPort, _ := json.Marshal(vDomain.Spec.PointPort)
+	PointPortData := string(PointPort)
+	var vPointString *string
+	if vDomain.Spec.PointString != nil {
+		v := string(*vDomain.Spec.PointString)
+		vPointString = &v
+	}
+	var vPointInt *int
+	if vDomain.Spec.PointInt != nil {
+		v := int(*vDomain.Spec.PointInt)
+		vPointInt = &v
+	}
+	PointMap, _ := json.Marshal(vDomain.Spec.PointMap)
+	PointMapData := string(PointMap)
+	PointSlice, _ := json.Marshal(vDomain.Spec.PointSlice)
+	PointSliceData := string(PointSlice)
+	SliceOfPoints, _ := json.Marshal(vDomain.Spec.SliceOfPoints)
+	SliceOfPointsData := string(SliceOfPoints)
+	SliceOfArrPoints, _ := json.Marshal(vDomain.Spec.SliceOfArrPoints)
+	SliceOfArrPointsData := string(SliceOfArrPoints)
+	MapOfArrsPoints, _ := json.Marshal(vDomain.Spec.MapOfArrsPoints)
+	MapOfArrsPointsData := string(MapOfArrsPoints)
+	PointStruct, _ := json.Marshal(vDomain.Spec.PointStruct)
+	PointStructData := string(PointStruct)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigDomain{
+		Id:               &dn,
+		ParentLabels:     parentLabels,
+		PointPort:        &PointPortData,
+		PointString:      vPointString,
+		PointInt:         vPointInt,
+		PointMap:         &PointMapData,
+		PointSlice:       &PointSliceData,
+		SliceOfPoints:    &SliceOfPointsData,
+		SliceOfArrPoints: &SliceOfArrPointsData,
+		MapOfArrsPoints:  &MapOfArrsPointsData,
+		PointStruct:      &PointStructData,
+	}
+	prime(ctx, loaderCacheKey("ConfigDomain", ret.ParentLabels), vDomain)
+	log.Debugf("[getUpdateConfigDomainResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteConfigDomainResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vDomain, err := getConfigDomainObject(ctx, &model.ConfigDomain{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeleteConfigDomainResolver]Error getting Domain node %s", err)
+		return false, err
+	}
+	if err := vDomain.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteConfigDomainResolver]Error deleting Domain node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: Domain PKG: Config
+// ////////////////////////////////////
+func getWatchConfigDomainResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.ConfigDomainEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.ConfigDomain{ParentLabels: ParentLabels}
+	events := make(chan *model.ConfigDomainEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vDomain *nexus_client.ConfigDomain) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchConfigDomainResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vDomain.DisplayName()
+		parentLabels := map[string]interface{}{"domains.config.tsm.tanzu.vmware.com": dn}
+		PointPort, _ := json.Marshal(vDomain.Spec.PointPort)
+		PointPortData := string(PointPort)
+		var vPointString *string
+		if vDomain.Spec.PointString != nil {
+			v := string(*vDomain.Spec.PointString)
+			vPointString = &v
+		}
+		var vPointInt *int
+		if vDomain.Spec.PointInt != nil {
+			v := int(*vDomain.Spec.PointInt)
+			vPointInt = &v
+		}
+		PointMap, _ := json.Marshal(vDomain.Spec.PointMap)
+		PointMapData := string(PointMap)
+		PointSlice, _ := json.Marshal(vDomain.Spec.PointSlice)
+		PointSliceData := string(PointSlice)
+		SliceOfPoints, _ := json.Marshal(vDomain.Spec.SliceOfPoints)
+		SliceOfPointsData := string(SliceOfPoints)
+		SliceOfArrPoints, _ := json.Marshal(vDomain.Spec.SliceOfArrPoints)
+		SliceOfArrPointsData := string(SliceOfArrPoints)
+		MapOfArrsPoints, _ := json.Marshal(vDomain.Spec.MapOfArrsPoints)
+		MapOfArrsPointsData := string(MapOfArrsPoints)
+		PointStruct, _ := json.Marshal(vDomain.Spec.PointStruct)
+		PointStructData := string(PointStruct)
+
+		if !matchParentLabels(obj.ParentLabels, vDomain.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.ConfigDomain{
+			Id:               &dn,
+			ParentLabels:     parentLabels,
+			PointPort:        &PointPortData,
+			PointString:      vPointString,
+			PointInt:         vPointInt,
+			PointMap:         &PointMapData,
+			PointSlice:       &PointSliceData,
+			SliceOfPoints:    &SliceOfPointsData,
+			SliceOfArrPoints: &SliceOfArrPointsData,
+			MapOfArrsPoints:  &MapOfArrsPointsData,
+			PointStruct:      &PointStructData,
+		}
+		select {
+		case events <- &model.ConfigDomainEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchConfigDomainResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).Domain("")
+	addId, err := chainer.RegisterAddCallback(func(vDomain *nexus_client.ConfigDomain) {
+		send(model.NexusEventTypeAdded, vDomain)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigDomainResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vDomain *nexus_client.ConfigDomain) {
+		send(model.NexusEventTypeUpdated, vDomain)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigDomainResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vDomain *nexus_client.ConfigDomain) {
+		send(model.NexusEventTypeDeleted, vDomain)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigDomainResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchConfigDomainResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchConfigDomainResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: Gns PKG: Gns
+// ////////////////////////////////////
+// getGnsGnsConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getGnsGnsConnection(list []*nexus_client.GnsGns, toModel func(*nexus_client.GnsGns) *model.GnsGns, first *int, after *string, filter *model.GnsGnsFilter, orderBy []*model.GnsGnsOrderBy) (*model.GnsGnsConnection, error) {
+	type item struct {
+		obj *nexus_client.GnsGns
+		key *model.GnsGns
+	}
+	var items []item
+	for _, v := range list {
+		key := getGnsGnsConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.Domain, filter.Domain) {
+				continue
+			}
+			if !common.MatchFilter(key.UseSharedGateway, filter.UseSharedGateway) {
+				continue
+			}
+			if !common.MatchFilter(key.Meta, filter.Meta) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareGnsGnsConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.GnsGns{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareGnsGnsConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.GnsGnsConnection{
+		Edges:      make([]*model.GnsGnsEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.GnsGnsEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getGnsGnsConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getGnsGnsConnectionKey(v *nexus_client.GnsGns) *model.GnsGns {
+	dn := v.DisplayName()
+	key := &model.GnsGns{Id: &dn}
+	vDomain := string(v.Spec.Domain)
+	key.Domain = &vDomain
+	vUseSharedGateway := bool(v.Spec.UseSharedGateway)
+	key.UseSharedGateway = &vUseSharedGateway
+	vMeta := string(v.Spec.Meta)
+	key.Meta = &vMeta
+	return key
+}
+
+// compareGnsGnsConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareGnsGnsConnectionKeys(a, b *model.GnsGns, orderBy []*model.GnsGnsOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "Domain":
+			c = common.CompareFields(a.Domain, b.Domain)
+		case "UseSharedGateway":
+			c = common.CompareFields(a.UseSharedGateway, b.UseSharedGateway)
+		case "Meta":
+			c = common.CompareFields(a.Meta, b.Meta)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: Gns PKG: Gns
+// ////////////////////////////////////
+func getGnsGnsObject(ctx context.Context, obj *model.GnsGns, id string) (*nexus_client.GnsGns, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetGNS(ctx, id)
+}
+
+// loadGnsGnsObject returns the node of obj from the loader of the request
+func loadGnsGnsObject(ctx context.Context, obj *model.GnsGns) (*nexus_client.GnsGns, error) {
+	v, err := load(ctx, loaderCacheKey("GnsGns", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetGNS(ctx, getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.GnsGns), nil
+}
+
+func addGnsGnsObject(ctx context.Context, obj *model.GnsGns, objToCreate *basegnstsmtanzuvmwarecomv1.Gns) (*nexus_client.GnsGns, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).AddGNS(ctx, objToCreate)
+}
+
+func setGnsGnsInput(spec *basegnstsmtanzuvmwarecomv1.GnsSpec, input *model.GnsGnsInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.Domain != nil {
+		spec.Domain = string(*input.Domain)
+	}
+	if input.UseSharedGateway != nil {
+		spec.UseSharedGateway = bool(*input.UseSharedGateway)
+	}
+	if input.Annotations != nil {
+		if err := json.Unmarshal([]byte(*input.Annotations), &spec.Annotations); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of Annotations: %s", err)}
+		}
+	}
+	if input.TargetPort != nil {
+		if err := json.Unmarshal([]byte(*input.TargetPort), &spec.TargetPort); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of TargetPort: %s", err)}
+		}
+	}
+	if input.Description != nil {
+		if err := json.Unmarshal([]byte(*input.Description), &spec.Description); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of Description: %s", err)}
+		}
+	}
+	if input.Meta != nil {
+		spec.Meta = string(*input.Meta)
+	}
+	if input.IntOrString != nil {
+		if err := json.Unmarshal([]byte(*input.IntOrString), &spec.IntOrString); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of IntOrString: %s", err)}
+		}
+	}
+	if input.Port != nil {
+		vPort := int(*input.Port)
+		spec.Port = &vPort
+	}
+	if input.OtherDescription != nil {
+		if err := json.Unmarshal([]byte(*input.OtherDescription), &spec.OtherDescription); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of OtherDescription: %s", err)}
+		}
+	}
+	if input.MapPointer != nil {
+		if err := json.Unmarshal([]byte(*input.MapPointer), &spec.MapPointer); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of MapPointer: %s", err)}
+		}
+	}
+	if input.SlicePointer != nil {
+		if err := json.Unmarshal([]byte(*input.SlicePointer), &spec.SlicePointer); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of SlicePointer: %s", err)}
+		}
+	}
+	if input.WorkloadSpec != nil {
+		if err := json.Unmarshal([]byte(*input.WorkloadSpec), &spec.WorkloadSpec); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of WorkloadSpec: %s", err)}
+		}
+	}
+	if input.DifferentSpec != nil {
+		if err := json.Unmarshal([]byte(*input.DifferentSpec), &spec.DifferentSpec); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of DifferentSpec: %s", err)}
+		}
+	}
+	if input.ServiceSegmentRef != nil {
+		if err := json.Unmarshal([]byte(*input.ServiceSegmentRef), &spec.ServiceSegmentRef); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of ServiceSegmentRef: %s", err)}
+		}
+	}
+	if input.ServiceSegmentRefPointer != nil {
+		if err := json.Unmarshal([]byte(*input.ServiceSegmentRefPointer), &spec.ServiceSegmentRefPointer); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of ServiceSegmentRefPointer: %s", err)}
+		}
+	}
+	if input.ServiceSegmentRefs != nil {
+		if err := json.Unmarshal([]byte(*input.ServiceSegmentRefs), &spec.ServiceSegmentRefs); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of ServiceSegmentRefs: %s", err)}
+		}
+	}
+	if input.ServiceSegmentRefMap != nil {
+		if err := json.Unmarshal([]byte(*input.ServiceSegmentRefMap), &spec.ServiceSegmentRefMap); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of ServiceSegmentRefMap: %s", err)}
+		}
+	}
+	return nil
+}
+
+func getCreateGnsGnsResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.GnsGnsInput) (*model.GnsGns, error) {
+	objToCreate := &basegnstsmtanzuvmwarecomv1.Gns{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	if err := setGnsGnsInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateGnsGnsResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vGns, err := addGnsGnsObject(ctx, &model.GnsGns{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateGnsGnsResolver]Error creating Gns node %s", err)
+		return nil, err
+	}
+	dn := vGns.DisplayName()
+	parentLabels := map[string]interface{}{"gnses.gns.tsm.tanzu.vmware.com": dn}
+	vDomain := string(vGns.Spec.Domain)
+	vUseSharedGateway := bool(vGns.Spec.UseSharedGateway)
+	Annotations, _ := json.Marshal(vGns.Spec.Annotations)
+	AnnotationsData := string(Annotations)
+	TargetPort, _ := json.Marshal(vGns.Spec.TargetPort)
+	TargetPortData := string(TargetPort)
+	Description, _ := json.Marshal(vGns.Spec.Description)
+	DescriptionData := string(Description)
+	vMeta := string(vGns.Spec.Meta)
+	IntOrString, _ := json.Marshal(vGns.Spec.IntOrString)
+	IntOrStringData := string(IntOrString)
+	var vPort *int
+	if vGns.Spec.Port != nil {
+		v := int(*vGns.Spec.Port)
+		vPort = &v
+	}
+	OtherDescription, _ := json.Marshal(vGns.Spec.OtherDescription)
+	OtherDescriptionData := string(OtherDescription)
+	MapPointer, _ := json.Marshal(vGns.Spec.MapPointer)
+	MapPointerData := string(MapPointer)
+	SlicePointer, _ := json.Marshal(vGns.Spec.SlicePointer)
+	SlicePointerData := string(SlicePointer)
+	WorkloadSpec, _ := json.Marshal(vGns.Spec.WorkloadSpec)
+	WorkloadSpecData := string(WorkloadSpec)
+	DifferentSpec, _ := json.Marshal(vGns.Spec.DifferentSpec)
+	DifferentSpecData := string(DifferentSpec)
+	ServiceSegmentRef, _ := json.Marshal(vGns.Spec.ServiceSegmentRef)
+	ServiceSegmentRefData := string(ServiceSegmentRef)
+	ServiceSegmentRefPointer, _ := json.Marshal(vGns.Spec.ServiceSegmentRefPointer)
+	ServiceSegmentRefPointerData := string(ServiceSegmentRefPointer)
+	ServiceSegmentRefs, _ := json.Marshal(vGns.Spec.ServiceSegmentRefs)
+	ServiceSegmentRefsData := string(ServiceSegmentRefs)
+	ServiceSegmentRefMap, _ := json.Marshal(vGns.Spec.ServiceSegmentRefMap)
+	ServiceSegmentRefMapData := string(ServiceSegmentRefMap)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.GnsGns{
+		Id:                       &dn,
+		ParentLabels:             parentLabels,
+		Domain:                   &vDomain,
+		UseSharedGateway:         &vUseSharedGateway,
+		Annotations:              &AnnotationsData,
+		TargetPort:               &TargetPortData,
+		Description:              &DescriptionData,
+		Meta:                     &vMeta,
+		IntOrString:              &IntOrStringData,
+		Port:                     vPort,
+		OtherDescription:         &OtherDescriptionData,
+		MapPointer:               &MapPointerData,
+		SlicePointer:             &SlicePointerData,
+		WorkloadSpec:             &WorkloadSpecData,
+		DifferentSpec:            &DifferentSpecData,
+		ServiceSegmentRef:        &ServiceSegmentRefData,
+		ServiceSegmentRefPointer: &ServiceSegmentRefPointerData,
+		ServiceSegmentRefs:       &ServiceSegmentRefsData,
+		ServiceSegmentRefMap:     &ServiceSegmentRefMapData,
+	}
+	prime(ctx, loaderCacheKey("GnsGns", ret.ParentLabels), vGns)
+	log.Debugf("[getCreateGnsGnsResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateGnsGnsResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input model.GnsGnsInput) (*model.GnsGns, error) {
+	vGns, err := getGnsGnsObject(ctx, &model.GnsGns{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUpdateGnsGnsResolver]Error getting Gns node %s", err)
+		return nil, err
+	}
+	if err := setGnsGnsInput(&vGns.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateGnsGnsResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vGns.Update(ctx); err != nil {
+		log.Errorf("[getUpdateGnsGnsResolver]Error updating Gns node %s", err)
+		return nil, err
+	}
+	dn := vGns.DisplayName()
+	parentLabels := map[string]interface{}{"gnses.gns.tsm.tanzu.vmware.com": dn}
+	vDomain := string(vGns.Spec.Domain)
+	vUseSharedGateway := bool(vGns.Spec.UseSharedGateway)
+	Annotations, _ := json.Marshal(vGns.Spec.Annotations)
+	AnnotationsData := string(Annotations)
+	TargetPort, _ := json.Marshal(vGns.Spec.TargetPort)
+	TargetPortData := string(TargetPort)
+	Description, _ := json.Marshal(vGns.Spec.Description)
+	DescriptionData := string(Description)
+	vMeta := string(vGns.Spec.Meta)
+	IntOrString, _ := json.Marshal(vGns.Spec.IntOrString)
+	IntOrStringData := string(IntOrString)
+	var vPort *int
+	if vGns.Spec.Port != nil {
+		v := int(*vGns.Spec.Port)
+		vPort = &v
+	}
+	OtherDescription, _ := json.Marshal(vGns.Spec.OtherDescription)
+	OtherDescriptionData := string(OtherDescription)
+	MapPointer, _ := json.Marshal(vGns.Spec.MapPointer)
+	MapPointerData := string(MapPointer)
+	SlicePointer, _ := json.Marshal(vGns.Spec.SlicePointer)
+	SlicePointerData := string(SlicePointer)
+	WorkloadSpec, _ := json.Marshal(vGns.Spec.WorkloadSpec)
+	WorkloadSpecData := string(WorkloadSpec)
+	DifferentSpec, _ := json.Marshal(vGns.Spec.DifferentSpec)
+	DifferentSpecData := string(DifferentSpec)
+	ServiceSegmentRef, _ := json.Marshal(vGns.Spec.ServiceSegmentRef)
+	ServiceSegmentRefData := string(ServiceSegmentRef)
+	ServiceSegmentRefPointer, _ := json.Marshal(vGns.Spec.ServiceSegmentRefPointer)
+	ServiceSegmentRefPointerData := string(ServiceSegmentRefPointer)
+	ServiceSegmentRefs, _ := json.Marshal(vGns.Spec.ServiceSegmentRefs)
+	ServiceSegmentRefsData := string(ServiceSegmentRefs)
+	ServiceSegmentRefMap, _ := json.Marshal(vGns.Spec.ServiceSegmentRefMap)
+	ServiceSegmentRefMapData := string(ServiceSegmentRefMap)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.GnsGns{
+		Id:                       &dn,
+		ParentLabels:             parentLabels,
+		Domain:                   &vDomain,
+		UseSharedGateway:         &vUseSharedGateway,
+		Annotations:              &AnnotationsData,
+		TargetPort:               &TargetPortData,
+		Description:              &DescriptionData,
+		Meta:                     &vMeta,
+		IntOrString:              &IntOrStringData,
+		Port:                     vPort,
+		OtherDescription:         &OtherDescriptionData,
+		MapPointer:               &MapPointerData,
+		SlicePointer:             &SlicePointerData,
+		WorkloadSpec:             &WorkloadSpecData,
+		DifferentSpec:            &DifferentSpecData,
+		ServiceSegmentRef:        &ServiceSegmentRefData,
+		ServiceSegmentRefPointer: &ServiceSegmentRefPointerData,
+		ServiceSegmentRefs:       &ServiceSegmentRefsData,
+		ServiceSegmentRefMap:     &ServiceSegmentRefMapData,
+	}
+	prime(ctx, loaderCacheKey("GnsGns", ret.ParentLabels), vGns)
+	log.Debugf("[getUpdateGnsGnsResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteGnsGnsResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vGns, err := getGnsGnsObject(ctx, &model.GnsGns{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeleteGnsGnsResolver]Error getting Gns node %s", err)
+		return false, err
+	}
+	if err := vGns.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteGnsGnsResolver]Error deleting Gns node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+func getSetGnsGnsStateResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Status string) (*model.GnsGns, error) {
+	status := &basegnstsmtanzuvmwarecomv1.GnsState{}
+	if err := json.Unmarshal([]byte(Status), status); err != nil {
+		log.Errorf("[getSetGnsGnsStateResolver]Invalid status %s", err)
+		return nil, codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid status: %s", err)}
+	}
+	vGns, err := getGnsGnsObject(ctx, &model.GnsGns{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getSetGnsGnsStateResolver]Error getting Gns node %s", err)
+		return nil, err
+	}
+	if err := vGns.SetState(ctx, status); err != nil {
+		log.Errorf("[getSetGnsGnsStateResolver]Error setting State %s", err)
+		return nil, err
+	}
+	dn := vGns.DisplayName()
+	parentLabels := map[string]interface{}{"gnses.gns.tsm.tanzu.vmware.com": dn}
+	vDomain := string(vGns.Spec.Domain)
+	vUseSharedGateway := bool(vGns.Spec.UseSharedGateway)
+	Annotations, _ := json.Marshal(vGns.Spec.Annotations)
+	AnnotationsData := string(Annotations)
+	TargetPort, _ := json.Marshal(vGns.Spec.TargetPort)
+	TargetPortData := string(TargetPort)
+	Description, _ := json.Marshal(vGns.Spec.Description)
+	DescriptionData := string(Description)
+	vMeta := string(vGns.Spec.Meta)
+	IntOrString, _ := json.Marshal(vGns.Spec.IntOrString)
+	IntOrStringData := string(IntOrString)
+	var vPort *int
+	if vGns.Spec.Port != nil {
+		v := int(*vGns.Spec.Port)
+		vPort = &v
+	}
+	OtherDescription, _ := json.Marshal(vGns.Spec.OtherDescription)
+	OtherDescriptionData := string(OtherDescription)
+	MapPointer, _ := json.Marshal(vGns.Spec.MapPointer)
+	MapPointerData := string(MapPointer)
+	SlicePointer, _ := json.Marshal(vGns.Spec.SlicePointer)
+	SlicePointerData := string(SlicePointer)
+	WorkloadSpec, _ := json.Marshal(vGns.Spec.WorkloadSpec)
+	WorkloadSpecData := string(WorkloadSpec)
+	DifferentSpec, _ := json.Marshal(vGns.Spec.DifferentSpec)
+	DifferentSpecData := string(DifferentSpec)
+	ServiceSegmentRef, _ := json.Marshal(vGns.Spec.ServiceSegmentRef)
+	ServiceSegmentRefData := string(ServiceSegmentRef)
+	ServiceSegmentRefPointer, _ := json.Marshal(vGns.Spec.ServiceSegmentRefPointer)
+	ServiceSegmentRefPointerData := string(ServiceSegmentRefPointer)
+	ServiceSegmentRefs, _ := json.Marshal(vGns.Spec.ServiceSegmentRefs)
+	ServiceSegmentRefsData := string(ServiceSegmentRefs)
+	ServiceSegmentRefMap, _ := json.Marshal(vGns.Spec.ServiceSegmentRefMap)
+	ServiceSegmentRefMapData := string(ServiceSegmentRefMap)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.GnsGns{
+		Id:                       &dn,
+		ParentLabels:             parentLabels,
+		Domain:                   &vDomain,
+		UseSharedGateway:         &vUseSharedGateway,
+		Annotations:              &AnnotationsData,
+		TargetPort:               &TargetPortData,
+		Description:              &DescriptionData,
+		Meta:                     &vMeta,
+		IntOrString:              &IntOrStringData,
+		Port:                     vPort,
+		OtherDescription:         &OtherDescriptionData,
+		MapPointer:               &MapPointerData,
+		SlicePointer:             &SlicePointerData,
+		WorkloadSpec:             &WorkloadSpecData,
+		DifferentSpec:            &DifferentSpecData,
+		ServiceSegmentRef:        &ServiceSegmentRefData,
+		ServiceSegmentRefPointer: &ServiceSegmentRefPointerData,
+		ServiceSegmentRefs:       &ServiceSegmentRefsData,
+		ServiceSegmentRefMap:     &ServiceSegmentRefMapData,
+	}
+	prime(ctx, loaderCacheKey("GnsGns", ret.ParentLabels), vGns)
+	return ret, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: Gns PKG: Gns
+// ////////////////////////////////////
+func getWatchGnsGnsResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.GnsGnsEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.GnsGns{ParentLabels: ParentLabels}
+	events := make(chan *model.GnsGnsEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vGns *nexus_client.GnsGns) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchGnsGnsResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vGns.DisplayName()
+		parentLabels := map[string]interface{}{"gnses.gns.tsm.tanzu.vmware.com": dn}
+		vDomain := string(vGns.Spec.Domain)
+		vUseSharedGateway := bool(vGns.Spec.UseSharedGateway)
+		Annotations, _ := json.Marshal(vGns.Spec.Annotations)
+		AnnotationsData := string(Annotations)
+		TargetPort, _ := json.Marshal(vGns.Spec.TargetPort)
+		TargetPortData := string(TargetPort)
+		Description, _ := json.Marshal(vGns.Spec.Description)
+		DescriptionData := string(Description)
+		vMeta := string(vGns.Spec.Meta)
+		IntOrString, _ := json.Marshal(vGns.Spec.IntOrString)
+		IntOrStringData := string(IntOrString)
+		var vPort *int
+		if vGns.Spec.Port != nil {
+			v := int(*vGns.Spec.Port)
+			vPort = &v
+		}
+		OtherDescription, _ := json.Marshal(vGns.Spec.OtherDescription)
+		OtherDescriptionData := string(OtherDescription)
+		MapPointer, _ := json.Marshal(vGns.Spec.MapPointer)
+		MapPointerData := string(MapPointer)
+		SlicePointer, _ := json.Marshal(vGns.Spec.SlicePointer)
+		SlicePointerData := string(SlicePointer)
+		WorkloadSpec, _ := json.Marshal(vGns.Spec.WorkloadSpec)
+		WorkloadSpecData := string(WorkloadSpec)
+		DifferentSpec, _ := json.Marshal(vGns.Spec.DifferentSpec)
+		DifferentSpecData := string(DifferentSpec)
+		ServiceSegmentRef, _ := json.Marshal(vGns.Spec.ServiceSegmentRef)
+		ServiceSegmentRefData := string(ServiceSegmentRef)
+		ServiceSegmentRefPointer, _ := json.Marshal(vGns.Spec.ServiceSegmentRefPointer)
+		ServiceSegmentRefPointerData := string(ServiceSegmentRefPointer)
+		ServiceSegmentRefs, _ := json.Marshal(vGns.Spec.ServiceSegmentRefs)
+		ServiceSegmentRefsData := string(ServiceSegmentRefs)
+		ServiceSegmentRefMap, _ := json.Marshal(vGns.Spec.ServiceSegmentRefMap)
+		ServiceSegmentRefMapData := string(ServiceSegmentRefMap)
+
+		if !matchParentLabels(obj.ParentLabels, vGns.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.GnsGns{
+			Id:                       &dn,
+			ParentLabels:             parentLabels,
+			Domain:                   &vDomain,
+			UseSharedGateway:         &vUseSharedGateway,
+			Annotations:              &AnnotationsData,
+			TargetPort:               &TargetPortData,
+			Description:              &DescriptionData,
+			Meta:                     &vMeta,
+			IntOrString:              &IntOrStringData,
+			Port:                     vPort,
+			OtherDescription:         &OtherDescriptionData,
+			MapPointer:               &MapPointerData,
+			SlicePointer:             &SlicePointerData,
+			WorkloadSpec:             &WorkloadSpecData,
+			DifferentSpec:            &DifferentSpecData,
+			ServiceSegmentRef:        &ServiceSegmentRefData,
+			ServiceSegmentRefPointer: &ServiceSegmentRefPointerData,
+			ServiceSegmentRefs:       &ServiceSegmentRefsData,
+			ServiceSegmentRefMap:     &ServiceSegmentRefMapData,
+		}
+		select {
+		case events <- &model.GnsGnsEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchGnsGnsResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS("")
+	addId, err := chainer.RegisterAddCallback(func(vGns *nexus_client.GnsGns) {
+		send(model.NexusEventTypeAdded, vGns)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsGnsResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vGns *nexus_client.GnsGns) {
+		send(model.NexusEventTypeUpdated, vGns)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsGnsResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vGns *nexus_client.GnsGns) {
+		send(model.NexusEventTypeDeleted, vGns)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsGnsResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchGnsGnsResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchGnsGnsResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: BarChild PKG: Gns
+// ////////////////////////////////////
+// getGnsBarChildConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getGnsBarChildConnection(list []*nexus_client.GnsBarChild, toModel func(*nexus_client.GnsBarChild) *model.GnsBarChild, first *int, after *string, filter *model.GnsBarChildFilter, orderBy []*model.GnsBarChildOrderBy) (*model.GnsBarChildConnection, error) {
+	type item struct {
+		obj *nexus_client.GnsBarChild
+		key *model.GnsBarChild
+	}
+	var items []item
+	for _, v := range list {
+		key := getGnsBarChildConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.Name, filter.Name) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareGnsBarChildConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.GnsBarChild{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareGnsBarChildConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.GnsBarChildConnection{
+		Edges:      make([]*model.GnsBarChildEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.GnsBarChildEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getGnsBarChildConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getGnsBarChildConnectionKey(v *nexus_client.GnsBarChild) *model.GnsBarChild {
+	dn := v.DisplayName()
+	key := &model.GnsBarChild{Id: &dn}
+	vName := string(v.Spec.Name)
+	key.Name = &vName
+	return key
+}
+
+// compareGnsBarChildConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareGnsBarChildConnectionKeys(a, b *model.GnsBarChild, orderBy []*model.GnsBarChildOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "Name":
+			c = common.CompareFields(a.Name, b.Name)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: BarChild PKG: Gns
+// ////////////////////////////////////
+func getGnsBarChildObject(ctx context.Context, obj *model.GnsBarChild, id string) (*nexus_client.GnsBarChild, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetFooChild(ctx)
+}
+
+// loadGnsBarChildObject returns the node of obj from the loader of the request
+func loadGnsBarChildObject(ctx context.Context, obj *model.GnsBarChild) (*nexus_client.GnsBarChild, error) {
+	v, err := load(ctx, loaderCacheKey("GnsBarChild", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetFooChild(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.GnsBarChild), nil
+}
+
+func addGnsBarChildObject(ctx context.Context, obj *model.GnsBarChild, objToCreate *basegnstsmtanzuvmwarecomv1.BarChild) (*nexus_client.GnsBarChild, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).AddFooChild(ctx, objToCreate)
+}
+
+func setGnsBarChildInput(spec *basegnstsmtanzuvmwarecomv1.BarChildSpec, input *model.GnsBarChildInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.Name != nil {
+		spec.Name = string(*input.Name)
+	}
+	return nil
+}
+
+func getCreateGnsBarChildResolver(ctx context.Context, ParentLabels map[string]interface{}, Input *model.GnsBarChildInput) (*model.GnsBarChild, error) {
+	objToCreate := &basegnstsmtanzuvmwarecomv1.BarChild{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+	}
+	if err := setGnsBarChildInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateGnsBarChildResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vBarChild, err := addGnsBarChildObject(ctx, &model.GnsBarChild{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateGnsBarChildResolver]Error creating BarChild node %s", err)
+		return nil, err
+	}
+	dn := vBarChild.DisplayName()
+	parentLabels := map[string]interface{}{"barchilds.gns.tsm.tanzu.vmware.com": dn}
+	vName := string(vBarChild.Spec.Name)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.GnsBarChild{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		Name:         &vName,
+	}
+	prime(ctx, loaderCacheKey("GnsBarChild", ret.ParentLabels), vBarChild)
+	log.Debugf("[getCreateGnsBarChildResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateGnsBarChildResolver(ctx context.Context, ParentLabels map[string]interface{}, Input model.GnsBarChildInput) (*model.GnsBarChild, error) {
+	vBarChild, err := getGnsBarChildObject(ctx, &model.GnsBarChild{ParentLabels: ParentLabels}, "")
+	if err != nil {
+		log.Errorf("[getUpdateGnsBarChildResolver]Error getting BarChild node %s", err)
+		return nil, err
+	}
+	if err := setGnsBarChildInput(&vBarChild.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateGnsBarChildResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vBarChild.Update(ctx); err != nil {
+		log.Errorf("[getUpdateGnsBarChildResolver]Error updating BarChild node %s", err)
+		return nil, err
+	}
+	dn := vBarChild.DisplayName()
+	parentLabels := map[string]interface{}{"barchilds.gns.tsm.tanzu.vmware.com": dn}
+	vName := string(vBarChild.Spec.Name)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.GnsBarChild{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		Name:         &vName,
+	}
+	prime(ctx, loaderCacheKey("GnsBarChild", ret.ParentLabels), vBarChild)
+	log.Debugf("[getUpdateGnsBarChildResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteGnsBarChildResolver(ctx context.Context, ParentLabels map[string]interface{}) (bool, error) {
+	vBarChild, err := getGnsBarChildObject(ctx, &model.GnsBarChild{ParentLabels: ParentLabels}, "")
+	if err != nil {
+		log.Errorf("[getDeleteGnsBarChildResolver]Error getting BarChild node %s", err)
+		return false, err
+	}
+	if err := vBarChild.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteGnsBarChildResolver]Error deleting BarChild node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: BarChild PKG: Gns
+// ////////////////////////////////////
+func getWatchGnsBarChildResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.GnsBarChildEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.GnsBarChild{ParentLabels: ParentLabels}
+	events := make(chan *model.GnsBarChildEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vBarChild *nexus_client.GnsBarChild) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchGnsBarChildResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vBarChild.DisplayName()
+		parentLabels := map[string]interface{}{"barchilds.gns.tsm.tanzu.vmware.com": dn}
+		vName := string(vBarChild.Spec.Name)
+
+		if !matchParentLabels(obj.ParentLabels, vBarChild.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.GnsBarChild{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+			Name:         &vName,
+		}
+		select {
+		case events <- &model.GnsBarChildEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchGnsBarChildResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).FooChild()
+	addId, err := chainer.RegisterAddCallback(func(vBarChild *nexus_client.GnsBarChild) {
+		send(model.NexusEventTypeAdded, vBarChild)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsBarChildResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vBarChild *nexus_client.GnsBarChild) {
+		send(model.NexusEventTypeUpdated, vBarChild)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsBarChildResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vBarChild *nexus_client.GnsBarChild) {
+		send(model.NexusEventTypeDeleted, vBarChild)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsBarChildResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchGnsBarChildResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchGnsBarChildResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: IgnoreChild PKG: Gns
+// ////////////////////////////////////
+// getGnsIgnoreChildConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getGnsIgnoreChildConnection(list []*nexus_client.GnsIgnoreChild, toModel func(*nexus_client.GnsIgnoreChild) *model.GnsIgnoreChild, first *int, after *string, filter *model.GnsIgnoreChildFilter, orderBy []*model.GnsIgnoreChildOrderBy) (*model.GnsIgnoreChildConnection, error) {
+	type item struct {
+		obj *nexus_client.GnsIgnoreChild
+		key *model.GnsIgnoreChild
+	}
+	var items []item
+	for _, v := range list {
+		key := getGnsIgnoreChildConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.Name, filter.Name) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareGnsIgnoreChildConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.GnsIgnoreChild{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareGnsIgnoreChildConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.GnsIgnoreChildConnection{
+		Edges:      make([]*model.GnsIgnoreChildEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.GnsIgnoreChildEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getGnsIgnoreChildConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getGnsIgnoreChildConnectionKey(v *nexus_client.GnsIgnoreChild) *model.GnsIgnoreChild {
+	dn := v.DisplayName()
+	key := &model.GnsIgnoreChild{Id: &dn}
+	vName := string(v.Spec.Name)
+	key.Name = &vName
+	return key
+}
+
+// compareGnsIgnoreChildConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareGnsIgnoreChildConnectionKeys(a, b *model.GnsIgnoreChild, orderBy []*model.GnsIgnoreChildOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "Name":
+			c = common.CompareFields(a.Name, b.Name)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: IgnoreChild PKG: Gns
+// ////////////////////////////////////
+func getGnsIgnoreChildObject(ctx context.Context, obj *model.GnsIgnoreChild, id string) (*nexus_client.GnsIgnoreChild, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetIgnoreChild(ctx, id)
+}
+
+// loadGnsIgnoreChildObject returns the node of obj from the loader of the request
+func loadGnsIgnoreChildObject(ctx context.Context, obj *model.GnsIgnoreChild) (*nexus_client.GnsIgnoreChild, error) {
+	v, err := load(ctx, loaderCacheKey("GnsIgnoreChild", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetIgnoreChild(ctx, getParentName(obj.ParentLabels, "ignorechilds.gns.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.GnsIgnoreChild), nil
+}
+
+func addGnsIgnoreChildObject(ctx context.Context, obj *model.GnsIgnoreChild, objToCreate *basegnstsmtanzuvmwarecomv1.IgnoreChild) (*nexus_client.GnsIgnoreChild, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).AddIgnoreChild(ctx, objToCreate)
+}
+
+func setGnsIgnoreChildInput(spec *basegnstsmtanzuvmwarecomv1.IgnoreChildSpec, input *model.GnsIgnoreChildInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.Name != nil {
+		spec.Name = string(*input.Name)
+	}
+	return nil
+}
+
+func getCreateGnsIgnoreChildResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.GnsIgnoreChildInput) (*model.GnsIgnoreChild, error) {
+	objToCreate := &basegnstsmtanzuvmwarecomv1.IgnoreChild{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	if err := setGnsIgnoreChildInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateGnsIgnoreChildResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vIgnoreChild, err := addGnsIgnoreChildObject(ctx, &model.GnsIgnoreChild{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateGnsIgnoreChildResolver]Error creating IgnoreChild node %s", err)
+		return nil, err
+	}
+	dn := vIgnoreChild.DisplayName()
+	parentLabels := map[string]interface{}{"ignorechilds.gns.tsm.tanzu.vmware.com": dn}
+	vName := string(vIgnoreChild.Spec.Name)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.GnsIgnoreChild{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		Name:         &vName,
+	}
+	prime(ctx, loaderCacheKey("GnsIgnoreChild", ret.ParentLabels), vIgnoreChild)
+	log.Debugf("[getCreateGnsIgnoreChildResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateGnsIgnoreChildResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input model.GnsIgnoreChildInput) (*model.GnsIgnoreChild, error) {
+	vIgnoreChild, err := getGnsIgnoreChildObject(ctx, &model.GnsIgnoreChild{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUpdateGnsIgnoreChildResolver]Error getting IgnoreChild node %s", err)
+		return nil, err
+	}
+	if err := setGnsIgnoreChildInput(&vIgnoreChild.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateGnsIgnoreChildResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vIgnoreChild.Update(ctx); err != nil {
+		log.Errorf("[getUpdateGnsIgnoreChildResolver]Error updating IgnoreChild node %s", err)
+		return nil, err
+	}
+	dn := vIgnoreChild.DisplayName()
+	parentLabels := map[string]interface{}{"ignorechilds.gns.tsm.tanzu.vmware.com": dn}
+	vName := string(vIgnoreChild.Spec.Name)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.GnsIgnoreChild{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		Name:         &vName,
+	}
+	prime(ctx, loaderCacheKey("GnsIgnoreChild", ret.ParentLabels), vIgnoreChild)
+	log.Debugf("[getUpdateGnsIgnoreChildResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteGnsIgnoreChildResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vIgnoreChild, err := getGnsIgnoreChildObject(ctx, &model.GnsIgnoreChild{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeleteGnsIgnoreChildResolver]Error getting IgnoreChild node %s", err)
+		return false, err
+	}
+	if err := vIgnoreChild.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteGnsIgnoreChildResolver]Error deleting IgnoreChild node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: IgnoreChild PKG: Gns
+// ////////////////////////////////////
+func getWatchGnsIgnoreChildResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.GnsIgnoreChildEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.GnsIgnoreChild{ParentLabels: ParentLabels}
+	events := make(chan *model.GnsIgnoreChildEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vIgnoreChild *nexus_client.GnsIgnoreChild) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchGnsIgnoreChildResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vIgnoreChild.DisplayName()
+		parentLabels := map[string]interface{}{"ignorechilds.gns.tsm.tanzu.vmware.com": dn}
+		vName := string(vIgnoreChild.Spec.Name)
+
+		if !matchParentLabels(obj.ParentLabels, vIgnoreChild.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.GnsIgnoreChild{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+			Name:         &vName,
+		}
+		select {
+		case events <- &model.GnsIgnoreChildEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchGnsIgnoreChildResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).IgnoreChild("")
+	addId, err := chainer.RegisterAddCallback(func(vIgnoreChild *nexus_client.GnsIgnoreChild) {
+		send(model.NexusEventTypeAdded, vIgnoreChild)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsIgnoreChildResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vIgnoreChild *nexus_client.GnsIgnoreChild) {
+		send(model.NexusEventTypeUpdated, vIgnoreChild)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsIgnoreChildResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vIgnoreChild *nexus_client.GnsIgnoreChild) {
+		send(model.NexusEventTypeDeleted, vIgnoreChild)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsIgnoreChildResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchGnsIgnoreChildResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchGnsIgnoreChildResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: Dns PKG: Gns
+// ////////////////////////////////////
+// getGnsDnsConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getGnsDnsConnection(list []*nexus_client.GnsDns, toModel func(*nexus_client.GnsDns) *model.GnsDns, first *int, after *string, filter *model.GnsDnsFilter, orderBy []*model.GnsDnsOrderBy) (*model.GnsDnsConnection, error) {
+	type item struct {
+		obj *nexus_client.GnsDns
+		key *model.GnsDns
+	}
+	var items []item
+	for _, v := range list {
+		key := getGnsDnsConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareGnsDnsConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.GnsDns{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareGnsDnsConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.GnsDnsConnection{
+		Edges:      make([]*model.GnsDnsEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.GnsDnsEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getGnsDnsConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getGnsDnsConnectionKey(v *nexus_client.GnsDns) *model.GnsDns {
+	dn := v.DisplayName()
+	key := &model.GnsDns{Id: &dn}
+	return key
+}
+
+// compareGnsDnsConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareGnsDnsConnectionKeys(a, b *model.GnsDns, orderBy []*model.GnsDnsOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: Dns PKG: Gns
+// ////////////////////////////////////
+func getGnsDnsObject(ctx context.Context, obj *model.GnsDns, id string) (*nexus_client.GnsDns, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetDNS(ctx)
+}
+
+// loadGnsDnsObject returns the node of obj from the loader of the request
+func loadGnsDnsObject(ctx context.Context, obj *model.GnsDns) (*nexus_client.GnsDns, error) {
+	v, err := load(ctx, loaderCacheKey("GnsDns", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetDNS(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.GnsDns), nil
+}
+
+func addGnsDnsObject(ctx context.Context, obj *model.GnsDns, objToCreate *basegnstsmtanzuvmwarecomv1.Dns) (*nexus_client.GnsDns, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).AddDNS(ctx, objToCreate)
+}
+
+func getCreateGnsDnsResolver(ctx context.Context, ParentLabels map[string]interface{}) (*model.GnsDns, error) {
+	objToCreate := &basegnstsmtanzuvmwarecomv1.Dns{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+	}
+	vDns, err := addGnsDnsObject(ctx, &model.GnsDns{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateGnsDnsResolver]Error creating Dns node %s", err)
+		return nil, err
+	}
+	dn := vDns.DisplayName()
+	parentLabels := map[string]interface{}{"dnses.gns.tsm.tanzu.vmware.com": dn}
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.GnsDns{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+	}
+	prime(ctx, loaderCacheKey("GnsDns", ret.ParentLabels), vDns)
+	log.Debugf("[getCreateGnsDnsResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteGnsDnsResolver(ctx context.Context, ParentLabels map[string]interface{}) (bool, error) {
+	vDns, err := getGnsDnsObject(ctx, &model.GnsDns{ParentLabels: ParentLabels}, "")
+	if err != nil {
+		log.Errorf("[getDeleteGnsDnsResolver]Error getting Dns node %s", err)
+		return false, err
+	}
+	if err := vDns.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteGnsDnsResolver]Error deleting Dns node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: Dns PKG: Gns
+// ////////////////////////////////////
+func getWatchGnsDnsResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.GnsDnsEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.GnsDns{ParentLabels: ParentLabels}
+	events := make(chan *model.GnsDnsEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vDns *nexus_client.GnsDns) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchGnsDnsResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vDns.DisplayName()
+		parentLabels := map[string]interface{}{"dnses.gns.tsm.tanzu.vmware.com": dn}
+
+		if !matchParentLabels(obj.ParentLabels, vDns.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.GnsDns{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+		}
+		select {
+		case events <- &model.GnsDnsEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchGnsDnsResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).DNS()
+	addId, err := chainer.RegisterAddCallback(func(vDns *nexus_client.GnsDns) {
+		send(model.NexusEventTypeAdded, vDns)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsDnsResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vDns *nexus_client.GnsDns) {
+		send(model.NexusEventTypeUpdated, vDns)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsDnsResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vDns *nexus_client.GnsDns) {
+		send(model.NexusEventTypeDeleted, vDns)
+	})
+	if err != nil {
+		log.Errorf("[getWatchGnsDnsResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchGnsDnsResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchGnsDnsResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: SvcGroupLinkInfo PKG: Servicegroup
+// ////////////////////////////////////
+// getServicegroupSvcGroupLinkInfoConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getServicegroupSvcGroupLinkInfoConnection(list []*nexus_client.ServicegroupSvcGroupLinkInfo, toModel func(*nexus_client.ServicegroupSvcGroupLinkInfo) *model.ServicegroupSvcGroupLinkInfo, first *int, after *string, filter *model.ServicegroupSvcGroupLinkInfoFilter, orderBy []*model.ServicegroupSvcGroupLinkInfoOrderBy) (*model.ServicegroupSvcGroupLinkInfoConnection, error) {
+	type item struct {
+		obj *nexus_client.ServicegroupSvcGroupLinkInfo
+		key *model.ServicegroupSvcGroupLinkInfo
+	}
+	var items []item
+	for _, v := range list {
+		key := getServicegroupSvcGroupLinkInfoConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.ClusterName, filter.ClusterName) {
+				continue
+			}
+			if !common.MatchFilter(key.DomainName, filter.DomainName) {
+				continue
+			}
+			if !common.MatchFilter(key.ServiceName, filter.ServiceName) {
+				continue
+			}
+			if !common.MatchFilter(key.ServiceType, filter.ServiceType) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareServicegroupSvcGroupLinkInfoConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.ServicegroupSvcGroupLinkInfo{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareServicegroupSvcGroupLinkInfoConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.ServicegroupSvcGroupLinkInfoConnection{
+		Edges:      make([]*model.ServicegroupSvcGroupLinkInfoEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.ServicegroupSvcGroupLinkInfoEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getServicegroupSvcGroupLinkInfoConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getServicegroupSvcGroupLinkInfoConnectionKey(v *nexus_client.ServicegroupSvcGroupLinkInfo) *model.ServicegroupSvcGroupLinkInfo {
+	dn := v.DisplayName()
+	key := &model.ServicegroupSvcGroupLinkInfo{Id: &dn}
+	vClusterName := string(v.Spec.ClusterName)
+	key.ClusterName = &vClusterName
+	vDomainName := string(v.Spec.DomainName)
+	key.DomainName = &vDomainName
+	vServiceName := string(v.Spec.ServiceName)
+	key.ServiceName = &vServiceName
+	vServiceType := string(v.Spec.ServiceType)
+	key.ServiceType = &vServiceType
+	return key
+}
+
+// compareServicegroupSvcGroupLinkInfoConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareServicegroupSvcGroupLinkInfoConnectionKeys(a, b *model.ServicegroupSvcGroupLinkInfo, orderBy []*model.ServicegroupSvcGroupLinkInfoOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "ClusterName":
+			c = common.CompareFields(a.ClusterName, b.ClusterName)
+		case "DomainName":
+			c = common.CompareFields(a.DomainName, b.DomainName)
+		case "ServiceName":
+			c = common.CompareFields(a.ServiceName, b.ServiceName)
+		case "ServiceType":
+			c = common.CompareFields(a.ServiceType, b.ServiceType)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: SvcGroupLinkInfo PKG: Servicegroup
+// ////////////////////////////////////
+func getServicegroupSvcGroupLinkInfoObject(ctx context.Context, obj *model.ServicegroupSvcGroupLinkInfo, id string) (*nexus_client.ServicegroupSvcGroupLinkInfo, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetSvcGrpInfo(ctx, id)
+}
+
+// loadServicegroupSvcGroupLinkInfoObject returns the node of obj from the loader of the request
+func loadServicegroupSvcGroupLinkInfoObject(ctx context.Context, obj *model.ServicegroupSvcGroupLinkInfo) (*nexus_client.ServicegroupSvcGroupLinkInfo, error) {
+	v, err := load(ctx, loaderCacheKey("ServicegroupSvcGroupLinkInfo", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetSvcGrpInfo(ctx, getParentName(obj.ParentLabels, "svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.ServicegroupSvcGroupLinkInfo), nil
+}
+
+func addServicegroupSvcGroupLinkInfoObject(ctx context.Context, obj *model.ServicegroupSvcGroupLinkInfo, objToCreate *baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo) (*nexus_client.ServicegroupSvcGroupLinkInfo, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).AddSvcGrpInfo(ctx, objToCreate)
+}
+
+func setServicegroupSvcGroupLinkInfoInput(spec *baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfoSpec, input *model.ServicegroupSvcGroupLinkInfoInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.ClusterName != nil {
+		spec.ClusterName = string(*input.ClusterName)
+	}
+	if input.DomainName != nil {
+		spec.DomainName = string(*input.DomainName)
+	}
+	if input.ServiceName != nil {
+		spec.ServiceName = string(*input.ServiceName)
+	}
+	if input.ServiceType != nil {
+		spec.ServiceType = string(*input.ServiceType)
+	}
+	return nil
+}
+
+func getCreateServicegroupSvcGroupLinkInfoResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.ServicegroupSvcGroupLinkInfoInput) (*model.ServicegroupSvcGroupLinkInfo, error) {
+	objToCreate := &baseservicegrouptsmtanzuvmwarecomv1.SvcGroupLinkInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	if err := setServicegroupSvcGroupLinkInfoInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateServicegroupSvcGroupLinkInfoResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vSvcGroupLinkInfo, err := addServicegroupSvcGroupLinkInfoObject(ctx, &model.ServicegroupSvcGroupLinkInfo{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateServicegroupSvcGroupLinkInfoResolver]Error creating SvcGroupLinkInfo node %s", err)
+		return nil, err
+	}
+	dn := vSvcGroupLinkInfo.DisplayName()
+	parentLabels := map[string]interface{}{"svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com": dn}
+	vClusterName := string(vSvcGroupLinkInfo.Spec.ClusterName)
+	vDomainName := string(vSvcGroupLinkInfo.Spec.DomainName)
+	vServiceName := string(vSvcGroupLinkInfo.Spec.ServiceName)
+	vServiceType := string(vSvcGroupLinkInfo.Spec.ServiceType)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ServicegroupSvcGroupLinkInfo{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		ClusterName:  &vClusterName,
+		DomainName:   &vDomainName,
+		ServiceName:  &vServiceName,
+		ServiceType:  &vServiceType,
+	}
+	prime(ctx, loaderCacheKey("ServicegroupSvcGroupLinkInfo", ret.ParentLabels), vSvcGroupLinkInfo)
+	log.Debugf("[getCreateServicegroupSvcGroupLinkInfoResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateServicegroupSvcGroupLinkInfoResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input model.ServicegroupSvcGroupLinkInfoInput) (*model.ServicegroupSvcGroupLinkInfo, error) {
+	vSvcGroupLinkInfo, err := getServicegroupSvcGroupLinkInfoObject(ctx, &model.ServicegroupSvcGroupLinkInfo{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUpdateServicegroupSvcGroupLinkInfoResolver]Error getting SvcGroupLinkInfo node %s", err)
+		return nil, err
+	}
+	if err := setServicegroupSvcGroupLinkInfoInput(&vSvcGroupLinkInfo.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateServicegroupSvcGroupLinkInfoResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vSvcGroupLinkInfo.Update(ctx); err != nil {
+		log.Errorf("[getUpdateServicegroupSvcGroupLinkInfoResolver]Error updating SvcGroupLinkInfo node %s", err)
+		return nil, err
+	}
+	dn := vSvcGroupLinkInfo.DisplayName()
+	parentLabels := map[string]interface{}{"svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com": dn}
+	vClusterName := string(vSvcGroupLinkInfo.Spec.ClusterName)
+	vDomainName := string(vSvcGroupLinkInfo.Spec.DomainName)
+	vServiceName := string(vSvcGroupLinkInfo.Spec.ServiceName)
+	vServiceType := string(vSvcGroupLinkInfo.Spec.ServiceType)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ServicegroupSvcGroupLinkInfo{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		ClusterName:  &vClusterName,
+		DomainName:   &vDomainName,
+		ServiceName:  &vServiceName,
+		ServiceType:  &vServiceType,
+	}
+	prime(ctx, loaderCacheKey("ServicegroupSvcGroupLinkInfo", ret.ParentLabels), vSvcGroupLinkInfo)
+	log.Debugf("[getUpdateServicegroupSvcGroupLinkInfoResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteServicegroupSvcGroupLinkInfoResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vSvcGroupLinkInfo, err := getServicegroupSvcGroupLinkInfoObject(ctx, &model.ServicegroupSvcGroupLinkInfo{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeleteServicegroupSvcGroupLinkInfoResolver]Error getting SvcGroupLinkInfo node %s", err)
+		return false, err
+	}
+	if err := vSvcGroupLinkInfo.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteServicegroupSvcGroupLinkInfoResolver]Error deleting SvcGroupLinkInfo node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: SvcGroupLinkInfo PKG: Servicegroup
+// ////////////////////////////////////
+func getWatchServicegroupSvcGroupLinkInfoResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.ServicegroupSvcGroupLinkInfoEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.ServicegroupSvcGroupLinkInfo{ParentLabels: ParentLabels}
+	events := make(chan *model.ServicegroupSvcGroupLinkInfoEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vSvcGroupLinkInfo *nexus_client.ServicegroupSvcGroupLinkInfo) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchServicegroupSvcGroupLinkInfoResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vSvcGroupLinkInfo.DisplayName()
+		parentLabels := map[string]interface{}{"svcgrouplinkinfos.servicegroup.tsm.tanzu.vmware.com": dn}
+		vClusterName := string(vSvcGroupLinkInfo.Spec.ClusterName)
+		vDomainName := string(vSvcGroupLinkInfo.Spec.DomainName)
+		vServiceName := string(vSvcGroupLinkInfo.Spec.ServiceName)
+		vServiceType := string(vSvcGroupLinkInfo.Spec.ServiceType)
+
+		if !matchParentLabels(obj.ParentLabels, vSvcGroupLinkInfo.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.ServicegroupSvcGroupLinkInfo{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+			ClusterName:  &vClusterName,
+			DomainName:   &vDomainName,
+			ServiceName:  &vServiceName,
+			ServiceType:  &vServiceType,
+		}
+		select {
+		case events <- &model.ServicegroupSvcGroupLinkInfoEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchServicegroupSvcGroupLinkInfoResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).SvcGrpInfo("")
+	addId, err := chainer.RegisterAddCallback(func(vSvcGroupLinkInfo *nexus_client.ServicegroupSvcGroupLinkInfo) {
+		send(model.NexusEventTypeAdded, vSvcGroupLinkInfo)
+	})
+	if err != nil {
+		log.Errorf("[getWatchServicegroupSvcGroupLinkInfoResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vSvcGroupLinkInfo *nexus_client.ServicegroupSvcGroupLinkInfo) {
+		send(model.NexusEventTypeUpdated, vSvcGroupLinkInfo)
+	})
+	if err != nil {
+		log.Errorf("[getWatchServicegroupSvcGroupLinkInfoResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vSvcGroupLinkInfo *nexus_client.ServicegroupSvcGroupLinkInfo) {
+		send(model.NexusEventTypeDeleted, vSvcGroupLinkInfo)
+	})
+	if err != nil {
+		log.Errorf("[getWatchServicegroupSvcGroupLinkInfoResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchServicegroupSvcGroupLinkInfoResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchServicegroupSvcGroupLinkInfoResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: AccessControlPolicy PKG: Policypkg
+// ////////////////////////////////////
+// getPolicypkgAccessControlPolicyConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getPolicypkgAccessControlPolicyConnection(list []*nexus_client.PolicypkgAccessControlPolicy, toModel func(*nexus_client.PolicypkgAccessControlPolicy) *model.PolicypkgAccessControlPolicy, first *int, after *string, filter *model.PolicypkgAccessControlPolicyFilter, orderBy []*model.PolicypkgAccessControlPolicyOrderBy) (*model.PolicypkgAccessControlPolicyConnection, error) {
+	type item struct {
+		obj *nexus_client.PolicypkgAccessControlPolicy
+		key *model.PolicypkgAccessControlPolicy
+	}
+	var items []item
+	for _, v := range list {
+		key := getPolicypkgAccessControlPolicyConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return comparePolicypkgAccessControlPolicyConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.PolicypkgAccessControlPolicy{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return comparePolicypkgAccessControlPolicyConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.PolicypkgAccessControlPolicyConnection{
+		Edges:      make([]*model.PolicypkgAccessControlPolicyEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.PolicypkgAccessControlPolicyEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getPolicypkgAccessControlPolicyConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getPolicypkgAccessControlPolicyConnectionKey(v *nexus_client.PolicypkgAccessControlPolicy) *model.PolicypkgAccessControlPolicy {
+	dn := v.DisplayName()
+	key := &model.PolicypkgAccessControlPolicy{Id: &dn}
+	return key
+}
+
+// comparePolicypkgAccessControlPolicyConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func comparePolicypkgAccessControlPolicyConnectionKeys(a, b *model.PolicypkgAccessControlPolicy, orderBy []*model.PolicypkgAccessControlPolicyOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: AccessControlPolicy PKG: Policypkg
+// ////////////////////////////////////
+func getPolicypkgAccessControlPolicyObject(ctx context.Context, obj *model.PolicypkgAccessControlPolicy, id string) (*nexus_client.PolicypkgAccessControlPolicy, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetGnsAccessControlPolicy(ctx, id)
+}
+
+// loadPolicypkgAccessControlPolicyObject returns the node of obj from the loader of the request
+func loadPolicypkgAccessControlPolicyObject(ctx context.Context, obj *model.PolicypkgAccessControlPolicy) (*nexus_client.PolicypkgAccessControlPolicy, error) {
+	v, err := load(ctx, loaderCacheKey("PolicypkgAccessControlPolicy", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GetGnsAccessControlPolicy(ctx, getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.PolicypkgAccessControlPolicy), nil
+}
+
+func addPolicypkgAccessControlPolicyObject(ctx context.Context, obj *model.PolicypkgAccessControlPolicy, objToCreate *basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy) (*nexus_client.PolicypkgAccessControlPolicy, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).AddGnsAccessControlPolicy(ctx, objToCreate)
+}
+
+func getCreatePolicypkgAccessControlPolicyResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (*model.PolicypkgAccessControlPolicy, error) {
+	objToCreate := &basepolicypkgtsmtanzuvmwarecomv1.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	vAccessControlPolicy, err := addPolicypkgAccessControlPolicyObject(ctx, &model.PolicypkgAccessControlPolicy{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreatePolicypkgAccessControlPolicyResolver]Error creating AccessControlPolicy node %s", err)
+		return nil, err
+	}
+	dn := vAccessControlPolicy.DisplayName()
+	parentLabels := map[string]interface{}{"accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com": dn}
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.PolicypkgAccessControlPolicy{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+	}
+	prime(ctx, loaderCacheKey("PolicypkgAccessControlPolicy", ret.ParentLabels), vAccessControlPolicy)
+	log.Debugf("[getCreatePolicypkgAccessControlPolicyResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeletePolicypkgAccessControlPolicyResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vAccessControlPolicy, err := getPolicypkgAccessControlPolicyObject(ctx, &model.PolicypkgAccessControlPolicy{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeletePolicypkgAccessControlPolicyResolver]Error getting AccessControlPolicy node %s", err)
+		return false, err
+	}
+	if err := vAccessControlPolicy.Delete(ctx); err != nil {
+		log.Errorf("[getDeletePolicypkgAccessControlPolicyResolver]Error deleting AccessControlPolicy node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: AccessControlPolicy PKG: Policypkg
+// ////////////////////////////////////
+func getWatchPolicypkgAccessControlPolicyResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.PolicypkgAccessControlPolicyEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.PolicypkgAccessControlPolicy{ParentLabels: ParentLabels}
+	events := make(chan *model.PolicypkgAccessControlPolicyEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vAccessControlPolicy *nexus_client.PolicypkgAccessControlPolicy) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchPolicypkgAccessControlPolicyResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vAccessControlPolicy.DisplayName()
+		parentLabels := map[string]interface{}{"accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com": dn}
+
+		if !matchParentLabels(obj.ParentLabels, vAccessControlPolicy.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.PolicypkgAccessControlPolicy{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+		}
+		select {
+		case events <- &model.PolicypkgAccessControlPolicyEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchPolicypkgAccessControlPolicyResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GnsAccessControlPolicy("")
+	addId, err := chainer.RegisterAddCallback(func(vAccessControlPolicy *nexus_client.PolicypkgAccessControlPolicy) {
+		send(model.NexusEventTypeAdded, vAccessControlPolicy)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgAccessControlPolicyResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vAccessControlPolicy *nexus_client.PolicypkgAccessControlPolicy) {
+		send(model.NexusEventTypeUpdated, vAccessControlPolicy)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgAccessControlPolicyResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vAccessControlPolicy *nexus_client.PolicypkgAccessControlPolicy) {
+		send(model.NexusEventTypeDeleted, vAccessControlPolicy)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgAccessControlPolicyResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchPolicypkgAccessControlPolicyResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchPolicypkgAccessControlPolicyResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: ACPConfig PKG: Policypkg
+// ////////////////////////////////////
+// getPolicypkgACPConfigConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getPolicypkgACPConfigConnection(list []*nexus_client.PolicypkgACPConfig, toModel func(*nexus_client.PolicypkgACPConfig) *model.PolicypkgACPConfig, first *int, after *string, filter *model.PolicypkgACPConfigFilter, orderBy []*model.PolicypkgACPConfigOrderBy) (*model.PolicypkgACPConfigConnection, error) {
+	type item struct {
+		obj *nexus_client.PolicypkgACPConfig
+		key *model.PolicypkgACPConfig
+	}
+	var items []item
+	for _, v := range list {
+		key := getPolicypkgACPConfigConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.DisplayName, filter.DisplayName) {
+				continue
+			}
+			if !common.MatchFilter(key.Gns, filter.Gns) {
+				continue
+			}
+			if !common.MatchFilter(key.Description, filter.Description) {
+				continue
+			}
+			if !common.MatchFilter(key.ProjectId, filter.ProjectId) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return comparePolicypkgACPConfigConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.PolicypkgACPConfig{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return comparePolicypkgACPConfigConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.PolicypkgACPConfigConnection{
+		Edges:      make([]*model.PolicypkgACPConfigEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.PolicypkgACPConfigEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getPolicypkgACPConfigConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getPolicypkgACPConfigConnectionKey(v *nexus_client.PolicypkgACPConfig) *model.PolicypkgACPConfig {
+	dn := v.DisplayName()
+	key := &model.PolicypkgACPConfig{Id: &dn}
+	vDisplayName := string(v.Spec.DisplayName)
+	key.DisplayName = &vDisplayName
+	vGns := string(v.Spec.Gns)
+	key.Gns = &vGns
+	vDescription := string(v.Spec.Description)
+	key.Description = &vDescription
+	vProjectId := string(v.Spec.ProjectId)
+	key.ProjectId = &vProjectId
+	return key
+}
+
+// comparePolicypkgACPConfigConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func comparePolicypkgACPConfigConnectionKeys(a, b *model.PolicypkgACPConfig, orderBy []*model.PolicypkgACPConfigOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "DisplayName":
+			c = common.CompareFields(a.DisplayName, b.DisplayName)
+		case "Gns":
+			c = common.CompareFields(a.Gns, b.Gns)
+		case "Description":
+			c = common.CompareFields(a.Description, b.Description)
+		case "ProjectId":
+			c = common.CompareFields(a.ProjectId, b.ProjectId)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: ACPConfig PKG: Policypkg
+// ////////////////////////////////////
+func getPolicypkgACPConfigObject(ctx context.Context, obj *model.PolicypkgACPConfig, id string) (*nexus_client.PolicypkgACPConfig, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GnsAccessControlPolicy(getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com")).GetPolicyConfigs(ctx, id)
+}
+
+// loadPolicypkgACPConfigObject returns the node of obj from the loader of the request
+func loadPolicypkgACPConfigObject(ctx context.Context, obj *model.PolicypkgACPConfig) (*nexus_client.PolicypkgACPConfig, error) {
+	v, err := load(ctx, loaderCacheKey("PolicypkgACPConfig", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GnsAccessControlPolicy(getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com")).GetPolicyConfigs(ctx, getParentName(obj.ParentLabels, "acpconfigs.policypkg.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.PolicypkgACPConfig), nil
+}
+
+func addPolicypkgACPConfigObject(ctx context.Context, obj *model.PolicypkgACPConfig, objToCreate *basepolicypkgtsmtanzuvmwarecomv1.ACPConfig) (*nexus_client.PolicypkgACPConfig, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GnsAccessControlPolicy(getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com")).AddPolicyConfigs(ctx, objToCreate)
+}
+
+func setPolicypkgACPConfigInput(spec *basepolicypkgtsmtanzuvmwarecomv1.ACPConfigSpec, input *model.PolicypkgACPConfigInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.DisplayName != nil {
+		spec.DisplayName = string(*input.DisplayName)
+	}
+	if input.Gns != nil {
+		spec.Gns = string(*input.Gns)
+	}
+	if input.Description != nil {
+		spec.Description = string(*input.Description)
+	}
+	if input.Tags != nil {
+		if err := json.Unmarshal([]byte(*input.Tags), &spec.Tags); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of Tags: %s", err)}
+		}
+	}
+	if input.ProjectId != nil {
+		spec.ProjectId = string(*input.ProjectId)
+	}
+	if input.Conditions != nil {
+		if err := json.Unmarshal([]byte(*input.Conditions), &spec.Conditions); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of Conditions: %s", err)}
+		}
+	}
+	return nil
+}
+
+func getCreatePolicypkgACPConfigResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.PolicypkgACPConfigInput) (*model.PolicypkgACPConfig, error) {
+	objToCreate := &basepolicypkgtsmtanzuvmwarecomv1.ACPConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	if err := setPolicypkgACPConfigInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreatePolicypkgACPConfigResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vACPConfig, err := addPolicypkgACPConfigObject(ctx, &model.PolicypkgACPConfig{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreatePolicypkgACPConfigResolver]Error creating ACPConfig node %s", err)
+		return nil, err
+	}
+	dn := vACPConfig.DisplayName()
+	parentLabels := map[string]interface{}{"acpconfigs.policypkg.tsm.tanzu.vmware.com": dn}
+	vDisplayName := string(vACPConfig.Spec.DisplayName)
+	vGns := string(vACPConfig.Spec.Gns)
+	vDescription := string(vACPConfig.Spec.Description)
+	Tags, _ := json.Marshal(vACPConfig.Spec.Tags)
+	TagsData := string(Tags)
+	vProjectId := string(vACPConfig.Spec.ProjectId)
+	Conditions, _ := json.Marshal(vACPConfig.Spec.Conditions)
+	ConditionsData := string(Conditions)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.PolicypkgACPConfig{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		DisplayName:  &vDisplayName,
+		Gns:          &vGns,
+		Description:  &vDescription,
+		Tags:         &TagsData,
+		ProjectId:    &vProjectId,
+		Conditions:   &ConditionsData,
+	}
+	prime(ctx, loaderCacheKey("PolicypkgACPConfig", ret.ParentLabels), vACPConfig)
+	log.Debugf("[getCreatePolicypkgACPConfigResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdatePolicypkgACPConfigResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input model.PolicypkgACPConfigInput) (*model.PolicypkgACPConfig, error) {
+	vACPConfig, err := getPolicypkgACPConfigObject(ctx, &model.PolicypkgACPConfig{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUpdatePolicypkgACPConfigResolver]Error getting ACPConfig node %s", err)
+		return nil, err
+	}
+	if err := setPolicypkgACPConfigInput(&vACPConfig.Spec, &Input); err != nil {
+		log.Errorf("[getUpdatePolicypkgACPConfigResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vACPConfig.Update(ctx); err != nil {
+		log.Errorf("[getUpdatePolicypkgACPConfigResolver]Error updating ACPConfig node %s", err)
+		return nil, err
+	}
+	dn := vACPConfig.DisplayName()
+	parentLabels := map[string]interface{}{"acpconfigs.policypkg.tsm.tanzu.vmware.com": dn}
+	vDisplayName := string(vACPConfig.Spec.DisplayName)
+	vGns := string(vACPConfig.Spec.Gns)
+	vDescription := string(vACPConfig.Spec.Description)
+	Tags, _ := json.Marshal(vACPConfig.Spec.Tags)
+	TagsData := string(Tags)
+	vProjectId := string(vACPConfig.Spec.ProjectId)
+	Conditions, _ := json.Marshal(vACPConfig.Spec.Conditions)
+	ConditionsData := string(Conditions)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.PolicypkgACPConfig{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		DisplayName:  &vDisplayName,
+		Gns:          &vGns,
+		Description:  &vDescription,
+		Tags:         &TagsData,
+		ProjectId:    &vProjectId,
+		Conditions:   &ConditionsData,
+	}
+	prime(ctx, loaderCacheKey("PolicypkgACPConfig", ret.ParentLabels), vACPConfig)
+	log.Debugf("[getUpdatePolicypkgACPConfigResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeletePolicypkgACPConfigResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vACPConfig, err := getPolicypkgACPConfigObject(ctx, &model.PolicypkgACPConfig{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeletePolicypkgACPConfigResolver]Error getting ACPConfig node %s", err)
+		return false, err
+	}
+	if err := vACPConfig.Delete(ctx); err != nil {
+		log.Errorf("[getDeletePolicypkgACPConfigResolver]Error deleting ACPConfig node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+func getSetPolicypkgACPConfigStatusResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Status string) (*model.PolicypkgACPConfig, error) {
+	status := &basepolicypkgtsmtanzuvmwarecomv1.ACPStatus{}
+	if err := json.Unmarshal([]byte(Status), status); err != nil {
+		log.Errorf("[getSetPolicypkgACPConfigStatusResolver]Invalid status %s", err)
+		return nil, codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid status: %s", err)}
+	}
+	vACPConfig, err := getPolicypkgACPConfigObject(ctx, &model.PolicypkgACPConfig{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getSetPolicypkgACPConfigStatusResolver]Error getting ACPConfig node %s", err)
+		return nil, err
+	}
+	if err := vACPConfig.SetStatus(ctx, status); err != nil {
+		log.Errorf("[getSetPolicypkgACPConfigStatusResolver]Error setting Status %s", err)
+		return nil, err
+	}
+	dn := vACPConfig.DisplayName()
+	parentLabels := map[string]interface{}{"acpconfigs.policypkg.tsm.tanzu.vmware.com": dn}
+	vDisplayName := string(vACPConfig.Spec.DisplayName)
+	vGns := string(vACPConfig.Spec.Gns)
+	vDescription := string(vACPConfig.Spec.Description)
+	Tags, _ := json.Marshal(vACPConfig.Spec.Tags)
+	TagsData := string(Tags)
+	vProjectId := string(vACPConfig.Spec.ProjectId)
+	Conditions, _ := json.Marshal(vACPConfig.Spec.Conditions)
+	ConditionsData := string(Conditions)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.PolicypkgACPConfig{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+		DisplayName:  &vDisplayName,
+		Gns:          &vGns,
+		Description:  &vDescription,
+		Tags:         &TagsData,
+		ProjectId:    &vProjectId,
+		Conditions:   &ConditionsData,
+	}
+	prime(ctx, loaderCacheKey("PolicypkgACPConfig", ret.ParentLabels), vACPConfig)
+	return ret, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: ACPConfig PKG: Policypkg
+// ////////////////////////////////////
+func getWatchPolicypkgACPConfigResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.PolicypkgACPConfigEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.PolicypkgACPConfig{ParentLabels: ParentLabels}
+	events := make(chan *model.PolicypkgACPConfigEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vACPConfig *nexus_client.PolicypkgACPConfig) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchPolicypkgACPConfigResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vACPConfig.DisplayName()
+		parentLabels := map[string]interface{}{"acpconfigs.policypkg.tsm.tanzu.vmware.com": dn}
+		vDisplayName := string(vACPConfig.Spec.DisplayName)
+		vGns := string(vACPConfig.Spec.Gns)
+		vDescription := string(vACPConfig.Spec.Description)
+		Tags, _ := json.Marshal(vACPConfig.Spec.Tags)
+		TagsData := string(Tags)
+		vProjectId := string(vACPConfig.Spec.ProjectId)
+		Conditions, _ := json.Marshal(vACPConfig.Spec.Conditions)
+		ConditionsData := string(Conditions)
+
+		if !matchParentLabels(obj.ParentLabels, vACPConfig.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.PolicypkgACPConfig{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+			DisplayName:  &vDisplayName,
+			Gns:          &vGns,
+			Description:  &vDescription,
+			Tags:         &TagsData,
+			ProjectId:    &vProjectId,
+			Conditions:   &ConditionsData,
+		}
+		select {
+		case events <- &model.PolicypkgACPConfigEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchPolicypkgACPConfigResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS(getParentName(obj.ParentLabels, "gnses.gns.tsm.tanzu.vmware.com")).GnsAccessControlPolicy(getParentName(obj.ParentLabels, "accesscontrolpolicies.policypkg.tsm.tanzu.vmware.com")).PolicyConfigs("")
+	addId, err := chainer.RegisterAddCallback(func(vACPConfig *nexus_client.PolicypkgACPConfig) {
+		send(model.NexusEventTypeAdded, vACPConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgACPConfigResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vACPConfig *nexus_client.PolicypkgACPConfig) {
+		send(model.NexusEventTypeUpdated, vACPConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgACPConfigResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vACPConfig *nexus_client.PolicypkgACPConfig) {
+		send(model.NexusEventTypeDeleted, vACPConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgACPConfigResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchPolicypkgACPConfigResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchPolicypkgACPConfigResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// ////////////////////////////////////
+// CONNECTION
+// Node: VMpolicy PKG: Policypkg
+// ////////////////////////////////////
+// getPolicypkgVMpolicyConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getPolicypkgVMpolicyConnection(list []*nexus_client.PolicypkgVMpolicy, toModel func(*nexus_client.PolicypkgVMpolicy) *model.PolicypkgVMpolicy, first *int, after *string, filter *model.PolicypkgVMpolicyFilter, orderBy []*model.PolicypkgVMpolicyOrderBy) (*model.PolicypkgVMpolicyConnection, error) {
+	type item struct {
+		obj *nexus_client.PolicypkgVMpolicy
+		key *model.PolicypkgVMpolicy
+	}
+	var items []item
+	for _, v := range list {
+		key := getPolicypkgVMpolicyConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return comparePolicypkgVMpolicyConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.PolicypkgVMpolicy{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return comparePolicypkgVMpolicyConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.PolicypkgVMpolicyConnection{
+		Edges:      make([]*model.PolicypkgVMpolicyEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.PolicypkgVMpolicyEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getPolicypkgVMpolicyConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getPolicypkgVMpolicyConnectionKey(v *nexus_client.PolicypkgVMpolicy) *model.PolicypkgVMpolicy {
+	dn := v.DisplayName()
+	key := &model.PolicypkgVMpolicy{Id: &dn}
+	return key
+}
+
+// comparePolicypkgVMpolicyConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func comparePolicypkgVMpolicyConnectionKeys(a, b *model.PolicypkgVMpolicy, orderBy []*model.PolicypkgVMpolicyOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+// ////////////////////////////////////
+// MUTATIONS
+// Node: VMpolicy PKG: Policypkg
+// ////////////////////////////////////
+func getPolicypkgVMpolicyObject(ctx context.Context, obj *model.PolicypkgVMpolicy, id string) (*nexus_client.PolicypkgVMpolicy, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetVMPPolicies(ctx, id)
+}
+
+// loadPolicypkgVMpolicyObject returns the node of obj from the loader of the request
+func loadPolicypkgVMpolicyObject(ctx context.Context, obj *model.PolicypkgVMpolicy) (*nexus_client.PolicypkgVMpolicy, error) {
+	v, err := load(ctx, loaderCacheKey("PolicypkgVMpolicy", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetVMPPolicies(ctx, getParentName(obj.ParentLabels, "vmpolicies.policypkg.tsm.tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.PolicypkgVMpolicy), nil
+}
+
+func addPolicypkgVMpolicyObject(ctx context.Context, obj *model.PolicypkgVMpolicy, objToCreate *basepolicypkgtsmtanzuvmwarecomv1.VMpolicy) (*nexus_client.PolicypkgVMpolicy, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).AddVMPPolicies(ctx, objToCreate)
+}
+
+func getCreatePolicypkgVMpolicyResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (*model.PolicypkgVMpolicy, error) {
+	objToCreate := &basepolicypkgtsmtanzuvmwarecomv1.VMpolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	vVMpolicy, err := addPolicypkgVMpolicyObject(ctx, &model.PolicypkgVMpolicy{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreatePolicypkgVMpolicyResolver]Error creating VMpolicy node %s", err)
+		return nil, err
+	}
+	dn := vVMpolicy.DisplayName()
+	parentLabels := map[string]interface{}{"vmpolicies.policypkg.tsm.tanzu.vmware.com": dn}
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.PolicypkgVMpolicy{
+		Id:           &dn,
+		ParentLabels: parentLabels,
+	}
+	prime(ctx, loaderCacheKey("PolicypkgVMpolicy", ret.ParentLabels), vVMpolicy)
+	log.Debugf("[getCreatePolicypkgVMpolicyResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeletePolicypkgVMpolicyResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vVMpolicy, err := getPolicypkgVMpolicyObject(ctx, &model.PolicypkgVMpolicy{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeletePolicypkgVMpolicyResolver]Error getting VMpolicy node %s", err)
+		return false, err
+	}
+	if err := vVMpolicy.Delete(ctx); err != nil {
+		log.Errorf("[getDeletePolicypkgVMpolicyResolver]Error deleting VMpolicy node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ////////////////////////////////////
+// SUBSCRIPTION
+// Node: VMpolicy PKG: Policypkg
+// ////////////////////////////////////
+func getWatchPolicypkgVMpolicyResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.PolicypkgVMpolicyEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.PolicypkgVMpolicy{ParentLabels: ParentLabels}
+	events := make(chan *model.PolicypkgVMpolicyEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vVMpolicy *nexus_client.PolicypkgVMpolicy) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchPolicypkgVMpolicyResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vVMpolicy.DisplayName()
+		parentLabels := map[string]interface{}{"vmpolicies.policypkg.tsm.tanzu.vmware.com": dn}
+
+		if !matchParentLabels(obj.ParentLabels, vVMpolicy.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.PolicypkgVMpolicy{
+			Id:           &dn,
+			ParentLabels: parentLabels,
+		}
+		select {
+		case events <- &model.PolicypkgVMpolicyEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchPolicypkgVMpolicyResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).VMPPolicies("")
+	addId, err := chainer.RegisterAddCallback(func(vVMpolicy *nexus_client.PolicypkgVMpolicy) {
+		send(model.NexusEventTypeAdded, vVMpolicy)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgVMpolicyResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vVMpolicy *nexus_client.PolicypkgVMpolicy) {
+		send(model.NexusEventTypeUpdated, vVMpolicy)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgVMpolicyResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vVMpolicy *nexus_client.PolicypkgVMpolicy) {
+		send(model.NexusEventTypeDeleted, vVMpolicy)
+	})
+	if err != nil {
+		log.Errorf("[getWatchPolicypkgVMpolicyResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchPolicypkgVMpolicyResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchPolicypkgVMpolicyResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// matchParentLabels checks that a node notified to a subscription is in the scope of the subscription's parent labels,
+// labels of the node itself are checked against nodeLabels and labels of its parents against the labels of the object
+func matchParentLabels(parentLabels map[string]interface{}, labels map[string]string, nodeLabels map[string]interface{}) bool {
+	for k, v := range parentLabels {
+		name, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if nodeName, ok := nodeLabels[k]; ok {
+			if nodeName != name {
+				return false
+			}
+			continue
+		}
+		if labels[k] != name {
+			return false
+		}
+	}
+	return true
+}
+
+// convertMutationInput copies the value of the mutation input to the field of the node spec
+func convertMutationInput(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// getPageInfo returns the page info of the range of positions of a connection, cursors are the ones of its nodes
+func getPageInfo(start, end, total int, cursors []string) *model.PageInfo {
+	pageInfo := &model.PageInfo{
+		HasNextPage:     end < total,
+		HasPreviousPage: start > 0,
+	}
+	if len(cursors) > 0 {
+		pageInfo.StartCursor = &cursors[0]
+		pageInfo.EndCursor = &cursors[len(cursors)-1]
+	}
+	return pageInfo
+}
+
+const (
+	ErrorCodeNotFound     = "NOT_FOUND"
+	ErrorCodeForbidden    = "FORBIDDEN"
+	ErrorCodeUnavailable  = "UNAVAILABLE"
+	ErrorCodeBadUserInput = "BAD_USER_INPUT"
+	ErrorCodeConflict     = "CONFLICT"
+	ErrorCodeInternal     = "INTERNAL"
+)
+
+// codedError is an error of the resolvers which sets the code of the GraphQL error itself
+type codedError struct {
+	code string
+	err  error
+}
+
+func (e codedError) Error() string {
+	return e.err.Error()
+}
+
+func (e codedError) Unwrap() error {
+	return e.err
+}
+
+// ErrorCode returns the code of a resolver error set in the extensions of the GraphQL error, errors of the nexus
+// client and of the k8s API are mapped to the code of their kind
+func ErrorCode(err error) string {
+	var coded codedError
+	switch {
+	case errors.As(err, &coded):
+		return coded.code
+	case nexus_client.IsNotFound(err), nexus_client.IsParentNotFound(err), nexus_client.IsChildNotFound(err),
+		nexus_client.IsLinkNotFound(err), nexus_client.IsUniqueNotFound(err):
+		return ErrorCodeNotFound
+	case nexus_client.IsForbidden(err), nexus_client.IsUnauthorized(err):
+		return ErrorCodeForbidden
+	case nexus_client.IsServiceUnavailable(err), nexus_client.IsTimeout(err), nexus_client.IsServerTimeout(err),
+		nexus_client.IsTooManyRequests(err), errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeUnavailable
+	case nexus_client.IsSingletonNameError(err), nexus_client.IsInvalid(err), nexus_client.IsBadRequest(err):
+		return ErrorCodeBadUserInput
+	case nexus_client.IsAlreadyExists(err), nexus_client.IsConflict(err):
+		return ErrorCodeConflict
+	}
+	return ErrorCodeInternal
+}
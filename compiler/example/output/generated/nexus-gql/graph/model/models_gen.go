@@ -2,6 +2,12 @@
 
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type NexusGraphqlResponse struct {
 	Code         *int    `json:"Code"`
 	Message      *string `json:"Message"`
@@ -10,6 +16,13 @@ type NexusGraphqlResponse struct {
 	TotalRecords *int    `json:"TotalRecords"`
 }
 
+type PageInfo struct {
+	HasNextPage     bool    `json:"HasNextPage"`
+	HasPreviousPage bool    `json:"HasPreviousPage"`
+	StartCursor     *string `json:"StartCursor"`
+	EndCursor       *string `json:"EndCursor"`
+}
+
 type TimeSeriesData struct {
 	Code         *int    `json:"Code"`
 	Message      *string `json:"Message"`
@@ -19,25 +32,66 @@ type TimeSeriesData struct {
 }
 
 type ConfigConfig struct {
-	Id                *string                         `json:"Id"`
-	ParentLabels      map[string]interface{}          `json:"ParentLabels"`
-	QueryExample      *NexusGraphqlResponse           `json:"QueryExample"`
-	ACPPolicies       []*PolicypkgAccessControlPolicy `json:"ACPPolicies"`
-	FooExample        []*ConfigFooTypeABC             `json:"FooExample"`
-	MyStr0            *string                         `json:"MyStr0"`
-	MyStr1            *string                         `json:"MyStr1"`
-	MyStr2            *string                         `json:"MyStr2"`
-	XYZPort           *string                         `json:"XYZPort"`
-	ABCHost           *string                         `json:"ABCHost"`
-	ClusterNamespaces *string                         `json:"ClusterNamespaces"`
-	TestValMarkers    *string                         `json:"TestValMarkers"`
-	Instance          *float64                        `json:"Instance"`
-	CuOption          *string                         `json:"CuOption"`
-	GNS               *GnsGns                         `json:"GNS"`
-	DNS               *GnsDns                         `json:"DNS"`
-	VMPPolicies       *PolicypkgVMpolicy              `json:"VMPPolicies"`
-	Domain            *ConfigDomain                   `json:"Domain"`
-	SvcGrpInfo        *ServicegroupSvcGroupLinkInfo   `json:"SvcGrpInfo"`
+	Id                    *string                                 `json:"Id"`
+	ParentLabels          map[string]interface{}                  `json:"ParentLabels"`
+	QueryExample          *NexusGraphqlResponse                   `json:"QueryExample"`
+	ACPPolicies           []*PolicypkgAccessControlPolicy         `json:"ACPPolicies"`
+	ACPPoliciesConnection *PolicypkgAccessControlPolicyConnection `json:"ACPPoliciesConnection"`
+	FooExample            []*ConfigFooTypeABC                     `json:"FooExample"`
+	FooExampleConnection  *ConfigFooTypeABCConnection             `json:"FooExampleConnection"`
+	MyStr0                *string                                 `json:"MyStr0"`
+	MyStr1                *string                                 `json:"MyStr1"`
+	MyStr2                *string                                 `json:"MyStr2"`
+	XYZPort               *string                                 `json:"XYZPort"`
+	ABCHost               *string                                 `json:"ABCHost"`
+	ClusterNamespaces     *string                                 `json:"ClusterNamespaces"`
+	TestValMarkers        *string                                 `json:"TestValMarkers"`
+	Instance              *float64                                `json:"Instance"`
+	CuOption              *string                                 `json:"CuOption"`
+	GNS                   *GnsGns                                 `json:"GNS"`
+	DNS                   *GnsDns                                 `json:"DNS"`
+	VMPPolicies           *PolicypkgVMpolicy                      `json:"VMPPolicies"`
+	Domain                *ConfigDomain                           `json:"Domain"`
+	SvcGrpInfo            *ServicegroupSvcGroupLinkInfo           `json:"SvcGrpInfo"`
+}
+
+type ConfigConfigConnection struct {
+	Edges      []*ConfigConfigEdge `json:"Edges"`
+	PageInfo   *PageInfo           `json:"PageInfo"`
+	TotalCount int                 `json:"TotalCount"`
+}
+
+type ConfigConfigEdge struct {
+	Cursor string        `json:"Cursor"`
+	Node   *ConfigConfig `json:"Node"`
+}
+
+type ConfigConfigEvent struct {
+	Type   NexusEventType `json:"Type"`
+	Object *ConfigConfig  `json:"Object"`
+}
+
+type ConfigConfigFilter struct {
+	Id       *string  `json:"Id"`
+	Instance *float64 `json:"Instance"`
+	CuOption *string  `json:"CuOption"`
+}
+
+type ConfigConfigInput struct {
+	MyStr0            *string  `json:"MyStr0"`
+	MyStr1            *string  `json:"MyStr1"`
+	MyStr2            *string  `json:"MyStr2"`
+	XYZPort           *string  `json:"XYZPort"`
+	ABCHost           *string  `json:"ABCHost"`
+	ClusterNamespaces *string  `json:"ClusterNamespaces"`
+	TestValMarkers    *string  `json:"TestValMarkers"`
+	Instance          *float64 `json:"Instance"`
+	CuOption          *string  `json:"CuOption"`
+}
+
+type ConfigConfigOrderBy struct {
+	Field     ConfigConfigOrderField `json:"Field"`
+	Direction *OrderDirection        `json:"Direction"`
 }
 
 type ConfigDomain struct {
@@ -54,6 +108,43 @@ type ConfigDomain struct {
 	PointStruct      *string                `json:"PointStruct"`
 }
 
+type ConfigDomainConnection struct {
+	Edges      []*ConfigDomainEdge `json:"Edges"`
+	PageInfo   *PageInfo           `json:"PageInfo"`
+	TotalCount int                 `json:"TotalCount"`
+}
+
+type ConfigDomainEdge struct {
+	Cursor string        `json:"Cursor"`
+	Node   *ConfigDomain `json:"Node"`
+}
+
+type ConfigDomainEvent struct {
+	Type   NexusEventType `json:"Type"`
+	Object *ConfigDomain  `json:"Object"`
+}
+
+type ConfigDomainFilter struct {
+	Id *string `json:"Id"`
+}
+
+type ConfigDomainInput struct {
+	PointPort        *string `json:"PointPort"`
+	PointString      *string `json:"PointString"`
+	PointInt         *int    `json:"PointInt"`
+	PointMap         *string `json:"PointMap"`
+	PointSlice       *string `json:"PointSlice"`
+	SliceOfPoints    *string `json:"SliceOfPoints"`
+	SliceOfArrPoints *string `json:"SliceOfArrPoints"`
+	MapOfArrsPoints  *string `json:"MapOfArrsPoints"`
+	PointStruct      *string `json:"PointStruct"`
+}
+
+type ConfigDomainOrderBy struct {
+	Field     ConfigDomainOrderField `json:"Field"`
+	Direction *OrderDirection        `json:"Direction"`
+}
+
 type ConfigFooTypeABC struct {
 	Id           *string                `json:"Id"`
 	ParentLabels map[string]interface{} `json:"ParentLabels"`
@@ -63,17 +154,104 @@ type ConfigFooTypeABC struct {
 	FooF         *string                `json:"FooF"`
 }
 
+type ConfigFooTypeABCConnection struct {
+	Edges      []*ConfigFooTypeABCEdge `json:"Edges"`
+	PageInfo   *PageInfo               `json:"PageInfo"`
+	TotalCount int                     `json:"TotalCount"`
+}
+
+type ConfigFooTypeABCEdge struct {
+	Cursor string            `json:"Cursor"`
+	Node   *ConfigFooTypeABC `json:"Node"`
+}
+
+type ConfigFooTypeABCEvent struct {
+	Type   NexusEventType    `json:"Type"`
+	Object *ConfigFooTypeABC `json:"Object"`
+}
+
+type ConfigFooTypeABCFilter struct {
+	Id *string `json:"Id"`
+}
+
+type ConfigFooTypeABCInput struct {
+	FooA *string `json:"FooA"`
+	FooB *string `json:"FooB"`
+	FooD *string `json:"FooD"`
+	FooF *string `json:"FooF"`
+}
+
+type ConfigFooTypeABCOrderBy struct {
+	Field     ConfigFooTypeABCOrderField `json:"Field"`
+	Direction *OrderDirection            `json:"Direction"`
+}
+
 type GnsBarChild struct {
 	Id           *string                `json:"Id"`
 	ParentLabels map[string]interface{} `json:"ParentLabels"`
 	Name         *string                `json:"Name"`
 }
 
+type GnsBarChildConnection struct {
+	Edges      []*GnsBarChildEdge `json:"Edges"`
+	PageInfo   *PageInfo          `json:"PageInfo"`
+	TotalCount int                `json:"TotalCount"`
+}
+
+type GnsBarChildEdge struct {
+	Cursor string       `json:"Cursor"`
+	Node   *GnsBarChild `json:"Node"`
+}
+
+type GnsBarChildEvent struct {
+	Type   NexusEventType `json:"Type"`
+	Object *GnsBarChild   `json:"Object"`
+}
+
+type GnsBarChildFilter struct {
+	Id   *string `json:"Id"`
+	Name *string `json:"Name"`
+}
+
+type GnsBarChildInput struct {
+	Name *string `json:"Name"`
+}
+
+type GnsBarChildOrderBy struct {
+	Field     GnsBarChildOrderField `json:"Field"`
+	Direction *OrderDirection       `json:"Direction"`
+}
+
 type GnsDns struct {
 	Id           *string                `json:"Id"`
 	ParentLabels map[string]interface{} `json:"ParentLabels"`
 }
 
+type GnsDnsConnection struct {
+	Edges      []*GnsDnsEdge `json:"Edges"`
+	PageInfo   *PageInfo     `json:"PageInfo"`
+	TotalCount int           `json:"TotalCount"`
+}
+
+type GnsDnsEdge struct {
+	Cursor string  `json:"Cursor"`
+	Node   *GnsDns `json:"Node"`
+}
+
+type GnsDnsEvent struct {
+	Type   NexusEventType `json:"Type"`
+	Object *GnsDns        `json:"Object"`
+}
+
+type GnsDnsFilter struct {
+	Id *string `json:"Id"`
+}
+
+type GnsDnsOrderBy struct {
+	Field     GnsDnsOrderField `json:"Field"`
+	Direction *OrderDirection  `json:"Direction"`
+}
+
 type GnsGns struct {
 	Id                       *string                       `json:"Id"`
 	ParentLabels             map[string]interface{}        `json:"ParentLabels"`
@@ -101,12 +279,90 @@ type GnsGns struct {
 	FooChild                 *GnsBarChild                  `json:"FooChild"`
 }
 
+type GnsGnsConnection struct {
+	Edges      []*GnsGnsEdge `json:"Edges"`
+	PageInfo   *PageInfo     `json:"PageInfo"`
+	TotalCount int           `json:"TotalCount"`
+}
+
+type GnsGnsEdge struct {
+	Cursor string  `json:"Cursor"`
+	Node   *GnsGns `json:"Node"`
+}
+
+type GnsGnsEvent struct {
+	Type   NexusEventType `json:"Type"`
+	Object *GnsGns        `json:"Object"`
+}
+
+type GnsGnsFilter struct {
+	Id               *string `json:"Id"`
+	Domain           *string `json:"Domain"`
+	UseSharedGateway *bool   `json:"UseSharedGateway"`
+	Meta             *string `json:"Meta"`
+}
+
+type GnsGnsInput struct {
+	Domain                   *string `json:"Domain"`
+	UseSharedGateway         *bool   `json:"UseSharedGateway"`
+	Annotations              *string `json:"Annotations"`
+	TargetPort               *string `json:"TargetPort"`
+	Description              *string `json:"Description"`
+	Meta                     *string `json:"Meta"`
+	IntOrString              *string `json:"IntOrString"`
+	Port                     *int    `json:"Port"`
+	OtherDescription         *string `json:"OtherDescription"`
+	MapPointer               *string `json:"MapPointer"`
+	SlicePointer             *string `json:"SlicePointer"`
+	WorkloadSpec             *string `json:"WorkloadSpec"`
+	DifferentSpec            *string `json:"DifferentSpec"`
+	ServiceSegmentRef        *string `json:"ServiceSegmentRef"`
+	ServiceSegmentRefPointer *string `json:"ServiceSegmentRefPointer"`
+	ServiceSegmentRefs       *string `json:"ServiceSegmentRefs"`
+	ServiceSegmentRefMap     *string `json:"ServiceSegmentRefMap"`
+}
+
+type GnsGnsOrderBy struct {
+	Field     GnsGnsOrderField `json:"Field"`
+	Direction *OrderDirection  `json:"Direction"`
+}
+
 type GnsIgnoreChild struct {
 	Id           *string                `json:"Id"`
 	ParentLabels map[string]interface{} `json:"ParentLabels"`
 	Name         *string                `json:"Name"`
 }
 
+type GnsIgnoreChildConnection struct {
+	Edges      []*GnsIgnoreChildEdge `json:"Edges"`
+	PageInfo   *PageInfo             `json:"PageInfo"`
+	TotalCount int                   `json:"TotalCount"`
+}
+
+type GnsIgnoreChildEdge struct {
+	Cursor string          `json:"Cursor"`
+	Node   *GnsIgnoreChild `json:"Node"`
+}
+
+type GnsIgnoreChildEvent struct {
+	Type   NexusEventType  `json:"Type"`
+	Object *GnsIgnoreChild `json:"Object"`
+}
+
+type GnsIgnoreChildFilter struct {
+	Id   *string `json:"Id"`
+	Name *string `json:"Name"`
+}
+
+type GnsIgnoreChildInput struct {
+	Name *string `json:"Name"`
+}
+
+type GnsIgnoreChildOrderBy struct {
+	Field     GnsIgnoreChildOrderField `json:"Field"`
+	Direction *OrderDirection          `json:"Direction"`
+}
+
 type PolicypkgACPConfig struct {
 	Id           *string                `json:"Id"`
 	ParentLabels map[string]interface{} `json:"ParentLabels"`
@@ -118,10 +374,74 @@ type PolicypkgACPConfig struct {
 	Conditions   *string                `json:"Conditions"`
 }
 
+type PolicypkgACPConfigConnection struct {
+	Edges      []*PolicypkgACPConfigEdge `json:"Edges"`
+	PageInfo   *PageInfo                 `json:"PageInfo"`
+	TotalCount int                       `json:"TotalCount"`
+}
+
+type PolicypkgACPConfigEdge struct {
+	Cursor string              `json:"Cursor"`
+	Node   *PolicypkgACPConfig `json:"Node"`
+}
+
+type PolicypkgACPConfigEvent struct {
+	Type   NexusEventType      `json:"Type"`
+	Object *PolicypkgACPConfig `json:"Object"`
+}
+
+type PolicypkgACPConfigFilter struct {
+	Id          *string `json:"Id"`
+	DisplayName *string `json:"DisplayName"`
+	Gns         *string `json:"Gns"`
+	Description *string `json:"Description"`
+	ProjectId   *string `json:"ProjectId"`
+}
+
+type PolicypkgACPConfigInput struct {
+	DisplayName *string `json:"DisplayName"`
+	Gns         *string `json:"Gns"`
+	Description *string `json:"Description"`
+	Tags        *string `json:"Tags"`
+	ProjectId   *string `json:"ProjectId"`
+	Conditions  *string `json:"Conditions"`
+}
+
+type PolicypkgACPConfigOrderBy struct {
+	Field     PolicypkgACPConfigOrderField `json:"Field"`
+	Direction *OrderDirection              `json:"Direction"`
+}
+
 type PolicypkgAccessControlPolicy struct {
-	Id            *string                `json:"Id"`
-	ParentLabels  map[string]interface{} `json:"ParentLabels"`
-	PolicyConfigs []*PolicypkgACPConfig  `json:"PolicyConfigs"`
+	Id                      *string                       `json:"Id"`
+	ParentLabels            map[string]interface{}        `json:"ParentLabels"`
+	PolicyConfigs           []*PolicypkgACPConfig         `json:"PolicyConfigs"`
+	PolicyConfigsConnection *PolicypkgACPConfigConnection `json:"PolicyConfigsConnection"`
+}
+
+type PolicypkgAccessControlPolicyConnection struct {
+	Edges      []*PolicypkgAccessControlPolicyEdge `json:"Edges"`
+	PageInfo   *PageInfo                           `json:"PageInfo"`
+	TotalCount int                                 `json:"TotalCount"`
+}
+
+type PolicypkgAccessControlPolicyEdge struct {
+	Cursor string                        `json:"Cursor"`
+	Node   *PolicypkgAccessControlPolicy `json:"Node"`
+}
+
+type PolicypkgAccessControlPolicyEvent struct {
+	Type   NexusEventType                `json:"Type"`
+	Object *PolicypkgAccessControlPolicy `json:"Object"`
+}
+
+type PolicypkgAccessControlPolicyFilter struct {
+	Id *string `json:"Id"`
+}
+
+type PolicypkgAccessControlPolicyOrderBy struct {
+	Field     PolicypkgAccessControlPolicyOrderField `json:"Field"`
+	Direction *OrderDirection                        `json:"Direction"`
 }
 
 type PolicypkgVMpolicy struct {
@@ -132,12 +452,62 @@ type PolicypkgVMpolicy struct {
 	queryGnsQM   *TimeSeriesData        `json:"queryGnsQM"`
 }
 
+type PolicypkgVMpolicyConnection struct {
+	Edges      []*PolicypkgVMpolicyEdge `json:"Edges"`
+	PageInfo   *PageInfo                `json:"PageInfo"`
+	TotalCount int                      `json:"TotalCount"`
+}
+
+type PolicypkgVMpolicyEdge struct {
+	Cursor string             `json:"Cursor"`
+	Node   *PolicypkgVMpolicy `json:"Node"`
+}
+
+type PolicypkgVMpolicyEvent struct {
+	Type   NexusEventType     `json:"Type"`
+	Object *PolicypkgVMpolicy `json:"Object"`
+}
+
+type PolicypkgVMpolicyFilter struct {
+	Id *string `json:"Id"`
+}
+
+type PolicypkgVMpolicyOrderBy struct {
+	Field     PolicypkgVMpolicyOrderField `json:"Field"`
+	Direction *OrderDirection             `json:"Direction"`
+}
+
 type RootRoot struct {
 	Id           *string                `json:"Id"`
 	ParentLabels map[string]interface{} `json:"ParentLabels"`
 	Config       *ConfigConfig          `json:"Config"`
 }
 
+type RootRootConnection struct {
+	Edges      []*RootRootEdge `json:"Edges"`
+	PageInfo   *PageInfo       `json:"PageInfo"`
+	TotalCount int             `json:"TotalCount"`
+}
+
+type RootRootEdge struct {
+	Cursor string    `json:"Cursor"`
+	Node   *RootRoot `json:"Node"`
+}
+
+type RootRootEvent struct {
+	Type   NexusEventType `json:"Type"`
+	Object *RootRoot      `json:"Object"`
+}
+
+type RootRootFilter struct {
+	Id *string `json:"Id"`
+}
+
+type RootRootOrderBy struct {
+	Field     RootRootOrderField `json:"Field"`
+	Direction *OrderDirection    `json:"Direction"`
+}
+
 type ServicegroupSvcGroupLinkInfo struct {
 	Id           *string                `json:"Id"`
 	ParentLabels map[string]interface{} `json:"ParentLabels"`
@@ -146,3 +516,621 @@ type ServicegroupSvcGroupLinkInfo struct {
 	ServiceName  *string                `json:"ServiceName"`
 	ServiceType  *string                `json:"ServiceType"`
 }
+
+type ServicegroupSvcGroupLinkInfoConnection struct {
+	Edges      []*ServicegroupSvcGroupLinkInfoEdge `json:"Edges"`
+	PageInfo   *PageInfo                           `json:"PageInfo"`
+	TotalCount int                                 `json:"TotalCount"`
+}
+
+type ServicegroupSvcGroupLinkInfoEdge struct {
+	Cursor string                        `json:"Cursor"`
+	Node   *ServicegroupSvcGroupLinkInfo `json:"Node"`
+}
+
+type ServicegroupSvcGroupLinkInfoEvent struct {
+	Type   NexusEventType                `json:"Type"`
+	Object *ServicegroupSvcGroupLinkInfo `json:"Object"`
+}
+
+type ServicegroupSvcGroupLinkInfoFilter struct {
+	Id          *string `json:"Id"`
+	ClusterName *string `json:"ClusterName"`
+	DomainName  *string `json:"DomainName"`
+	ServiceName *string `json:"ServiceName"`
+	ServiceType *string `json:"ServiceType"`
+}
+
+type ServicegroupSvcGroupLinkInfoInput struct {
+	ClusterName *string `json:"ClusterName"`
+	DomainName  *string `json:"DomainName"`
+	ServiceName *string `json:"ServiceName"`
+	ServiceType *string `json:"ServiceType"`
+}
+
+type ServicegroupSvcGroupLinkInfoOrderBy struct {
+	Field     ServicegroupSvcGroupLinkInfoOrderField `json:"Field"`
+	Direction *OrderDirection                        `json:"Direction"`
+}
+
+type NexusEventType string
+
+const (
+	NexusEventTypeAdded   NexusEventType = "Added"
+	NexusEventTypeUpdated NexusEventType = "Updated"
+	NexusEventTypeDeleted NexusEventType = "Deleted"
+)
+
+var AllNexusEventType = []NexusEventType{
+	NexusEventTypeAdded,
+	NexusEventTypeUpdated,
+	NexusEventTypeDeleted,
+}
+
+func (e NexusEventType) IsValid() bool {
+	switch e {
+	case NexusEventTypeAdded, NexusEventTypeUpdated, NexusEventTypeDeleted:
+		return true
+	}
+	return false
+}
+
+func (e NexusEventType) String() string {
+	return string(e)
+}
+
+func (e *NexusEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NexusEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NexusEventType", str)
+	}
+	return nil
+}
+
+func (e NexusEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrderDirection string
+
+const (
+	OrderDirectionASC  OrderDirection = "ASC"
+	OrderDirectionDESC OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionASC,
+	OrderDirectionDESC,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionASC, OrderDirectionDESC:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ConfigConfigOrderField string
+
+const (
+	ConfigConfigOrderFieldId       ConfigConfigOrderField = "Id"
+	ConfigConfigOrderFieldInstance ConfigConfigOrderField = "Instance"
+	ConfigConfigOrderFieldCuOption ConfigConfigOrderField = "CuOption"
+)
+
+var AllConfigConfigOrderField = []ConfigConfigOrderField{
+	ConfigConfigOrderFieldId,
+	ConfigConfigOrderFieldInstance,
+	ConfigConfigOrderFieldCuOption,
+}
+
+func (e ConfigConfigOrderField) IsValid() bool {
+	switch e {
+	case ConfigConfigOrderFieldId, ConfigConfigOrderFieldInstance, ConfigConfigOrderFieldCuOption:
+		return true
+	}
+	return false
+}
+
+func (e ConfigConfigOrderField) String() string {
+	return string(e)
+}
+
+func (e *ConfigConfigOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConfigConfigOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid config_ConfigOrderField", str)
+	}
+	return nil
+}
+
+func (e ConfigConfigOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ConfigDomainOrderField string
+
+const (
+	ConfigDomainOrderFieldId ConfigDomainOrderField = "Id"
+)
+
+var AllConfigDomainOrderField = []ConfigDomainOrderField{
+	ConfigDomainOrderFieldId,
+}
+
+func (e ConfigDomainOrderField) IsValid() bool {
+	switch e {
+	case ConfigDomainOrderFieldId:
+		return true
+	}
+	return false
+}
+
+func (e ConfigDomainOrderField) String() string {
+	return string(e)
+}
+
+func (e *ConfigDomainOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConfigDomainOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid config_DomainOrderField", str)
+	}
+	return nil
+}
+
+func (e ConfigDomainOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ConfigFooTypeABCOrderField string
+
+const (
+	ConfigFooTypeABCOrderFieldId ConfigFooTypeABCOrderField = "Id"
+)
+
+var AllConfigFooTypeABCOrderField = []ConfigFooTypeABCOrderField{
+	ConfigFooTypeABCOrderFieldId,
+}
+
+func (e ConfigFooTypeABCOrderField) IsValid() bool {
+	switch e {
+	case ConfigFooTypeABCOrderFieldId:
+		return true
+	}
+	return false
+}
+
+func (e ConfigFooTypeABCOrderField) String() string {
+	return string(e)
+}
+
+func (e *ConfigFooTypeABCOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConfigFooTypeABCOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid config_FooTypeABCOrderField", str)
+	}
+	return nil
+}
+
+func (e ConfigFooTypeABCOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GnsBarChildOrderField string
+
+const (
+	GnsBarChildOrderFieldId   GnsBarChildOrderField = "Id"
+	GnsBarChildOrderFieldName GnsBarChildOrderField = "Name"
+)
+
+var AllGnsBarChildOrderField = []GnsBarChildOrderField{
+	GnsBarChildOrderFieldId,
+	GnsBarChildOrderFieldName,
+}
+
+func (e GnsBarChildOrderField) IsValid() bool {
+	switch e {
+	case GnsBarChildOrderFieldId, GnsBarChildOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e GnsBarChildOrderField) String() string {
+	return string(e)
+}
+
+func (e *GnsBarChildOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GnsBarChildOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid gns_BarChildOrderField", str)
+	}
+	return nil
+}
+
+func (e GnsBarChildOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GnsDnsOrderField string
+
+const (
+	GnsDnsOrderFieldId GnsDnsOrderField = "Id"
+)
+
+var AllGnsDnsOrderField = []GnsDnsOrderField{
+	GnsDnsOrderFieldId,
+}
+
+func (e GnsDnsOrderField) IsValid() bool {
+	switch e {
+	case GnsDnsOrderFieldId:
+		return true
+	}
+	return false
+}
+
+func (e GnsDnsOrderField) String() string {
+	return string(e)
+}
+
+func (e *GnsDnsOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GnsDnsOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid gns_DnsOrderField", str)
+	}
+	return nil
+}
+
+func (e GnsDnsOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GnsGnsOrderField string
+
+const (
+	GnsGnsOrderFieldId               GnsGnsOrderField = "Id"
+	GnsGnsOrderFieldDomain           GnsGnsOrderField = "Domain"
+	GnsGnsOrderFieldUseSharedGateway GnsGnsOrderField = "UseSharedGateway"
+	GnsGnsOrderFieldMeta             GnsGnsOrderField = "Meta"
+)
+
+var AllGnsGnsOrderField = []GnsGnsOrderField{
+	GnsGnsOrderFieldId,
+	GnsGnsOrderFieldDomain,
+	GnsGnsOrderFieldUseSharedGateway,
+	GnsGnsOrderFieldMeta,
+}
+
+func (e GnsGnsOrderField) IsValid() bool {
+	switch e {
+	case GnsGnsOrderFieldId, GnsGnsOrderFieldDomain, GnsGnsOrderFieldUseSharedGateway, GnsGnsOrderFieldMeta:
+		return true
+	}
+	return false
+}
+
+func (e GnsGnsOrderField) String() string {
+	return string(e)
+}
+
+func (e *GnsGnsOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GnsGnsOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid gns_GnsOrderField", str)
+	}
+	return nil
+}
+
+func (e GnsGnsOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GnsIgnoreChildOrderField string
+
+const (
+	GnsIgnoreChildOrderFieldId   GnsIgnoreChildOrderField = "Id"
+	GnsIgnoreChildOrderFieldName GnsIgnoreChildOrderField = "Name"
+)
+
+var AllGnsIgnoreChildOrderField = []GnsIgnoreChildOrderField{
+	GnsIgnoreChildOrderFieldId,
+	GnsIgnoreChildOrderFieldName,
+}
+
+func (e GnsIgnoreChildOrderField) IsValid() bool {
+	switch e {
+	case GnsIgnoreChildOrderFieldId, GnsIgnoreChildOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e GnsIgnoreChildOrderField) String() string {
+	return string(e)
+}
+
+func (e *GnsIgnoreChildOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GnsIgnoreChildOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid gns_IgnoreChildOrderField", str)
+	}
+	return nil
+}
+
+func (e GnsIgnoreChildOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PolicypkgACPConfigOrderField string
+
+const (
+	PolicypkgACPConfigOrderFieldId          PolicypkgACPConfigOrderField = "Id"
+	PolicypkgACPConfigOrderFieldDisplayName PolicypkgACPConfigOrderField = "DisplayName"
+	PolicypkgACPConfigOrderFieldGns         PolicypkgACPConfigOrderField = "Gns"
+	PolicypkgACPConfigOrderFieldDescription PolicypkgACPConfigOrderField = "Description"
+	PolicypkgACPConfigOrderFieldProjectId   PolicypkgACPConfigOrderField = "ProjectId"
+)
+
+var AllPolicypkgACPConfigOrderField = []PolicypkgACPConfigOrderField{
+	PolicypkgACPConfigOrderFieldId,
+	PolicypkgACPConfigOrderFieldDisplayName,
+	PolicypkgACPConfigOrderFieldGns,
+	PolicypkgACPConfigOrderFieldDescription,
+	PolicypkgACPConfigOrderFieldProjectId,
+}
+
+func (e PolicypkgACPConfigOrderField) IsValid() bool {
+	switch e {
+	case PolicypkgACPConfigOrderFieldId, PolicypkgACPConfigOrderFieldDisplayName, PolicypkgACPConfigOrderFieldGns, PolicypkgACPConfigOrderFieldDescription, PolicypkgACPConfigOrderFieldProjectId:
+		return true
+	}
+	return false
+}
+
+func (e PolicypkgACPConfigOrderField) String() string {
+	return string(e)
+}
+
+func (e *PolicypkgACPConfigOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicypkgACPConfigOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid policypkg_ACPConfigOrderField", str)
+	}
+	return nil
+}
+
+func (e PolicypkgACPConfigOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PolicypkgAccessControlPolicyOrderField string
+
+const (
+	PolicypkgAccessControlPolicyOrderFieldId PolicypkgAccessControlPolicyOrderField = "Id"
+)
+
+var AllPolicypkgAccessControlPolicyOrderField = []PolicypkgAccessControlPolicyOrderField{
+	PolicypkgAccessControlPolicyOrderFieldId,
+}
+
+func (e PolicypkgAccessControlPolicyOrderField) IsValid() bool {
+	switch e {
+	case PolicypkgAccessControlPolicyOrderFieldId:
+		return true
+	}
+	return false
+}
+
+func (e PolicypkgAccessControlPolicyOrderField) String() string {
+	return string(e)
+}
+
+func (e *PolicypkgAccessControlPolicyOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicypkgAccessControlPolicyOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid policypkg_AccessControlPolicyOrderField", str)
+	}
+	return nil
+}
+
+func (e PolicypkgAccessControlPolicyOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PolicypkgVMpolicyOrderField string
+
+const (
+	PolicypkgVMpolicyOrderFieldId PolicypkgVMpolicyOrderField = "Id"
+)
+
+var AllPolicypkgVMpolicyOrderField = []PolicypkgVMpolicyOrderField{
+	PolicypkgVMpolicyOrderFieldId,
+}
+
+func (e PolicypkgVMpolicyOrderField) IsValid() bool {
+	switch e {
+	case PolicypkgVMpolicyOrderFieldId:
+		return true
+	}
+	return false
+}
+
+func (e PolicypkgVMpolicyOrderField) String() string {
+	return string(e)
+}
+
+func (e *PolicypkgVMpolicyOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicypkgVMpolicyOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid policypkg_VMpolicyOrderField", str)
+	}
+	return nil
+}
+
+func (e PolicypkgVMpolicyOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RootRootOrderField string
+
+const (
+	RootRootOrderFieldId RootRootOrderField = "Id"
+)
+
+var AllRootRootOrderField = []RootRootOrderField{
+	RootRootOrderFieldId,
+}
+
+func (e RootRootOrderField) IsValid() bool {
+	switch e {
+	case RootRootOrderFieldId:
+		return true
+	}
+	return false
+}
+
+func (e RootRootOrderField) String() string {
+	return string(e)
+}
+
+func (e *RootRootOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RootRootOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid root_RootOrderField", str)
+	}
+	return nil
+}
+
+func (e RootRootOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ServicegroupSvcGroupLinkInfoOrderField string
+
+const (
+	ServicegroupSvcGroupLinkInfoOrderFieldId          ServicegroupSvcGroupLinkInfoOrderField = "Id"
+	ServicegroupSvcGroupLinkInfoOrderFieldClusterName ServicegroupSvcGroupLinkInfoOrderField = "ClusterName"
+	ServicegroupSvcGroupLinkInfoOrderFieldDomainName  ServicegroupSvcGroupLinkInfoOrderField = "DomainName"
+	ServicegroupSvcGroupLinkInfoOrderFieldServiceName ServicegroupSvcGroupLinkInfoOrderField = "ServiceName"
+	ServicegroupSvcGroupLinkInfoOrderFieldServiceType ServicegroupSvcGroupLinkInfoOrderField = "ServiceType"
+)
+
+var AllServicegroupSvcGroupLinkInfoOrderField = []ServicegroupSvcGroupLinkInfoOrderField{
+	ServicegroupSvcGroupLinkInfoOrderFieldId,
+	ServicegroupSvcGroupLinkInfoOrderFieldClusterName,
+	ServicegroupSvcGroupLinkInfoOrderFieldDomainName,
+	ServicegroupSvcGroupLinkInfoOrderFieldServiceName,
+	ServicegroupSvcGroupLinkInfoOrderFieldServiceType,
+}
+
+func (e ServicegroupSvcGroupLinkInfoOrderField) IsValid() bool {
+	switch e {
+	case ServicegroupSvcGroupLinkInfoOrderFieldId, ServicegroupSvcGroupLinkInfoOrderFieldClusterName, ServicegroupSvcGroupLinkInfoOrderFieldDomainName, ServicegroupSvcGroupLinkInfoOrderFieldServiceName, ServicegroupSvcGroupLinkInfoOrderFieldServiceType:
+		return true
+	}
+	return false
+}
+
+func (e ServicegroupSvcGroupLinkInfoOrderField) String() string {
+	return string(e)
+}
+
+func (e *ServicegroupSvcGroupLinkInfoOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ServicegroupSvcGroupLinkInfoOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid servicegroup_SvcGroupLinkInfoOrderField", str)
+	}
+	return nil
+}
+
+func (e ServicegroupSvcGroupLinkInfoOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
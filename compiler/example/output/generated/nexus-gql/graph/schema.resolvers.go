@@ -5,108 +5,370 @@ package graph
 
 import (
 	"context"
+
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/nexus-gql/graph/generated"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/nexus-gql/graph/model"
 )
 
+// CreateRootRoot is the resolver for the createRootRoot field.
+func (r *mutationResolver) CreateRootRoot(ctx context.Context, parentLabels map[string]interface{}) (*model.RootRoot, error) {
+	return getCreateRootRootResolver(ctx, parentLabels)
+}
+
+// DeleteRootRoot is the resolver for the deleteRootRoot field.
+func (r *mutationResolver) DeleteRootRoot(ctx context.Context, parentLabels map[string]interface{}) (bool, error) {
+	return getDeleteRootRootResolver(ctx, parentLabels)
+}
+
+// CreateConfigConfig is the resolver for the createConfigConfig field.
+func (r *mutationResolver) CreateConfigConfig(ctx context.Context, parentLabels map[string]interface{}, id string, input *model.ConfigConfigInput) (*model.ConfigConfig, error) {
+	return getCreateConfigConfigResolver(ctx, parentLabels, id, input)
+}
+
+// UpdateConfigConfig is the resolver for the updateConfigConfig field.
+func (r *mutationResolver) UpdateConfigConfig(ctx context.Context, parentLabels map[string]interface{}, id string, input model.ConfigConfigInput) (*model.ConfigConfig, error) {
+	return getUpdateConfigConfigResolver(ctx, parentLabels, id, input)
+}
+
+// DeleteConfigConfig is the resolver for the deleteConfigConfig field.
+func (r *mutationResolver) DeleteConfigConfig(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeleteConfigConfigResolver(ctx, parentLabels, id)
+}
+
+// LinkConfigConfigACPPolicies is the resolver for the linkConfigConfigACPPolicies field.
+func (r *mutationResolver) LinkConfigConfigACPPolicies(ctx context.Context, parentLabels map[string]interface{}, id string, linkParentLabels map[string]interface{}, linkId string) (*model.ConfigConfig, error) {
+	return getLinkConfigConfigACPPoliciesResolver(ctx, parentLabels, id, linkParentLabels, linkId)
+}
+
+// UnlinkConfigConfigACPPolicies is the resolver for the unlinkConfigConfigACPPolicies field.
+func (r *mutationResolver) UnlinkConfigConfigACPPolicies(ctx context.Context, parentLabels map[string]interface{}, id string, linkParentLabels map[string]interface{}, linkId string) (*model.ConfigConfig, error) {
+	return getUnlinkConfigConfigACPPoliciesResolver(ctx, parentLabels, id, linkParentLabels, linkId)
+}
+
+// CreateConfigFooTypeABC is the resolver for the createConfigFooTypeABC field.
+func (r *mutationResolver) CreateConfigFooTypeABC(ctx context.Context, parentLabels map[string]interface{}, id string, input *model.ConfigFooTypeABCInput) (*model.ConfigFooTypeABC, error) {
+	return getCreateConfigFooTypeABCResolver(ctx, parentLabels, id, input)
+}
+
+// UpdateConfigFooTypeABC is the resolver for the updateConfigFooTypeABC field.
+func (r *mutationResolver) UpdateConfigFooTypeABC(ctx context.Context, parentLabels map[string]interface{}, id string, input model.ConfigFooTypeABCInput) (*model.ConfigFooTypeABC, error) {
+	return getUpdateConfigFooTypeABCResolver(ctx, parentLabels, id, input)
+}
+
+// DeleteConfigFooTypeABC is the resolver for the deleteConfigFooTypeABC field.
+func (r *mutationResolver) DeleteConfigFooTypeABC(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeleteConfigFooTypeABCResolver(ctx, parentLabels, id)
+}
+
+// CreateConfigDomain is the resolver for the createConfigDomain field.
+func (r *mutationResolver) CreateConfigDomain(ctx context.Context, parentLabels map[string]interface{}, id string, input *model.ConfigDomainInput) (*model.ConfigDomain, error) {
+	return getCreateConfigDomainResolver(ctx, parentLabels, id, input)
+}
+
+// UpdateConfigDomain is the resolver for the updateConfigDomain field.
+func (r *mutationResolver) UpdateConfigDomain(ctx context.Context, parentLabels map[string]interface{}, id string, input model.ConfigDomainInput) (*model.ConfigDomain, error) {
+	return getUpdateConfigDomainResolver(ctx, parentLabels, id, input)
+}
+
+// DeleteConfigDomain is the resolver for the deleteConfigDomain field.
+func (r *mutationResolver) DeleteConfigDomain(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeleteConfigDomainResolver(ctx, parentLabels, id)
+}
+
+// CreateGnsGns is the resolver for the createGnsGns field.
+func (r *mutationResolver) CreateGnsGns(ctx context.Context, parentLabels map[string]interface{}, id string, input *model.GnsGnsInput) (*model.GnsGns, error) {
+	return getCreateGnsGnsResolver(ctx, parentLabels, id, input)
+}
+
+// UpdateGnsGns is the resolver for the updateGnsGns field.
+func (r *mutationResolver) UpdateGnsGns(ctx context.Context, parentLabels map[string]interface{}, id string, input model.GnsGnsInput) (*model.GnsGns, error) {
+	return getUpdateGnsGnsResolver(ctx, parentLabels, id, input)
+}
+
+// DeleteGnsGns is the resolver for the deleteGnsGns field.
+func (r *mutationResolver) DeleteGnsGns(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeleteGnsGnsResolver(ctx, parentLabels, id)
+}
+
+// SetGnsGnsState is the resolver for the setGnsGnsState field.
+func (r *mutationResolver) SetGnsGnsState(ctx context.Context, parentLabels map[string]interface{}, id string, status string) (*model.GnsGns, error) {
+	return getSetGnsGnsStateResolver(ctx, parentLabels, id, status)
+}
+
+// CreateGnsBarChild is the resolver for the createGnsBarChild field.
+func (r *mutationResolver) CreateGnsBarChild(ctx context.Context, parentLabels map[string]interface{}, input *model.GnsBarChildInput) (*model.GnsBarChild, error) {
+	return getCreateGnsBarChildResolver(ctx, parentLabels, input)
+}
+
+// UpdateGnsBarChild is the resolver for the updateGnsBarChild field.
+func (r *mutationResolver) UpdateGnsBarChild(ctx context.Context, parentLabels map[string]interface{}, input model.GnsBarChildInput) (*model.GnsBarChild, error) {
+	return getUpdateGnsBarChildResolver(ctx, parentLabels, input)
+}
+
+// DeleteGnsBarChild is the resolver for the deleteGnsBarChild field.
+func (r *mutationResolver) DeleteGnsBarChild(ctx context.Context, parentLabels map[string]interface{}) (bool, error) {
+	return getDeleteGnsBarChildResolver(ctx, parentLabels)
+}
+
+// CreateGnsIgnoreChild is the resolver for the createGnsIgnoreChild field.
+func (r *mutationResolver) CreateGnsIgnoreChild(ctx context.Context, parentLabels map[string]interface{}, id string, input *model.GnsIgnoreChildInput) (*model.GnsIgnoreChild, error) {
+	return getCreateGnsIgnoreChildResolver(ctx, parentLabels, id, input)
+}
+
+// UpdateGnsIgnoreChild is the resolver for the updateGnsIgnoreChild field.
+func (r *mutationResolver) UpdateGnsIgnoreChild(ctx context.Context, parentLabels map[string]interface{}, id string, input model.GnsIgnoreChildInput) (*model.GnsIgnoreChild, error) {
+	return getUpdateGnsIgnoreChildResolver(ctx, parentLabels, id, input)
+}
+
+// DeleteGnsIgnoreChild is the resolver for the deleteGnsIgnoreChild field.
+func (r *mutationResolver) DeleteGnsIgnoreChild(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeleteGnsIgnoreChildResolver(ctx, parentLabels, id)
+}
+
+// CreateGnsDns is the resolver for the createGnsDns field.
+func (r *mutationResolver) CreateGnsDns(ctx context.Context, parentLabels map[string]interface{}) (*model.GnsDns, error) {
+	return getCreateGnsDnsResolver(ctx, parentLabels)
+}
+
+// DeleteGnsDns is the resolver for the deleteGnsDns field.
+func (r *mutationResolver) DeleteGnsDns(ctx context.Context, parentLabels map[string]interface{}) (bool, error) {
+	return getDeleteGnsDnsResolver(ctx, parentLabels)
+}
+
+// CreateServicegroupSvcGroupLinkInfo is the resolver for the createServicegroupSvcGroupLinkInfo field.
+func (r *mutationResolver) CreateServicegroupSvcGroupLinkInfo(ctx context.Context, parentLabels map[string]interface{}, id string, input *model.ServicegroupSvcGroupLinkInfoInput) (*model.ServicegroupSvcGroupLinkInfo, error) {
+	return getCreateServicegroupSvcGroupLinkInfoResolver(ctx, parentLabels, id, input)
+}
+
+// UpdateServicegroupSvcGroupLinkInfo is the resolver for the updateServicegroupSvcGroupLinkInfo field.
+func (r *mutationResolver) UpdateServicegroupSvcGroupLinkInfo(ctx context.Context, parentLabels map[string]interface{}, id string, input model.ServicegroupSvcGroupLinkInfoInput) (*model.ServicegroupSvcGroupLinkInfo, error) {
+	return getUpdateServicegroupSvcGroupLinkInfoResolver(ctx, parentLabels, id, input)
+}
+
+// DeleteServicegroupSvcGroupLinkInfo is the resolver for the deleteServicegroupSvcGroupLinkInfo field.
+func (r *mutationResolver) DeleteServicegroupSvcGroupLinkInfo(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeleteServicegroupSvcGroupLinkInfoResolver(ctx, parentLabels, id)
+}
+
+// CreatePolicypkgAccessControlPolicy is the resolver for the createPolicypkgAccessControlPolicy field.
+func (r *mutationResolver) CreatePolicypkgAccessControlPolicy(ctx context.Context, parentLabels map[string]interface{}, id string) (*model.PolicypkgAccessControlPolicy, error) {
+	return getCreatePolicypkgAccessControlPolicyResolver(ctx, parentLabels, id)
+}
+
+// DeletePolicypkgAccessControlPolicy is the resolver for the deletePolicypkgAccessControlPolicy field.
+func (r *mutationResolver) DeletePolicypkgAccessControlPolicy(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeletePolicypkgAccessControlPolicyResolver(ctx, parentLabels, id)
+}
+
+// CreatePolicypkgACPConfig is the resolver for the createPolicypkgACPConfig field.
+func (r *mutationResolver) CreatePolicypkgACPConfig(ctx context.Context, parentLabels map[string]interface{}, id string, input *model.PolicypkgACPConfigInput) (*model.PolicypkgACPConfig, error) {
+	return getCreatePolicypkgACPConfigResolver(ctx, parentLabels, id, input)
+}
+
+// UpdatePolicypkgACPConfig is the resolver for the updatePolicypkgACPConfig field.
+func (r *mutationResolver) UpdatePolicypkgACPConfig(ctx context.Context, parentLabels map[string]interface{}, id string, input model.PolicypkgACPConfigInput) (*model.PolicypkgACPConfig, error) {
+	return getUpdatePolicypkgACPConfigResolver(ctx, parentLabels, id, input)
+}
+
+// DeletePolicypkgACPConfig is the resolver for the deletePolicypkgACPConfig field.
+func (r *mutationResolver) DeletePolicypkgACPConfig(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeletePolicypkgACPConfigResolver(ctx, parentLabels, id)
+}
+
+// SetPolicypkgACPConfigStatus is the resolver for the setPolicypkgACPConfigStatus field.
+func (r *mutationResolver) SetPolicypkgACPConfigStatus(ctx context.Context, parentLabels map[string]interface{}, id string, status string) (*model.PolicypkgACPConfig, error) {
+	return getSetPolicypkgACPConfigStatusResolver(ctx, parentLabels, id, status)
+}
+
+// CreatePolicypkgVMpolicy is the resolver for the createPolicypkgVMpolicy field.
+func (r *mutationResolver) CreatePolicypkgVMpolicy(ctx context.Context, parentLabels map[string]interface{}, id string) (*model.PolicypkgVMpolicy, error) {
+	return getCreatePolicypkgVMpolicyResolver(ctx, parentLabels, id)
+}
+
+// DeletePolicypkgVMpolicy is the resolver for the deletePolicypkgVMpolicy field.
+func (r *mutationResolver) DeletePolicypkgVMpolicy(ctx context.Context, parentLabels map[string]interface{}, id string) (bool, error) {
+	return getDeletePolicypkgVMpolicyResolver(ctx, parentLabels, id)
+}
+
 // Root is the resolver for the root field.
 func (r *queryResolver) Root(ctx context.Context) (*model.RootRoot, error) {
-	return getRootResolver()
+	return getRootResolver(ctx)
+}
+
+// WatchRootRoot is the resolver for the watchRootRoot field.
+func (r *subscriptionResolver) WatchRootRoot(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.RootRootEvent, error) {
+	return getWatchRootRootResolver(ctx, parentLabels)
+}
+
+// WatchConfigConfig is the resolver for the watchConfigConfig field.
+func (r *subscriptionResolver) WatchConfigConfig(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.ConfigConfigEvent, error) {
+	return getWatchConfigConfigResolver(ctx, parentLabels)
+}
+
+// WatchConfigFooTypeABC is the resolver for the watchConfigFooTypeABC field.
+func (r *subscriptionResolver) WatchConfigFooTypeABC(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.ConfigFooTypeABCEvent, error) {
+	return getWatchConfigFooTypeABCResolver(ctx, parentLabels)
+}
+
+// WatchConfigDomain is the resolver for the watchConfigDomain field.
+func (r *subscriptionResolver) WatchConfigDomain(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.ConfigDomainEvent, error) {
+	return getWatchConfigDomainResolver(ctx, parentLabels)
+}
+
+// WatchGnsGns is the resolver for the watchGnsGns field.
+func (r *subscriptionResolver) WatchGnsGns(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.GnsGnsEvent, error) {
+	return getWatchGnsGnsResolver(ctx, parentLabels)
+}
+
+// WatchGnsBarChild is the resolver for the watchGnsBarChild field.
+func (r *subscriptionResolver) WatchGnsBarChild(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.GnsBarChildEvent, error) {
+	return getWatchGnsBarChildResolver(ctx, parentLabels)
+}
+
+// WatchGnsIgnoreChild is the resolver for the watchGnsIgnoreChild field.
+func (r *subscriptionResolver) WatchGnsIgnoreChild(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.GnsIgnoreChildEvent, error) {
+	return getWatchGnsIgnoreChildResolver(ctx, parentLabels)
+}
+
+// WatchGnsDns is the resolver for the watchGnsDns field.
+func (r *subscriptionResolver) WatchGnsDns(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.GnsDnsEvent, error) {
+	return getWatchGnsDnsResolver(ctx, parentLabels)
+}
+
+// WatchServicegroupSvcGroupLinkInfo is the resolver for the watchServicegroupSvcGroupLinkInfo field.
+func (r *subscriptionResolver) WatchServicegroupSvcGroupLinkInfo(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.ServicegroupSvcGroupLinkInfoEvent, error) {
+	return getWatchServicegroupSvcGroupLinkInfoResolver(ctx, parentLabels)
+}
+
+// WatchPolicypkgAccessControlPolicy is the resolver for the watchPolicypkgAccessControlPolicy field.
+func (r *subscriptionResolver) WatchPolicypkgAccessControlPolicy(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.PolicypkgAccessControlPolicyEvent, error) {
+	return getWatchPolicypkgAccessControlPolicyResolver(ctx, parentLabels)
+}
+
+// WatchPolicypkgACPConfig is the resolver for the watchPolicypkgACPConfig field.
+func (r *subscriptionResolver) WatchPolicypkgACPConfig(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.PolicypkgACPConfigEvent, error) {
+	return getWatchPolicypkgACPConfigResolver(ctx, parentLabels)
+}
+
+// WatchPolicypkgVMpolicy is the resolver for the watchPolicypkgVMpolicy field.
+func (r *subscriptionResolver) WatchPolicypkgVMpolicy(ctx context.Context, parentLabels map[string]interface{}) (<-chan *model.PolicypkgVMpolicyEvent, error) {
+	return getWatchPolicypkgVMpolicyResolver(ctx, parentLabels)
 }
 
 // QueryExample is the resolver for the QueryExample field.
 func (r *config_ConfigResolver) QueryExample(ctx context.Context, obj *model.ConfigConfig, startTime *string, endTime *string, interval *string, isServiceDeployment *bool, startVal *int) (*model.NexusGraphqlResponse, error) {
-	return getConfigConfigQueryExampleResolver(obj, startTime, endTime, interval, isServiceDeployment, startVal)
+	return getConfigConfigQueryExampleResolver(ctx, obj, startTime, endTime, interval, isServiceDeployment, startVal)
 }
 
 // ACPPolicies is the resolver for the ACPPolicies field.
 func (r *config_ConfigResolver) ACPPolicies(ctx context.Context, obj *model.ConfigConfig, id *string) ([]*model.PolicypkgAccessControlPolicy, error) {
-	return getConfigConfigACPPoliciesResolver(obj, id)
+	return getConfigConfigACPPoliciesResolver(ctx, obj, id)
+}
+
+// ACPPoliciesConnection is the resolver for the ACPPoliciesConnection field.
+func (r *config_ConfigResolver) ACPPoliciesConnection(ctx context.Context, obj *model.ConfigConfig, first *int, after *string, filter *model.PolicypkgAccessControlPolicyFilter, orderBy []*model.PolicypkgAccessControlPolicyOrderBy) (*model.PolicypkgAccessControlPolicyConnection, error) {
+	return getConfigConfigACPPoliciesConnectionResolver(ctx, obj, first, after, filter, orderBy)
 }
 
 // FooExample is the resolver for the FooExample field.
 func (r *config_ConfigResolver) FooExample(ctx context.Context, obj *model.ConfigConfig, id *string) ([]*model.ConfigFooTypeABC, error) {
-	return getConfigConfigFooExampleResolver(obj, id)
+	return getConfigConfigFooExampleResolver(ctx, obj, id)
+}
+
+// FooExampleConnection is the resolver for the FooExampleConnection field.
+func (r *config_ConfigResolver) FooExampleConnection(ctx context.Context, obj *model.ConfigConfig, first *int, after *string, filter *model.ConfigFooTypeABCFilter, orderBy []*model.ConfigFooTypeABCOrderBy) (*model.ConfigFooTypeABCConnection, error) {
+	return getConfigConfigFooExampleConnectionResolver(ctx, obj, first, after, filter, orderBy)
 }
 
 // GNS is the resolver for the GNS field.
 func (r *config_ConfigResolver) GNS(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.GnsGns, error) {
-	return getConfigConfigGNSResolver(obj, id)
+	return getConfigConfigGNSResolver(ctx, obj, id)
 }
 
 // DNS is the resolver for the DNS field.
 func (r *config_ConfigResolver) DNS(ctx context.Context, obj *model.ConfigConfig) (*model.GnsDns, error) {
-	return getConfigConfigDNSResolver(obj)
+	return getConfigConfigDNSResolver(ctx, obj)
 }
 
 // VMPPolicies is the resolver for the VMPPolicies field.
 func (r *config_ConfigResolver) VMPPolicies(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.PolicypkgVMpolicy, error) {
-	return getConfigConfigVMPPoliciesResolver(obj, id)
+	return getConfigConfigVMPPoliciesResolver(ctx, obj, id)
 }
 
 // Domain is the resolver for the Domain field.
 func (r *config_ConfigResolver) Domain(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.ConfigDomain, error) {
-	return getConfigConfigDomainResolver(obj, id)
+	return getConfigConfigDomainResolver(ctx, obj, id)
 }
 
 // SvcGrpInfo is the resolver for the SvcGrpInfo field.
 func (r *config_ConfigResolver) SvcGrpInfo(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.ServicegroupSvcGroupLinkInfo, error) {
-	return getConfigConfigSvcGrpInfoResolver(obj, id)
+	return getConfigConfigSvcGrpInfoResolver(ctx, obj, id)
 }
 
 // QueryGns1 is the resolver for the queryGns1 field.
 func (r *gns_GnsResolver) QueryGns1(ctx context.Context, obj *model.GnsGns, startTime *string, endTime *string, interval *string, isServiceDeployment *bool, startVal *int) (*model.NexusGraphqlResponse, error) {
-	return getGnsGnsqueryGns1Resolver(obj, startTime, endTime, interval, isServiceDeployment, startVal)
+	return getGnsGnsqueryGns1Resolver(ctx, obj, startTime, endTime, interval, isServiceDeployment, startVal)
 }
 
 // QueryGnsQM1 is the resolver for the queryGnsQM1 field.
 func (r *gns_GnsResolver) QueryGnsQM1(ctx context.Context, obj *model.GnsGns) (*model.TimeSeriesData, error) {
-	return getGnsGnsqueryGnsQM1Resolver(obj)
+	return getGnsGnsqueryGnsQM1Resolver(ctx, obj)
 }
 
 // QueryGnsQM is the resolver for the queryGnsQM field.
 func (r *gns_GnsResolver) QueryGnsQM(ctx context.Context, obj *model.GnsGns, startTime *string, endTime *string, timeInterval *string, someUserArg1 *string, someUserArg2 *int, someUserArg3 *bool) (*model.TimeSeriesData, error) {
-	return getGnsGnsqueryGnsQMResolver(obj, startTime, endTime, timeInterval, someUserArg1, someUserArg2, someUserArg3)
+	return getGnsGnsqueryGnsQMResolver(ctx, obj, startTime, endTime, timeInterval, someUserArg1, someUserArg2, someUserArg3)
 }
 
 // GnsAccessControlPolicy is the resolver for the GnsAccessControlPolicy field.
 func (r *gns_GnsResolver) GnsAccessControlPolicy(ctx context.Context, obj *model.GnsGns, id *string) (*model.PolicypkgAccessControlPolicy, error) {
-	return getGnsGnsGnsAccessControlPolicyResolver(obj, id)
+	return getGnsGnsGnsAccessControlPolicyResolver(ctx, obj, id)
 }
 
 // FooChild is the resolver for the FooChild field.
 func (r *gns_GnsResolver) FooChild(ctx context.Context, obj *model.GnsGns) (*model.GnsBarChild, error) {
-	return getGnsGnsFooChildResolver(obj)
+	return getGnsGnsFooChildResolver(ctx, obj)
 }
 
 // PolicyConfigs is the resolver for the PolicyConfigs field.
 func (r *policypkg_AccessControlPolicyResolver) PolicyConfigs(ctx context.Context, obj *model.PolicypkgAccessControlPolicy, id *string) ([]*model.PolicypkgACPConfig, error) {
-	return getPolicypkgAccessControlPolicyPolicyConfigsResolver(obj, id)
+	return getPolicypkgAccessControlPolicyPolicyConfigsResolver(ctx, obj, id)
+}
+
+// PolicyConfigsConnection is the resolver for the PolicyConfigsConnection field.
+func (r *policypkg_AccessControlPolicyResolver) PolicyConfigsConnection(ctx context.Context, obj *model.PolicypkgAccessControlPolicy, first *int, after *string, filter *model.PolicypkgACPConfigFilter, orderBy []*model.PolicypkgACPConfigOrderBy) (*model.PolicypkgACPConfigConnection, error) {
+	return getPolicypkgAccessControlPolicyPolicyConfigsConnectionResolver(ctx, obj, first, after, filter, orderBy)
 }
 
 // QueryGns1 is the resolver for the queryGns1 field.
 func (r *policypkg_VMpolicyResolver) QueryGns1(ctx context.Context, obj *model.PolicypkgVMpolicy, startTime *string, endTime *string, interval *string, isServiceDeployment *bool, startVal *int) (*model.NexusGraphqlResponse, error) {
-	return getPolicypkgVMpolicyqueryGns1Resolver(obj, startTime, endTime, interval, isServiceDeployment, startVal)
+	return getPolicypkgVMpolicyqueryGns1Resolver(ctx, obj, startTime, endTime, interval, isServiceDeployment, startVal)
 }
 
 // QueryGnsQM1 is the resolver for the queryGnsQM1 field.
 func (r *policypkg_VMpolicyResolver) QueryGnsQM1(ctx context.Context, obj *model.PolicypkgVMpolicy) (*model.TimeSeriesData, error) {
-	return getPolicypkgVMpolicyqueryGnsQM1Resolver(obj)
+	return getPolicypkgVMpolicyqueryGnsQM1Resolver(ctx, obj)
 }
 
 // QueryGnsQM is the resolver for the queryGnsQM field.
 func (r *policypkg_VMpolicyResolver) QueryGnsQM(ctx context.Context, obj *model.PolicypkgVMpolicy, startTime *string, endTime *string, timeInterval *string, someUserArg1 *string, someUserArg2 *int, someUserArg3 *bool) (*model.TimeSeriesData, error) {
-	return getPolicypkgVMpolicyqueryGnsQMResolver(obj, startTime, endTime, timeInterval, someUserArg1, someUserArg2, someUserArg3)
+	return getPolicypkgVMpolicyqueryGnsQMResolver(ctx, obj, startTime, endTime, timeInterval, someUserArg1, someUserArg2, someUserArg3)
 }
 
 // Config is the resolver for the Config field.
 func (r *root_RootResolver) Config(ctx context.Context, obj *model.RootRoot, id *string) (*model.ConfigConfig, error) {
-	return getRootRootConfigResolver(obj, id)
+	return getRootRootConfigResolver(ctx, obj, id)
 }
 
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
 // Config_Config returns generated.Config_ConfigResolver implementation.
 func (r *Resolver) Config_Config() generated.Config_ConfigResolver { return &config_ConfigResolver{r} }
 
@@ -126,7 +388,9 @@ func (r *Resolver) Policypkg_VMpolicy() generated.Policypkg_VMpolicyResolver {
 // Root_Root returns generated.Root_RootResolver implementation.
 func (r *Resolver) Root_Root() generated.Root_RootResolver { return &root_RootResolver{r} }
 
+type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type config_ConfigResolver struct{ *Resolver }
 type gns_GnsResolver struct{ *Resolver }
 type policypkg_AccessControlPolicyResolver struct{ *Resolver }
@@ -0,0 +1,9 @@
+package common
+
+// nexus-enum
+type Size string
+
+const (
+	Small Size = "Small"
+	Large Size = "Large"
+)
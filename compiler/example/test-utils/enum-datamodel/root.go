@@ -0,0 +1,29 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/test-utils/enum-datamodel/common"
+)
+
+// nexus-enum
+type Color string
+
+const (
+	Red   Color = "Red"
+	Green Color = "Green"
+)
+
+// nexus-enum
+type Protocol string
+
+const (
+	HTTP1 Protocol = "http/1.1"
+	HTTP2 Protocol = "h2"
+)
+
+type Root struct {
+	nexus.SingletonNode
+	Color    Color
+	Protocol Protocol
+	Size     common.Size
+}
@@ -0,0 +1,21 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+)
+
+type Root struct {
+	nexus.SingletonNode
+	Projects Project `nexus:"children,max=10"`
+}
+
+type Project struct {
+	nexus.Node
+	Teams     Team `nexus:"children"`
+	Reviewers Team `nexus:"links,max=3,scope=subtree"`
+}
+
+type Team struct {
+	nexus.Node
+	Mentor Team `nexus:"link,scope=sibling"`
+}
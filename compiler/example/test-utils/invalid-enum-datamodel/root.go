@@ -0,0 +1,22 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+)
+
+// nexus-enum
+type Priority int
+
+// nexus-enum
+type Status string
+
+const (
+	Low  Priority = 1
+	High Priority = 2
+)
+
+type Root struct {
+	nexus.SingletonNode
+	Priority Priority
+	Status   Status
+}
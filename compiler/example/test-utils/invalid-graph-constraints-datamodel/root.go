@@ -0,0 +1,16 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+)
+
+type Root struct {
+	nexus.SingletonNode
+	Project  Project `nexus:"child,max=1"`
+	Projects Project `nexus:"children,max=none,scope=sibling"`
+	Lead     Project `nexus:"link,scope=everywhere,min=1"`
+}
+
+type Project struct {
+	nexus.Node
+}
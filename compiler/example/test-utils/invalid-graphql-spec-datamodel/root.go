@@ -0,0 +1,18 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
+)
+
+var GraphQLPositionalSpec = nexus.GraphQLSpec{"name", true} // not allowed
+
+var GraphQLWrongTypesSpec = nexus.GraphQLSpec{
+	IdName:     name,    // not allowed
+	IdNullable: "false", // not allowed
+}
+
+const name = "name"
+
+type Root struct {
+	nexus.SingletonNode
+}
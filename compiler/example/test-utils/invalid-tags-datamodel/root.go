@@ -0,0 +1,31 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
+)
+
+type Root struct {
+	nexus.Node
+	Name    string     `json:"name` // invalid tag
+	Status  RootStatus `nexus:"status"`
+	Status2 RootStatus `nexus:"status"` // second status field
+}
+
+type RootStatus struct {
+	State string
+}
+
+const queryName = "query"
+
+var RootGraphQLQuerySpec = nexus.GraphQLQuerySpec{
+	Queries: []nexus.GraphQLQuery{
+		{
+			Name: queryName, // not a string literal
+			ServiceEndpoint: nexus.GraphQLQueryEndpoint{
+				Domain: "query-manager",
+				Port:   15000,
+			},
+			ApiType: nexus.GraphQLQueryApi,
+		},
+	},
+}
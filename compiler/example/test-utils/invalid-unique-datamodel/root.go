@@ -0,0 +1,14 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+)
+
+// nexus-unique: Domain, Missing
+// nexus-unique: scope=everywhere
+type Root struct {
+	nexus.SingletonNode
+	// nexus-unique
+	Labels map[string]string
+	Domain string
+}
@@ -0,0 +1,26 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+)
+
+// nexus-validation-rule: self.minReplicas <= self.maxReplicas
+type Root struct {
+	nexus.SingletonNode
+	// nexus-immutable
+	Region string
+	// nexus-default: 1
+	MinReplicas int
+	// nexus-default: 3
+	MaxReplicas int
+	// nexus-default: info
+	// nexus-validation-rule: self in ["debug", "info", "error"]
+	LogLevel string
+	Limits   Limits
+}
+
+// nexus-validation-rule: self.cpu != ""
+type Limits struct {
+	// nexus-default: "100m"
+	Cpu string
+}
@@ -0,0 +1,11 @@
+package project
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+)
+
+type Project struct {
+	nexus.Node
+	Key    string
+	Labels []string
+}
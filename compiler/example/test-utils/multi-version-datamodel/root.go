@@ -0,0 +1,11 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/test-utils/multi-version-datamodel/project"
+)
+
+type Root struct {
+	nexus.SingletonNode
+	Project project.Project `nexus:"child"`
+}
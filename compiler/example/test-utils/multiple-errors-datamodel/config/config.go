@@ -0,0 +1,14 @@
+package config
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
+)
+
+type Config struct {
+	nexus.Node
+	Id string
+}
+
+type Link struct { // reserved name
+	Id string
+}
@@ -0,0 +1,13 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/test-utils/multiple-errors-datamodel/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
+)
+
+type Root struct {
+	nexus.Node
+	Config  *config.Config           `nexus:"child"` // not allowed
+	Configs map[string]config.Config `nexus:"link"`  // not allowed
+	Missing config.Missing           `nexus:"link"`  // not a node
+}
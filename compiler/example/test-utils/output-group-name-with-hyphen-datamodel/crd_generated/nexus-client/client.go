@@ -6,7 +6,7 @@
 // - name hashing to avoid name collision between objects with same name but different parents,
 // - ability to get, create and delete child of given parent object,
 // - ability to add link and remove link to given object,
-// - recursive delete of object and all it's children.
+// - cascading delete of object and all it's children, children are owned by their parents.
 // To initialize client use NewForConfig function with Rest Config as a parameter. After that you can start using
 // nexus client. You can check example in: https://gitlab.eng.vmware.com/nsx-allspark_users/nexus-sdk/docs/-/tree/master/example/crudapp
 
@@ -17,9 +17,12 @@ import (
 	"encoding/json"
 	customerrors "errors"
 	"fmt"
+	"math/rand"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -46,8 +49,6 @@ import (
 
 var log = logrus.New()
 
-const maxRetryCount = 12
-const sleepTime = 5
 const ownershipAnnotation string = "Ownership"
 
 // informerResyncPeriod is in second, default value is 10 Hrs(36000 Sec). INFORMER_RESYNC_PERIOD is os env to set Resync Period for informers
@@ -55,6 +56,7 @@ var informerResyncPeriod time.Duration = 36000
 
 type Clientset struct {
 	baseClient   baseClientset.Interface
+	retryPolicy  RetryPolicy
 	rootTsmV1    *RootTsmV1
 	configTsmV1  *ConfigTsmV1
 	projectTsmV1 *ProjectTsmV1
@@ -78,24 +80,45 @@ func subscribe(key string, informer cache.SharedIndexInformer) {
 	subscriptionMap.Store(key, s)
 }
 
+// uniqueIndexKey returns the key of an object in the index of unique fields.
+func uniqueIndexKey(values ...interface{}) string {
+	key, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Sprint(values...)
+	}
+	return string(key)
+}
+
+// hashedParentName returns the name of the parent object based on labels of its child.
+func hashedParentName(labels map[string]string, parentCrdName string) string {
+	name, ok := labels[parentCrdName]
+	if !ok {
+		name = helper.DEFAULT_KEY
+	}
+	if labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		name = helper.GetHashedName(parentCrdName, labels, name)
+	}
+	return name
+}
+
 func (c *Clientset) SubscribeAll() {
 	var key string
 
 	key = "roots.root.tsm-tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "configs.config.tsm-tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
 	key = "projects.project.tsm-tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.baseClient, informerResyncPeriod*time.Second, projectProjectTsmV1Indexers())
 		subscribe(key, informer)
 	}
 
@@ -109,8 +132,92 @@ func (c *Clientset) UnsubscribeAll() {
 	})
 }
 
+// RetryPolicy decides which failed requests to the database are retried and how long the client waits between
+// attempts. The wait grows exponentially from InitialBackoff up to MaxBackoff and is randomized by Jitter, so that
+// clients failing at the same time don't retry at the same time.
+type RetryPolicy struct {
+	// MaxAttempts is the maximal number of attempts of a request, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the wait after the first failed attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the wait between attempts.
+	MaxBackoff time.Duration
+	// Multiplier is the growth of the wait after every failed attempt.
+	Multiplier float64
+	// Jitter is the fraction of the wait added or subtracted randomly, e.g. 0.2 for +-20%.
+	Jitter float64
+	// Retryable returns true for errors which may not occur when the request is retried.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy returns the policy used by clients created without WithRetryPolicy option.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    13,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		Retryable:      IsRetryable,
+	}
+}
+
+// IsRetryable returns true for timeouts and throttling of requests, it's the default classifier of RetryPolicy.
+func IsRetryable(err error) bool {
+	return errors.IsTimeout(err) || errors.IsServerTimeout(err) || errors.IsTooManyRequests(err) ||
+		customerrors.Is(err, context.DeadlineExceeded)
+}
+
+func (p RetryPolicy) isRetryable(err error) bool {
+	if p.Retryable == nil {
+		return IsRetryable(err)
+	}
+	return p.Retryable(err)
+}
+
+// exhausted returns true if the request can't be retried after retryCount retries.
+func (p RetryPolicy) exhausted(retryCount int) bool {
+	return retryCount+1 >= p.MaxAttempts
+}
+
+// backoff returns the wait before the retry with the given number, starting from 1.
+func (p RetryPolicy) backoff(retryCount int) time.Duration {
+	backoff := float64(p.InitialBackoff)
+	for i := 1; i < retryCount && backoff < float64(p.MaxBackoff); i++ {
+		backoff *= p.Multiplier
+	}
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		backoff += backoff * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(backoff)
+}
+
+// wait sleeps before the retry with the given number. It returns early when the context is done, the next attempt
+// then fails with the error of the context.
+func (p RetryPolicy) wait(ctx context.Context, retryCount int) {
+	timer := time.NewTimer(p.backoff(retryCount))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// Option configures Clientset created by NewForConfig or NewFakeClient.
+type Option func(*Clientset)
+
+// WithRetryPolicy sets the policy of retries of failed requests to the database.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Clientset) {
+		c.retryPolicy = policy
+	}
+}
+
 // NewForConfig returns Client which can be which can be used to connect to database
-func NewForConfig(config *rest.Config) (*Clientset, error) {
+func NewForConfig(config *rest.Config, opts ...Option) (*Clientset, error) {
 	baseClient, err := baseClientset.NewForConfig(config)
 	if err != nil {
 		return nil, err
@@ -139,6 +246,10 @@ func NewForConfig(config *rest.Config) (*Clientset, error) {
 
 	client := &Clientset{}
 	client.baseClient = baseClient
+	client.retryPolicy = DefaultRetryPolicy()
+	for _, opt := range opts {
+		opt(client)
+	}
 	client.rootTsmV1 = newRootTsmV1(client)
 	client.configTsmV1 = newConfigTsmV1(client)
 	client.projectTsmV1 = newProjectTsmV1(client)
@@ -147,9 +258,13 @@ func NewForConfig(config *rest.Config) (*Clientset, error) {
 }
 
 // NewFakeClient creates simple client which can be used for unit tests
-func NewFakeClient() *Clientset {
+func NewFakeClient(opts ...Option) *Clientset {
 	client := &Clientset{}
 	client.baseClient = fakeBaseClienset.NewSimpleClientset()
+	client.retryPolicy = DefaultRetryPolicy()
+	for _, opt := range opts {
+		opt(client)
+	}
 	client.rootTsmV1 = newRootTsmV1(client)
 	client.configTsmV1 = newConfigTsmV1(client)
 	client.projectTsmV1 = newProjectTsmV1(client)
@@ -169,6 +284,182 @@ func (p Patch) Marshal() ([]byte, error) {
 	return json.Marshal(p)
 }
 
+type optimisticConcurrencyKey struct{}
+
+// WithOptimisticConcurrency returns a context for Update<Node>ByName calls which update the object only if its
+// resourceVersion is still the one of the updated object. Otherwise the call fails with ResourceVersionConflict
+// instead of overwriting changes made since the object was read.
+func WithOptimisticConcurrency(ctx context.Context) context.Context {
+	return context.WithValue(ctx, optimisticConcurrencyKey{}, true)
+}
+
+func optimisticConcurrencyEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(optimisticConcurrencyKey{}).(bool)
+	return enabled
+}
+
+// setOwner makes the parent owner of the object. Kubernetes garbage collector deletes objects when their owner is
+// deleted, so the subtree of a deleted object is removed even if the client stops in the middle.
+func setOwner(obj metav1.Object, apiVersion, kind string, parent metav1.Object) {
+	owner := metav1.OwnerReference{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       parent.GetName(),
+		UID:        parent.GetUID(),
+	}
+	refs := obj.GetOwnerReferences()
+	for i, ref := range refs {
+		if ownerGroup(ref.APIVersion) == ownerGroup(apiVersion) && ref.Kind == kind {
+			refs[i] = owner
+			obj.SetOwnerReferences(refs)
+			return
+		}
+	}
+	obj.SetOwnerReferences(append(refs, owner))
+}
+
+// ownerGroup returns group of the apiVersion, so owner references of older versions of the parent are replaced.
+func ownerGroup(apiVersion string) string {
+	return strings.SplitN(apiVersion, "/", 2)[0]
+}
+
+// isOwnedBy returns true if the object has owner reference to the parent. Objects created before children were owned
+// by their parents have no owner references and aren't deleted by Kubernetes garbage collector.
+func isOwnedBy(obj metav1.Object, parent metav1.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Name == parent.GetName() && ref.UID == parent.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceVersionTest returns a patch operation which fails if resourceVersion of the object changed.
+func resourceVersionTest(resourceVersion string) PatchOp {
+	return PatchOp{
+		Op:    "test",
+		Path:  "/metadata/resourceVersion",
+		Value: resourceVersion,
+	}
+}
+
+// isResourceVersionTestFailed returns true if the patch failed because of the resourceVersionTest operation.
+func isResourceVersionTestFailed(err error) bool {
+	return errors.IsConflict(err) || strings.Contains(err.Error(), "testing value /metadata/resourceVersion failed")
+}
+
+// Tx records writes of a transaction started by Clientset.Tx. Typed handles of objects in the transaction are
+// returned by Add<Node> methods of Tx and by InTx methods of objects.
+type Tx struct {
+	client *Clientset
+	steps  []*txStep
+	err    error
+}
+
+// txPhase orders steps of a transaction. Objects are created before they are updated or linked and links are
+// removed before objects are deleted. Links and unlinks share a phase, so they are applied in the recorded order.
+type txPhase int
+
+const (
+	txPhaseCreate txPhase = iota
+	txPhaseUpdate
+	txPhaseLink
+	txPhaseDelete
+)
+
+type txStep struct {
+	phase txPhase
+	// depth is the number of parents of the object, parents are created before and deleted after their children.
+	depth    int
+	name     string
+	apply    func(ctx context.Context) error
+	rollback func(ctx context.Context) error
+}
+
+func (tx *Tx) record(step *txStep) {
+	tx.steps = append(tx.steps, step)
+}
+
+// fail records an error found while recording steps, the transaction is then not applied.
+func (tx *Tx) fail(err error) {
+	if tx.err == nil {
+		tx.err = err
+	}
+}
+
+// Tx calls fn to record creates, updates, links and deletes of objects and applies them together. Steps are applied
+// in dependency order: creates from parents to children, updates, links and unlinks and deletes from children to
+// parents, steps of the same kind in the order in which they were recorded. If a step fails, the already applied
+// steps are compensated in the reverse order and TxError with the error of the step is returned. Nothing is applied
+// if fn returns an error.
+//
+// Compensation is best effort, other clients may change the graph during the transaction. Deleted objects are
+// created again without their children and links, children are removed by Kubernetes garbage collector.
+func (c *Clientset) Tx(ctx context.Context, fn func(tx *Tx) error) error {
+	tx := &Tx{client: c}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if tx.err != nil {
+		return tx.err
+	}
+	return tx.commit(ctx)
+}
+
+func (tx *Tx) commit(ctx context.Context) error {
+	steps := make([]*txStep, len(tx.steps))
+	copy(steps, tx.steps)
+	sort.SliceStable(steps, func(i, j int) bool {
+		if steps[i].phase != steps[j].phase {
+			return steps[i].phase < steps[j].phase
+		}
+		switch steps[i].phase {
+		case txPhaseCreate:
+			return steps[i].depth < steps[j].depth
+		case txPhaseDelete:
+			return steps[i].depth > steps[j].depth
+		}
+		return false
+	})
+
+	for i, step := range steps {
+		log.Debugf("[Tx] Applying %s", step.name)
+		if err := step.apply(ctx); err != nil {
+			log.Errorf("[Tx] Failed to apply %s, rolling back %d applied steps: %+v", step.name, i, err)
+			return NewTxError(step.name, err, tx.rollback(steps[:i]))
+		}
+	}
+	log.Debugf("[Tx] Applied %d steps successfully", len(steps))
+	return nil
+}
+
+// rollback compensates applied steps in the reverse order. Failed compensations don't stop the rollback, so that as
+// little as possible of the transaction is left in the graph.
+func (tx *Tx) rollback(applied []*txStep) (errs []error) {
+	// ctx of the transaction may be already cancelled
+	ctx := context.Background()
+	for i := len(applied) - 1; i >= 0; i-- {
+		log.Debugf("[Tx] Rolling back %s", applied[i].name)
+		if err := applied[i].rollback(ctx); err != nil {
+			log.Errorf("[Tx] Failed to roll back %s: %+v", applied[i].name, err)
+			errs = append(errs, fmt.Errorf("%s: %w", applied[i].name, err))
+		}
+	}
+	return
+}
+
+// restoreGvkPatch returns a merge patch which sets the link of the field back to value, nil value removes the link.
+// key is the display name of the link in named fields, empty in single ones.
+func restoreGvkPatch(field, key string, value interface{}) ([]byte, error) {
+	fieldValue := value
+	if key != "" {
+		fieldValue = map[string]interface{}{key: value}
+	}
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{field: fieldValue},
+	})
+}
+
 func (c *Clientset) Root() *RootTsmV1 {
 	return c.rootTsmV1
 }
@@ -232,14 +523,14 @@ func (group *RootTsmV1) GetRootByName(ctx context.Context, hashedName string) (*
 			Roots().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetRootByName] Failed to Get Roots: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Roots: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetRootByName]: %+v", err)
 				return nil, context.Canceled
@@ -267,14 +558,14 @@ func (group *RootTsmV1) ForceReadRootByName(ctx context.Context, hashedName stri
 			Roots().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadRootByName] Failed to Get Roots: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Roots: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadRootByName]: %+v", err)
 				return nil, context.Canceled
@@ -308,14 +599,14 @@ func (group *RootTsmV1) DeleteRootByName(ctx context.Context, hashedName string)
 			Roots().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteRootByName] Failed to get Roots: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Roots: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteRootByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -336,29 +627,29 @@ func (group *RootTsmV1) DeleteRootByName(ctx context.Context, hashedName string)
 	}
 
 	if result.Spec.ProjectGvk != nil {
-		err := group.client.
-			Project().
-			DeleteProjectByName(ctx, result.Spec.ProjectGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteProjectIfNotOwned(ctx, result, result.Spec.ProjectGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			RootTsmV1().
-			Roots().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Roots().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteRootByName] failed to delete Roots: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Roots: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteRootByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -378,6 +669,31 @@ func (group *RootTsmV1) DeleteRootByName(ctx context.Context, hashedName string)
 	return
 }
 
+// deleteProjectIfNotOwned deletes child Project of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *RootTsmV1) deleteProjectIfNotOwned(ctx context.Context,
+	parent *baseroottsmtanzuvmwarecomv1.Root, hashedName string) error {
+	child, err := group.client.baseClient.
+		ProjectTsmV1().
+		Projects().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Project().DeleteProjectByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 // CreateRootByName creates object in the database without hashing the name.
 // Use it directly ONLY when objToCreate.Name is hashed name of the object.
 func (group *RootTsmV1) CreateRootByName(ctx context.Context,
@@ -397,6 +713,7 @@ func (group *RootTsmV1) CreateRootByName(ctx context.Context,
 		result     *baseroottsmtanzuvmwarecomv1.Root
 		err        error
 	)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -404,14 +721,14 @@ func (group *RootTsmV1) CreateRootByName(ctx context.Context,
 			Roots().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateRootByName] Failed to create Root: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Root: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateRootByName] context canceled while creating Root: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -440,6 +757,11 @@ func (group *RootTsmV1) UpdateRootByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Root().GetRootByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -511,33 +833,22 @@ func (group *RootTsmV1) UpdateRootByName(ctx context.Context,
 			Roots().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateRootByName] Failed to patch Root gvk in parent node[]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("roots.root.tsm-tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Root Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteRootByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Root: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Root deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateRootByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateRootByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Root Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteRootByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Root: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Root Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -584,6 +895,12 @@ func (group *RootTsmV1) ListRoots(ctx context.Context,
 	return
 }
 
+// rootRootTsmV1Indexers returns indexers of Root informers, which index objects by values
+// of unique fields.
+func rootRootTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type RootRoot struct {
 	client *Clientset
 	*baseroottsmtanzuvmwarecomv1.Root
@@ -713,6 +1030,144 @@ func (obj *RootRoot) DeleteProject(ctx context.Context) (err error) {
 	return
 }
 
+// TxRootRoot is Root object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxRootRoot struct {
+	tx *Tx
+	*baseroottsmtanzuvmwarecomv1.Root
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *RootRoot) InTx(tx *Tx) *TxRootRoot {
+	return &TxRootRoot{
+		tx:   tx,
+		Root: obj.Root,
+	}
+}
+
+// createRootRoot records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createRootRoot(objToCreate *baseroottsmtanzuvmwarecomv1.Root) *TxRootRoot {
+	handle := &TxRootRoot{
+		tx:   tx,
+		Root: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["roots.root.tsm-tanzu.vmware.com"]),
+		name:  "create of roots.root.tsm-tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Root().CreateRootByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Root = result.Root
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Root().DeleteRootByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxRootRoot) Update() {
+	var previous *baseroottsmtanzuvmwarecomv1.Root
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["roots.root.tsm-tanzu.vmware.com"]),
+		name:  "update of roots.root.tsm-tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Root().GetRootByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Root.DeepCopy()
+			result, err := obj.tx.client.Root().UpdateRootByName(ctx, obj.Root)
+			if err != nil {
+				return err
+			}
+			obj.Root = result.Root
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Root().UpdateRootByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxRootRoot) Delete() {
+	var deleted *baseroottsmtanzuvmwarecomv1.Root
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["roots.root.tsm-tanzu.vmware.com"]),
+		name:  "delete of roots.root.tsm-tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Root().GetRootByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Root.DeepCopy()
+			return obj.tx.client.Root().DeleteRootByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Root().CreateRootByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
+// AddRootRoot records creation of objToCreate, its name is hashed the same way
+// as by Clientset.AddRootRoot.
+func (tx *Tx) AddRootRoot(objToCreate *baseroottsmtanzuvmwarecomv1.Root) *TxRootRoot {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), nil, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return tx.createRootRoot(objToCreate)
+}
+
+// AddProject records creation of the child, its name is hashed the same way as by
+// RootRoot.AddProject.
+func (obj *TxRootRoot) AddProject(
+	objToCreate *baseprojecttsmtanzuvmwarecomv1.Project) *TxProjectProject {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["roots.root.tsm-tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["roots.root.tsm-tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		if objToCreate.GetName() == "" {
+			objToCreate.SetName(helper.DEFAULT_KEY)
+		}
+		if objToCreate.GetName() != helper.DEFAULT_KEY {
+			obj.tx.fail(NewSingletonNameError(objToCreate.GetName()))
+		}
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createProjectProject(objToCreate)
+}
+
 type rootRootTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -722,7 +1177,7 @@ type rootRootTsmV1Chainer struct {
 func (c *rootRootTsmV1Chainer) Subscribe() {
 	key := "roots.root.tsm-tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -755,7 +1210,7 @@ func (c *rootRootTsmV1Chainer) RegisterEventHandler(addCB func(obj *RootRoot), u
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for RootRoot, so creating a new one")
-		informer = informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -781,9 +1236,18 @@ func (c *rootRootTsmV1Chainer) RegisterEventHandler(addCB func(obj *RootRoot), u
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseroottsmtanzuvmwarecomv1.Root)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &RootRoot{
 				client: c.client,
-				Root:   obj.(*baseroottsmtanzuvmwarecomv1.Root),
+				Root:   deleted,
 			}
 
 			deleteCB(nc)
@@ -815,7 +1279,7 @@ func (c *rootRootTsmV1Chainer) RegisterAddCallback(cbfn func(obj *RootRoot)) (ca
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] RootRoot Create New Informer")
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &RootRoot{
@@ -857,7 +1321,7 @@ func (c *rootRootTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newObj *
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] RootRoot Create New Informer")
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &RootRoot{
@@ -889,9 +1353,18 @@ func (c *rootRootTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *RootRoot))
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseroottsmtanzuvmwarecomv1.Root)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &RootRoot{
 					client: c.client,
-					Root:   obj.(*baseroottsmtanzuvmwarecomv1.Root),
+					Root:   deleted,
 				}
 
 				cbfn(nc)
@@ -899,12 +1372,21 @@ func (c *rootRootTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *RootRoot))
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] RootRoot Create New Informer")
-		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerroottsmtanzuvmwarecomv1.NewRootInformer(c.client.baseClient, informerResyncPeriod*time.Second, rootRootTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseroottsmtanzuvmwarecomv1.Root)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &RootRoot{
 					client: c.client,
-					Root:   obj.(*baseroottsmtanzuvmwarecomv1.Root),
+					Root:   deleted,
 				}
 
 				cbfn(nc)
@@ -915,6 +1397,15 @@ func (c *rootRootTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *RootRoot))
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of RootRoot
+func (c *rootRootTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "roots.root.tsm-tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 func (c *rootRootTsmV1Chainer) Project() *projectProjectTsmV1Chainer {
 	parentLabels := c.parentLabels
 	parentLabels["projects.project.tsm-tanzu.vmware.com"] = helper.DEFAULT_KEY
@@ -991,14 +1482,14 @@ func (group *ConfigTsmV1) GetConfigByName(ctx context.Context, hashedName string
 			Configs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetConfigByName] Failed to Get Configs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Configs: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetConfigByName]: %+v", err)
 				return nil, context.Canceled
@@ -1026,14 +1517,14 @@ func (group *ConfigTsmV1) ForceReadConfigByName(ctx context.Context, hashedName
 			Configs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadConfigByName] Failed to Get Configs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Configs: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadConfigByName]: %+v", err)
 				return nil, context.Canceled
@@ -1067,14 +1558,14 @@ func (group *ConfigTsmV1) DeleteConfigByName(ctx context.Context, hashedName str
 			Configs().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteConfigByName] Failed to get Configs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Configs: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteConfigByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -1094,21 +1585,23 @@ func (group *ConfigTsmV1) DeleteConfigByName(ctx context.Context, hashedName str
 		return err
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			ConfigTsmV1().
-			Configs().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Configs().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteConfigByName] failed to delete Configs: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Configs: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteConfigByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -1181,14 +1674,14 @@ func (group *ConfigTsmV1) DeleteConfigByName(ctx context.Context, hashedName str
 				Projects().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteConfigByName] Failed to patch Config gvk in parent node[Projects]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteConfigByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -1232,6 +1725,21 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["projects.project.tsm-tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("projects.project.tsm-tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Project().GetProjectByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateConfigByName] Failed to get parent of Config: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseprojecttsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Project", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -1239,14 +1747,14 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 			Configs().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateConfigByName] Failed to create Config: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Config: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateConfigByName] context canceled while creating Config: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -1265,14 +1773,6 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["projects.project.tsm-tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("projects.project.tsm-tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Project().GetProjectByName(context.Background(), parentName)
 		if err2 != nil {
@@ -1309,9 +1809,9 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 			Projects().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateConfigByName] Failed to patch Config gvk in parent node[Projects]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger Config delete: %s", objToCreate.GetName())
 					delErr := group.DeleteConfigByName(newCtx, objToCreate.GetName())
@@ -1323,7 +1823,7 @@ func (group *ConfigTsmV1) CreateConfigByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateConfigByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -1362,6 +1862,11 @@ func (group *ConfigTsmV1) UpdateConfigByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Config().GetConfigByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -1475,33 +1980,22 @@ func (group *ConfigTsmV1) UpdateConfigByName(ctx context.Context,
 			Configs().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateConfigByName] Failed to patch Config gvk in parent node[Projects]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("configs.config.tsm-tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Config Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteConfigByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Config: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Config deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateConfigByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateConfigByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Config Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteConfigByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Config: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Config Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -1548,6 +2042,12 @@ func (group *ConfigTsmV1) ListConfigs(ctx context.Context,
 	return
 }
 
+// configConfigTsmV1Indexers returns indexers of Config informers, which index objects by values
+// of unique fields.
+func configConfigTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type ConfigConfig struct {
 	client *Clientset
 	*baseconfigtsmtanzuvmwarecomv1.Config
@@ -1578,6 +2078,103 @@ func (obj *ConfigConfig) GetParent(ctx context.Context) (result *ProjectProject,
 	return obj.client.Project().GetProjectByName(ctx, hashedName)
 }
 
+// TxConfigConfig is Config object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxConfigConfig struct {
+	tx *Tx
+	*baseconfigtsmtanzuvmwarecomv1.Config
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *ConfigConfig) InTx(tx *Tx) *TxConfigConfig {
+	return &TxConfigConfig{
+		tx:     tx,
+		Config: obj.Config,
+	}
+}
+
+// createConfigConfig records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createConfigConfig(objToCreate *baseconfigtsmtanzuvmwarecomv1.Config) *TxConfigConfig {
+	handle := &TxConfigConfig{
+		tx:     tx,
+		Config: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["configs.config.tsm-tanzu.vmware.com"]),
+		name:  "create of configs.config.tsm-tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Config().CreateConfigByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Config = result.Config
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Config().DeleteConfigByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxConfigConfig) Update() {
+	var previous *baseconfigtsmtanzuvmwarecomv1.Config
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["configs.config.tsm-tanzu.vmware.com"]),
+		name:  "update of configs.config.tsm-tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Config.DeepCopy()
+			result, err := obj.tx.client.Config().UpdateConfigByName(ctx, obj.Config)
+			if err != nil {
+				return err
+			}
+			obj.Config = result.Config
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Config().UpdateConfigByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxConfigConfig) Delete() {
+	var deleted *baseconfigtsmtanzuvmwarecomv1.Config
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["configs.config.tsm-tanzu.vmware.com"]),
+		name:  "delete of configs.config.tsm-tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Config().GetConfigByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Config.DeepCopy()
+			return obj.tx.client.Config().DeleteConfigByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Config().CreateConfigByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
 type configConfigTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -1587,7 +2184,7 @@ type configConfigTsmV1Chainer struct {
 func (c *configConfigTsmV1Chainer) Subscribe() {
 	key := "configs.config.tsm-tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -1620,7 +2217,7 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for ConfigConfig, so creating a new one")
-		informer = informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -1657,9 +2254,11 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -1668,7 +2267,8 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -1687,9 +2287,18 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Config)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &ConfigConfig{
 				client: c.client,
-				Config: obj.(*baseconfigtsmtanzuvmwarecomv1.Config),
+				Config: deleted,
 			}
 
 			var parent *ProjectProject
@@ -1719,9 +2328,11 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -1730,7 +2341,8 @@ func (c *configConfigTsmV1Chainer) RegisterEventHandler(addCB func(obj *ConfigCo
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -1788,9 +2400,11 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -1800,7 +2414,8 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -1808,7 +2423,7 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] ConfigConfig Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &ConfigConfig{
@@ -1847,9 +2462,11 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -1859,7 +2476,8 @@ func (c *configConfigTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ConfigConf
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -1896,7 +2514,7 @@ func (c *configConfigTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newO
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] ConfigConfig Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &ConfigConfig{
@@ -1928,9 +2546,18 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Config)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ConfigConfig{
 					client: c.client,
-					Config: obj.(*baseconfigtsmtanzuvmwarecomv1.Config),
+					Config: deleted,
 				}
 
 				var parent *ProjectProject
@@ -1963,9 +2590,11 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -1975,7 +2604,8 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -1983,12 +2613,21 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] ConfigConfig Create New Informer")
-		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerconfigtsmtanzuvmwarecomv1.NewConfigInformer(c.client.baseClient, informerResyncPeriod*time.Second, configConfigTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseconfigtsmtanzuvmwarecomv1.Config)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ConfigConfig{
 					client: c.client,
-					Config: obj.(*baseconfigtsmtanzuvmwarecomv1.Config),
+					Config: deleted,
 				}
 
 				var parent *ProjectProject
@@ -2022,9 +2661,11 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -2034,7 +2675,8 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -2045,6 +2687,15 @@ func (c *configConfigTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *ConfigC
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of ConfigConfig
+func (c *configConfigTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "configs.config.tsm-tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 // GetProjectByName returns object stored in the database under the hashedName which is a hash of display
 // name and parents names. Use it when you know hashed name of object.
 func (group *ProjectTsmV1) GetProjectByName(ctx context.Context, hashedName string) (*ProjectProject, error) {
@@ -2068,14 +2719,14 @@ func (group *ProjectTsmV1) GetProjectByName(ctx context.Context, hashedName stri
 			Projects().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[GetProjectByName] Failed to Get Projects: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on Get Projects: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[GetProjectByName]: %+v", err)
 				return nil, context.Canceled
@@ -2103,14 +2754,14 @@ func (group *ProjectTsmV1) ForceReadProjectByName(ctx context.Context, hashedNam
 			Projects().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[ForceReadProjectByName] Failed to Get Projects: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Errorf("[Retry Count: %d ] %+v", retryCount, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max Retry exceed on Get Projects: %s", hashedName)
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[ForceReadProjectByName]: %+v", err)
 				return nil, context.Canceled
@@ -2144,14 +2795,14 @@ func (group *ProjectTsmV1) DeleteProjectByName(ctx context.Context, hashedName s
 			Projects().Get(ctx, hashedName, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("[DeleteProjectByName] Failed to get Projects: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on get Projects: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteProjectByName] context canceled: %s", hashedName)
 				return context.Canceled
@@ -2172,29 +2823,29 @@ func (group *ProjectTsmV1) DeleteProjectByName(ctx context.Context, hashedName s
 	}
 
 	if result.Spec.ConfigGvk != nil {
-		err := group.client.
-			Config().
-			DeleteConfigByName(ctx, result.Spec.ConfigGvk.Name)
-		if err != nil && !errors.IsNotFound(err) {
+		err := group.deleteConfigIfNotOwned(ctx, result, result.Spec.ConfigGvk.Name)
+		if err != nil {
 			return err
 		}
 	}
 
+	// children are owned by the object, Kubernetes garbage collector deletes them in the background
+	propagationPolicy := metav1.DeletePropagationBackground
 	retryCount = 0
 	for {
 		err = group.client.baseClient.
 			ProjectTsmV1().
-			Projects().Delete(ctx, hashedName, metav1.DeleteOptions{})
+			Projects().Delete(ctx, hashedName, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		if err != nil {
 			log.Errorf("[DeleteProjectByName] failed to delete Projects: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on delete Projects: %s", hashedName)
 					return err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[DeleteProjectByName]: context canceled: %s", hashedName)
 				return context.Canceled
@@ -2267,14 +2918,14 @@ func (group *ProjectTsmV1) DeleteProjectByName(ctx context.Context, hashedName s
 				Roots().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 			if err != nil {
 				log.Errorf("[DeleteProjectByName] Failed to patch Project gvk in parent node[Roots]: %+v", err)
-				if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				if group.client.retryPolicy.isRetryable(err) {
 					log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
-					if retryCount == maxRetryCount {
+					if group.client.retryPolicy.exhausted(retryCount) {
 						log.Errorf("Max retry exceed on patching gvk: %s", hashedName)
 						return err
 					}
 					retryCount += 1
-					time.Sleep(sleepTime * time.Second)
+					group.client.retryPolicy.wait(ctx, retryCount)
 				} else if customerrors.Is(err, context.Canceled) {
 					log.Errorf("[DeleteProjectByName]: context canceled: %s", hashedName)
 					return context.Canceled
@@ -2293,6 +2944,31 @@ func (group *ProjectTsmV1) DeleteProjectByName(ctx context.Context, hashedName s
 	return
 }
 
+// deleteConfigIfNotOwned deletes child Config of the parent if it isn't owned by the parent.
+// Kubernetes garbage collector deletes owned children, children created without owner reference are deleted
+// recursively by the client.
+func (group *ProjectTsmV1) deleteConfigIfNotOwned(ctx context.Context,
+	parent *baseprojecttsmtanzuvmwarecomv1.Project, hashedName string) error {
+	child, err := group.client.baseClient.
+		ConfigTsmV1().
+		Configs().Get(ctx, hashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isOwnedBy(child, parent) {
+		return nil
+	}
+	err = group.client.
+		Config().DeleteConfigByName(ctx, hashedName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 // CreateProjectByName creates object in the database without hashing the name.
 // Use it directly ONLY when objToCreate.Name is hashed name of the object.
 func (group *ProjectTsmV1) CreateProjectByName(ctx context.Context,
@@ -2320,6 +2996,21 @@ func (group *ProjectTsmV1) CreateProjectByName(ctx context.Context,
 		exists     bool
 		existsErr  error
 	)
+
+	parentName, ok := objToCreate.GetLabels()["roots.root.tsm-tanzu.vmware.com"]
+	if !ok {
+		parentName = helper.DEFAULT_KEY
+	}
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
+		parentName = helper.GetHashedName("roots.root.tsm-tanzu.vmware.com", objToCreate.GetLabels(), parentName)
+	}
+	owner, err := group.client.Root().GetRootByName(ctx, parentName)
+	if err != nil {
+		log.Errorf("[CreateProjectByName] Failed to get parent of Project: %s, error: %+v", objToCreate.GetName(), err)
+		return nil, err
+	}
+	setOwner(objToCreate, baseroottsmtanzuvmwarecomv1.SchemeGroupVersion.String(), "Root", owner)
+
 	retryCount = 0
 	for {
 		result, err = group.client.baseClient.
@@ -2327,14 +3018,14 @@ func (group *ProjectTsmV1) CreateProjectByName(ctx context.Context,
 			Projects().Create(ctx, objToCreate, metav1.CreateOptions{})
 		if err != nil {
 			log.Errorf("[CreateProjectByName] Failed to create Project: %s, error: %+v", objToCreate.GetName(), err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on create Project: %s", objToCreate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateProjectByName] context canceled while creating Project: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -2353,14 +3044,6 @@ func (group *ProjectTsmV1) CreateProjectByName(ctx context.Context,
 		}
 	}
 
-	parentName, ok := objToCreate.GetLabels()["roots.root.tsm-tanzu.vmware.com"]
-	if !ok {
-		parentName = helper.DEFAULT_KEY
-	}
-	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] == "true" {
-		parentName = helper.GetHashedName("roots.root.tsm-tanzu.vmware.com", objToCreate.GetLabels(), parentName)
-	}
-
 	if exists {
 		parent, err2 := group.client.Root().GetRootByName(context.Background(), parentName)
 		if err2 != nil {
@@ -2397,9 +3080,9 @@ func (group *ProjectTsmV1) CreateProjectByName(ctx context.Context,
 			Roots().Patch(newCtx, parentName, types.JSONPatchType, marshaled, metav1.PatchOptions{})
 		if err != nil {
 			log.Errorf("[CreateProjectByName] Failed to patch Project gvk in parent node[Roots]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToCreate.GetName())
 					log.Debugf("Trigger Project delete: %s", objToCreate.GetName())
 					delErr := group.DeleteProjectByName(newCtx, objToCreate.GetName())
@@ -2411,7 +3094,7 @@ func (group *ProjectTsmV1) CreateProjectByName(ctx context.Context,
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[CreateProjectByName]: context canceled: %s", objToCreate.GetName())
 				return nil, context.Canceled
@@ -2450,6 +3133,11 @@ func (group *ProjectTsmV1) UpdateProjectByName(ctx context.Context,
 
 	var patch Patch
 
+	checkResourceVersion := optimisticConcurrencyEnabled(ctx) && objToUpdate.ResourceVersion != ""
+	if checkResourceVersion {
+		patch = append(patch, resourceVersionTest(objToUpdate.ResourceVersion))
+	}
+
 	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
 		current, err := group.client.Project().GetProjectByName(ctx, objToUpdate.Name)
 		if err != nil {
@@ -2563,33 +3251,22 @@ func (group *ProjectTsmV1) UpdateProjectByName(ctx context.Context,
 			Projects().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			log.Errorf("[UpdateProjectByName] Failed to patch Project gvk in parent node[Roots]: %+v", err)
-			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+			if checkResourceVersion && isResourceVersionTestFailed(err) {
+				return nil, NewResourceVersionConflict("projects.project.tsm-tanzu.vmware.com", objToUpdate.GetName(), objToUpdate.ResourceVersion)
+			}
+			if group.client.retryPolicy.isRetryable(err) {
 				log.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
-				if retryCount == maxRetryCount {
+				if group.client.retryPolicy.exhausted(retryCount) {
 					log.Errorf("Max retry exceed on patching gvk: %s", objToUpdate.GetName())
-					log.Debugf("Trigger Project Delete: %s", objToUpdate.GetName())
-					delErr := group.DeleteProjectByName(newCtx, objToUpdate.GetName())
-					if delErr != nil {
-						log.Debugf("Error occur while deleting Project: %s", objToUpdate.GetName())
-						return nil, delErr
-					}
-					log.Debugf("Project deleted: %s", objToUpdate.GetName())
 					return nil, err
 				}
 				retryCount += 1
-				time.Sleep(sleepTime * time.Second)
+				group.client.retryPolicy.wait(ctx, retryCount)
 			} else if customerrors.Is(err, context.Canceled) {
 				log.Errorf("[UpdateProjectByName]: context canceled: %s", objToUpdate.GetName())
 				return nil, context.Canceled
 			} else {
 				log.Errorf("[UpdateProjectByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
-				log.Debugf("Trigger Project Delete: %s", objToUpdate.GetName())
-				delErr := group.DeleteProjectByName(newCtx, objToUpdate.GetName())
-				if delErr != nil {
-					log.Debugf("Error occur while deleting Project: %+v", objToUpdate.GetName())
-					return nil, delErr
-				}
-				log.Debugf("Project Deleted: %s", objToUpdate.GetName())
 				return nil, err
 			}
 		} else {
@@ -2636,6 +3313,12 @@ func (group *ProjectTsmV1) ListProjects(ctx context.Context,
 	return
 }
 
+// projectProjectTsmV1Indexers returns indexers of Project informers, which index objects by values
+// of unique fields.
+func projectProjectTsmV1Indexers() cache.Indexers {
+	return cache.Indexers{}
+}
+
 type ProjectProject struct {
 	client *Clientset
 	*baseprojecttsmtanzuvmwarecomv1.Project
@@ -2729,6 +3412,129 @@ func (obj *ProjectProject) DeleteConfig(ctx context.Context) (err error) {
 	return
 }
 
+// TxProjectProject is Project object in a transaction. Its methods record
+// steps which are applied by Clientset.Tx, the object is updated when they are applied.
+type TxProjectProject struct {
+	tx *Tx
+	*baseprojecttsmtanzuvmwarecomv1.Project
+}
+
+// InTx returns handle of obj which records changes of obj, its children and links in the transaction.
+func (obj *ProjectProject) InTx(tx *Tx) *TxProjectProject {
+	return &TxProjectProject{
+		tx:      tx,
+		Project: obj.Project,
+	}
+}
+
+// createProjectProject records creation of objToCreate with already hashed name.
+// The object is deleted on rollback.
+func (tx *Tx) createProjectProject(objToCreate *baseprojecttsmtanzuvmwarecomv1.Project) *TxProjectProject {
+	handle := &TxProjectProject{
+		tx:      tx,
+		Project: objToCreate,
+	}
+	tx.record(&txStep{
+		phase: txPhaseCreate,
+		depth: len(helper.GetCRDParentsMap()["projects.project.tsm-tanzu.vmware.com"]),
+		name:  "create of projects.project.tsm-tanzu.vmware.com " + objToCreate.GetName(),
+		apply: func(ctx context.Context) error {
+			result, err := tx.client.Project().CreateProjectByName(ctx, objToCreate)
+			if err != nil {
+				return err
+			}
+			handle.Project = result.Project
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			return tx.client.Project().DeleteProjectByName(ctx, objToCreate.GetName())
+		},
+	})
+	return handle
+}
+
+// Update records update of the object, changes of the object made before the transaction is applied are written.
+// The object is updated back to its previous state on rollback.
+func (obj *TxProjectProject) Update() {
+	var previous *baseprojecttsmtanzuvmwarecomv1.Project
+	obj.tx.record(&txStep{
+		phase: txPhaseUpdate,
+		depth: len(helper.GetCRDParentsMap()["projects.project.tsm-tanzu.vmware.com"]),
+		name:  "update of projects.project.tsm-tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Project().GetProjectByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			previous = current.Project.DeepCopy()
+			result, err := obj.tx.client.Project().UpdateProjectByName(ctx, obj.Project)
+			if err != nil {
+				return err
+			}
+			obj.Project = result.Project
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			_, err := obj.tx.client.Project().UpdateProjectByName(ctx, previous)
+			return err
+		},
+	})
+}
+
+// Delete records delete of the object and its children. On rollback the object is created again, without its
+// children and links.
+func (obj *TxProjectProject) Delete() {
+	var deleted *baseprojecttsmtanzuvmwarecomv1.Project
+	obj.tx.record(&txStep{
+		phase: txPhaseDelete,
+		depth: len(helper.GetCRDParentsMap()["projects.project.tsm-tanzu.vmware.com"]),
+		name:  "delete of projects.project.tsm-tanzu.vmware.com " + obj.GetName(),
+		apply: func(ctx context.Context) error {
+			current, err := obj.tx.client.Project().GetProjectByName(ctx, obj.GetName())
+			if err != nil {
+				return err
+			}
+			deleted = current.Project.DeepCopy()
+			return obj.tx.client.Project().DeleteProjectByName(ctx, obj.GetName())
+		},
+		rollback: func(ctx context.Context) error {
+			deleted.ResourceVersion = ""
+			deleted.UID = ""
+			deleted.CreationTimestamp = metav1.Time{}
+			deleted.DeletionTimestamp = nil
+			deleted.ManagedFields = nil
+			_, err := obj.tx.client.Project().CreateProjectByName(ctx, deleted)
+			return err
+		},
+	})
+}
+
+// AddConfig records creation of the child, its name is hashed the same way as by
+// ProjectProject.AddConfig.
+func (obj *TxProjectProject) AddConfig(
+	objToCreate *baseconfigtsmtanzuvmwarecomv1.Config) *TxConfigConfig {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["projects.project.tsm-tanzu.vmware.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["projects.project.tsm-tanzu.vmware.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IS_NAME_HASHED_LABEL] != "true" {
+		if objToCreate.GetName() == "" {
+			objToCreate.SetName(helper.DEFAULT_KEY)
+		}
+		if objToCreate.GetName() != helper.DEFAULT_KEY {
+			obj.tx.fail(NewSingletonNameError(objToCreate.GetName()))
+		}
+		objToCreate.Labels[common.DISPLAY_NAME_LABEL] = objToCreate.GetName()
+		objToCreate.Labels[common.IS_NAME_HASHED_LABEL] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return obj.tx.createConfigConfig(objToCreate)
+}
+
 type projectProjectTsmV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -2738,7 +3544,7 @@ type projectProjectTsmV1Chainer struct {
 func (c *projectProjectTsmV1Chainer) Subscribe() {
 	key := "projects.project.tsm-tanzu.vmware.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
-		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, projectProjectTsmV1Indexers())
 		subscribe(key, informer)
 	}
 }
@@ -2771,7 +3577,7 @@ func (c *projectProjectTsmV1Chainer) RegisterEventHandler(addCB func(obj *Projec
 		informer = sub.informer
 	} else {
 		fmt.Println("Informer doesn't exists for ProjectProject, so creating a new one")
-		informer = informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer = informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, projectProjectTsmV1Indexers())
 		subscribe(key, informer)
 	}
 	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -2808,9 +3614,11 @@ func (c *projectProjectTsmV1Chainer) RegisterEventHandler(addCB func(obj *Projec
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if !gvkExist {
 				// Check GVK
@@ -2819,7 +3627,8 @@ func (c *projectProjectTsmV1Chainer) RegisterEventHandler(addCB func(obj *Projec
 					return
 				}
 
-				panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			addCB(nc)
@@ -2838,9 +3647,18 @@ func (c *projectProjectTsmV1Chainer) RegisterEventHandler(addCB func(obj *Projec
 		},
 
 		DeleteFunc: func(obj interface{}) {
+			// the final state of an object deleted while the informer was disconnected is unknown
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			deleted, ok := obj.(*baseprojecttsmtanzuvmwarecomv1.Project)
+			if !ok {
+				log.Errorf("[RegisterEventHandler] unexpected deleted object %T", obj)
+				return
+			}
 			nc := &ProjectProject{
 				client:  c.client,
-				Project: obj.(*baseprojecttsmtanzuvmwarecomv1.Project),
+				Project: deleted,
 			}
 
 			var parent *RootRoot
@@ -2870,9 +3688,11 @@ func (c *projectProjectTsmV1Chainer) RegisterEventHandler(addCB func(obj *Projec
 					if errors.IsNotFound(err) {
 						return
 					}
-					panic("error occurred while fetching parent " + err.Error())
+					log.Errorf("[RegisterEventHandler] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+					return
 				}
-				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] parent found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 			if gvkExist {
 				// Check GVK
@@ -2881,7 +3701,8 @@ func (c *projectProjectTsmV1Chainer) RegisterEventHandler(addCB func(obj *Projec
 					return
 				}
 
-				panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+				log.Errorf("[RegisterEventHandler] gvk not found (event loop is stalled) %s", nc.DisplayName())
+				return
 			}
 
 			deleteCB(nc)
@@ -2939,9 +3760,11 @@ func (c *projectProjectTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ProjectP
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -2951,7 +3774,8 @@ func (c *projectProjectTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ProjectP
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -2959,7 +3783,7 @@ func (c *projectProjectTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ProjectP
 		})
 	} else {
 		log.Debugf("[RegisterAddCallback] ProjectProject Create New Informer")
-		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, projectProjectTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				nc := &ProjectProject{
@@ -2998,9 +3822,11 @@ func (c *projectProjectTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ProjectP
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterAddCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if !gvkExist {
@@ -3010,7 +3836,8 @@ func (c *projectProjectTsmV1Chainer) RegisterAddCallback(cbfn func(obj *ProjectP
 						return
 					}
 
-					panic(fmt.Sprintf("gvk found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterAddCallback] gvk found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -3047,7 +3874,7 @@ func (c *projectProjectTsmV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, ne
 		})
 	} else {
 		log.Debugf("[RegisterUpdateCallback] ProjectProject Create New Informer")
-		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, projectProjectTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				oldData := &ProjectProject{
@@ -3079,9 +3906,18 @@ func (c *projectProjectTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Proje
 		sub := s.(subscription)
 		registrationId, err = sub.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseprojecttsmtanzuvmwarecomv1.Project)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ProjectProject{
 					client:  c.client,
-					Project: obj.(*baseprojecttsmtanzuvmwarecomv1.Project),
+					Project: deleted,
 				}
 
 				var parent *RootRoot
@@ -3114,9 +3950,11 @@ func (c *projectProjectTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Proje
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -3126,7 +3964,8 @@ func (c *projectProjectTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Proje
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -3134,12 +3973,21 @@ func (c *projectProjectTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Proje
 		})
 	} else {
 		log.Debugf("[RegisterDeleteCallback] ProjectProject Create New Informer")
-		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		informer := informerprojecttsmtanzuvmwarecomv1.NewProjectInformer(c.client.baseClient, informerResyncPeriod*time.Second, projectProjectTsmV1Indexers())
 		registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
+				// the final state of an object deleted while the informer was disconnected is unknown
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				deleted, ok := obj.(*baseprojecttsmtanzuvmwarecomv1.Project)
+				if !ok {
+					log.Errorf("[RegisterDeleteCallback] unexpected deleted object %T", obj)
+					return
+				}
 				nc := &ProjectProject{
 					client:  c.client,
-					Project: obj.(*baseprojecttsmtanzuvmwarecomv1.Project),
+					Project: deleted,
 				}
 
 				var parent *RootRoot
@@ -3173,9 +4021,11 @@ func (c *projectProjectTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Proje
 							return
 						}
 
-						panic("error occurred while fetching parent " + err.Error())
+						log.Errorf("[RegisterDeleteCallback] error occurred while fetching parent of %s: %s", nc.DisplayName(), err)
+						return
 					}
-					panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] parent found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				if gvkExist {
@@ -3185,7 +4035,8 @@ func (c *projectProjectTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Proje
 						return
 					}
 
-					panic(fmt.Sprintf("gvk not found (event loop is stalled) " + nc.DisplayName()))
+					log.Errorf("[RegisterDeleteCallback] gvk not found (event loop is stalled) %s", nc.DisplayName())
+					return
 				}
 
 				cbfn(nc)
@@ -3196,6 +4047,15 @@ func (c *projectProjectTsmV1Chainer) RegisterDeleteCallback(cbfn func(obj *Proje
 	return registrationId, err
 }
 
+// UnregisterCallback removes a callback registered on the subscription informer of ProjectProject
+func (c *projectProjectTsmV1Chainer) UnregisterCallback(registrationId cache.ResourceEventHandlerRegistration) error {
+	key := "projects.project.tsm-tanzu.vmware.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		return s.(subscription).informer.RemoveEventHandler(registrationId)
+	}
+	return nil
+}
+
 func (c *projectProjectTsmV1Chainer) Config() *configConfigTsmV1Chainer {
 	parentLabels := c.parentLabels
 	parentLabels["configs.config.tsm-tanzu.vmware.com"] = helper.DEFAULT_KEY
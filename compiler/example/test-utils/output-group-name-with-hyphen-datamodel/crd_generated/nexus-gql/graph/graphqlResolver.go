@@ -8,9 +8,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 
 	qm "github.com/vmware-tanzu/graph-framework-for-microservices/nexus/generated/query-manager"
+	"../../example/test-utils/output-group-name-with-hyphen-datamodel/crd_generated/common"
 	nexus_client "../../example/test-utils/output-group-name-with-hyphen-datamodel/crd_generated/nexus-client"
+	baseconfigtsmtanzuvmwarecomv1 "../../example/test-utils/output-group-name-with-hyphen-datamodel/crd_generated/apis/config.tsm-tanzu.vmware.com/v1"
+	baseprojecttsmtanzuvmwarecomv1 "../../example/test-utils/output-group-name-with-hyphen-datamodel/crd_generated/apis/project.tsm-tanzu.vmware.com/v1"
+	baseroottsmtanzuvmwarecomv1 "../../example/test-utils/output-group-name-with-hyphen-datamodel/crd_generated/apis/root.tsm-tanzu.vmware.com/v1"
 	"../../example/test-utils/output-group-name-with-hyphen-datamodel/crd_generated/nexus-gql/graph/model"
 )
 
@@ -20,6 +25,10 @@ var c = GrpcClients{
 }
 var nc *nexus_client.Clientset
 
+// subscriptionBufferSize is the number of events buffered for each subscription, events of a subscription with a full
+// buffer are dropped, so a slow subscriber doesn't block the informers
+const subscriptionBufferSize = 100
+
 func getParentName(parentLabels map[string]interface{}, key string) string {
     if v, ok := parentLabels[key]; ok && v != nil {
 	    return v.(string)
@@ -60,29 +69,109 @@ func getK8sAPIEndpointConfig() *rest.Config {
 	config.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(200, 300)
 	return config
 }
-//////////////////////////////////////
-// Non Singleton Resolver for Parent Node
-// PKG: Root, NODE: Root
-//////////////////////////////////////
-func getRootResolver(id *string) ([]*model.RootRoot, error) {
+
+func initNexusClient() error {
 	if nc == nil {
 		k8sApiConfig := getK8sAPIEndpointConfig()
 		nexusClient, err := nexus_client.NewForConfig(k8sApiConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get k8s client config: %s", err)
+			return codedError{code: ErrorCodeUnavailable, err: fmt.Errorf("failed to get k8s client config: %s", err)}
 		}
 		nc = nexusClient
 		nc.SubscribeAll()
 		log.Debugf("Subscribed to all nodes in datamodel")
 	}
+	return nil
+}
+
+// loaderKey is the key of the loader in the context of a request
+type loaderKey struct{}
+
+// loader deduplicates the lookups of nexus nodes done by the resolvers of a single request. Every node is stored
+// under the key of its type and parent labels, so that the resolvers of its fields reuse it instead of fetching it
+// again, and concurrent resolvers looking up the same node wait for a single fetch.
+type loader struct {
+	mtx   sync.Mutex
+	calls map[string]*loaderCall
+}
+
+type loaderCall struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+}
+
+// WithLoader returns a copy of ctx carrying a new loader, it is called once for every GraphQL operation
+func WithLoader(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loaderKey{}, &loader{calls: map[string]*loaderCall{}})
+}
+
+// loaderCacheKey returns the key of a node of the given type identified by its parent labels
+func loaderCacheKey(nodeType string, parentLabels map[string]interface{}) string {
+	keys := make([]string, 0, len(parentLabels))
+	for k := range parentLabels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	key := nodeType
+	for _, k := range keys {
+		key += fmt.Sprintf("/%s=%v", k, parentLabels[k])
+	}
+	return key
+}
+
+// load returns the node stored under key in the loader of the request, fetch is called if it isn't loaded yet
+// or if there is no loader in ctx
+func load(ctx context.Context, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	l, ok := ctx.Value(loaderKey{}).(*loader)
+	if !ok {
+		return fetch()
+	}
+	l.mtx.Lock()
+	if call, ok := l.calls[key]; ok {
+		l.mtx.Unlock()
+		<-call.done
+		return call.val, call.err
+	}
+	call := &loaderCall{done: make(chan struct{})}
+	l.calls[key] = call
+	l.mtx.Unlock()
+
+	call.val, call.err = fetch()
+	close(call.done)
+	return call.val, call.err
+}
+
+// prime stores a node which was already fetched in the loader of the request
+func prime(ctx context.Context, key string, val interface{}) {
+	l, ok := ctx.Value(loaderKey{}).(*loader)
+	if !ok {
+		return
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if _, ok := l.calls[key]; !ok {
+		call := &loaderCall{done: make(chan struct{}), val: val}
+		close(call.done)
+		l.calls[key] = call
+	}
+}
+//////////////////////////////////////
+// Non Singleton Resolver for Parent Node
+// PKG: Root, NODE: Root
+//////////////////////////////////////
+func getRootResolver(ctx context.Context, id *string) ([]*model.RootRoot, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
 
 	var vRootList []*model.RootRoot
 	if id != nil && *id != "" {
 		log.Debugf("[getRootResolver]Id: %q", *id)
-		vRoot, err := nc.GetRootRoot(context.TODO(), *id)
+		vRoot, err := nc.GetRootRoot(ctx, *id)
 		if err != nil {
 			log.Errorf("[getRootResolver]Error getting Root node %q: %s", *id, err)
-			return nil, nil
+			return nil, err
 		}
 		dn := vRoot.DisplayName()
 parentLabels := map[string]interface{}{"roots.root.tsm-tanzu.vmware.com":dn}
@@ -93,6 +182,7 @@ vSomeRootData := string(vRoot.Spec.SomeRootData)
 	ParentLabels: parentLabels,
 	SomeRootData: &vSomeRootData,
 	}
+		prime(ctx, loaderCacheKey("RootRoot", ret.ParentLabels), vRoot)
 		vRootList = append(vRootList, ret)
 		log.Debugf("[getRootResolver]Output Root objects %+v", vRootList)
 		return vRootList, nil
@@ -100,17 +190,12 @@ vSomeRootData := string(vRoot.Spec.SomeRootData)
 
 	log.Debugf("[getRootResolver]Id is empty, process all Roots")
 
-	vRootListObj, err := nc.Root().ListRoots(context.TODO(), metav1.ListOptions{})
+	vRootListObj, err := nc.Root().ListRoots(ctx, metav1.ListOptions{})
 	if err != nil {
 		log.Errorf("[getRootResolver]Error getting Root node %s", err)
-		return nil, nil
+		return nil, err
 	}
-	for _,i := range vRootListObj{
-		vRoot, err := nc.GetRootRoot(context.TODO(), i.DisplayName())
-		if err != nil {
-			log.Errorf("[getRootResolver]Error getting Root node %q : %s", i.DisplayName(), err)
-			continue
-		}
+	for _, vRoot := range vRootListObj {
 		dn := vRoot.DisplayName()
 parentLabels := map[string]interface{}{"roots.root.tsm-tanzu.vmware.com":dn}
 vSomeRootData := string(vRoot.Spec.SomeRootData)
@@ -120,6 +205,7 @@ vSomeRootData := string(vRoot.Spec.SomeRootData)
 	ParentLabels: parentLabels,
 	SomeRootData: &vSomeRootData,
 	}
+		prime(ctx, loaderCacheKey("RootRoot", ret.ParentLabels), vRoot)
 		vRootList = append(vRootList, ret)
 	}
 
@@ -127,16 +213,40 @@ vSomeRootData := string(vRoot.Spec.SomeRootData)
 	return vRootList, nil
 }
 
+func getRootConnectionResolver(ctx context.Context, first *int, after *string, filter *model.RootRootFilter, orderBy []*model.RootRootOrderBy) (*model.RootRootConnection, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	vRootListObj, err := nc.Root().ListRoots(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Errorf("[getRootConnectionResolver]Error getting Root node %s", err)
+		return nil, err
+	}
+	return getRootRootConnection(vRootListObj, func(vRoot *nexus_client.RootRoot) *model.RootRoot {
+		dn := vRoot.DisplayName()
+parentLabels := map[string]interface{}{"roots.root.tsm-tanzu.vmware.com":dn}
+vSomeRootData := string(vRoot.Spec.SomeRootData)
+
+		ret := &model.RootRoot {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	SomeRootData: &vSomeRootData,
+	}
+		prime(ctx, loaderCacheKey("RootRoot", ret.ParentLabels), vRoot)
+		return ret
+	}, first, after, filter, orderBy)
+}
+
 //////////////////////////////////////
 // CHILD RESOLVER (Singleton)
 // FieldName: Project Node: Root PKG: Root
 //////////////////////////////////////
-func getRootRootProjectResolver(obj *model.RootRoot) (*model.ProjectProject, error) {
+func getRootRootProjectResolver(ctx context.Context, obj *model.RootRoot) (*model.ProjectProject, error) {
 	log.Debugf("[getRootRootProjectResolver]Parent Object %+v", obj)
-	vProject, err := nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).GetProject(context.TODO())
+	vProject, err := nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).GetProject(ctx)
 	if err != nil {
 	    log.Errorf("[getRootRootProjectResolver]Error getting Root node %s", err)
-        return &model.ProjectProject{}, nil
+        return nil, err
     }
 	dn := vProject.DisplayName()
 parentLabels := map[string]interface{}{"projects.project.tsm-tanzu.vmware.com":dn}
@@ -154,6 +264,7 @@ vField2 := int(vProject.Spec.Field2)
 	Field1: &vField1,
 	Field2: &vField2,
 	}
+	prime(ctx, loaderCacheKey("ProjectProject", ret.ParentLabels), vProject)
 
     log.Debugf("[getRootRootProjectResolver]Output object %+v", ret)
 	return ret, nil
@@ -162,12 +273,12 @@ vField2 := int(vProject.Spec.Field2)
 // CHILD RESOLVER (Singleton)
 // FieldName: Config Node: Project PKG: Project
 //////////////////////////////////////
-func getProjectProjectConfigResolver(obj *model.ProjectProject) (*model.ConfigConfig, error) {
+func getProjectProjectConfigResolver(ctx context.Context, obj *model.ProjectProject) (*model.ConfigConfig, error) {
 	log.Debugf("[getProjectProjectConfigResolver]Parent Object %+v", obj)
-	vConfig, err := nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).Project().GetConfig(context.TODO())
+	vConfig, err := nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).Project().GetConfig(ctx)
 	if err != nil {
 	    log.Errorf("[getProjectProjectConfigResolver]Error getting Project node %s", err)
-        return &model.ConfigConfig{}, nil
+        return nil, err
     }
 	dn := vConfig.DisplayName()
 parentLabels := map[string]interface{}{"configs.config.tsm-tanzu.vmware.com":dn}
@@ -186,7 +297,1040 @@ MyStructFieldData := string(MyStructField)
 	FieldY: &vFieldY,
 	MyStructField: &MyStructFieldData,
 	}
+	prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
 
     log.Debugf("[getProjectProjectConfigResolver]Output object %+v", ret)
 	return ret, nil
 }
+//////////////////////////////////////
+// CONNECTION
+// Node: Root PKG: Root
+//////////////////////////////////////
+// getRootRootConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getRootRootConnection(list []*nexus_client.RootRoot, toModel func(*nexus_client.RootRoot) *model.RootRoot, first *int, after *string, filter *model.RootRootFilter, orderBy []*model.RootRootOrderBy) (*model.RootRootConnection, error) {
+	type item struct {
+		obj *nexus_client.RootRoot
+		key *model.RootRoot
+	}
+	var items []item
+	for _, v := range list {
+		key := getRootRootConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.SomeRootData, filter.SomeRootData) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareRootRootConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.RootRoot{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareRootRootConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.RootRootConnection{
+		Edges:      make([]*model.RootRootEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.RootRootEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getRootRootConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getRootRootConnectionKey(v *nexus_client.RootRoot) *model.RootRoot {
+	dn := v.DisplayName()
+	key := &model.RootRoot{Id: &dn}
+	vSomeRootData := string(v.Spec.SomeRootData)
+	key.SomeRootData = &vSomeRootData
+	return key
+}
+
+// compareRootRootConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareRootRootConnectionKeys(a, b *model.RootRoot, orderBy []*model.RootRootOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "SomeRootData":
+			c = common.CompareFields(a.SomeRootData, b.SomeRootData)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+//////////////////////////////////////
+// MUTATIONS
+// Node: Root PKG: Root
+//////////////////////////////////////
+func getRootRootObject(ctx context.Context, obj *model.RootRoot, id string) (*nexus_client.RootRoot, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.GetRootRoot(ctx, id)
+}
+
+// loadRootRootObject returns the node of obj from the loader of the request
+func loadRootRootObject(ctx context.Context, obj *model.RootRoot) (*nexus_client.RootRoot, error) {
+	v, err := load(ctx, loaderCacheKey("RootRoot", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.GetRootRoot(ctx, getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.RootRoot), nil
+}
+
+func addRootRootObject(ctx context.Context, obj *model.RootRoot, objToCreate *baseroottsmtanzuvmwarecomv1.Root) (*nexus_client.RootRoot, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.AddRootRoot(ctx, objToCreate)
+}
+
+func setRootRootInput(spec *baseroottsmtanzuvmwarecomv1.RootSpec, input *model.RootRootInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.SomeRootData != nil {
+		spec.SomeRootData = string(*input.SomeRootData)
+	}
+	return nil
+}
+
+func getCreateRootRootResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.RootRootInput) (*model.RootRoot, error) {
+	objToCreate := &baseroottsmtanzuvmwarecomv1.Root{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Id,
+		},
+	}
+	if err := setRootRootInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateRootRootResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vRoot, err := addRootRootObject(ctx, &model.RootRoot{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateRootRootResolver]Error creating Root node %s", err)
+		return nil, err
+	}
+	dn := vRoot.DisplayName()
+parentLabels := map[string]interface{}{"roots.root.tsm-tanzu.vmware.com":dn}
+vSomeRootData := string(vRoot.Spec.SomeRootData)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.RootRoot {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	SomeRootData: &vSomeRootData,
+	}
+	prime(ctx, loaderCacheKey("RootRoot", ret.ParentLabels), vRoot)
+	log.Debugf("[getCreateRootRootResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateRootRootResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input model.RootRootInput) (*model.RootRoot, error) {
+	vRoot, err := getRootRootObject(ctx, &model.RootRoot{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getUpdateRootRootResolver]Error getting Root node %s", err)
+		return nil, err
+	}
+	if err := setRootRootInput(&vRoot.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateRootRootResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vRoot.Update(ctx); err != nil {
+		log.Errorf("[getUpdateRootRootResolver]Error updating Root node %s", err)
+		return nil, err
+	}
+	dn := vRoot.DisplayName()
+parentLabels := map[string]interface{}{"roots.root.tsm-tanzu.vmware.com":dn}
+vSomeRootData := string(vRoot.Spec.SomeRootData)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.RootRoot {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	SomeRootData: &vSomeRootData,
+	}
+	prime(ctx, loaderCacheKey("RootRoot", ret.ParentLabels), vRoot)
+	log.Debugf("[getUpdateRootRootResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteRootRootResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string) (bool, error) {
+	vRoot, err := getRootRootObject(ctx, &model.RootRoot{ParentLabels: ParentLabels}, Id)
+	if err != nil {
+		log.Errorf("[getDeleteRootRootResolver]Error getting Root node %s", err)
+		return false, err
+	}
+	if err := vRoot.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteRootRootResolver]Error deleting Root node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+//////////////////////////////////////
+// SUBSCRIPTION
+// Node: Root PKG: Root
+//////////////////////////////////////
+func getWatchRootRootResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.RootRootEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.RootRoot{ParentLabels: ParentLabels}
+	events := make(chan *model.RootRootEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vRoot *nexus_client.RootRoot) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchRootRootResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vRoot.DisplayName()
+parentLabels := map[string]interface{}{"roots.root.tsm-tanzu.vmware.com":dn}
+vSomeRootData := string(vRoot.Spec.SomeRootData)
+
+		if !matchParentLabels(obj.ParentLabels, vRoot.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.RootRoot {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	SomeRootData: &vSomeRootData,
+	}
+		select {
+		case events <- &model.RootRootEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchRootRootResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot("")
+	addId, err := chainer.RegisterAddCallback(func(vRoot *nexus_client.RootRoot) {
+		send(model.NexusEventTypeAdded, vRoot)
+	})
+	if err != nil {
+		log.Errorf("[getWatchRootRootResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vRoot *nexus_client.RootRoot) {
+		send(model.NexusEventTypeUpdated, vRoot)
+	})
+	if err != nil {
+		log.Errorf("[getWatchRootRootResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vRoot *nexus_client.RootRoot) {
+		send(model.NexusEventTypeDeleted, vRoot)
+	})
+	if err != nil {
+		log.Errorf("[getWatchRootRootResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchRootRootResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchRootRootResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+//////////////////////////////////////
+// CONNECTION
+// Node: Config PKG: Config
+//////////////////////////////////////
+// getConfigConfigConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getConfigConfigConnection(list []*nexus_client.ConfigConfig, toModel func(*nexus_client.ConfigConfig) *model.ConfigConfig, first *int, after *string, filter *model.ConfigConfigFilter, orderBy []*model.ConfigConfigOrderBy) (*model.ConfigConfigConnection, error) {
+	type item struct {
+		obj *nexus_client.ConfigConfig
+		key *model.ConfigConfig
+	}
+	var items []item
+	for _, v := range list {
+		key := getConfigConfigConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.FieldX, filter.FieldX) {
+				continue
+			}
+			if !common.MatchFilter(key.FieldY, filter.FieldY) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareConfigConfigConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.ConfigConfig{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareConfigConfigConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.ConfigConfigConnection{
+		Edges:      make([]*model.ConfigConfigEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.ConfigConfigEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getConfigConfigConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getConfigConfigConnectionKey(v *nexus_client.ConfigConfig) *model.ConfigConfig {
+	dn := v.DisplayName()
+	key := &model.ConfigConfig{Id: &dn}
+	vFieldX := string(v.Spec.FieldX)
+	key.FieldX = &vFieldX
+	vFieldY := int(v.Spec.FieldY)
+	key.FieldY = &vFieldY
+	return key
+}
+
+// compareConfigConfigConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareConfigConfigConnectionKeys(a, b *model.ConfigConfig, orderBy []*model.ConfigConfigOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "FieldX":
+			c = common.CompareFields(a.FieldX, b.FieldX)
+		case "FieldY":
+			c = common.CompareFields(a.FieldY, b.FieldY)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+//////////////////////////////////////
+// MUTATIONS
+// Node: Config PKG: Config
+//////////////////////////////////////
+func getConfigConfigObject(ctx context.Context, obj *model.ConfigConfig, id string) (*nexus_client.ConfigConfig, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).Project().GetConfig(ctx)
+}
+
+// loadConfigConfigObject returns the node of obj from the loader of the request
+func loadConfigConfigObject(ctx context.Context, obj *model.ConfigConfig) (*nexus_client.ConfigConfig, error) {
+	v, err := load(ctx, loaderCacheKey("ConfigConfig", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).Project().GetConfig(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.ConfigConfig), nil
+}
+
+func addConfigConfigObject(ctx context.Context, obj *model.ConfigConfig, objToCreate *baseconfigtsmtanzuvmwarecomv1.Config) (*nexus_client.ConfigConfig, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).Project().AddConfig(ctx, objToCreate)
+}
+
+func setConfigConfigInput(spec *baseconfigtsmtanzuvmwarecomv1.ConfigSpec, input *model.ConfigConfigInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.FieldX != nil {
+		spec.FieldX = string(*input.FieldX)
+	}
+	if input.FieldY != nil {
+		spec.FieldY = int64(*input.FieldY)
+	}
+	if input.MyStructField != nil {
+		if err := json.Unmarshal([]byte(*input.MyStructField), &spec.MyStructField); err != nil {
+			return codedError{code: ErrorCodeBadUserInput, err: fmt.Errorf("invalid value of MyStructField: %s", err)}
+		}
+	}
+	return nil
+}
+
+func getCreateConfigConfigResolver(ctx context.Context, ParentLabels map[string]interface{}, Input *model.ConfigConfigInput) (*model.ConfigConfig, error) {
+	objToCreate := &baseconfigtsmtanzuvmwarecomv1.Config{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+	}
+	if err := setConfigConfigInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateConfigConfigResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vConfig, err := addConfigConfigObject(ctx, &model.ConfigConfig{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateConfigConfigResolver]Error creating Config node %s", err)
+		return nil, err
+	}
+	dn := vConfig.DisplayName()
+parentLabels := map[string]interface{}{"configs.config.tsm-tanzu.vmware.com":dn}
+vFieldX := string(vConfig.Spec.FieldX)
+vFieldY := int(vConfig.Spec.FieldY)
+MyStructField, _ := json.Marshal(vConfig.Spec.MyStructField)
+MyStructFieldData := string(MyStructField)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigConfig {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	FieldX: &vFieldX,
+	FieldY: &vFieldY,
+	MyStructField: &MyStructFieldData,
+	}
+	prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
+	log.Debugf("[getCreateConfigConfigResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateConfigConfigResolver(ctx context.Context, ParentLabels map[string]interface{}, Input model.ConfigConfigInput) (*model.ConfigConfig, error) {
+	vConfig, err := getConfigConfigObject(ctx, &model.ConfigConfig{ParentLabels: ParentLabels}, "")
+	if err != nil {
+		log.Errorf("[getUpdateConfigConfigResolver]Error getting Config node %s", err)
+		return nil, err
+	}
+	if err := setConfigConfigInput(&vConfig.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateConfigConfigResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vConfig.Update(ctx); err != nil {
+		log.Errorf("[getUpdateConfigConfigResolver]Error updating Config node %s", err)
+		return nil, err
+	}
+	dn := vConfig.DisplayName()
+parentLabels := map[string]interface{}{"configs.config.tsm-tanzu.vmware.com":dn}
+vFieldX := string(vConfig.Spec.FieldX)
+vFieldY := int(vConfig.Spec.FieldY)
+MyStructField, _ := json.Marshal(vConfig.Spec.MyStructField)
+MyStructFieldData := string(MyStructField)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ConfigConfig {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	FieldX: &vFieldX,
+	FieldY: &vFieldY,
+	MyStructField: &MyStructFieldData,
+	}
+	prime(ctx, loaderCacheKey("ConfigConfig", ret.ParentLabels), vConfig)
+	log.Debugf("[getUpdateConfigConfigResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteConfigConfigResolver(ctx context.Context, ParentLabels map[string]interface{}) (bool, error) {
+	vConfig, err := getConfigConfigObject(ctx, &model.ConfigConfig{ParentLabels: ParentLabels}, "")
+	if err != nil {
+		log.Errorf("[getDeleteConfigConfigResolver]Error getting Config node %s", err)
+		return false, err
+	}
+	if err := vConfig.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteConfigConfigResolver]Error deleting Config node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+//////////////////////////////////////
+// SUBSCRIPTION
+// Node: Config PKG: Config
+//////////////////////////////////////
+func getWatchConfigConfigResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.ConfigConfigEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.ConfigConfig{ParentLabels: ParentLabels}
+	events := make(chan *model.ConfigConfigEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vConfig *nexus_client.ConfigConfig) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchConfigConfigResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vConfig.DisplayName()
+parentLabels := map[string]interface{}{"configs.config.tsm-tanzu.vmware.com":dn}
+vFieldX := string(vConfig.Spec.FieldX)
+vFieldY := int(vConfig.Spec.FieldY)
+MyStructField, _ := json.Marshal(vConfig.Spec.MyStructField)
+MyStructFieldData := string(MyStructField)
+
+		if !matchParentLabels(obj.ParentLabels, vConfig.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.ConfigConfig {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	FieldX: &vFieldX,
+	FieldY: &vFieldY,
+	MyStructField: &MyStructFieldData,
+	}
+		select {
+		case events <- &model.ConfigConfigEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchConfigConfigResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).Project().Config()
+	addId, err := chainer.RegisterAddCallback(func(vConfig *nexus_client.ConfigConfig) {
+		send(model.NexusEventTypeAdded, vConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigConfigResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vConfig *nexus_client.ConfigConfig) {
+		send(model.NexusEventTypeUpdated, vConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigConfigResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vConfig *nexus_client.ConfigConfig) {
+		send(model.NexusEventTypeDeleted, vConfig)
+	})
+	if err != nil {
+		log.Errorf("[getWatchConfigConfigResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchConfigConfigResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchConfigConfigResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+//////////////////////////////////////
+// CONNECTION
+// Node: Project PKG: Project
+//////////////////////////////////////
+// getProjectProjectConnection returns the page of the nodes of list, which are filtered and ordered
+// by their connection keys so that models are only built for the nodes of the page
+func getProjectProjectConnection(list []*nexus_client.ProjectProject, toModel func(*nexus_client.ProjectProject) *model.ProjectProject, first *int, after *string, filter *model.ProjectProjectFilter, orderBy []*model.ProjectProjectOrderBy) (*model.ProjectProjectConnection, error) {
+	type item struct {
+		obj *nexus_client.ProjectProject
+		key *model.ProjectProject
+	}
+	var items []item
+	for _, v := range list {
+		key := getProjectProjectConnectionKey(v)
+		if filter != nil {
+			if !common.MatchFilter(key.Id, filter.Id) {
+				continue
+			}
+			if !common.MatchFilter(key.Key, filter.Key) {
+				continue
+			}
+			if !common.MatchFilter(key.Field1, filter.Field1) {
+				continue
+			}
+			if !common.MatchFilter(key.Field2, filter.Field2) {
+				continue
+			}
+		}
+		items = append(items, item{obj: v, key: key})
+	}
+
+	// nodes are ordered by Id last, so that every node has its own position in the order
+	sort.Slice(items, func(i, j int) bool {
+		return compareProjectProjectConnectionKeys(items[i].key, items[j].key, orderBy) < 0
+	})
+
+	start := 0
+	if after != nil && *after != "" {
+		cursor := &model.ProjectProject{}
+		if err := common.DecodeCursor(*after, cursor); err != nil {
+			return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+		}
+		// the page resumes after the position of the cursor node, even if the node has been deleted since
+		start = sort.Search(len(items), func(i int) bool {
+			return compareProjectProjectConnectionKeys(items[i].key, cursor, orderBy) > 0
+		})
+	}
+	start, end, err := common.Paginate(len(items), start, first)
+	if err != nil {
+		return nil, codedError{code: ErrorCodeBadUserInput, err: err}
+	}
+
+	cursorFields := []string{"Id"}
+	for _, o := range orderBy {
+		cursorFields = append(cursorFields, string(o.Field))
+	}
+	connection := &model.ProjectProjectConnection{
+		Edges:      make([]*model.ProjectProjectEdge, 0, end-start),
+		TotalCount: len(items),
+	}
+	var cursors []string
+	for _, i := range items[start:end] {
+		cursor := common.EncodeCursor(i.key, cursorFields)
+		connection.Edges = append(connection.Edges, &model.ProjectProjectEdge{
+			Cursor: cursor,
+			Node:   toModel(i.obj),
+		})
+		cursors = append(cursors, cursor)
+	}
+	connection.PageInfo = getPageInfo(start, end, len(items), cursors)
+	return connection, nil
+}
+
+// getProjectProjectConnectionKey returns the fields of the node that connections are filtered and
+// ordered by
+func getProjectProjectConnectionKey(v *nexus_client.ProjectProject) *model.ProjectProject {
+	dn := v.DisplayName()
+	key := &model.ProjectProject{Id: &dn}
+	vKey := string(v.Spec.Key)
+	key.Key = &vKey
+	vField1 := string(v.Spec.Field1)
+	key.Field1 = &vField1
+	vField2 := int(v.Spec.Field2)
+	key.Field2 = &vField2
+	return key
+}
+
+// compareProjectProjectConnectionKeys compares the keys of two nodes in the order of orderBy, then by Id
+func compareProjectProjectConnectionKeys(a, b *model.ProjectProject, orderBy []*model.ProjectProjectOrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		switch o.Field {
+		case "Id":
+			c = common.CompareFields(a.Id, b.Id)
+		case "Key":
+			c = common.CompareFields(a.Key, b.Key)
+		case "Field1":
+			c = common.CompareFields(a.Field1, b.Field1)
+		case "Field2":
+			c = common.CompareFields(a.Field2, b.Field2)
+		}
+		if o.Direction != nil && *o.Direction == model.OrderDirectionDESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return common.CompareFields(a.Id, b.Id)
+}
+
+//////////////////////////////////////
+// MUTATIONS
+// Node: Project PKG: Project
+//////////////////////////////////////
+func getProjectProjectObject(ctx context.Context, obj *model.ProjectProject, id string) (*nexus_client.ProjectProject, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).GetProject(ctx)
+}
+
+// loadProjectProjectObject returns the node of obj from the loader of the request
+func loadProjectProjectObject(ctx context.Context, obj *model.ProjectProject) (*nexus_client.ProjectProject, error) {
+	v, err := load(ctx, loaderCacheKey("ProjectProject", obj.ParentLabels), func() (interface{}, error) {
+		if err := initNexusClient(); err != nil {
+			return nil, err
+		}
+		return nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).GetProject(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*nexus_client.ProjectProject), nil
+}
+
+func addProjectProjectObject(ctx context.Context, obj *model.ProjectProject, objToCreate *baseprojecttsmtanzuvmwarecomv1.Project) (*nexus_client.ProjectProject, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	return nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).AddProject(ctx, objToCreate)
+}
+
+func setProjectProjectInput(spec *baseprojecttsmtanzuvmwarecomv1.ProjectSpec, input *model.ProjectProjectInput) error {
+	if input == nil {
+		return nil
+	}
+	if input.Key != nil {
+		spec.Key = string(*input.Key)
+	}
+	if input.Field1 != nil {
+		spec.Field1 = string(*input.Field1)
+	}
+	if input.Field2 != nil {
+		spec.Field2 = int64(*input.Field2)
+	}
+	return nil
+}
+
+func getCreateProjectProjectResolver(ctx context.Context, ParentLabels map[string]interface{}, Input *model.ProjectProjectInput) (*model.ProjectProject, error) {
+	objToCreate := &baseprojecttsmtanzuvmwarecomv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+	}
+	if err := setProjectProjectInput(&objToCreate.Spec, Input); err != nil {
+		log.Errorf("[getCreateProjectProjectResolver]Invalid input %s", err)
+		return nil, err
+	}
+	vProject, err := addProjectProjectObject(ctx, &model.ProjectProject{ParentLabels: ParentLabels}, objToCreate)
+	if err != nil {
+		log.Errorf("[getCreateProjectProjectResolver]Error creating Project node %s", err)
+		return nil, err
+	}
+	dn := vProject.DisplayName()
+parentLabels := map[string]interface{}{"projects.project.tsm-tanzu.vmware.com":dn}
+vKey := string(vProject.Spec.Key)
+vField1 := string(vProject.Spec.Field1)
+vField2 := int(vProject.Spec.Field2)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ProjectProject {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	Key: &vKey,
+	Field1: &vField1,
+	Field2: &vField2,
+	}
+	prime(ctx, loaderCacheKey("ProjectProject", ret.ParentLabels), vProject)
+	log.Debugf("[getCreateProjectProjectResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getUpdateProjectProjectResolver(ctx context.Context, ParentLabels map[string]interface{}, Input model.ProjectProjectInput) (*model.ProjectProject, error) {
+	vProject, err := getProjectProjectObject(ctx, &model.ProjectProject{ParentLabels: ParentLabels}, "")
+	if err != nil {
+		log.Errorf("[getUpdateProjectProjectResolver]Error getting Project node %s", err)
+		return nil, err
+	}
+	if err := setProjectProjectInput(&vProject.Spec, &Input); err != nil {
+		log.Errorf("[getUpdateProjectProjectResolver]Invalid input %s", err)
+		return nil, err
+	}
+	if err := vProject.Update(ctx); err != nil {
+		log.Errorf("[getUpdateProjectProjectResolver]Error updating Project node %s", err)
+		return nil, err
+	}
+	dn := vProject.DisplayName()
+parentLabels := map[string]interface{}{"projects.project.tsm-tanzu.vmware.com":dn}
+vKey := string(vProject.Spec.Key)
+vField1 := string(vProject.Spec.Field1)
+vField2 := int(vProject.Spec.Field2)
+
+	for k, v := range ParentLabels {
+		parentLabels[k] = v
+	}
+	ret := &model.ProjectProject {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	Key: &vKey,
+	Field1: &vField1,
+	Field2: &vField2,
+	}
+	prime(ctx, loaderCacheKey("ProjectProject", ret.ParentLabels), vProject)
+	log.Debugf("[getUpdateProjectProjectResolver]Output object %+v", ret)
+	return ret, nil
+}
+
+func getDeleteProjectProjectResolver(ctx context.Context, ParentLabels map[string]interface{}) (bool, error) {
+	vProject, err := getProjectProjectObject(ctx, &model.ProjectProject{ParentLabels: ParentLabels}, "")
+	if err != nil {
+		log.Errorf("[getDeleteProjectProjectResolver]Error getting Project node %s", err)
+		return false, err
+	}
+	if err := vProject.Delete(ctx); err != nil {
+		log.Errorf("[getDeleteProjectProjectResolver]Error deleting Project node %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+//////////////////////////////////////
+// SUBSCRIPTION
+// Node: Project PKG: Project
+//////////////////////////////////////
+func getWatchProjectProjectResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.ProjectProjectEvent, error) {
+	if err := initNexusClient(); err != nil {
+		return nil, err
+	}
+	obj := &model.ProjectProject{ParentLabels: ParentLabels}
+	events := make(chan *model.ProjectProjectEvent, subscriptionBufferSize)
+	send := func(eventType model.NexusEventType, vProject *nexus_client.ProjectProject) {
+		// callbacks run in the informer event loop, a failing event is dropped instead of stopping it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("[getWatchProjectProjectResolver]Error sending %s event %v", eventType, r)
+			}
+		}()
+		dn := vProject.DisplayName()
+parentLabels := map[string]interface{}{"projects.project.tsm-tanzu.vmware.com":dn}
+vKey := string(vProject.Spec.Key)
+vField1 := string(vProject.Spec.Field1)
+vField2 := int(vProject.Spec.Field2)
+
+		if !matchParentLabels(obj.ParentLabels, vProject.Labels, parentLabels) {
+			return
+		}
+		for k, v := range obj.ParentLabels {
+			parentLabels[k] = v
+		}
+		ret := &model.ProjectProject {
+	Id: &dn,
+	ParentLabels: parentLabels,
+	Key: &vKey,
+	Field1: &vField1,
+	Field2: &vField2,
+	}
+		select {
+		case events <- &model.ProjectProjectEvent{Type: eventType, Object: ret}:
+		case <-ctx.Done():
+		default:
+			log.Warnf("[getWatchProjectProjectResolver]Subscription buffer is full, dropping %s event", eventType)
+		}
+	}
+
+	chainer := nc.RootRoot(getParentName(obj.ParentLabels, "roots.root.tsm-tanzu.vmware.com")).Project()
+	addId, err := chainer.RegisterAddCallback(func(vProject *nexus_client.ProjectProject) {
+		send(model.NexusEventTypeAdded, vProject)
+	})
+	if err != nil {
+		log.Errorf("[getWatchProjectProjectResolver]Error registering add callback %s", err)
+		return nil, err
+	}
+	updateId, err := chainer.RegisterUpdateCallback(func(_, vProject *nexus_client.ProjectProject) {
+		send(model.NexusEventTypeUpdated, vProject)
+	})
+	if err != nil {
+		log.Errorf("[getWatchProjectProjectResolver]Error registering update callback %s", err)
+		chainer.UnregisterCallback(addId)
+		return nil, err
+	}
+	deleteId, err := chainer.RegisterDeleteCallback(func(vProject *nexus_client.ProjectProject) {
+		send(model.NexusEventTypeDeleted, vProject)
+	})
+	if err != nil {
+		log.Errorf("[getWatchProjectProjectResolver]Error registering delete callback %s", err)
+		chainer.UnregisterCallback(addId)
+		chainer.UnregisterCallback(updateId)
+		return nil, err
+	}
+
+	// the channel is not closed as the callbacks may still be running, it is released with the subscription
+	go func() {
+		<-ctx.Done()
+		log.Debugf("[getWatchProjectProjectResolver]Subscription closed")
+		for _, id := range []cache.ResourceEventHandlerRegistration{addId, updateId, deleteId} {
+			if err := chainer.UnregisterCallback(id); err != nil {
+				log.Errorf("[getWatchProjectProjectResolver]Error unregistering callback %s", err)
+			}
+		}
+	}()
+	return events, nil
+}
+
+// matchParentLabels checks that a node notified to a subscription is in the scope of the subscription's parent labels,
+// labels of the node itself are checked against nodeLabels and labels of its parents against the labels of the object
+func matchParentLabels(parentLabels map[string]interface{}, labels map[string]string, nodeLabels map[string]interface{}) bool {
+	for k, v := range parentLabels {
+		name, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if nodeName, ok := nodeLabels[k]; ok {
+			if nodeName != name {
+				return false
+			}
+			continue
+		}
+		if labels[k] != name {
+			return false
+		}
+	}
+	return true
+}
+
+// convertMutationInput copies the value of the mutation input to the field of the node spec
+func convertMutationInput(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// getPageInfo returns the page info of the range of positions of a connection, cursors are the ones of its nodes
+func getPageInfo(start, end, total int, cursors []string) *model.PageInfo {
+	pageInfo := &model.PageInfo{
+		HasNextPage:     end < total,
+		HasPreviousPage: start > 0,
+	}
+	if len(cursors) > 0 {
+		pageInfo.StartCursor = &cursors[0]
+		pageInfo.EndCursor = &cursors[len(cursors)-1]
+	}
+	return pageInfo
+}
+
+const (
+	ErrorCodeNotFound     = "NOT_FOUND"
+	ErrorCodeForbidden    = "FORBIDDEN"
+	ErrorCodeUnavailable  = "UNAVAILABLE"
+	ErrorCodeBadUserInput = "BAD_USER_INPUT"
+	ErrorCodeConflict     = "CONFLICT"
+	ErrorCodeInternal     = "INTERNAL"
+)
+
+// codedError is an error of the resolvers which sets the code of the GraphQL error itself
+type codedError struct {
+	code string
+	err  error
+}
+
+func (e codedError) Error() string {
+	return e.err.Error()
+}
+
+func (e codedError) Unwrap() error {
+	return e.err
+}
+
+// ErrorCode returns the code of a resolver error set in the extensions of the GraphQL error, errors of the nexus
+// client and of the k8s API are mapped to the code of their kind
+func ErrorCode(err error) string {
+	var coded codedError
+	switch {
+	case errors.As(err, &coded):
+		return coded.code
+	case nexus_client.IsNotFound(err), nexus_client.IsParentNotFound(err), nexus_client.IsChildNotFound(err),
+		nexus_client.IsLinkNotFound(err), nexus_client.IsUniqueNotFound(err):
+		return ErrorCodeNotFound
+	case nexus_client.IsForbidden(err), nexus_client.IsUnauthorized(err):
+		return ErrorCodeForbidden
+	case nexus_client.IsServiceUnavailable(err), nexus_client.IsTimeout(err), nexus_client.IsServerTimeout(err),
+		nexus_client.IsTooManyRequests(err), errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeUnavailable
+	case nexus_client.IsSingletonNameError(err), nexus_client.IsInvalid(err), nexus_client.IsBadRequest(err):
+		return ErrorCodeBadUserInput
+	case nexus_client.IsAlreadyExists(err), nexus_client.IsConflict(err):
+		return ErrorCodeConflict
+	}
+	return ErrorCodeInternal
+}
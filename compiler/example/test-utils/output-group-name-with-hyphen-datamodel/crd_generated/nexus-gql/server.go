@@ -1,13 +1,21 @@
 package main
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"../../example/test-utils/output-group-name-with-hyphen-datamodel/crd_generated/nexus-gql/graph"
 	"../../example/test-utils/output-group-name-with-hyphen-datamodel/crd_generated/nexus-gql/graph/generated"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/gqlgen/graphql"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/gqlgen/graphql/handler"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/gqlgen/graphql/handler/extension"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/gqlgen/graphql/handler/lru"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/gqlgen/graphql/handler/transport"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/gqlgen/graphql/playground"
 )
 
@@ -19,7 +27,44 @@ func StartHttpServer() {
 	})
 
 	ES := generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})
-	Hander_server := handler.NewDefaultServer(ES)
+	Hander_server := handler.New(ES)
+	// subscriptions are served over websocket (graphql-ws and graphql-transport-ws),
+	// origins are not checked as for the CORS requests
+	Hander_server.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	})
+	Hander_server.AddTransport(transport.Options{})
+	Hander_server.AddTransport(transport.GET{})
+	Hander_server.AddTransport(transport.POST{})
+	Hander_server.AddTransport(transport.MultipartForm{})
+	Hander_server.SetQueryCache(lru.New(1000))
+	Hander_server.Use(extension.Introspection{})
+	Hander_server.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
+	// nodes are loaded once per query or mutation, subscriptions always get the current state of the nodes
+	Hander_server.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		if graphql.GetOperationContext(ctx).Operation.Operation == ast.Subscription {
+			return next(ctx)
+		}
+		return next(graph.WithLoader(ctx))
+	})
+	// errors of the resolvers are returned on the path of their field, with the kind of the error in the extensions
+	Hander_server.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
+		gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+		if _, ok := gqlErr.Extensions["code"]; !ok {
+			gqlErr.Extensions["code"] = graph.ErrorCode(err)
+		}
+		return gqlErr
+	})
 	HttpHandlerFunc := playground.Handler("GraphQL playground", "/apis/graphql/v1/query")
 	http.Handle("/", HttpHandlerFunc)
 	http.Handle("/query", c.Handler(Hander_server))
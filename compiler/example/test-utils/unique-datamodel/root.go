@@ -0,0 +1,34 @@
+package root
+
+import (
+	"github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus"
+)
+
+type Root struct {
+	nexus.SingletonNode
+	Projects Project `nexus:"children"`
+}
+
+type Project struct {
+	nexus.Node
+	// nexus-unique: scope=global
+	Owner string
+	Gnses Gns `nexus:"children"`
+}
+
+// nexus-unique: Domain, Port, scope=global
+type Gns struct {
+	nexus.Node
+	// nexus-unique
+	Domain string
+	Port   int
+	Tier   Tier `json:"tier"`
+}
+
+// nexus-enum
+type Tier string
+
+const (
+	Gold   Tier = "gold"
+	Silver Tier = "silver"
+)
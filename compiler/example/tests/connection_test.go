@@ -0,0 +1,88 @@
+package nexus_compiler_test
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/common"
+)
+
+// connectionKey has pointer fields like the models of nodes that connections are filtered and ordered by.
+type connectionKey struct {
+	Id     *string `json:"Id,omitempty"`
+	Domain *string `json:"Domain,omitempty"`
+	Meta   *string `json:"Meta,omitempty"`
+}
+
+var _ = Describe("Connection tests", func() {
+	two, negative := 2, -1
+
+	DescribeTable("should paginate connections",
+		func(start int, first *int, expectedStart, expectedEnd int) {
+			start, end, err := common.Paginate(5, start, first)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(start).To(Equal(expectedStart))
+			Expect(end).To(Equal(expectedEnd))
+		},
+		Entry("all nodes", 0, nil, 0, 5),
+		Entry("first nodes", 0, &two, 0, 2),
+		Entry("nodes after a position", 2, &two, 2, 4),
+		Entry("last nodes", 4, &two, 4, 5),
+		Entry("start after the last node", 7, &two, 5, 5),
+	)
+
+	It("should fail to paginate with negative first", func() {
+		_, _, err := common.Paginate(5, 0, &negative)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should decode only the fields of the cursor", func() {
+		id, domain := "gns", "example.com"
+		cursor := common.EncodeCursor(&connectionKey{Id: &id, Domain: &domain, Meta: &domain}, []string{"Id", "Domain"})
+
+		key := &connectionKey{}
+		Expect(common.DecodeCursor(cursor, key)).To(Succeed())
+		Expect(key).To(Equal(&connectionKey{Id: &id, Domain: &domain}))
+	})
+
+	DescribeTable("should fail to decode invalid cursors",
+		func(cursor string) {
+			Expect(common.DecodeCursor(cursor, &connectionKey{})).NotTo(Succeed())
+		},
+		Entry("not base64", "not base64"),
+		Entry("not json", base64.StdEncoding.EncodeToString([]byte("cursor:1"))),
+		Entry("without Id", base64.StdEncoding.EncodeToString([]byte(`{"Domain":"example.com"}`))),
+		Entry("Id of a different type", base64.StdEncoding.EncodeToString([]byte(`{"Id":1}`))),
+	)
+
+	It("should match filters", func() {
+		a, b := "a", "b"
+		yes := true
+		Expect(common.MatchFilter(&a, (*string)(nil))).To(BeTrue())
+		Expect(common.MatchFilter((*string)(nil), &a)).To(BeFalse())
+		Expect(common.MatchFilter(&a, &a)).To(BeTrue())
+		Expect(common.MatchFilter(&a, &b)).To(BeFalse())
+		Expect(common.MatchFilter(&yes, &yes)).To(BeTrue())
+	})
+
+	a, b := "a", "b"
+	one, high := 1, 2
+	low, higher := 0.5, 1.5
+	no, yes := false, true
+	DescribeTable("should compare fields",
+		func(x, y interface{}, expected int) {
+			Expect(common.CompareFields(x, y)).To(Equal(expected))
+		},
+		Entry("no values", (*string)(nil), (*string)(nil), 0),
+		Entry("no value is lower", (*string)(nil), &a, -1),
+		Entry("value is higher than no value", &a, (*string)(nil), 1),
+		Entry("equal strings", &a, &a, 0),
+		Entry("lower string", &a, &b, -1),
+		Entry("higher int", &high, &one, 1),
+		Entry("lower float", &low, &higher, -1),
+		Entry("false is lower", &no, &yes, -1),
+	)
+})
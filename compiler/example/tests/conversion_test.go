@@ -0,0 +1,78 @@
+package nexus_compiler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/common"
+)
+
+// projectHub and projectSpoke are two versions of a node, Owner exists only in the hub and Description only in
+// the spoke version.
+type projectHub struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              projectHubSpec `json:"spec,omitempty"`
+}
+
+type projectHubSpec struct {
+	Name  string `json:"name"`
+	Owner string `json:"owner,omitempty"`
+}
+
+type projectSpoke struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              projectSpokeSpec `json:"spec,omitempty"`
+}
+
+type projectSpokeSpec struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+var _ = Describe("Conversion tests", func() {
+	It("should keep fields of the spoke version when converting to the hub and back", func() {
+		spoke := &projectSpoke{
+			ObjectMeta: metav1.ObjectMeta{Name: "project", Labels: map[string]string{"team": "a"}},
+			Spec:       projectSpokeSpec{Name: "project", Description: "spoke only"},
+		}
+
+		hub := &projectHub{}
+		Expect(common.ConvertPreservingFields(spoke, hub, "v2", "v1")).To(Succeed())
+		Expect(hub.Spec.Name).To(Equal("project"))
+		Expect(hub.Annotations).To(HaveKeyWithValue(common.CONVERSION_DATA_ANNOTATION_PREFIX+"v2", `{"spec":{"description":"spoke only"}}`))
+
+		converted := &projectSpoke{}
+		Expect(common.ConvertPreservingFields(hub, converted, "v1", "v2")).To(Succeed())
+		Expect(converted).To(Equal(spoke))
+	})
+
+	It("should keep fields of the hub version when converting to the spoke and back", func() {
+		hub := &projectHub{
+			ObjectMeta: metav1.ObjectMeta{Name: "project", Annotations: map[string]string{"owner": "b"}},
+			Spec:       projectHubSpec{Name: "project", Owner: "hub only"},
+		}
+
+		spoke := &projectSpoke{}
+		Expect(common.ConvertPreservingFields(hub, spoke, "v1", "v2")).To(Succeed())
+		Expect(spoke.Annotations).To(HaveKey(common.CONVERSION_DATA_ANNOTATION_PREFIX + "v1"))
+
+		converted := &projectHub{}
+		Expect(common.ConvertPreservingFields(spoke, converted, "v2", "v1")).To(Succeed())
+		Expect(converted).To(Equal(hub))
+	})
+
+	It("should not add annotations when no fields are lost", func() {
+		spoke := &projectSpoke{
+			ObjectMeta: metav1.ObjectMeta{Name: "project"},
+			Spec:       projectSpokeSpec{Name: "project"},
+		}
+
+		hub := &projectHub{}
+		Expect(common.ConvertPreservingFields(spoke, hub, "v2", "v1")).To(Succeed())
+		Expect(hub.Annotations).To(BeEmpty())
+		Expect(hub.Spec.Name).To(Equal("project"))
+	})
+})
@@ -3,6 +3,8 @@ package nexus_compiler_test
 import (
 	"context"
 	"fmt"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/output/generated/helper"
@@ -119,12 +121,16 @@ var _ = Describe("Nexus clients tests", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getGns.GetName()).To(Equal(gns.GetName()))
 
+			// fake client has no garbage collector, Kubernetes deletes children owned by the deleted object
+			Expect(getGns.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion: "config.tsm.tanzu.vmware.com/v1",
+				Kind:       "Config",
+				Name:       cfg.GetName(),
+			}))
+
 			err = root.DeleteConfig(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
-			getGns, err = fakeClient.Gns().GetGnsByName(context.TODO(), gns.GetName())
-			Expect(err).To(HaveOccurred())
-
 			cfg, err = root.GetConfig(context.TODO())
 
 			//Expect(err).NotTo(HaveOccurred())
@@ -155,6 +161,37 @@ var _ = Describe("Nexus clients tests", func() {
 			Expect(cfg.Spec.MyStr0).To(Equal(&updatedStr))
 		})
 
+		It("should update object only if it wasn't modified with optimistic concurrency", func() {
+			cfgDef := &configv1.Config{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "configObj",
+					// fake client doesn't set resourceVersion of objects
+					ResourceVersion: "1",
+				},
+				Spec: configv1.ConfigSpec{
+					MyStr0: &str,
+				},
+			}
+			cfg, err := root.AddConfig(context.TODO(), cfgDef)
+			Expect(err).NotTo(HaveOccurred())
+
+			var updatedStr gnsv1.MyStr = "updatedStr"
+			cfg.Spec.MyStr0 = &updatedStr
+			err = cfg.Update(nexus_client.WithOptimisticConcurrency(context.TODO()))
+			Expect(err).NotTo(HaveOccurred())
+
+			var staleStr gnsv1.MyStr = "staleStr"
+			cfg.Spec.MyStr0 = &staleStr
+			cfg.ResourceVersion = "0"
+			err = cfg.Update(nexus_client.WithOptimisticConcurrency(context.TODO()))
+			Expect(nexus_client.IsResourceVersionConflict(err)).To(BeTrue())
+			Expect(nexus_client.IsConflict(err)).To(BeTrue())
+
+			cfg, err = root.GetConfig(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Spec.MyStr0).To(Equal(&updatedStr))
+		})
+
 		It("should create named child", func() {
 			cfgName := "configObj"
 			cfgDef := &configv1.Config{
@@ -269,7 +306,7 @@ var _ = Describe("Nexus clients tests", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
-		It("should remove all children when parent is removed", func() {
+		It("should make parent owner of all children", func() {
 			cfgName := "configObj"
 			cfgDef := &configv1.Config{
 				ObjectMeta: metav1.ObjectMeta{
@@ -295,19 +332,26 @@ var _ = Describe("Nexus clients tests", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(gns.DisplayName()).To(Equal("gnsName"))
 
+			Expect(cfg.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion: "root.tsm.tanzu.vmware.com/v1",
+				Kind:       "Root",
+				Name:       root.GetName(),
+			}))
+			Expect(gns.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion: "config.tsm.tanzu.vmware.com/v1",
+				Kind:       "Config",
+				Name:       cfg.GetName(),
+			}))
+
 			err = root.Delete(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
-			cfg, err = fakeClient.Config().GetConfigByName(context.TODO(), cfg.GetName())
-			Expect(err).To(HaveOccurred())
-			Expect(cfg).To(BeNil())
-
-			gns, err = fakeClient.Gns().GetGnsByName(context.TODO(), gns.GetName())
-			Expect(err).To(HaveOccurred())
-			Expect(gns).To(BeNil())
+			root, err := fakeClient.GetRootRoot(context.TODO())
+			Expect(nexus_client.IsNotFound(err)).To(BeTrue())
+			Expect(root).To(BeNil())
 		})
 
-		It("should delete all named children when parent is removed", func() {
+		It("should make parent owner of all named children", func() {
 			cfgName := "configObj"
 			cfgDef := &configv1.Config{
 				ObjectMeta: metav1.ObjectMeta{
@@ -343,18 +387,21 @@ var _ = Describe("Nexus clients tests", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(sg2.DisplayName()).To(Equal("sg2"))
 
-			_, err = fakeClient.Servicegroup().GetSvcGroupByName(context.TODO(), sg1.GetName())
-			Expect(err).NotTo(HaveOccurred())
-			_, err = fakeClient.Servicegroup().GetSvcGroupByName(context.TODO(), sg2.GetName())
-			Expect(err).NotTo(HaveOccurred())
+			for _, sg := range []*nexus_client.ServicegroupSvcGroup{sg1, sg2} {
+				sg, err = fakeClient.Servicegroup().GetSvcGroupByName(context.TODO(), sg.GetName())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sg.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+					APIVersion: "gns.tsm.tanzu.vmware.com/v1",
+					Kind:       "Gns",
+					Name:       gns.GetName(),
+				}))
+			}
 
 			err = cfg.DeleteGNS(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
-			_, err = fakeClient.Servicegroup().GetSvcGroupByName(context.TODO(), sg1.GetName())
-			Expect(err).To(HaveOccurred())
-			_, err = fakeClient.Servicegroup().GetSvcGroupByName(context.TODO(), sg2.GetName())
-			Expect(err).To(HaveOccurred())
+			_, err = fakeClient.Gns().GetGnsByName(context.TODO(), gns.GetName())
+			Expect(nexus_client.IsNotFound(err)).To(BeTrue())
 		})
 	})
 
@@ -456,6 +503,214 @@ var _ = Describe("Nexus clients tests", func() {
 		})
 	})
 
+	Context("Unique fields", func() {
+		It("should get object by unique field of given parent", func() {
+			fakeClient = nexus_client.NewFakeClient()
+			rootDef := &rootv1.Root{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "default",
+				},
+			}
+			root, err := fakeClient.AddRootRoot(context.TODO(), rootDef)
+			Expect(err).NotTo(HaveOccurred())
+			var configs []*nexus_client.ConfigConfig
+			for _, name := range []string{"cfg1", "cfg2"} {
+				cfgDef := &configv1.Config{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: name,
+					},
+				}
+				cfg, err := root.AddConfig(context.TODO(), cfgDef)
+				Expect(err).NotTo(HaveOccurred())
+				gnsDef := &gnsv1.Gns{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "gns-" + name,
+					},
+					Spec: gnsv1.GnsSpec{
+						Domain: "example.com",
+					},
+				}
+				_, err = cfg.AddGNS(context.TODO(), gnsDef)
+				Expect(err).NotTo(HaveOccurred())
+				configs = append(configs, cfg)
+			}
+
+			gns, err := fakeClient.Gns().GetGnsByDomain(context.TODO(), configs[1].GetName(), "example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gns.DisplayName()).To(Equal("gns-cfg2"))
+
+			gns, err = fakeClient.Gns().GetGnsByDomain(context.TODO(), configs[0].GetName(), "other.com")
+			Expect(gns).To(BeNil())
+			Expect(nexus_client.IsUniqueNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("Retries", func() {
+		It("should retry requests according to retry policy", func() {
+			var retryable []error
+			fakeClient = nexus_client.NewFakeClient(nexus_client.WithRetryPolicy(nexus_client.RetryPolicy{
+				MaxAttempts:    3,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     time.Millisecond,
+				Multiplier:     2,
+				Jitter:         0.5,
+				Retryable: func(err error) bool {
+					retryable = append(retryable, err)
+					return nexus_client.IsNotFound(err)
+				},
+			}))
+
+			// patch of object which doesn't exist fails
+			_, err := fakeClient.Config().UpdateConfigByName(context.TODO(), &configv1.Config{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "missing",
+				},
+			})
+			Expect(nexus_client.IsNotFound(err)).To(BeTrue())
+			Expect(retryable).To(HaveLen(3))
+		})
+	})
+
+	Context("Transactions", func() {
+		var root *nexus_client.RootRoot
+
+		BeforeEach(func() {
+			var err error
+			root, err = fakeClient.AddRootRoot(context.TODO(), &rootv1.Root{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "default",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should create subtree with links in a transaction", func() {
+			var gns *nexus_client.TxGnsGns
+			err := fakeClient.Tx(context.TODO(), func(tx *nexus_client.Tx) error {
+				// link is recorded before its target is created
+				cfg := root.InTx(tx).AddConfig(&configv1.Config{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cfg",
+					},
+				})
+				gns = cfg.AddGNS(&gnsv1.Gns{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "gnsName",
+					},
+				})
+				gns.LinkDns(cfg.AddDNS(&gnsv1.Dns{}))
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			root, err = fakeClient.GetRootRoot(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			cfg, err := root.GetConfig(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			createdGns, err := cfg.GetGNS(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createdGns.GetName()).To(Equal(gns.GetName()))
+			dns, err := createdGns.GetDns(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dns.DisplayName()).To(Equal("default"))
+		})
+
+		It("should apply links and unlinks of a field in the recorded order", func() {
+			cfg, err := root.AddConfig(context.TODO(), &configv1.Config{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cfg",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			gns, err := cfg.AddGNS(context.TODO(), &gnsv1.Gns{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "gnsName",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			dns, err := cfg.AddDNS(context.TODO(), &gnsv1.Dns{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gns.LinkDns(context.TODO(), dns)).To(Succeed())
+
+			// the link is relinked, it would be removed if the unlink was applied last
+			err = fakeClient.Tx(context.TODO(), func(tx *nexus_client.Tx) error {
+				txGns := gns.InTx(tx)
+				txGns.UnlinkDns()
+				txGns.LinkDns(dns.InTx(tx))
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			gns, err = cfg.GetGNS(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			linkedDns, err := gns.GetDns(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(linkedDns.DisplayName()).To(Equal("default"))
+		})
+
+		It("should not apply transaction if recording failed", func() {
+			err := fakeClient.Tx(context.TODO(), func(tx *nexus_client.Tx) error {
+				root.InTx(tx).AddConfig(&configv1.Config{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cfg",
+					},
+				}).AddDNS(&gnsv1.Dns{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "notDefault",
+					},
+				})
+				return nil
+			})
+			Expect(nexus_client.IsSingletonNameError(err)).To(BeTrue())
+
+			_, err = root.GetConfig(context.TODO())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should roll back applied steps when a step fails", func() {
+			cfg, err := root.AddConfig(context.TODO(), &configv1.Config{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cfg",
+				},
+				Spec: configv1.ConfigSpec{
+					MyStr0: &str,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			dns, err := cfg.AddDNS(context.TODO(), &gnsv1.Dns{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = fakeClient.Tx(context.TODO(), func(tx *nexus_client.Tx) error {
+				txCfg := cfg.InTx(tx)
+				gns := txCfg.AddGNS(&gnsv1.Gns{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "gnsName",
+					},
+				})
+				gns.LinkDns(dns.InTx(tx))
+				var changed gnsv1.MyStr = "changed"
+				txCfg.Spec.MyStr0 = &changed
+				txCfg.Update()
+				dns.InTx(tx).Delete()
+
+				// dns is deleted by someone else before the transaction is applied
+				Expect(cfg.DeleteDNS(context.TODO())).To(Succeed())
+				return nil
+			})
+			Expect(nexus_client.IsTxError(err)).To(BeTrue())
+			Expect(nexus_client.IsNotFound(err)).To(BeTrue())
+
+			cfg, err = root.GetConfig(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*cfg.Spec.MyStr0).To(Equal(str))
+			_, err = cfg.GetGNS(context.TODO())
+			Expect(nexus_client.IsChildNotFound(err)).To(BeTrue())
+			gnsList, err := fakeClient.Gns().ListGnses(context.TODO(), metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gnsList).To(BeEmpty())
+		})
+	})
+
 	Context("Custom Errors", func() {
 		It("should throw IsNotFound error when node's not present", func() {
 			root, err := fakeClient.GetRootRoot(context.TODO())
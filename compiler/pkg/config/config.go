@@ -11,9 +11,56 @@ import (
 var ConfigInstance *Config
 
 type Config struct {
-	GroupName     string   `yaml:"groupName"`
-	CrdModulePath string   `yaml:"crdModulePath"`
-	IgnoredDirs   []string `yaml:"ignoredDirs"`
+	GroupName         string                  `yaml:"groupName"`
+	CrdModulePath     string                  `yaml:"crdModulePath"`
+	IgnoredDirs       []string                `yaml:"ignoredDirs"`
+	ConversionWebhook ConversionWebhookConfig `yaml:"conversionWebhook"`
+	// CacheDir keeps files rendered for DSL packages between runs, so only changed packages are rendered again.
+	// Empty value disables the cache.
+	CacheDir string `yaml:"cacheDir"`
+}
+
+// ConversionWebhookConfig describes the service serving conversion requests of multi-version CRDs.
+type ConversionWebhookConfig struct {
+	ServiceName      string `yaml:"serviceName"`
+	ServiceNamespace string `yaml:"serviceNamespace"`
+	Path             string `yaml:"path"`
+}
+
+const (
+	DefaultConversionWebhookServiceName      = "nexus-conversion-webhook"
+	DefaultConversionWebhookServiceNamespace = "default"
+	DefaultConversionWebhookPath             = "/convert"
+)
+
+// GetConversionWebhook returns the conversion webhook configuration with defaults applied.
+func (c *Config) GetConversionWebhook() ConversionWebhookConfig {
+	webhook := ConversionWebhookConfig{
+		ServiceName:      DefaultConversionWebhookServiceName,
+		ServiceNamespace: DefaultConversionWebhookServiceNamespace,
+		Path:             DefaultConversionWebhookPath,
+	}
+	if c == nil {
+		return webhook
+	}
+	if c.ConversionWebhook.ServiceName != "" {
+		webhook.ServiceName = c.ConversionWebhook.ServiceName
+	}
+	if c.ConversionWebhook.ServiceNamespace != "" {
+		webhook.ServiceNamespace = c.ConversionWebhook.ServiceNamespace
+	}
+	if c.ConversionWebhook.Path != "" {
+		webhook.Path = c.ConversionWebhook.Path
+	}
+	return webhook
+}
+
+// GetCacheDir returns the build cache directory, empty if the cache is disabled.
+func (c *Config) GetCacheDir() string {
+	if c == nil {
+		return ""
+	}
+	return c.CacheDir
 }
 
 func LoadConfig(configFile string) (*Config, error) {
@@ -0,0 +1,277 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"io"
+	"os"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Severity of a diagnostic.
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+)
+
+// Format selects how diagnostics are printed.
+type Format string
+
+const (
+	TextFormat Format = "text"
+	JSONFormat Format = "json"
+)
+
+// ParseFormat validates a diagnostics format given on the command line.
+func ParseFormat(format string) (Format, error) {
+	switch f := Format(format); f {
+	case "":
+		return TextFormat, nil
+	case TextFormat, JSONFormat:
+		return f, nil
+	}
+	return "", fmt.Errorf("unsupported diagnostics format %q, expected one of: %s, %s", format, TextFormat, JSONFormat)
+}
+
+// Position is a location in a DSL source file.
+type Position struct {
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// NoPos is used for diagnostics which can't be attributed to a source location.
+var NoPos = Position{}
+
+// PositionFor resolves pos in the given file set.
+func PositionFor(fileset *token.FileSet, pos token.Pos) Position {
+	if fileset == nil || !pos.IsValid() {
+		return NoPos
+	}
+	p := fileset.Position(pos)
+	return Position{File: p.Filename, Line: p.Line, Column: p.Column}
+}
+
+// IsValid returns true if the position refers to a file.
+func (p Position) IsValid() bool {
+	return p.File != ""
+}
+
+func (p Position) String() string {
+	switch {
+	case !p.IsValid():
+		return ""
+	case p.Line == 0:
+		return p.File
+	case p.Column == 0:
+		return fmt.Sprintf("%s:%d", p.File, p.Line)
+	}
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// Diagnostic is a single problem found in the DSL.
+type Diagnostic struct {
+	Position
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	if d.Position.IsValid() {
+		return fmt.Sprintf("%s: %s: %s", d.Position, d.Severity, d.Message)
+	}
+	return fmt.Sprintf("%s: %s", d.Severity, d.Message)
+}
+
+// Collector records diagnostics so all problems of a compilation phase are reported together.
+type Collector struct {
+	mu          sync.Mutex
+	format      Format
+	out         io.Writer
+	diagnostics []Diagnostic
+}
+
+// NewCollector returns a collector printing diagnostics in the given format to out.
+func NewCollector(format Format, out io.Writer) *Collector {
+	return &Collector{format: format, out: out}
+}
+
+// SetOutput changes how the collector prints diagnostics.
+func (c *Collector) SetOutput(format Format, out io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.format = format
+	c.out = out
+}
+
+func (c *Collector) add(pos Position, severity Severity, format string, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d := Diagnostic{
+		Position: pos,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	// the same part of the DSL may be visited more than once, e.g. while building the graph
+	for _, existing := range c.diagnostics {
+		if existing == d {
+			return
+		}
+	}
+	c.diagnostics = append(c.diagnostics, d)
+}
+
+// Errorf records an error.
+func (c *Collector) Errorf(pos Position, format string, args ...interface{}) {
+	c.add(pos, Error, format, args...)
+}
+
+// Warnf records a warning.
+func (c *Collector) Warnf(pos Position, format string, args ...interface{}) {
+	c.add(pos, Warning, format, args...)
+}
+
+// AddParseError records errors returned by go/parser, which carry their own positions.
+func (c *Collector) AddParseError(err error) {
+	if list, ok := err.(scanner.ErrorList); ok {
+		for _, e := range list {
+			c.Errorf(Position{File: e.Pos.Filename, Line: e.Pos.Line, Column: e.Pos.Column}, "%s", e.Msg)
+		}
+		return
+	}
+	c.Errorf(NoPos, "%v", err)
+}
+
+// Diagnostics returns a copy of all recorded diagnostics.
+func (c *Collector) Diagnostics() []Diagnostic {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Diagnostic(nil), c.diagnostics...)
+}
+
+// ErrorCount returns the number of recorded errors.
+func (c *Collector) ErrorCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	count := 0
+	for _, d := range c.diagnostics {
+		if d.Severity == Error {
+			count++
+		}
+	}
+	return count
+}
+
+// HasErrors returns true if at least one error was recorded.
+func (c *Collector) HasErrors() bool {
+	return c.ErrorCount() > 0
+}
+
+// Write prints the diagnostics in the given format. JSON output is a single document, so it can be consumed
+// by editors and CI.
+func Write(out io.Writer, format Format, diagnostics []Diagnostic) error {
+	if format == JSONFormat {
+		if diagnostics == nil {
+			diagnostics = []Diagnostic{}
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Diagnostics []Diagnostic `json:"diagnostics"`
+		}{diagnostics})
+	}
+	for _, d := range diagnostics {
+		if _, err := fmt.Fprintln(out, d.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush prints and forgets all recorded diagnostics.
+func (c *Collector) Flush() {
+	c.mu.Lock()
+	diagnostics := c.diagnostics
+	c.diagnostics = nil
+	format, out := c.format, c.out
+	c.mu.Unlock()
+
+	if len(diagnostics) == 0 {
+		return
+	}
+	if err := Write(out, format, diagnostics); err != nil {
+		log.Errorf("Failed to write diagnostics: %v", err)
+	}
+}
+
+// Err prints all recorded diagnostics and returns an error if any of them is an error. Diagnostics are kept when
+// there are no errors, so warnings are printed together with errors of later phases.
+func (c *Collector) Err() error {
+	count := c.ErrorCount()
+	if count == 0 {
+		return nil
+	}
+	c.Flush()
+	return fmt.Errorf("found %d error(s) in DSL", count)
+}
+
+// FailOnErrors prints all recorded diagnostics and stops the compilation if any of them is an error.
+func (c *Collector) FailOnErrors() {
+	if err := c.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Configure sets the format of the default collector. JSON diagnostics are printed to stdout, so they are not
+// mixed with logs.
+func Configure(format string) error {
+	f, err := ParseFormat(format)
+	if err != nil {
+		return err
+	}
+	out := os.Stderr
+	if f == JSONFormat {
+		out = os.Stdout
+	}
+	Default.SetOutput(f, out)
+	return nil
+}
+
+// Default is the collector used by the compiler packages.
+var Default = NewCollector(TextFormat, os.Stderr)
+
+// Errorf records an error in the default collector.
+func Errorf(pos Position, format string, args ...interface{}) {
+	Default.Errorf(pos, format, args...)
+}
+
+// Warnf records a warning in the default collector.
+func Warnf(pos Position, format string, args ...interface{}) {
+	Default.Warnf(pos, format, args...)
+}
+
+// AddParseError records errors returned by go/parser in the default collector.
+func AddParseError(err error) {
+	Default.AddParseError(err)
+}
+
+// Err prints diagnostics of the default collector and returns an error if any of them is an error.
+func Err() error {
+	return Default.Err()
+}
+
+// FailOnErrors prints diagnostics of the default collector and stops the compilation if any of them is an error.
+func FailOnErrors() {
+	Default.FailOnErrors()
+}
+
+// Flush prints and forgets all diagnostics of the default collector.
+func Flush() {
+	Default.Flush()
+}
@@ -0,0 +1,16 @@
+package diagnostics_test
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDiagnostics(t *testing.T) {
+	log.StandardLogger().ExitFunc = nil
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Diagnostics Suite")
+}
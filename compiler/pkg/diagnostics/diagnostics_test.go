@@ -0,0 +1,125 @@
+package diagnostics_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/parser"
+	"go/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
+)
+
+var _ = Describe("Diagnostics tests", func() {
+	var (
+		out       *bytes.Buffer
+		collector *diagnostics.Collector
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		collector = diagnostics.NewCollector(diagnostics.TextFormat, out)
+	})
+
+	It("should print all diagnostics with positions", func() {
+		collector.Errorf(diagnostics.Position{File: "root.go", Line: 10, Column: 2}, "invalid field %s", "Config")
+		collector.Warnf(diagnostics.NoPos, "something is odd")
+		Expect(collector.HasErrors()).To(BeTrue())
+		Expect(collector.ErrorCount()).To(Equal(1))
+
+		collector.Flush()
+		Expect(out.String()).To(Equal("root.go:10:2: error: invalid field Config\nwarning: something is odd\n"))
+		Expect(collector.Diagnostics()).To(BeEmpty())
+	})
+
+	It("should resolve positions from file set", func() {
+		fileset := token.NewFileSet()
+		file, err := parser.ParseFile(fileset, "root.go", "package root\n\ntype Root struct{}\n", 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		pos := diagnostics.PositionFor(fileset, file.Decls[0].Pos())
+		Expect(pos).To(Equal(diagnostics.Position{File: "root.go", Line: 3, Column: 1}))
+		Expect(diagnostics.PositionFor(nil, file.Decls[0].Pos())).To(Equal(diagnostics.NoPos))
+	})
+
+	It("should record every go syntax error", func() {
+		_, err := parser.ParseFile(token.NewFileSet(), "root.go", "package root\n\ntype Root struct {\n\tA int\n\tB\n", 0)
+		Expect(err).To(HaveOccurred())
+
+		collector.AddParseError(err)
+		Expect(collector.Diagnostics()).NotTo(BeEmpty())
+		for _, d := range collector.Diagnostics() {
+			Expect(d.File).To(Equal("root.go"))
+			Expect(d.Line).To(BeNumerically(">", 0))
+		}
+	})
+
+	It("should not record the same diagnostic twice", func() {
+		pos := diagnostics.Position{File: "root.go", Line: 1}
+		collector.Errorf(pos, "duplicated")
+		collector.Errorf(pos, "duplicated")
+		Expect(collector.Diagnostics()).To(HaveLen(1))
+	})
+
+	It("should write diagnostics as json", func() {
+		collector.SetOutput(diagnostics.JSONFormat, out)
+		collector.Errorf(diagnostics.Position{File: "root.go", Line: 10, Column: 2}, "invalid field")
+		collector.Flush()
+
+		var report map[string][]map[string]interface{}
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		Expect(report["diagnostics"]).To(ConsistOf(map[string]interface{}{
+			"file":     "root.go",
+			"line":     float64(10),
+			"column":   float64(2),
+			"severity": "error",
+			"message":  "invalid field",
+		}))
+	})
+
+	It("should fail only when errors were recorded", func() {
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+
+		fail := false
+		log.StandardLogger().ExitFunc = func(int) {
+			fail = true
+		}
+
+		collector.Warnf(diagnostics.NoPos, "warning")
+		collector.FailOnErrors()
+		Expect(fail).To(BeFalse())
+
+		collector.Errorf(diagnostics.NoPos, "error")
+		collector.FailOnErrors()
+		Expect(fail).To(BeTrue())
+		Expect(out.String()).To(ContainSubstring("warning: warning"))
+		Expect(out.String()).To(ContainSubstring("error: error"))
+	})
+
+	It("should return an error only if an error was recorded", func() {
+		collector.Warnf(diagnostics.NoPos, "warning")
+		Expect(collector.Err()).To(Succeed())
+		Expect(out.String()).To(BeEmpty())
+
+		collector.Errorf(diagnostics.NoPos, "error")
+		Expect(collector.Err()).To(MatchError("found 1 error(s) in DSL"))
+		Expect(out.String()).To(Equal("warning: warning\nerror: error\n"))
+		Expect(collector.Diagnostics()).To(BeEmpty())
+	})
+
+	It("should validate format", func() {
+		format, err := diagnostics.ParseFormat("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(format).To(Equal(diagnostics.TextFormat))
+
+		format, err = diagnostics.ParseFormat("json")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(format).To(Equal(diagnostics.JSONFormat))
+
+		_, err = diagnostics.ParseFormat("xml")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,412 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/util"
+)
+
+const (
+	cacheIndexFile  = "index.json"
+	cacheObjectsDir = "objects"
+)
+
+// Cache keys of files rendered for the whole datamodel, DSL packages are keyed by their import path.
+const (
+	clientCacheKey  = "nexus-client"
+	graphqlCacheKey = "nexus-gql"
+)
+
+//go:embed template
+var templatesFS embed.FS
+
+var (
+	compilerFingerprintOnce sync.Once
+	compilerFingerprint     string
+	compilerFingerprintErr  error
+)
+
+// GeneratedFile is a file rendered for a DSL package. Path is relative to the output directory.
+type GeneratedFile struct {
+	Path    string
+	Content []byte
+}
+
+// BuildCache keeps files rendered for DSL packages between runs of the compiler. Files of a package are reused as
+// long as the fingerprint of the package, its transitive DSL dependencies and its place in the graph doesn't change.
+// The index is stored in the cache directory together with the content of files stored by their hash.
+type BuildCache struct {
+	dir     string
+	entries map[string]cacheEntry
+	used    map[string]bool
+}
+
+type cacheEntry struct {
+	Fingerprint string       `json:"fingerprint"`
+	Files       []cachedFile `json:"files"`
+}
+
+type cachedFile struct {
+	Path string `json:"path"`
+	Hash string `json:"hash"`
+}
+
+// LoadBuildCache reads the index of the cache directory. Empty directory disables the cache, a missing or broken
+// index starts a new one.
+func LoadBuildCache(dir string) *BuildCache {
+	c := &BuildCache{
+		dir:     dir,
+		entries: make(map[string]cacheEntry),
+		used:    make(map[string]bool),
+	}
+	if dir == "" {
+		return c
+	}
+	index, err := os.ReadFile(filepath.Join(dir, cacheIndexFile))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warnf("Failed to read build cache index, all packages will be rendered: %v", err)
+		}
+		return c
+	}
+	if err = json.Unmarshal(index, &c.entries); err != nil {
+		log.Warnf("Failed to parse build cache index, all packages will be rendered: %v", err)
+		c.entries = make(map[string]cacheEntry)
+	}
+	return c
+}
+
+func (c *BuildCache) enabled() bool {
+	return c != nil && c.dir != ""
+}
+
+// Get returns files of the package rendered by a previous run with the same fingerprint.
+func (c *BuildCache) Get(pkgImport, fingerprint string) ([]GeneratedFile, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+	entry, ok := c.entries[pkgImport]
+	if !ok || entry.Fingerprint != fingerprint {
+		return nil, false
+	}
+	files := make([]GeneratedFile, 0, len(entry.Files))
+	for _, f := range entry.Files {
+		content, err := os.ReadFile(c.objectPath(f.Hash))
+		if err != nil {
+			log.Debugf("Cached file %s of package %s is missing: %v", f.Path, pkgImport, err)
+			return nil, false
+		}
+		files = append(files, GeneratedFile{Path: f.Path, Content: content})
+	}
+	c.used[pkgImport] = true
+	return files, true
+}
+
+// Put stores files rendered for the package.
+func (c *BuildCache) Put(pkgImport, fingerprint string, files []GeneratedFile) error {
+	if !c.enabled() {
+		return nil
+	}
+	if err := createFolder(filepath.Join(c.dir, cacheObjectsDir)); err != nil {
+		return err
+	}
+	entry := cacheEntry{Fingerprint: fingerprint}
+	for _, f := range files {
+		hash := hashBytes(f.Content)
+		if err := writeFileIfChanged(c.objectPath(hash), f.Content); err != nil {
+			return fmt.Errorf("failed to store %s in build cache: %v", f.Path, err)
+		}
+		entry.Files = append(entry.Files, cachedFile{Path: f.Path, Hash: hash})
+	}
+	c.entries[pkgImport] = entry
+	c.used[pkgImport] = true
+	return nil
+}
+
+// Save writes the index and removes entries and files of packages which weren't rendered by this run.
+func (c *BuildCache) Save() error {
+	if !c.enabled() {
+		return nil
+	}
+	for pkgImport := range c.entries {
+		if !c.used[pkgImport] {
+			delete(c.entries, pkgImport)
+		}
+	}
+	index, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = createFolder(c.dir); err != nil {
+		return err
+	}
+	if err = writeFileIfChanged(filepath.Join(c.dir, cacheIndexFile), index); err != nil {
+		return err
+	}
+	return c.removeUnusedObjects()
+}
+
+func (c *BuildCache) removeUnusedObjects() error {
+	used := make(map[string]bool)
+	for _, entry := range c.entries {
+		for _, f := range entry.Files {
+			used[f.Hash] = true
+		}
+	}
+	objects, err := os.ReadDir(filepath.Join(c.dir, cacheObjectsDir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, object := range objects {
+		if !used[object.Name()] {
+			if err = os.Remove(c.objectPath(object.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *BuildCache) objectPath(hash string) string {
+	return filepath.Join(c.dir, cacheObjectsDir, hash)
+}
+
+// getCompilerFingerprint hashes all embedded templates and the build info of the compiler. Development builds
+// don't have a version, so the compiler binary is hashed for them.
+func getCompilerFingerprint() (string, error) {
+	compilerFingerprintOnce.Do(func() {
+		h := sha256.New()
+		compilerFingerprintErr = fs.WalkDir(templatesFS, "template", func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			content, err := templatesFS.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(h, "%s:%s\n", path, hashBytes(content))
+			return nil
+		})
+		if compilerFingerprintErr != nil {
+			return
+		}
+
+		info, ok := debug.ReadBuildInfo()
+		released := false
+		if ok {
+			fmt.Fprintf(h, "%s:%s@%s:%s\n", info.GoVersion, info.Main.Path, info.Main.Version, info.Main.Sum)
+			for _, dep := range info.Deps {
+				fmt.Fprintf(h, "%s@%s:%s\n", dep.Path, dep.Version, dep.Sum)
+			}
+			released = info.Main.Version != "" && info.Main.Version != "(devel)"
+			for _, setting := range info.Settings {
+				fmt.Fprintf(h, "%s=%s\n", setting.Key, setting.Value)
+				if setting.Key == "vcs.modified" && setting.Value == "true" {
+					released = false
+				}
+			}
+		}
+		if !released {
+			compilerFingerprintErr = writeExecutableHash(h)
+		}
+		compilerFingerprint = hex.EncodeToString(h.Sum(nil))
+	})
+	return compilerFingerprint, compilerFingerprintErr
+}
+
+func writeExecutableHash(w io.Writer) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find compiler executable for build cache: %v", err)
+	}
+	f, err := os.Open(exe)
+	if err != nil {
+		return fmt.Errorf("failed to read compiler executable for build cache: %v", err)
+	}
+	defer f.Close()
+	exeHash := sha256.New()
+	if _, err = io.Copy(exeHash, f); err != nil {
+		return fmt.Errorf("failed to read compiler executable for build cache: %v", err)
+	}
+	fmt.Fprintf(w, "executable:%s\n", hex.EncodeToString(exeHash.Sum(nil)))
+	return nil
+}
+
+// fingerprintWriter writes named JSON values to a hash together with the compiler fingerprint, the base group name,
+// the crd module path and the compiler configuration.
+func fingerprintWriter(baseGroupName, crdModulePath, name string) (hash.Hash, func(string, interface{}) error, error) {
+	h := sha256.New()
+	write := func(key string, v interface{}) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to fingerprint %s of %s: %v", key, name, err)
+		}
+		fmt.Fprintf(h, "%s:%d:", key, len(data))
+		h.Write(data)
+		return nil
+	}
+
+	compiler, err := getCompilerFingerprint()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = write("compiler", compiler); err != nil {
+		return nil, nil, err
+	}
+	if err = write("settings", []string{baseGroupName, crdModulePath}); err != nil {
+		return nil, nil, err
+	}
+	// location of the cache doesn't change rendered files
+	var conf config.Config
+	if config.ConfigInstance != nil {
+		conf = *config.ConfigInstance
+		conf.CacheDir = ""
+	}
+	if err = write("config", conf); err != nil {
+		return nil, nil, err
+	}
+	return h, write, nil
+}
+
+// PackageFingerprint hashes everything rendered files of the package depend on: sources of the package and of DSL
+// packages it imports, transitively, graph relations of its nodes, REST API responses, the compiler configuration,
+// templates and the compiler itself.
+func PackageFingerprint(baseGroupName, crdModulePath string, pkg parser.Package, pkgs parser.Packages,
+	parentsMap map[string]parser.NodeHelper, httpMethods map[string]nexus.HTTPMethodsResponses,
+	httpCodes map[string]nexus.HTTPCodesResponse) (string, error) {
+	h, write, err := fingerprintWriter(baseGroupName, crdModulePath, "package "+pkg.FullName)
+	if err != nil {
+		return "", err
+	}
+	if err := write("versions", pkg.NodeVersions); err != nil {
+		return "", err
+	}
+	if err := write("methods", httpMethods); err != nil {
+		return "", err
+	}
+	if err := write("codes", httpCodes); err != nil {
+		return "", err
+	}
+
+	nodes := make(map[string]parser.NodeHelper)
+	for _, node := range pkg.GetNexusNodes() {
+		crdName := util.GetCrdName(node.Name.Name, pkg.Name, baseGroupName)
+		nodes[crdName] = parentsMap[crdName]
+	}
+	if err := write("graph", nodes); err != nil {
+		return "", err
+	}
+
+	visited := make(map[string]bool)
+	if err := writePackageSources(h, pkg, pkgs, visited); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// DatamodelFingerprint hashes everything files rendered for the whole datamodel, the nexus client and the graphql
+// server, depend on: sources of all DSL packages, the graph, graphql files of the datamodel, the compiler
+// configuration, templates and the compiler itself.
+func DatamodelFingerprint(baseGroupName, crdModulePath string, pkgs parser.Packages,
+	parentsMap map[string]parser.NodeHelper, graphqlFiles map[string]string) (string, error) {
+	h, write, err := fingerprintWriter(baseGroupName, crdModulePath, "datamodel")
+	if err != nil {
+		return "", err
+	}
+	if err := write("graph", parentsMap); err != nil {
+		return "", err
+	}
+	if err := write("graphql", graphqlFiles); err != nil {
+		return "", err
+	}
+
+	pkgImports := make([]string, 0, len(pkgs))
+	for pkgImport := range pkgs {
+		pkgImports = append(pkgImports, pkgImport)
+	}
+	sort.Strings(pkgImports)
+	visited := make(map[string]bool)
+	for _, pkgImport := range pkgImports {
+		if err := writePackageSources(h, pkgs[pkgImport], pkgs, visited); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// cachedFiles returns files stored in the cache under the given key and fingerprint or renders and stores them.
+func cachedFiles(cache *BuildCache, key, fingerprint string, render func() ([]GeneratedFile, error)) ([]GeneratedFile, error) {
+	if files, ok := cache.Get(key, fingerprint); ok {
+		log.Debugf("Reusing files of %s from build cache", key)
+		return files, nil
+	}
+	files, err := render()
+	if err != nil {
+		return nil, err
+	}
+	if err = cache.Put(key, fingerprint, files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// writePackageSources writes sources of the package and of DSL packages imported by it.
+func writePackageSources(w io.Writer, pkg parser.Package, pkgs parser.Packages, visited map[string]bool) error {
+	if visited[pkg.FullName] {
+		return nil
+	}
+	visited[pkg.FullName] = true
+
+	fileNames := make([]string, 0, len(pkg.Pkg.Files))
+	for fileName := range pkg.Pkg.Files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	for _, fileName := range fileNames {
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			return fmt.Errorf("failed to fingerprint package %s: %v", pkg.FullName, err)
+		}
+		fmt.Fprintf(w, "%s:%s:%s\n", pkg.FullName, filepath.Base(fileName), hashBytes(content))
+	}
+
+	for _, importSpec := range pkg.GetImports() {
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil {
+			return fmt.Errorf("failed to parse import %s of package %s: %v", importSpec.Path.Value, pkg.FullName, err)
+		}
+		if dep, ok := pkgs[importPath]; ok {
+			if err = writePackageSources(w, dep, pkgs, visited); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func hashBytes(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,246 @@
+package generator_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/generator"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser/rest"
+)
+
+const enumDatamodelModPath = "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/test-utils/enum-datamodel"
+
+var _ = Describe("Build cache tests", func() {
+	var (
+		datamodelPath string
+		cacheDir      string
+	)
+
+	BeforeEach(func() {
+		var err error
+		datamodelPath, err = os.MkdirTemp("", "cache-datamodel")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(copyDir("../../example/test-utils/enum-datamodel", datamodelPath)).To(Succeed())
+		cacheDir, err = os.MkdirTemp("", "cache")
+		Expect(err).NotTo(HaveOccurred())
+		config.ConfigInstance.CacheDir = cacheDir
+	})
+
+	AfterEach(func() {
+		config.ConfigInstance.CacheDir = ""
+		os.RemoveAll(datamodelPath)
+		os.RemoveAll(cacheDir)
+	})
+
+	render := func(outputDir string) error {
+		for _, dir := range []string{"crds", "nexus-client", "nexus-gql/graph", "tsm-nexus-gql/graph"} {
+			Expect(os.MkdirAll(outputDir+"/"+dir, os.ModePerm)).To(Succeed())
+		}
+		pkgs := parser.ParseDSLPkg(datamodelPath)
+		graphlqQueries := parser.ParseGraphqlQuerySpecs(pkgs)
+		graph, nonNexusTypes, fileset := parser.ParseDSLNodes(datamodelPath, baseGroupName, pkgs, graphlqQueries)
+		methods, codes := rest.ParseResponses(pkgs)
+		return generator.RenderCRDTemplate(baseGroupName, crdModulePath, pkgs, graph, outputDir, methods, codes, nonNexusTypes, fileset, nil)
+	}
+
+	fingerprints := func() map[string]string {
+		pkgs := parser.ParseDSLPkg(datamodelPath)
+		graph, _, _ := parser.ParseDSLNodes(datamodelPath, baseGroupName, pkgs, nil)
+		parentsMap := parser.CreateParentsMap(graph)
+		methods, codes := rest.ParseResponses(pkgs)
+		result := make(map[string]string)
+		for name, pkg := range pkgs {
+			fingerprint, err := generator.PackageFingerprint(baseGroupName, crdModulePath, pkg, pkgs, parentsMap, methods, codes)
+			Expect(err).NotTo(HaveOccurred())
+			result[name] = fingerprint
+		}
+		return result
+	}
+
+	It("should restore files of unchanged packages from build cache", func() {
+		outputDir, err := os.MkdirTemp("", "cache-output")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		Expect(render(outputDir)).To(Succeed())
+		Expect(filepath.Join(cacheDir, "index.json")).To(BeAnExistingFile())
+
+		typesPath := outputDir + "/apis/root.tsm.tanzu.vmware.com/v1/types.go"
+		types, err := os.ReadFile(typesPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		// files are stored by content, mark the cached copy to check that it's reused
+		objects, err := os.ReadDir(filepath.Join(cacheDir, "objects"))
+		Expect(err).NotTo(HaveOccurred())
+		marked := false
+		for _, object := range objects {
+			path := filepath.Join(cacheDir, "objects", object.Name())
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			if string(content) == string(types) {
+				types = append(types, []byte("// restored from cache\n")...)
+				Expect(os.WriteFile(path, types, 0644)).To(Succeed())
+				marked = true
+			}
+		}
+		Expect(marked).To(BeTrue())
+
+		restoredDir, err := os.MkdirTemp("", "cache-output")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(restoredDir)
+		Expect(render(restoredDir)).To(Succeed())
+		restored, err := os.ReadFile(restoredDir + "/apis/root.tsm.tanzu.vmware.com/v1/types.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored).To(Equal(types))
+		Expect(restoredDir + "/crds/root_root.yaml").To(BeAnExistingFile())
+	})
+
+	It("should restore the nexus client and graphql server from build cache", func() {
+		outputDir, err := os.MkdirTemp("", "cache-output")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		Expect(render(outputDir)).To(Succeed())
+
+		marked := map[string][]byte{}
+		for _, path := range []string{"nexus-client/client.go", "nexus-gql/graph/graphqlResolver.go", "nexus-gql/server.go"} {
+			content, err := os.ReadFile(filepath.Join(outputDir, path))
+			Expect(err).NotTo(HaveOccurred())
+			objectPath := filepath.Join(cacheDir, "objects", hashBytes(content))
+			Expect(objectPath).To(BeAnExistingFile())
+			content = append(content, []byte("// restored from cache\n")...)
+			Expect(os.WriteFile(objectPath, content, 0644)).To(Succeed())
+			marked[path] = content
+		}
+
+		restoredDir, err := os.MkdirTemp("", "cache-output")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(restoredDir)
+		Expect(render(restoredDir)).To(Succeed())
+		for path, content := range marked {
+			restored, err := os.ReadFile(filepath.Join(restoredDir, path))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(Equal(content))
+		}
+		Expect(restoredDir + "/tsm-nexus-gql/graph/schema.graphqls").To(BeAnExistingFile())
+	})
+
+	It("should not write files which didn't change", func() {
+		outputDir, err := os.MkdirTemp("", "cache-output")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		Expect(render(outputDir)).To(Succeed())
+
+		modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+		paths := []string{
+			outputDir + "/apis/root.tsm.tanzu.vmware.com/v1/types.go",
+			outputDir + "/nexus-client/client.go",
+		}
+		for _, path := range paths {
+			Expect(os.Chtimes(path, modTime, modTime)).To(Succeed())
+		}
+		Expect(render(outputDir)).To(Succeed())
+		for _, path := range paths {
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.ModTime()).To(Equal(modTime))
+		}
+	})
+
+	It("should validate RestAPISpecs of packages restored from build cache", func() {
+		defer rest.ResetURIs()
+
+		Expect(os.RemoveAll(datamodelPath)).To(Succeed())
+		Expect(copyDir("../../example/test-utils/duplicated-uris-datamodel", datamodelPath)).To(Succeed())
+		configPath := filepath.Join(datamodelPath, "config", "config.go")
+		duplicated, err := os.ReadFile(configPath)
+		Expect(err).NotTo(HaveOccurred())
+		unique := strings.Replace(string(duplicated), "/project/{config.Config}", "/config/{config.Config}", 1)
+		Expect(os.WriteFile(configPath, []byte(unique), 0644)).To(Succeed())
+
+		outputDir, err := os.MkdirTemp("", "cache-output")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		rest.ResetURIs()
+		Expect(render(outputDir)).To(Succeed())
+
+		// only the config package changes, the project package declaring the same URI is restored from cache
+		Expect(os.WriteFile(configPath, duplicated, 0644)).To(Succeed())
+		rest.ResetURIs()
+		Expect(render(outputDir)).NotTo(Succeed())
+	})
+
+	It("should change fingerprints of changed packages and packages importing them", func() {
+		initial := fingerprints()
+		Expect(initial).To(HaveKey(enumDatamodelModPath))
+		Expect(initial).To(HaveKey(enumDatamodelModPath + "/common"))
+
+		appendToFile(filepath.Join(datamodelPath, "root.go"), "\n// Changed root package\n")
+		changedRoot := fingerprints()
+		Expect(changedRoot[enumDatamodelModPath]).NotTo(Equal(initial[enumDatamodelModPath]))
+		Expect(changedRoot[enumDatamodelModPath+"/common"]).To(Equal(initial[enumDatamodelModPath+"/common"]))
+
+		appendToFile(filepath.Join(datamodelPath, "common", "common.go"), "\n// Changed common package\n")
+		changedCommon := fingerprints()
+		Expect(changedCommon[enumDatamodelModPath]).NotTo(Equal(changedRoot[enumDatamodelModPath]))
+		Expect(changedCommon[enumDatamodelModPath+"/common"]).NotTo(Equal(changedRoot[enumDatamodelModPath+"/common"]))
+	})
+
+	It("should change the datamodel fingerprint when any package or graphql file changes", func() {
+		datamodelFingerprint := func(graphqlFiles map[string]string) string {
+			pkgs := parser.ParseDSLPkg(datamodelPath)
+			graph, _, _ := parser.ParseDSLNodes(datamodelPath, baseGroupName, pkgs, nil)
+			fingerprint, err := generator.DatamodelFingerprint(baseGroupName, crdModulePath, pkgs,
+				parser.CreateParentsMap(graph), graphqlFiles)
+			Expect(err).NotTo(HaveOccurred())
+			return fingerprint
+		}
+
+		initial := datamodelFingerprint(nil)
+		Expect(datamodelFingerprint(nil)).To(Equal(initial))
+		Expect(datamodelFingerprint(map[string]string{"root": "type Query { foo: String }"})).NotTo(Equal(initial))
+
+		appendToFile(filepath.Join(datamodelPath, "common", "common.go"), "\n// Changed common package\n")
+		Expect(datamodelFingerprint(nil)).NotTo(Equal(initial))
+	})
+})
+
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dst, rel), os.ModePerm)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dst, rel), content, 0644)
+	})
+}
+
+func appendToFile(path, text string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	Expect(err).NotTo(HaveOccurred())
+	defer f.Close()
+	_, err = f.WriteString(text)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+func hashBytes(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
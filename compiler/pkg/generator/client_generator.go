@@ -2,6 +2,7 @@ package generator
 
 import (
 	"go/ast"
+	"go/token"
 	"sort"
 	"strconv"
 	"strings"
@@ -181,11 +182,57 @@ func resolveNode(baseImportName, informerImportName string, pkg parser.Package,
 		clientGroupVars.Parent.GvkFieldName = parentHelper.Children[clientGroupVars.CrdName].FieldNameGvk
 		clientGroupVars.Parent.GoGvkFieldName = parentHelper.Children[clientGroupVars.CrdName].GoFieldNameGvk
 		clientGroupVars.Parent.BaseNodeName = parentHelper.Name
+		// owner references point to the hub version of the parent, which is the version stored by Kubernetes
+		clientGroupVars.Parent.BaseImportName = util.GetBaseImportName(
+			util.GetPackageNameFromCrdName(parentCrdName), baseGroupName, parser.DefaultVersion)
+		clientGroupVars.Parent.Kind = parentHelper.Name
+	}
+
+	for _, constraint := range parser.GetUniqueConstraints(pkg, node) {
+		clientGroupVars.UniqueConstraints = append(clientGroupVars.UniqueConstraints,
+			getUniqueConstraintVars(baseImportName, node, constraint, clientGroupVars.Parent.HasParent))
 	}
 
 	return nil
 }
 
+// uniqueArgReservedNames are names used in generated lookups, which can't be used as names of arguments.
+var uniqueArgReservedNames = map[string]bool{
+	"ctx": true, "group": true, "parentName": true, "key": true, "indexKey": true, "items": true,
+	"objs": true, "list": true, "s": true, "ok": true, "err": true, "i": true,
+}
+
+func getUniqueConstraintVars(baseImportName string, node *ast.TypeSpec, constraint parser.UniqueConstraint,
+	hasParent bool) uniqueConstraintVars {
+	vars := uniqueConstraintVars{
+		Name:      constraint.Name(),
+		IndexName: "unique:" + strings.Join(constraint.JSONFields, ","),
+		IsGlobal:  constraint.Scope == parser.UniqueScopeGlobal || !hasParent,
+	}
+	fields := make(map[string]*ast.Field)
+	for _, f := range parser.GetSpecFields(node) {
+		for _, name := range f.Names {
+			fields[name.Name] = f
+		}
+	}
+	for _, name := range constraint.Fields {
+		fieldType := parser.GetFieldType(fields[name])
+		if !parser.IsBasicType(fieldType) {
+			fieldType = baseImportName + "." + fieldType
+		}
+		arg := util.GetTag(name)
+		if token.IsKeyword(arg) || uniqueArgReservedNames[arg] {
+			arg += "Value"
+		}
+		vars.Fields = append(vars.Fields, uniqueFieldVars{
+			Name: name,
+			Arg:  arg,
+			Type: fieldType,
+		})
+	}
+	return vars
+}
+
 type fieldInfo struct {
 	pkgName   string
 	fieldName string
@@ -314,12 +361,30 @@ type apiGroupsClientVars struct {
 		SimpleGroupTypeName    string
 		GroupResourceNameTitle string
 		BaseNodeName           string
+		BaseImportName         string
+		Kind                   string
 	}
 
 	Links            []apiGroupsClientVarsLink
 	Children         []apiGroupsClientVarsLink
 	LinksAndChildren []apiGroupsClientVarsLink
 	Fields           []apiGroupsClientVarsLink
+
+	UniqueConstraints []uniqueConstraintVars
+}
+
+type uniqueConstraintVars struct {
+	Name      string
+	IndexName string
+	// IsGlobal is true if values can't repeat between objects of different parents.
+	IsGlobal bool
+	Fields   []uniqueFieldVars
+}
+
+type uniqueFieldVars struct {
+	Name string
+	Arg  string
+	Type string
 }
 
 type apiGroupsClientVarsLink struct {
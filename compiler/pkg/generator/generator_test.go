@@ -6,6 +6,7 @@ import (
 
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/util"
 
+	nexus_compare "github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus-compare"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser/rest"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
 
@@ -176,6 +177,100 @@ var _ = Describe("Template renderers tests", func() {
 		Expect(err).NotTo(HaveOccurred())
 	})
 
+	It("should render graphql mutations", func() {
+		schema, err := generator.RenderGraphqlSchemaTemplate(gql, crdModulePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schema.String()).To(ContainSubstring("input gns_GnsInput {\n    Domain: String\n    UseSharedGateway: Boolean"))
+		Expect(schema.String()).To(ContainSubstring("    Port: Int\n    OtherDescription: String\n    MapPointer: String\n    SlicePointer: String\n    WorkloadSpec: String\n"))
+		Expect(schema.String()).To(ContainSubstring("    ServiceSegmentRefs: String\n    ServiceSegmentRefMap: String\n}"))
+		Expect(schema.String()).To(ContainSubstring("createRootRoot(ParentLabels: Map): root_Root"))
+		Expect(schema.String()).To(ContainSubstring("createGnsGns(ParentLabels: Map, Id: ID!, Input: gns_GnsInput): gns_Gns"))
+		Expect(schema.String()).To(ContainSubstring("updateGnsGns(ParentLabels: Map, Id: ID!, Input: gns_GnsInput!): gns_Gns"))
+		Expect(schema.String()).To(ContainSubstring("deleteGnsGns(ParentLabels: Map, Id: ID!): Boolean!"))
+		Expect(schema.String()).To(ContainSubstring("setGnsGnsState(ParentLabels: Map, Id: ID!, Status: String!): gns_Gns"))
+		Expect(schema.String()).To(ContainSubstring("linkConfigConfigACPPolicies(ParentLabels: Map, Id: ID!, LinkParentLabels: Map, LinkId: ID!): config_Config"))
+		Expect(schema.String()).To(ContainSubstring("unlinkConfigConfigACPPolicies(ParentLabels: Map, Id: ID!, LinkParentLabels: Map, LinkId: ID!): config_Config"))
+
+		resolver, err := generator.RenderGraphqlResolverTemplate(gql, crdModulePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolver.String()).To(ContainSubstring(`basegnstsmtanzuvmwarecomv1 "nexustempmodule/apis/gns.tsm.tanzu.vmware.com/v1"`))
+		Expect(resolver.String()).To(ContainSubstring(`return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GetGNS(ctx, id)`))
+		Expect(resolver.String()).To(ContainSubstring(`return nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).AddGNS(ctx, objToCreate)`))
+		Expect(resolver.String()).To(ContainSubstring("func getCreateGnsGnsResolver(ctx context.Context, ParentLabels map[string]interface{}, Id string, Input *model.GnsGnsInput) (*model.GnsGns, error)"))
+		Expect(resolver.String()).To(ContainSubstring("if err := vGns.SetState(ctx, status); err != nil {"))
+		Expect(resolver.String()).To(ContainSubstring("vPort := int(*input.Port)\n\t\tspec.Port = &vPort"))
+		Expect(resolver.String()).To(ContainSubstring("if err := json.Unmarshal([]byte(*input.WorkloadSpec), &spec.WorkloadSpec); err != nil {"))
+		Expect(resolver.String()).To(ContainSubstring("if err := json.Unmarshal([]byte(*input.ServiceSegmentRefs), &spec.ServiceSegmentRefs); err != nil {"))
+		Expect(resolver.String()).To(ContainSubstring("if err := vConfig.LinkACPPolicies(ctx, linkObj); err != nil {"))
+	})
+
+	It("should render graphql subscriptions", func() {
+		schema, err := generator.RenderGraphqlSchemaTemplate(gql, crdModulePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schema.String()).To(ContainSubstring("type gns_GnsEvent {\n    Type: NexusEventType!\n    Object: gns_Gns\n}"))
+		Expect(schema.String()).To(ContainSubstring("watchRootRoot(ParentLabels: Map): root_RootEvent!"))
+		Expect(schema.String()).To(ContainSubstring("watchGnsGns(ParentLabels: Map): gns_GnsEvent!"))
+
+		resolver, err := generator.RenderGraphqlResolverTemplate(gql, crdModulePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolver.String()).To(ContainSubstring("func getWatchGnsGnsResolver(ctx context.Context, ParentLabels map[string]interface{}) (<-chan *model.GnsGnsEvent, error)"))
+		Expect(resolver.String()).To(ContainSubstring(`chainer := nc.RootRoot().Config(getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com")).GNS("")`))
+		Expect(resolver.String()).To(ContainSubstring("chainer := nc.RootRoot()\n"))
+		Expect(resolver.String()).To(ContainSubstring("addId, err := chainer.RegisterAddCallback("))
+		Expect(resolver.String()).To(ContainSubstring("if err := chainer.UnregisterCallback(id); err != nil {"))
+	})
+
+	It("should render graphql connections", func() {
+		schema, err := generator.RenderGraphqlSchemaTemplate(gql, crdModulePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schema.String()).To(ContainSubstring("FooExampleConnection(First: Int, After: String, Filter: config_FooTypeABCFilter, OrderBy: [config_FooTypeABCOrderBy!]): config_FooTypeABCConnection"))
+		Expect(schema.String()).To(ContainSubstring("type gns_GnsConnection {\n    Edges: [gns_GnsEdge!]!\n    PageInfo: PageInfo!\n    TotalCount: Int!\n}"))
+		Expect(schema.String()).To(ContainSubstring("input gns_GnsFilter {\n    Id: ID\n    Domain: String\n    UseSharedGateway: Boolean"))
+		Expect(schema.String()).To(ContainSubstring("enum gns_GnsOrderField {\n    Id\n    Domain\n    UseSharedGateway"))
+
+		resolver, err := generator.RenderGraphqlResolverTemplate(gql, crdModulePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolver.String()).To(ContainSubstring("func getConfigConfigFooExampleConnectionResolver(ctx context.Context, obj *model.ConfigConfig, first *int, after *string, filter *model.ConfigFooTypeABCFilter, orderBy []*model.ConfigFooTypeABCOrderBy) (*model.ConfigFooTypeABCConnection, error)"))
+		Expect(resolver.String()).To(ContainSubstring("if !common.MatchFilter(key.UseSharedGateway, filter.UseSharedGateway) {"))
+		Expect(resolver.String()).To(ContainSubstring("c = common.CompareFields(a.Domain, b.Domain)"))
+		Expect(resolver.String()).To(ContainSubstring("func getGnsGnsConnection(list []*nexus_client.GnsGns, toModel func(*nexus_client.GnsGns) *model.GnsGns, first *int, after *string, filter *model.GnsGnsFilter, orderBy []*model.GnsGnsOrderBy) (*model.GnsGnsConnection, error)"))
+		// the page resumes after the position of the key of the cursor, also if the node of the cursor was deleted
+		Expect(resolver.String()).To(ContainSubstring("if err := common.DecodeCursor(*after, cursor); err != nil {\n\t\t\treturn nil, codedError{code: ErrorCodeBadUserInput, err: err}"))
+		Expect(resolver.String()).To(ContainSubstring("return compareGnsGnsConnectionKeys(items[i].key, cursor, orderBy) > 0"))
+		Expect(resolver.String()).To(ContainSubstring(`cursorFields := []string{"Id"}`))
+	})
+
+	It("should render graphql resolvers returning errors", func() {
+		resolver, err := generator.RenderGraphqlResolverTemplate(gql, crdModulePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolver.String()).NotTo(ContainSubstring("return nil, nil"))
+		Expect(resolver.String()).NotTo(ContainSubstring("{}, nil"))
+		Expect(resolver.String()).To(ContainSubstring("log.Errorf(\"[getConfigConfigFooExampleResolver]Error getting parent node %s\", err)\n        return nil, err"))
+		Expect(resolver.String()).To(ContainSubstring("func ErrorCode(err error) string {"))
+		Expect(resolver.String()).To(ContainSubstring("case nexus_client.IsSingletonNameError(err), nexus_client.IsInvalid(err), nexus_client.IsBadRequest(err):\n\t\treturn ErrorCodeBadUserInput"))
+
+		server, err := generator.RenderGqlServerTemplate(generator.ServerVars{BaseImportPath: crdModulePath})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server.String()).To(ContainSubstring(`gqlErr.Extensions["code"] = graph.ErrorCode(err)`))
+	})
+
+	It("should render graphql resolvers using the loader of the request", func() {
+		resolver, err := generator.RenderGraphqlResolverTemplate(gql, crdModulePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolver.String()).To(ContainSubstring("func loadConfigConfigObject(ctx context.Context, obj *model.ConfigConfig) (*nexus_client.ConfigConfig, error)"))
+		Expect(resolver.String()).To(ContainSubstring(`return nc.RootRoot().GetConfig(ctx, getParentName(obj.ParentLabels, "configs.config.tsm.tanzu.vmware.com"))`))
+		Expect(resolver.String()).NotTo(ContainSubstring("context.TODO()"))
+		Expect(resolver.String()).To(ContainSubstring("func getConfigConfigGNSResolver(ctx context.Context, obj *model.ConfigConfig, id *string) (*model.GnsGns, error)"))
+		Expect(resolver.String()).To(ContainSubstring("vGnsParent, err := loadConfigConfigObject(ctx, obj)"))
+		Expect(resolver.String()).To(ContainSubstring(`prime(ctx, loaderCacheKey("GnsGns", ret.ParentLabels), vGns)`))
+		Expect(resolver.String()).To(ContainSubstring("for _, vFooTypeABC := range vFooTypeABCAllObj {"))
+		Expect(resolver.String()).NotTo(ContainSubstring("i.DisplayName()"))
+
+		server, err := generator.RenderGqlServerTemplate(generator.ServerVars{BaseImportPath: crdModulePath})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server.String()).To(ContainSubstring("return next(graph.WithLoader(ctx))"))
+	})
+
 	It("should test GetPackageName ", func() {
 		expectedPackageName := "tsm_tanzu_vmware_com"
 
@@ -209,4 +304,204 @@ var _ = Describe("Template renderers tests", func() {
 		Expect(err).NotTo(HaveOccurred())
 	})
 
+	It("should render DSL sources of nodes and their fields", func() {
+		outputDir, err := os.MkdirTemp("", "dsl-sources")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+
+		Expect(generator.RenderDSLSources(baseGroupName, exampleDSLPath, outputDir, pkgs)).To(Succeed())
+		sources, err := nexus_compare.LoadSourceMap(outputDir + "/dsl_sources.json")
+		Expect(err).NotTo(HaveOccurred())
+
+		gns, ok := sources["gnses.gns.tsm.tanzu.vmware.com"]
+		Expect(ok).To(BeTrue())
+		Expect(gns.Node.File).To(Equal("config/gns/gns.go"))
+		Expect(gns.Spec).To(HaveKey("domain"))
+		Expect(gns.Spec).To(HaveKey("targetPort"))
+		Expect(gns.Spec).To(HaveKey("fooGvk"))
+		Expect(gns.Spec["domain"].Line).To(BeNumerically(">", gns.Node.Line))
+		Expect(gns.Status).NotTo(BeNil())
+		Expect(gns.Spec).NotTo(HaveKey("state"))
+	})
+
+	It("should render additional versions of nodes with conversion", func() {
+		datamodelPath := "../../example/test-utils/multi-version-datamodel"
+		outputDir, err := os.MkdirTemp("", "multi-version")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		for _, dir := range []string{"crds", "nexus-client", "nexus-gql/graph", "tsm-nexus-gql/graph"} {
+			Expect(os.MkdirAll(outputDir+"/"+dir, os.ModePerm)).To(Succeed())
+		}
+
+		pkgs := parser.ParseDSLPkg(datamodelPath)
+		graphlqQueries := parser.ParseGraphqlQuerySpecs(pkgs)
+		graph, nonNexusTypes, fileset := parser.ParseDSLNodes(datamodelPath, baseGroupName, pkgs, graphlqQueries)
+		methods, codes := rest.ParseResponses(pkgs)
+		err = generator.RenderCRDTemplate(baseGroupName, crdModulePath, pkgs, graph, outputDir, methods, codes, nonNexusTypes, fileset, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		apiNames, err := os.ReadFile(outputDir + "/api_names.sh")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(apiNames)).To(ContainSubstring("project.tsm.tanzu.vmware.com:v1,v2"))
+		Expect(string(apiNames)).To(ContainSubstring("root.tsm.tanzu.vmware.com:v1 "))
+
+		crd, err := os.ReadFile(outputDir + "/crds/project_project.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(crd)).To(ContainSubstring("strategy: Webhook"))
+		Expect(string(crd)).To(ContainSubstring("path: /convert"))
+		Expect(string(crd)).To(ContainSubstring("    - name: v1\n      served: true\n      storage: true"))
+		Expect(string(crd)).To(ContainSubstring("    - name: v2\n      served: true\n      storage: false"))
+
+		crd, err = os.ReadFile(outputDir + "/crds/root_root.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(crd)).To(ContainSubstring("strategy: None"))
+
+		types, err := os.ReadFile(outputDir + "/apis/project.tsm.tanzu.vmware.com/v2/types.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(types)).To(ContainSubstring("package v2"))
+		Expect(string(types)).To(ContainSubstring("Description string"))
+
+		conversion, err := os.ReadFile(outputDir + "/apis/project.tsm.tanzu.vmware.com/v2/conversion.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(conversion)).To(ContainSubstring("func (src *Project) ConvertTo(dst *baseprojecttsmtanzuvmwarecomv1.Project) error"))
+		Expect(string(conversion)).To(ContainSubstring("func (dst *Project) ConvertFrom(src *baseprojecttsmtanzuvmwarecomv1.Project) error"))
+		Expect(string(conversion)).To(ContainSubstring("common.ConvertPreservingFields(src, dst, SchemeGroupVersion.Version, baseprojecttsmtanzuvmwarecomv1.SchemeGroupVersion.Version)"))
+
+		webhook, err := os.ReadFile(outputDir + "/nexus-client/conversion.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(webhook)).To(ContainSubstring(`"Project.project.tsm.tanzu.vmware.com": convertProjectProject`))
+		Expect(string(webhook)).To(ContainSubstring("func NewConversionWebhookHandler() http.Handler"))
+	})
+
+	It("should render enums", func() {
+		datamodelPath := "../../example/test-utils/enum-datamodel"
+		outputDir, err := os.MkdirTemp("", "enum")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		for _, dir := range []string{"crds", "nexus-client", "nexus-gql/graph", "tsm-nexus-gql/graph"} {
+			Expect(os.MkdirAll(outputDir+"/"+dir, os.ModePerm)).To(Succeed())
+		}
+
+		pkgs := parser.ParseDSLPkg(datamodelPath)
+		graphlqQueries := parser.ParseGraphqlQuerySpecs(pkgs)
+		graph, nonNexusTypes, fileset := parser.ParseDSLNodes(datamodelPath, baseGroupName, pkgs, graphlqQueries)
+		methods, codes := rest.ParseResponses(pkgs)
+		err = generator.RenderCRDTemplate(baseGroupName, crdModulePath, pkgs, graph, outputDir, methods, codes, nonNexusTypes, fileset, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		types, err := os.ReadFile(outputDir + "/apis/root.tsm.tanzu.vmware.com/v1/types.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(types)).To(ContainSubstring("// +enum\n// nexus-enum\ntype Color string"))
+		Expect(string(types)).To(ContainSubstring("// +enum\n// nexus-enum\ntype Protocol string"))
+		Expect(string(types)).To(ContainSubstring(`Red   Color    = "Red"`))
+
+		schema, err := os.ReadFile(outputDir + "/nexus-gql/graph/schema.graphqls")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(schema)).To(ContainSubstring("enum root_Color {\n    Red\n    Green\n}"))
+		Expect(string(schema)).To(ContainSubstring("enum common_Size {\n    Small\n    Large\n}"))
+		Expect(string(schema)).NotTo(ContainSubstring("enum root_Protocol"))
+		Expect(string(schema)).To(ContainSubstring("Color: root_Color"))
+		Expect(string(schema)).To(ContainSubstring("Size: common_Size"))
+		Expect(string(schema)).To(ContainSubstring("Protocol: String"))
+
+		resolver, err := os.ReadFile(outputDir + "/nexus-gql/graph/graphqlResolver.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(resolver)).To(ContainSubstring("vColor := model.RootColor(vRoot.Spec.Color)"))
+		Expect(string(resolver)).To(ContainSubstring("vSize := model.CommonSize(vRoot.Spec.Size)"))
+	})
+
+	It("should render nexus markers as openapi-gen tags", func() {
+		datamodelPath := "../../example/test-utils/markers-datamodel"
+		outputDir, err := os.MkdirTemp("", "markers")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		for _, dir := range []string{"crds", "nexus-client", "nexus-gql/graph", "tsm-nexus-gql/graph"} {
+			Expect(os.MkdirAll(outputDir+"/"+dir, os.ModePerm)).To(Succeed())
+		}
+
+		pkgs := parser.ParseDSLPkg(datamodelPath)
+		graphlqQueries := parser.ParseGraphqlQuerySpecs(pkgs)
+		graph, nonNexusTypes, fileset := parser.ParseDSLNodes(datamodelPath, baseGroupName, pkgs, graphlqQueries)
+		methods, codes := rest.ParseResponses(pkgs)
+		err = generator.RenderCRDTemplate(baseGroupName, crdModulePath, pkgs, graph, outputDir, methods, codes, nonNexusTypes, fileset, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		types, err := os.ReadFile(outputDir + "/apis/root.tsm.tanzu.vmware.com/v1/types.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(types)).To(ContainSubstring("// +k8s:openapi-gen=true\n// +nexus:validation-rule=self.minReplicas <= self.maxReplicas\ntype RootSpec struct {"))
+		Expect(string(types)).To(ContainSubstring("// +nexus:immutable=true\n\tRegion string"))
+		Expect(string(types)).To(ContainSubstring("// +nexus:default=1\n\tMinReplicas int"))
+		Expect(string(types)).To(ContainSubstring("// +nexus:default=info\n\t// +nexus:validation-rule=self in [\"debug\", \"info\", \"error\"]\n\tLogLevel string"))
+		Expect(string(types)).To(ContainSubstring("// +k8s:openapi-gen=true\n// +nexus:validation-rule=self.cpu != \"\"\ntype Limits struct {"))
+		Expect(string(types)).To(ContainSubstring("// +nexus:default=\"100m\"\n\tCpu string"))
+	})
+
+	It("should render lookups of unique fields", func() {
+		datamodelPath := "../../example/test-utils/unique-datamodel"
+		outputDir, err := os.MkdirTemp("", "unique")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		for _, dir := range []string{"crds", "nexus-client", "nexus-gql/graph", "tsm-nexus-gql/graph"} {
+			Expect(os.MkdirAll(outputDir+"/"+dir, os.ModePerm)).To(Succeed())
+		}
+
+		pkgs := parser.ParseDSLPkg(datamodelPath)
+		graphlqQueries := parser.ParseGraphqlQuerySpecs(pkgs)
+		graph, nonNexusTypes, fileset := parser.ParseDSLNodes(datamodelPath, baseGroupName, pkgs, graphlqQueries)
+		methods, codes := rest.ParseResponses(pkgs)
+		err = generator.RenderCRDTemplate(baseGroupName, crdModulePath, pkgs, graph, outputDir, methods, codes, nonNexusTypes, fileset, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		client, err := os.ReadFile(outputDir + "/nexus-client/client.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(client)).To(ContainSubstring(
+			"func (group *RootTsmV1) GetProjectByOwner(ctx context.Context, owner string) (*RootProject, error) {"))
+		Expect(string(client)).To(ContainSubstring(
+			"func (group *RootTsmV1) GetGnsByDomainAndPort(ctx context.Context, domain string, port int) (*RootGns, error) {"))
+		Expect(string(client)).To(ContainSubstring(
+			"func (group *RootTsmV1) GetGnsByDomain(ctx context.Context, parentName string, domain string) (*RootGns, error) {"))
+		Expect(string(client)).To(ContainSubstring(
+			`return uniqueIndexKey(hashedParentName(obj.GetLabels(), "projects.root.tsm.tanzu.vmware.com"), obj.Spec.Domain)`))
+		Expect(string(client)).To(ContainSubstring(
+			"informerroottsmtanzuvmwarecomv1.NewGnsInformer(c.baseClient, informerResyncPeriod*time.Second, gnsRootTsmV1Indexers())"))
+
+		crd, err := os.ReadFile(outputDir + "/crds/root_gns.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(crd)).To(ContainSubstring(
+			`"unique":[{"fields":["domain","port"],"scope":"global"},{"fields":["domain"],"scope":"parent"}]`))
+	})
+
+	It("should render graph constraints into nexus annotation", func() {
+		datamodelPath := "../../example/test-utils/graph-constraints-datamodel"
+		outputDir, err := os.MkdirTemp("", "graph-constraints")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		for _, dir := range []string{"crds", "nexus-client", "nexus-gql/graph", "tsm-nexus-gql/graph"} {
+			Expect(os.MkdirAll(outputDir+"/"+dir, os.ModePerm)).To(Succeed())
+		}
+
+		pkgs := parser.ParseDSLPkg(datamodelPath)
+		graphlqQueries := parser.ParseGraphqlQuerySpecs(pkgs)
+		graph, nonNexusTypes, fileset := parser.ParseDSLNodes(datamodelPath, baseGroupName, pkgs, graphlqQueries)
+		methods, codes := rest.ParseResponses(pkgs)
+		err = generator.RenderCRDTemplate(baseGroupName, crdModulePath, pkgs, graph, outputDir, methods, codes, nonNexusTypes, fileset, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		crd, err := os.ReadFile(outputDir + "/crds/root_project.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(crd)).To(ContainSubstring(
+			`"links":{"Reviewers":{"fieldName":"Reviewers","fieldNameGvk":"reviewersGvk","goFieldNameGvk":"ReviewersGvk","isNamed":true,"max":3,"scope":"subtree"}}`))
+
+		crd, err = os.ReadFile(outputDir + "/crds/root_root.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(crd)).To(ContainSubstring(
+			`"children":{"projects.root.tsm.tanzu.vmware.com":{"fieldName":"Projects","fieldNameGvk":"projectsGvk","goFieldNameGvk":"ProjectsGvk","isNamed":true,"max":10}}`))
+	})
+
+	It("should not render conversion webhook if all nodes have a single version", func() {
+		file, err := generator.RenderConversionWebhookTemplate(baseGroupName, crdModulePath, pkgs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file).To(BeNil())
+	})
+
 })
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/types"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -37,6 +38,8 @@ type ReturnStatement struct {
 	FieldCount  int
 	CRDName     string
 	ChainAPI    string
+	AddAPI      string
+	ChainerAPI  string
 	IsSingleton bool
 }
 
@@ -52,6 +55,7 @@ type FieldProperty struct {
 	IsCustomTypeField       bool
 	IsPointerTypeField      bool
 	IsStringType            bool
+	IsEnumTypeField         bool
 	IsArrayStdType          bool
 	IsSingleton             bool
 	PkgName                 string
@@ -84,6 +88,16 @@ type NodeProperty struct {
 	SchemaName             string
 	Alias                  string
 	ReturnType             string
+	MutationAlias          string
+	MutationReturnType     string
+	GetAPI                 string
+	AddAPI                 string
+	LinkAPI                string
+	ChainerAPI             string
+	BaseImportName         string
+	ApisImportPath         string
+	StatusName             string
+	StatusType             string
 	GroupResourceNameTitle string
 	GroupName              string
 	Singular               string
@@ -100,10 +114,22 @@ type NodeProperty struct {
 	NonStructFields        []FieldProperty
 	GraphqlSchemaFields    []FieldProperty
 	ResolverFields         map[string][]FieldProperty
+	InputFields            []FieldProperty
+	FilterFields           []FieldProperty
 	CustomQueries          []nexus.GraphQLQuery
 	GraphQlSpec            nexus.GraphQLSpec
 }
 
+type EnumProperty struct {
+	SchemaName string
+	Values     []string
+}
+
+// getConnectionArgs returns the arguments of a connection field of nodes with the given schema type name
+func getConnectionArgs(schemaTypeName string) string {
+	return fmt.Sprintf("First: Int, After: String, Filter: %sFilter, OrderBy: [%sOrderBy!]", schemaTypeName, schemaTypeName)
+}
+
 // Convert go standardType to GraphQL standardType
 func convertGraphqlStdType(t string) string {
 	// remove pointers
@@ -248,6 +274,64 @@ func findTypeAndPkgForField(ptParts []string, importMap map[string]string, pkgs
 	return structType, &p
 }
 
+// findEnumForField returns the enum used as type of field, if the enum can be exposed as GraphQL enum
+func findEnumForField(pkg parser.Package, typeString string, pkgs map[string]parser.Package) (parser.Enum, *parser.Package, bool) {
+	if strings.ContainsAny(typeString, "*[]") {
+		return parser.Enum{}, nil, false
+	}
+	enumPkg := &pkg
+	enumName := typeString
+	if parts := strings.Split(typeString, "."); len(parts) == 2 {
+		pkgPath, ok := pkg.GetImportMap()[parts[0]]
+		if !ok {
+			return parser.Enum{}, nil, false
+		}
+		importPath, err := strconv.Unquote(pkgPath)
+		if err != nil {
+			return parser.Enum{}, nil, false
+		}
+		p, ok := pkgs[importPath]
+		if !ok {
+			return parser.Enum{}, nil, false
+		}
+		enumPkg = &p
+		enumName = parts[1]
+	}
+	enum, ok := enumPkg.GetEnum(enumName)
+	if !ok || !enum.IsGraphqlEnum() {
+		return parser.Enum{}, nil, false
+	}
+	return enum, enumPkg, true
+}
+
+func getEnumSchemaName(pkg *parser.Package, enum parser.Enum) string {
+	return fmt.Sprintf("%s_%s", pkg.Name, enum.Name)
+}
+
+// GenerateGraphqlEnumVars collects enums of all packages which can be exposed as GraphQL enums
+func GenerateGraphqlEnumVars(pkgs parser.Packages) []EnumProperty {
+	sortedKeys := make([]string, 0, len(pkgs))
+	for k := range pkgs {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+
+	var enums []EnumProperty
+	for _, k := range sortedKeys {
+		pkg := pkgs[k]
+		for _, enum := range pkg.GetEnums() {
+			if !enum.IsGraphqlEnum() {
+				continue
+			}
+			enums = append(enums, EnumProperty{
+				SchemaName: getEnumSchemaName(&pkg, enum),
+				Values:     enum.ValueStrings(),
+			})
+		}
+	}
+	return enums
+}
+
 /*
 collect and construct type alias field into map recursively before
 populating the nexus node and custom struct type
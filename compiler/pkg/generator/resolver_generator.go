@@ -13,7 +13,7 @@ import (
 )
 
 // populateValuesForEachNode populates each node with required resolver properties
-func populateValuesForEachNode(nodes []*NodeProperty, linkAPI map[string]string, retMap map[string]ReturnStatement) []NodeProperty {
+func populateValuesForEachNode(nodes []*NodeProperty, linkAPI, getAPI map[string]string, retMap map[string]ReturnStatement) []NodeProperty {
 	var nodeProperties []NodeProperty
 	for _, n := range nodes {
 		resNodeProp := NodeProperty{}
@@ -30,6 +30,10 @@ func populateValuesForEachNode(nodes []*NodeProperty, linkAPI map[string]string,
 		resNodeProp.PkgName = n.PkgName
 		resNodeProp.NodeName = n.NodeName
 		resNodeProp.SchemaName = n.SchemaName
+		resNodeProp.BaseImportName = n.BaseImportName
+		resNodeProp.ApisImportPath = n.ApisImportPath
+		resNodeProp.StatusName = n.StatusName
+		resNodeProp.StatusType = n.StatusType
 
 		// populate return values for root of the graph
 		if !n.HasParent && n.IsParentNode {
@@ -38,6 +42,18 @@ func populateValuesForEachNode(nodes []*NodeProperty, linkAPI map[string]string,
 			resNodeProp.IsParentNode = true
 		}
 
+		// populate values for mutations and the loader of the node
+		if n.IsNexusNode {
+			resNodeProp.MutationAlias = retMap[resNodeProp.PkgName+resNodeProp.NodeName].Alias
+			resNodeProp.MutationReturnType = retMap[resNodeProp.PkgName+resNodeProp.NodeName].ReturnType
+			resNodeProp.AddAPI = retMap[resNodeProp.PkgName+resNodeProp.NodeName].AddAPI
+			resNodeProp.ChainerAPI = retMap[resNodeProp.PkgName+resNodeProp.NodeName].ChainerAPI
+			resNodeProp.GetAPI = getAPI[resNodeProp.PkgName+resNodeProp.NodeName]
+			resNodeProp.LinkAPI = linkAPI[resNodeProp.PkgName+resNodeProp.NodeName]
+			resNodeProp.InputFields = getMutationInputFields(n.ResolverFields[resNodeProp.PkgName+resNodeProp.NodeName])
+			resNodeProp.FilterFields = getFilterFields(n.ResolverFields[resNodeProp.PkgName+resNodeProp.NodeName])
+		}
+
 		// populate return values for child fields
 		for _, f := range n.ChildFields {
 			f.ReturnType = retMap[f.FieldTypePkgPath].ReturnType
@@ -100,16 +116,49 @@ func populateValuesForEachNode(nodes []*NodeProperty, linkAPI map[string]string,
 	return nodeProperties
 }
 
+// getMutationInputFields returns the spec fields which can be set through the input object of the mutations,
+// these are the same fields that are returned by the query resolvers. Map, array and struct fields are set as JSON
+// encoded strings, the same way they're returned.
+func getMutationInputFields(fields []FieldProperty) []FieldProperty {
+	var inputFields []FieldProperty
+	for _, f := range fields {
+		if f.IsMapTypeField || f.IsArrayTypeField || f.IsCustomTypeField || f.IsStringType || f.IsEnumTypeField ||
+			(f.IsStdTypeField && len(convertGoStdType(f.BaseTypeName)) != 0) {
+			inputFields = append(inputFields, f)
+		}
+	}
+	return inputFields
+}
+
+// getFilterFields returns the spec fields of scalar and enum types, connections of the node can be filtered and
+// ordered by these fields. ModelType of the scalar fields is set to the Go type of their model field.
+func getFilterFields(fields []FieldProperty) []FieldProperty {
+	var filterFields []FieldProperty
+	for _, f := range fields {
+		if f.IsMapTypeField || f.IsStringType {
+			continue
+		}
+		if f.IsEnumTypeField {
+			filterFields = append(filterFields, f)
+		} else if f.IsStdTypeField && len(convertGoStdType(f.FieldType)) != 0 {
+			f.ModelType = convertGoStdType(f.FieldType)
+			filterFields = append(filterFields, f)
+		}
+	}
+	return filterFields
+}
+
 func populateValuesForResolver(nodes []*NodeProperty, parentsMap map[string]parser.NodeHelper,
-	crdNameMap, nonStructMap map[string]string) (map[string]string, map[string]ReturnStatement) {
+	crdNameMap, nonStructMap map[string]string) (map[string]string, map[string]string, map[string]ReturnStatement) {
 	linkAPI := make(map[string]string)
+	getAPI := make(map[string]string)
 	retMap := make(map[string]ReturnStatement)
 
 	for _, n := range nodes {
 		var (
-			retType, aliasVal, listRetVal, ChainAPI string
-			fieldCount                              int
-			IsSingleton                             bool
+			retType, aliasVal, listRetVal, ChainAPI, AddAPI, ChainerAPI string
+			fieldCount                                                  int
+			IsSingleton                                                 bool
 		)
 
 		retType += fmt.Sprintf("ret := &model.%s%s {\n", n.PkgName, n.NodeName)
@@ -149,24 +198,62 @@ func populateValuesForResolver(nodes []*NodeProperty, parentsMap map[string]pars
 			if n.IsSingletonNode {
 				IsSingleton = true
 				if !n.HasParent && n.IsParentNode {
-					linkAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(context.TODO())", ChainAPI, n.PkgName+n.NodeName)
+					linkAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(ctx)", ChainAPI, n.PkgName+n.NodeName)
 				} else {
-					linkAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(context.TODO())", ChainAPI, prevNode.Children[n.CrdName].FieldName)
+					linkAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(ctx)", ChainAPI, prevNode.Children[n.CrdName].FieldName)
 				}
 			} else {
 				IsSingleton = false
 				if !n.HasParent && n.IsParentNode {
-					linkAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(context.TODO(), getParentName(obj.ParentLabels, %q))", ChainAPI, n.PkgName+n.NodeName, n.CrdName)
+					linkAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(ctx, getParentName(obj.ParentLabels, %q))", ChainAPI, n.PkgName+n.NodeName, n.CrdName)
 				} else {
-					linkAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(context.TODO(), getParentName(obj.ParentLabels, %q))", ChainAPI, prevNode.Children[n.CrdName].FieldName, n.CrdName)
+					linkAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(ctx, getParentName(obj.ParentLabels, %q))", ChainAPI, prevNode.Children[n.CrdName].FieldName, n.CrdName)
 				}
 			}
+
+			// Create getAPI and addAPI used by the mutations, the node itself is identified by `id`
+			if !n.HasParent && n.IsParentNode {
+				AddAPI = fmt.Sprintf("%s.Add%s", ChainAPI, n.PkgName+n.NodeName)
+				getAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(ctx", ChainAPI, n.PkgName+n.NodeName)
+			} else {
+				AddAPI = fmt.Sprintf("%s.Add%s", ChainAPI, prevNode.Children[n.CrdName].FieldName)
+				getAPI[n.PkgName+n.NodeName] = fmt.Sprintf("%s.Get%s(ctx", ChainAPI, prevNode.Children[n.CrdName].FieldName)
+			}
+			if n.IsSingletonNode {
+				getAPI[n.PkgName+n.NodeName] += ")"
+			} else {
+				getAPI[n.PkgName+n.NodeName] += ", id)"
+			}
+
+			// Create chainerAPI used by the subscriptions to register the callbacks of the node type
+			if !n.HasParent && n.IsParentNode {
+				ChainerAPI = fmt.Sprintf("%s.%s", ChainAPI, n.PkgName+n.NodeName)
+			} else {
+				ChainerAPI = fmt.Sprintf("%s.%s", ChainAPI, prevNode.Children[n.CrdName].FieldName)
+			}
+			if n.IsSingletonNode {
+				ChainerAPI += "()"
+			} else {
+				ChainerAPI += `("")`
+			}
 		}
 		for _, i := range n.ResolverFields[n.PkgName+n.NodeName] {
 			if i.IsMapTypeField || i.IsStringType {
 				fieldCount += 1
 				retType += fmt.Sprintf("\t%s: &%sData,\n", i.FieldName, i.FieldName)
 				aliasVal += jsonMarshalResolver(i.FieldName, n.NodeName)
+			} else if i.IsEnumTypeField {
+				fieldCount += 1
+				retType += fmt.Sprintf("\t%s: &v%s,\n", i.FieldName, i.FieldName)
+				aliasVal += fmt.Sprintf("v%s := model.%s(v%s.Spec.%s)\n", i.FieldName, i.ModelType, i.NodeName, i.FieldName)
+			} else if i.IsStdTypeField && i.IsPointerTypeField {
+				if len(convertGoStdType(i.BaseTypeName)) != 0 {
+					fieldCount += 1
+					retType += fmt.Sprintf("\t%s: v%s,\n", i.FieldName, i.FieldName)
+					aliasVal += fmt.Sprintf("var v%s *%s\nif v%s.Spec.%s != nil {\nv := %s(*v%s.Spec.%s)\nv%s = &v\n}\n",
+						i.FieldName, convertGoStdType(i.BaseTypeName), i.NodeName, i.FieldName,
+						convertGoStdType(i.BaseTypeName), i.NodeName, i.FieldName, i.FieldName)
+				}
 			} else if i.IsStdTypeField {
 				if len(convertGoStdType(i.FieldType)) != 0 {
 					fieldCount += 1
@@ -183,17 +270,19 @@ func populateValuesForResolver(nodes []*NodeProperty, parentsMap map[string]pars
 			FieldCount:  fieldCount,
 			CRDName:     n.CrdName,
 			ChainAPI:    ChainAPI,
+			AddAPI:      AddAPI,
+			ChainerAPI:  ChainerAPI,
 			IsSingleton: IsSingleton,
 		}
 	}
 
-	return linkAPI, retMap
+	return linkAPI, getAPI, retMap
 }
 
 // processNonNexusFields process and populates properties for each non nexus fields
 // <Domain  string>
-func processNonNexusFields(aliasNameMap map[string]string, node *ast.TypeSpec,
-	nodeProp *NodeProperty, simpleGroupTypeName string) {
+func processNonNexusFields(pkg parser.Package, aliasNameMap map[string]string, node *ast.TypeSpec,
+	nodeProp *NodeProperty, simpleGroupTypeName string, pkgs map[string]parser.Package) {
 	resField := make(map[string][]FieldProperty)
 	for _, f := range parser.GetSpecFields(node) {
 		var (
@@ -220,14 +309,28 @@ func processNonNexusFields(aliasNameMap map[string]string, node *ast.TypeSpec,
 			fieldProp.IsStringType = true
 			fieldProp.SchemaFieldName = fmt.Sprintf("%s: %s", fieldProp.FieldName, "String")
 			resField[nodeProp.PkgName+nodeProp.NodeName] = append(resField[nodeProp.PkgName+nodeProp.NodeName], fieldProp)
+		} else if enum, enumPkg, ok := findEnumForField(pkg, typeString, pkgs); ok {
+			// enum type, exposed as GraphQL enum
+			fieldProp.IsEnumTypeField = true
+			fieldProp.SchemaTypeName = getEnumSchemaName(enumPkg, enum)
+			fieldProp.ModelType = util.GetSimpleGroupTypeName(enumPkg.Name) + enum.Name
+			fieldProp.SchemaFieldName = fmt.Sprintf("%s: %s", fieldProp.FieldName, fieldProp.SchemaTypeName)
+			resField[nodeProp.PkgName+nodeProp.NodeName] = append(resField[nodeProp.PkgName+nodeProp.NodeName], fieldProp)
 		} else {
 			stdType := convertGraphqlStdType(typeString)
 			// standard type
 			if len(stdType) != 0 {
 				fieldProp.IsStdTypeField = true
+				// pointer to standard type, e.g. *int, is exposed as its standard type
+				fieldProp.IsPointerTypeField = strings.HasPrefix(typeString, "*")
+				fieldProp.BaseTypeName = strings.TrimPrefix(typeString, "*")
 				fieldProp.SchemaFieldName = fmt.Sprintf("%s: %s", fieldProp.FieldName, stdType)
 				resField[nodeProp.PkgName+nodeProp.NodeName] = append(resField[nodeProp.PkgName+nodeProp.NodeName], fieldProp)
 			} else {
+				// map, array and struct types are exposed as JSON encoded string
+				fieldProp.IsMapTypeField = parser.IsMapField(f)
+				fieldProp.IsArrayTypeField = parser.IsArrayField(f) || parser.IsPointerToArrayField(f)
+				fieldProp.IsCustomTypeField = !fieldProp.IsMapTypeField && !fieldProp.IsArrayTypeField
 				fieldProp.SchemaFieldName = fmt.Sprintf("%s: %s", fieldProp.FieldName, "String")
 				fieldProp.IsStringType = true
 				resField[nodeProp.PkgName+nodeProp.NodeName] = append(resField[nodeProp.PkgName+nodeProp.NodeName], fieldProp)
@@ -363,6 +466,16 @@ func processNexusFields(pkg parser.Package, aliasNameMap map[string]string, node
 		if !parser.IsOnlyChildField(nf) {
 			nodeProp.GraphqlSchemaFields = append(nodeProp.GraphqlSchemaFields, fieldProp)
 		}
+		// children and links can also be queried page by page through a connection
+		if parser.IsNamedChildOrLink(nf) {
+			var connectionFieldProp FieldProperty
+			connectionFieldProp.IsResolver = true
+			connectionFieldProp.FieldName = fieldProp.FieldName + "Connection"
+			connectionFieldProp.SchemaFieldName = fmt.Sprintf("%s(%s): %sConnection", connectionFieldProp.FieldName,
+				getConnectionArgs(fieldProp.SchemaTypeName), fieldProp.SchemaTypeName)
+			nodeProp.GraphqlSchemaFields = append(nodeProp.GraphqlSchemaFields, connectionFieldProp)
+			nodeProp.ResolverCount += 1
+		}
 	}
 }
 
@@ -421,12 +534,22 @@ func GenerateGraphqlResolverVars(baseGroupName, crdModulePath string, pkgs parse
 			}
 			setNexusProperties(nodeHelper, node, nodeProp)
 			nodeProp.SchemaName = fmt.Sprintf("%s_%s", pkg.Name, parser.GetTypeName(node))
+			nodeProp.BaseImportName = util.GetBaseImportName(pkg.Name, baseGroupName, packageVersion(pkg))
+			nodeProp.ApisImportPath = crdModulePath + "apis/" + util.GetImportPath(pkg.Name, baseGroupName, packageVersion(pkg))
+			if statusField := parser.GetStatusField(node); statusField != nil {
+				nodeProp.StatusType = parser.GetFieldType(statusField)
+				statusName, err := parser.GetFieldName(statusField)
+				if err != nil {
+					log.Fatalf("failed to determine field name: %v", err)
+				}
+				nodeProp.StatusName = statusName
+			}
 
 			// Iterate each node's nexus fields and set its properties
 			processNexusFields(pkg, aliasNameMap, node, nodeProp, simpleGroupTypeName, pkgs)
 
 			// Iterate each node's non-nexus fields and set its properties
-			processNonNexusFields(aliasNameMap, node, nodeProp, simpleGroupTypeName)
+			processNonNexusFields(pkg, aliasNameMap, node, nodeProp, simpleGroupTypeName, pkgs)
 			nodes = append(nodes, nodeProp)
 		}
 	}
@@ -434,10 +557,10 @@ func GenerateGraphqlResolverVars(baseGroupName, crdModulePath string, pkgs parse
 	crdNameMap := constructNexusTypeMap(nodes)
 	// populate return values of each Node for resolver
 	nonStructMap := constructAliasType(sortedPackages)
-	linkAPI, retMap := populateValuesForResolver(nodes, parentsMap, crdNameMap, nonStructMap)
+	linkAPI, getAPI, retMap := populateValuesForResolver(nodes, parentsMap, crdNameMap, nonStructMap)
 
 	// populate return values of each node
-	nodeProperties := populateValuesForEachNode(nodes, linkAPI, retMap)
+	nodeProperties := populateValuesForEachNode(nodes, linkAPI, getAPI, retMap)
 
 	return nodeProperties, nil
 }
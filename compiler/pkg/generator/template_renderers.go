@@ -5,25 +5,33 @@ import (
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"go/ast"
 	"go/format"
 	"go/printer"
 	"go/token"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"text/template"
 
 	log "github.com/sirupsen/logrus"
+	nexus_compare "github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus-compare"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"golang.org/x/tools/imports"
 
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser/rest"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/util"
 )
 
+// dslSourcesFile is written to the output directory by RenderDSLSources.
+const dslSourcesFile = "dsl_sources.json"
+
 //go:embed template/doc.go.tmpl
 var docTemplateFile []byte
 
@@ -63,74 +71,55 @@ var tsmGraphqlSchemaTemplateFile []byte
 //go:embed template/model.go.tmpl
 var modelTemplateFile []byte
 
+//go:embed template/conversion.go.tmpl
+var conversionTemplateFile []byte
+
+//go:embed template/conversion_webhook.go.tmpl
+var conversionWebhookTemplateFile []byte
+
 func RenderCRDTemplate(baseGroupName, crdModulePath string,
 	pkgs parser.Packages, graph map[string]parser.Node,
 	outputDir string, httpMethods map[string]nexus.HTTPMethodsResponses,
 	httpCodes map[string]nexus.HTTPCodesResponse, nonNexusTypes *parser.NonNexusTypes,
 	fileset *token.FileSet, graphqlFiles map[string]string) error {
 	parentsMap := parser.CreateParentsMap(graph)
+	allPkgs := pkgs
+	pkgs, versionPkgs := parser.SplitVersionPackages(pkgs)
+	cache := LoadBuildCache(config.ConfigInstance.GetCacheDir())
+
+	for _, pkg := range pkgs {
+		CheckRestApiSpecs(baseGroupName, pkg, parentsMap, httpMethods, httpCodes)
+	}
+	if err := diagnostics.Err(); err != nil {
+		return err
+	}
 
 	pkgNames := make([]string, len(pkgs))
 	i := 0
 	for _, pkg := range pkgs {
 		groupName := pkg.Name + "." + baseGroupName
-		pkgNames[i] = groupName + ":v1"
+		pkgNames[i] = groupName + ":" + strings.Join(pkg.GetAllVersions(), ",")
 		i++
-		groupFolder := outputDir + "/apis/" + groupName
-		crdFolder := outputDir + "/crds"
-		apiFolder := groupFolder + "/v1"
-		var err error
-		err = createFolder(apiFolder)
-		if err != nil {
-			return err
-		}
-		file, err := RenderDocTemplate(baseGroupName, pkg)
-		if err != nil {
-			return err
-		}
-		log.Debugf("Rendered doc template for package %s: %s", pkg.Name, file)
-		err = createFile(apiFolder, "doc.go", file, true)
+		fingerprint, err := PackageFingerprint(baseGroupName, crdModulePath, pkg, allPkgs, parentsMap, httpMethods, httpCodes)
 		if err != nil {
 			return err
 		}
-		file, err = RenderRegisterGroupTemplate(baseGroupName, pkg)
+		files, err := cachedFiles(cache, pkg.FullName, fingerprint, func() ([]GeneratedFile, error) {
+			return RenderPackage(baseGroupName, crdModulePath, pkg, parentsMap, httpMethods, httpCodes)
+		})
 		if err != nil {
 			return err
 		}
-		log.Debugf("Rendered register group template for package %s: %s", pkg.Name, file)
-		err = createFile(groupFolder, "register.go", file, true)
-		if err != nil {
-			return err
-		}
-		file, err = RenderRegisterCRDTemplate(crdModulePath, baseGroupName, pkg)
-		if err != nil {
+		if err = writeGeneratedFiles(outputDir, files); err != nil {
 			return err
 		}
-		log.Debugf("Rendered register CRD template for package %s: %s", pkg.Name, file)
-		err = createFile(apiFolder, "register.go", file, true)
-		if err != nil {
-			return err
-		}
-		file, err = RenderTypesTemplate(crdModulePath, pkg)
-		if err != nil {
-			return err
-		}
-		log.Debugf("Rendered types template for package %s: %s", pkg.Name, file)
-		err = createFile(apiFolder, "types.go", file, true)
-		if err != nil {
-			return err
-		}
-		crdFiles, err := RenderCRDBaseTemplate(baseGroupName, pkg, parentsMap, httpMethods, httpCodes)
+	}
+
+	for _, pkg := range versionPkgs {
+		err := RenderVersionPackage(baseGroupName, crdModulePath, pkg, outputDir)
 		if err != nil {
 			return err
 		}
-		for _, f := range crdFiles {
-			log.Debugf("Rendered crd base template for package %s: %s", pkg.Name, f.File)
-			err = createFile(crdFolder, f.Name, f.File, false)
-			if err != nil {
-				return err
-			}
-		}
 	}
 
 	err := RenderHelper(parentsMap, outputDir, crdModulePath)
@@ -138,7 +127,20 @@ func RenderCRDTemplate(baseGroupName, crdModulePath string,
 		return err
 	}
 
-	err = RenderClient(baseGroupName, outputDir, crdModulePath, pkgs, parentsMap)
+	fingerprint, err := DatamodelFingerprint(baseGroupName, crdModulePath, allPkgs, parentsMap, graphqlFiles)
+	if err != nil {
+		return err
+	}
+	files, err := cachedFiles(cache, clientCacheKey, fingerprint, func() ([]GeneratedFile, error) {
+		return RenderClientFiles(baseGroupName, crdModulePath, pkgs, parentsMap)
+	})
+	if err != nil {
+		return err
+	}
+	if err = writeGeneratedFiles(outputDir, files); err != nil {
+		return err
+	}
+	err = RenderConversionWebhook(baseGroupName, outputDir, crdModulePath, pkgs)
 	if err != nil {
 		return err
 	}
@@ -147,21 +149,125 @@ func RenderCRDTemplate(baseGroupName, crdModulePath string,
 		return err
 	}
 
-	err = RenderGraphQL(baseGroupName, outputDir, crdModulePath, pkgs, parentsMap, graphqlFiles, nonNexusTypes)
+	files, err = cachedFiles(cache, graphqlCacheKey, fingerprint, func() ([]GeneratedFile, error) {
+		return RenderGraphQLFiles(baseGroupName, crdModulePath, pkgs, parentsMap, graphqlFiles, nonNexusTypes)
+	})
 	if err != nil {
 		return err
 	}
+	if err = writeGeneratedFiles(outputDir, files); err != nil {
+		return err
+	}
 
-	err = RenderGqlserver(outputDir, crdModulePath)
+	err = RenderNonNexusTypes(outputDir, nonNexusTypes, fileset)
 	if err != nil {
 		return err
 	}
+	return cache.Save()
+}
 
-	err = RenderNonNexusTypes(outputDir, nonNexusTypes, fileset)
+// RenderPackage renders API types and CRDs of a hub package.
+func RenderPackage(baseGroupName, crdModulePath string, pkg parser.Package,
+	parentsMap map[string]parser.NodeHelper, httpMethods map[string]nexus.HTTPMethodsResponses,
+	httpCodes map[string]nexus.HTTPCodesResponse) ([]GeneratedFile, error) {
+	groupFolder := "apis/" + pkg.Name + "." + baseGroupName
+	apiFolder := groupFolder + "/v1"
+	var files []GeneratedFile
+	add := func(dirName, fileName string, file *bytes.Buffer, formatFile bool) error {
+		content, err := formatContent(file, formatFile)
+		if err != nil {
+			return err
+		}
+		files = append(files, GeneratedFile{Path: dirName + "/" + fileName, Content: content})
+		return nil
+	}
+
+	file, err := RenderDocTemplate(baseGroupName, pkg)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Rendered doc template for package %s: %s", pkg.Name, file)
+	if err = add(apiFolder, "doc.go", file, true); err != nil {
+		return nil, err
+	}
+	file, err = RenderRegisterGroupTemplate(baseGroupName, pkg)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Rendered register group template for package %s: %s", pkg.Name, file)
+	if err = add(groupFolder, "register.go", file, true); err != nil {
+		return nil, err
+	}
+	file, err = RenderRegisterCRDTemplate(crdModulePath, baseGroupName, pkg)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Rendered register CRD template for package %s: %s", pkg.Name, file)
+	if err = add(apiFolder, "register.go", file, true); err != nil {
+		return nil, err
+	}
+	file, err = RenderTypesTemplate(crdModulePath, pkg)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Rendered types template for package %s: %s", pkg.Name, file)
+	if err = add(apiFolder, "types.go", file, true); err != nil {
+		return nil, err
+	}
+	crdFiles, err := RenderCRDBaseTemplate(baseGroupName, pkg, parentsMap, httpMethods, httpCodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range crdFiles {
+		log.Debugf("Rendered crd base template for package %s: %s", pkg.Name, f.File)
+		if err = add("crds", f.Name, f.File, false); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// RenderVersionPackage renders API types of a package declaring an additional version of nodes
+// together with functions converting them to and from the hub version.
+func RenderVersionPackage(baseGroupName, crdModulePath string, pkg parser.Package, outputDir string) error {
+	apiFolder := outputDir + "/apis/" + pkg.Name + "." + baseGroupName + "/" + pkg.Version
+	err := createFolder(apiFolder)
 	if err != nil {
 		return err
 	}
-	return nil
+	file, err := RenderDocTemplate(baseGroupName, pkg)
+	if err != nil {
+		return err
+	}
+	log.Debugf("Rendered doc template for package %s version %s: %s", pkg.Name, pkg.Version, file)
+	err = createFile(apiFolder, "doc.go", file, true)
+	if err != nil {
+		return err
+	}
+	file, err = RenderRegisterCRDTemplate(crdModulePath, baseGroupName, pkg)
+	if err != nil {
+		return err
+	}
+	log.Debugf("Rendered register CRD template for package %s version %s: %s", pkg.Name, pkg.Version, file)
+	err = createFile(apiFolder, "register.go", file, true)
+	if err != nil {
+		return err
+	}
+	file, err = RenderTypesTemplate(crdModulePath, pkg)
+	if err != nil {
+		return err
+	}
+	log.Debugf("Rendered types template for package %s version %s: %s", pkg.Name, pkg.Version, file)
+	err = createFile(apiFolder, "types.go", file, true)
+	if err != nil {
+		return err
+	}
+	file, err = RenderConversionTemplate(crdModulePath, baseGroupName, pkg)
+	if err != nil {
+		return err
+	}
+	log.Debugf("Rendered conversion template for package %s version %s: %s", pkg.Name, pkg.Version, file)
+	return createFile(apiFolder, "conversion.go", file, true)
 }
 
 func RenderHelper(parentsMap map[string]parser.NodeHelper, outputDir string, crdModulePath string) error {
@@ -194,21 +300,42 @@ func createFolder(name string) error {
 }
 
 func createFile(dirName string, fileName string, file *bytes.Buffer, formatFile bool) error {
-	var err error
-	formatted := file.Bytes()
-	if formatFile {
-		formatted, err = format.Source(file.Bytes())
-		if err != nil {
-			return err
-		}
-	}
-	err = os.WriteFile(dirName+"/"+fileName, formatted, 0644)
+	formatted, err := formatContent(file, formatFile)
 	if err != nil {
 		return err
 	}
+	return writeFileIfChanged(dirName+"/"+fileName, formatted)
+}
+
+func formatContent(file *bytes.Buffer, formatFile bool) ([]byte, error) {
+	if !formatFile {
+		return file.Bytes(), nil
+	}
+	return format.Source(file.Bytes())
+}
+
+func writeGeneratedFiles(outputDir string, files []GeneratedFile) error {
+	for _, f := range files {
+		path := outputDir + "/" + f.Path
+		if err := createFolder(filepath.Dir(path)); err != nil {
+			return err
+		}
+		if err := writeFileIfChanged(path, f.Content); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// writeFileIfChanged doesn't touch files with the same content, so modification times are kept for build caches
+// of generated code.
+func writeFileIfChanged(path string, content []byte) error {
+	if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+	return os.WriteFile(path, content, 0644)
+}
+
 func readTemplateFile(rawTemplate []byte) (*template.Template, error) {
 	return template.New("tmpl").Parse(string(rawTemplate))
 }
@@ -236,8 +363,7 @@ type docVars struct {
 func RenderDocTemplate(baseGroupName string, pkg parser.Package) (*bytes.Buffer, error) {
 	groupGoName := util.GetSimpleGroupTypeName(pkg.Name) + util.GetGroupGoName(baseGroupName)
 	vars := docVars{
-		// TODO make it configurable
-		Version:     "v1",
+		Version:     packageVersion(pkg),
 		GroupName:   pkg.Name + "." + baseGroupName,
 		GroupGoName: groupGoName,
 	}
@@ -295,9 +421,8 @@ func RenderRegisterCRDTemplate(crdModulePath, baseGroupName string, pkg parser.P
 	vars := registerCRDVars{
 		GroupPackageName:   util.GetPackageName(groupName),
 		GroupPackageImport: crdModulePath + "apis/" + groupName,
-		// TODO make configurable by some variable in package
-		ResourceVersion: "v1",
-		KnownTypes:      knownTypes,
+		ResourceVersion:    packageVersion(pkg),
+		KnownTypes:         knownTypes,
 	}
 
 	if vars.GroupPackageName == "" ||
@@ -314,6 +439,7 @@ func RenderRegisterCRDTemplate(crdModulePath, baseGroupName string, pkg parser.P
 }
 
 type typesVars struct {
+	Version      string
 	Imports      string
 	CommonImport string
 	CRDTypes     string
@@ -325,6 +451,7 @@ type typesVars struct {
 func RenderTypesTemplate(crdModulePath string, pkg parser.Package) (*bytes.Buffer, error) {
 	aliasNameMap := make(map[string]string)
 	var vars typesVars
+	vars.Version = packageVersion(pkg)
 	vars.Imports = parsePackageImports(pkg, aliasNameMap)
 	vars.CRDTypes = parsePackageCRDs(pkg, aliasNameMap)
 	vars.Structs = parsePackageStructs(pkg, aliasNameMap)
@@ -359,6 +486,13 @@ type crdBaseVars struct {
 	KindList        string
 	ResourceVersion string
 	NexusAnnotation string
+	Versions        []crdVersionVars
+	Conversion      *config.ConversionWebhookConfig
+}
+
+type crdVersionVars struct {
+	Name    string
+	Storage bool
 }
 
 type NexusAnnotation struct {
@@ -369,6 +503,7 @@ type NexusAnnotation struct {
 	IsSingleton     bool                              `json:"is_singleton"`
 	NexusRestAPIGen nexus.RestAPISpec                 `json:"nexus-rest-api-gen,omitempty"`
 	Description     string                            `json:"description,omitempty"`
+	Unique          []parser.UniqueConstraint         `json:"unique,omitempty"`
 }
 
 type CrdBaseFile struct {
@@ -376,6 +511,22 @@ type CrdBaseFile struct {
 	File *bytes.Buffer
 }
 
+// CheckRestApiSpecs records problems of RestAPISpecs of all nodes of the package as diagnostics. It runs for every
+// package, also when files of the package are reused from the build cache, so URIs of all packages are validated
+// and duplicates between them are found.
+func CheckRestApiSpecs(baseGroupName string, pkg parser.Package, parentsMap map[string]parser.NodeHelper,
+	httpMethods map[string]nexus.HTTPMethodsResponses, httpCodes map[string]nexus.HTTPCodesResponse) {
+	restAPISpecMap := rest.GetRestApiSpecs(pkg, httpMethods, httpCodes, parentsMap)
+	restAPISpecPositions := rest.GetRestApiSpecPositions(pkg)
+	for _, node := range pkg.GetNexusNodes() {
+		typeName := parser.GetTypeName(node)
+		if annotation, ok := parser.GetNexusRestAPIGenAnnotation(pkg, typeName); ok {
+			crdName := util.GetCrdName(typeName, pkg.Name, baseGroupName)
+			rest.CheckRestApiSpec(restAPISpecMap[annotation], parentsMap, crdName, restAPISpecPositions[annotation])
+		}
+	}
+}
+
 func RenderCRDBaseTemplate(baseGroupName string, pkg parser.Package, parentsMap map[string]parser.NodeHelper,
 	httpMethods map[string]nexus.HTTPMethodsResponses, httpCodes map[string]nexus.HTTPCodesResponse) ([]CrdBaseFile, error) {
 	var crds []CrdBaseFile
@@ -403,11 +554,11 @@ func RenderCRDBaseTemplate(baseGroupName string, pkg parser.Package, parentsMap
 
 		if annotation, ok := parser.GetNexusRestAPIGenAnnotation(pkg, typeName); ok {
 			nexusAnnotation.NexusRestAPIGen = restAPISpecMap[annotation]
-			rest.ValidateRestApiSpec(restAPISpecMap[annotation], parentsMap, crdName)
 		}
 		if annotation, ok := parser.GetNexusDescriptionAnnotation(pkg, typeName); ok {
 			nexusAnnotation.Description = annotation
 		}
+		nexusAnnotation.Unique = parser.GetUniqueConstraints(pkg, node)
 
 		nexusAnnotationStr, err := json.Marshal(nexusAnnotation)
 		if err != nil {
@@ -422,8 +573,17 @@ func RenderCRDBaseTemplate(baseGroupName string, pkg parser.Package, parentsMap
 			Kind:            kind,
 			KindList:        fmt.Sprintf("%sList", kind),
 			NexusAnnotation: string(nexusAnnotationStr),
-			// TODO make configurable by some variable in package
-			ResourceVersion: "v1",
+			ResourceVersion: parser.DefaultVersion,
+		}
+		for _, version := range pkg.GetVersions(typeName) {
+			vars.Versions = append(vars.Versions, crdVersionVars{
+				Name:    version,
+				Storage: version == parser.DefaultVersion,
+			})
+		}
+		if len(vars.Versions) > 1 {
+			conversion := config.ConfigInstance.GetConversionWebhook()
+			vars.Conversion = &conversion
 		}
 
 		if vars.GroupName == "" ||
@@ -451,6 +611,126 @@ func RenderCRDBaseTemplate(baseGroupName string, pkg parser.Package, parentsMap
 	return crds, nil
 }
 
+type conversionVars struct {
+	Version      string
+	HubVersion   string
+	HubAlias     string
+	HubImport    string
+	CommonImport string
+	Kinds        []string
+}
+
+// RenderConversionTemplate renders ConvertTo and ConvertFrom functions of every node of a version package.
+func RenderConversionTemplate(crdModulePath, baseGroupName string, pkg parser.Package) (*bytes.Buffer, error) {
+	vars := conversionVars{
+		Version:      pkg.Version,
+		HubVersion:   parser.DefaultVersion,
+		HubAlias:     util.GetBaseImportName(pkg.Name, baseGroupName, parser.DefaultVersion),
+		HubImport:    crdModulePath + "apis/" + util.GetImportPath(pkg.Name, baseGroupName, parser.DefaultVersion),
+		CommonImport: util.GetInternalImport(crdModulePath, "common"),
+	}
+	for _, node := range pkg.GetNexusNodes() {
+		vars.Kinds = append(vars.Kinds, parser.GetTypeName(node))
+	}
+	sort.Strings(vars.Kinds)
+
+	conversionTemplate, err := readTemplateFile(conversionTemplateFile)
+	if err != nil {
+		return nil, err
+	}
+	return renderTemplate(conversionTemplate, vars)
+}
+
+type conversionWebhookVars struct {
+	Imports string
+	Kinds   []conversionKindVars
+}
+
+type conversionKindVars struct {
+	Key        string
+	FuncName   string
+	Kind       string
+	HubVersion string
+	HubAlias   string
+	Versions   []conversionVersionVars
+}
+
+type conversionVersionVars struct {
+	Name  string
+	Alias string
+}
+
+// RenderConversionWebhook renders the conversion webhook of nodes served in more than one version.
+// Nothing is rendered if all nodes are served in a single version.
+func RenderConversionWebhook(baseGroupName, outputDir, crdModulePath string, pkgs parser.Packages) error {
+	file, err := RenderConversionWebhookTemplate(baseGroupName, crdModulePath, pkgs)
+	if err != nil {
+		return err
+	}
+	if file == nil {
+		return nil
+	}
+	log.Debugf("Rendered conversion webhook template: %s", file)
+	return createFile(outputDir+"/nexus-client", "conversion.go", file, true)
+}
+
+func RenderConversionWebhookTemplate(baseGroupName, crdModulePath string, pkgs parser.Packages) (*bytes.Buffer, error) {
+	var vars conversionWebhookVars
+	imports := make(map[string]string)
+	for _, pkg := range pkgs {
+		hubAlias := util.GetBaseImportName(pkg.Name, baseGroupName, parser.DefaultVersion)
+		for _, node := range pkg.GetNexusNodes() {
+			typeName := parser.GetTypeName(node)
+			versions := pkg.NodeVersions[typeName]
+			if len(versions) == 0 {
+				continue
+			}
+			imports[hubAlias] = crdModulePath + "apis/" + util.GetImportPath(pkg.Name, baseGroupName, parser.DefaultVersion)
+			kind := conversionKindVars{
+				Key:        typeName + "." + util.GetGroupName(pkg.Name, baseGroupName),
+				FuncName:   "convert" + util.GetSimpleGroupTypeName(pkg.Name) + typeName,
+				Kind:       typeName,
+				HubVersion: parser.DefaultVersion,
+				HubAlias:   hubAlias,
+			}
+			for _, version := range versions {
+				alias := util.GetBaseImportName(pkg.Name, baseGroupName, version)
+				imports[alias] = crdModulePath + "apis/" + util.GetImportPath(pkg.Name, baseGroupName, version)
+				kind.Versions = append(kind.Versions, conversionVersionVars{Name: version, Alias: alias})
+			}
+			vars.Kinds = append(vars.Kinds, kind)
+		}
+	}
+	if len(vars.Kinds) == 0 {
+		return nil, nil
+	}
+	sort.Slice(vars.Kinds, func(i, j int) bool {
+		return vars.Kinds[i].Key < vars.Kinds[j].Key
+	})
+	aliases := make([]string, 0, len(imports))
+	for alias := range imports {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		vars.Imports += alias + ` "` + imports[alias] + `"` + "\n"
+	}
+
+	conversionWebhookTemplate, err := readTemplateFile(conversionWebhookTemplateFile)
+	if err != nil {
+		return nil, err
+	}
+	return renderTemplate(conversionWebhookTemplate, vars)
+}
+
+// packageVersion returns the API version of types rendered from the package.
+func packageVersion(pkg parser.Package) string {
+	if pkg.Version == "" {
+		return parser.DefaultVersion
+	}
+	return pkg.Version
+}
+
 func createApiNamesFile(apiList []string, outputDir string) error {
 	sort.Strings(apiList)
 	apiNames := "API_NAMES=\""
@@ -463,6 +743,60 @@ func createApiNamesFile(apiList []string, outputDir string) error {
 	return createFile(outputDir, "api_names.sh", &b, false)
 }
 
+// RenderDSLSources writes the DSL positions of every node and of the fields of its spec, keyed by the CRD name, so
+// the compatibility report can point to the DSL instead of the generated CRDs. Files are relative to dslDir.
+func RenderDSLSources(baseGroupName, dslDir, outputDir string, pkgs parser.Packages) error {
+	position := func(pkg parser.Package, pos token.Pos) nexus_compare.SourcePosition {
+		p := pkg.FileSet.Position(pos)
+		file := p.Filename
+		if rel, err := filepath.Rel(dslDir, p.Filename); err == nil {
+			file = filepath.ToSlash(rel)
+		}
+		return nexus_compare.SourcePosition{File: file, Line: p.Line, Column: p.Column}
+	}
+
+	sources := nexus_compare.SourceMap{}
+	hubs, _ := parser.SplitVersionPackages(pkgs)
+	for _, pkg := range hubs {
+		for _, node := range pkg.GetNexusNodes() {
+			nodeSources := nexus_compare.NodeSources{
+				Node: position(pkg, node.Name.Pos()),
+				Spec: make(map[string]nexus_compare.SourcePosition),
+			}
+			if st, ok := node.Type.(*ast.StructType); ok {
+				for _, f := range st.Fields.List {
+					if len(f.Names) == 0 || parser.IsNexusTypeField(f) {
+						continue
+					}
+					// fields without json tag get a lower camel case tag in the generated types
+					name := util.GetTag(f.Names[0].Name)
+					switch {
+					case parser.IsStatusField(f):
+						pos := position(pkg, f.Pos())
+						nodeSources.Status = &pos
+						continue
+					case parser.IsNexusField(f):
+						// children and links are stored as GVKs in the spec
+						name = util.GetGvkFieldTagName(f.Names[0].Name)
+					case parser.GetFieldNameJsonTag(f) == "-":
+						continue
+					case parser.GetFieldNameJsonTag(f) != "":
+						name = parser.GetFieldNameJsonTag(f)
+					}
+					nodeSources.Spec[name] = position(pkg, f.Pos())
+				}
+			}
+			sources[util.GetCrdName(node.Name.Name, pkg.Name, baseGroupName)] = nodeSources
+		}
+	}
+
+	data, err := json.MarshalIndent(sources, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileIfChanged(filepath.Join(outputDir, dslSourcesFile), data)
+}
+
 type helperVars struct {
 	CrdModulePath      string
 	GetCrdParentsMap   string
@@ -499,19 +833,18 @@ func RenderHelperTemplate(parentsMap map[string]parser.NodeHelper, crdModulePath
 	return bytes.NewBuffer(out), nil
 }
 
-func RenderClient(baseGroupName, outputDir, crdModulePath string, pkgs parser.Packages, parentsMap map[string]parser.NodeHelper) error {
-	clientFolder := outputDir + "/nexus-client"
+// RenderClientFiles renders the nexus client of the datamodel.
+func RenderClientFiles(baseGroupName, crdModulePath string, pkgs parser.Packages, parentsMap map[string]parser.NodeHelper) ([]GeneratedFile, error) {
 	file, err := RenderClientTemplate(baseGroupName, crdModulePath, pkgs, parentsMap)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	log.Debugf("Rendered client template: %s", file)
-	err = createFile(clientFolder, "client.go", file, true)
+	content, err := formatContent(file, true)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	return nil
+	return []GeneratedFile{{Path: "nexus-client/client.go", Content: content}}, nil
 }
 
 type clientVars struct {
@@ -553,76 +886,78 @@ func RenderClientTemplate(baseGroupName, crdModulePath string, pkgs parser.Packa
 type GraphDetails struct {
 	BaseImportPath string
 	Nodes          []NodeProperty
+	Enums          []EnumProperty
 	GraphQlFiles   map[string]string
+	ApisImports    map[string]string
 }
 
-func RenderGraphQL(baseGroupName, outputDir, crdModulePath string, pkgs parser.Packages, parentsMap map[string]parser.NodeHelper, graphqlFiles map[string]string, nonNexusTypes *parser.NonNexusTypes) error {
-	gqlFolder := outputDir + "/nexus-gql/graph"
+// RenderGraphQLFiles renders the schema, resolvers, gqlgen config and server of the graphql server of the datamodel
+// and the TSM graphql schema.
+func RenderGraphQLFiles(baseGroupName, crdModulePath string, pkgs parser.Packages, parentsMap map[string]parser.NodeHelper, graphqlFiles map[string]string, nonNexusTypes *parser.NonNexusTypes) ([]GeneratedFile, error) {
 	var (
-		vars GraphDetails
-		err  error
+		vars  GraphDetails
+		err   error
+		files []GeneratedFile
 	)
+	add := func(path string, file *bytes.Buffer) {
+		files = append(files, GeneratedFile{Path: path, Content: file.Bytes()})
+	}
 
 	vars.BaseImportPath = crdModulePath
 	vars.Nodes, err = GenerateGraphqlResolverVars(baseGroupName, crdModulePath, pkgs, parentsMap)
 	vars.GraphQlFiles = graphqlFiles
 	if err != nil {
-		return err
+		return nil, err
 	}
+	vars.Enums = GenerateGraphqlEnumVars(pkgs)
 	// Render Graphql Schema Template
 	file, err := RenderGraphqlSchemaTemplate(vars, crdModulePath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	log.Debugf("Rendered graphql schema template: %s", file)
-	err = createFile(gqlFolder, "schema.graphqls", file, false)
-	if err != nil {
-		return err
-	}
+	add("nexus-gql/graph/schema.graphqls", file)
 
 	// Render Graphql Resolver Template
 	file, err = RenderGraphqlResolverTemplate(vars, crdModulePath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	log.Debugf("Rendered graphql Resolver template: %s", file)
-	if err = createFile(gqlFolder, "graphqlResolver.go", file, false); err != nil {
-		return err
-	}
-
-	gqlgenFolder := outputDir + "/nexus-gql"
+	add("nexus-gql/graph/graphqlResolver.go", file)
 
 	// Render GQLGen Template
 	file, err = RenderGQLGenTemplate(vars, crdModulePath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	log.Debugf("Rendered gqlgen template: %s", file)
-	err = createFile(gqlgenFolder, "gqlgen.yml", file, false)
+	add("nexus-gql/gqlgen.yml", file)
+
+	// Render Gql Server Template
+	file, err = RenderGqlServerTemplate(ServerVars{BaseImportPath: crdModulePath})
 	if err != nil {
-		return err
+		return nil, err
 	}
+	log.Debugf("Rendered gqlserver template: %s", file)
+	add("nexus-gql/server.go", file)
 
 	//Render Graphql Schema Template (TSM)
 	vars.Nodes, err = GenerateTsmGraphqlSchemaVars(baseGroupName, crdModulePath, pkgs, parentsMap, nonNexusTypes)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tsmGqlFolder := outputDir + "/tsm-nexus-gql/graph"
 	schemaTemplate, err := readTemplateFile(tsmGraphqlSchemaTemplateFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	tsmFile, err := renderTemplate(schemaTemplate, vars)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	log.Debugf("Rendered graphql schema template: %s", tsmFile)
-	err = createFile(tsmGqlFolder, "schema.graphqls", tsmFile, false)
-	if err != nil {
-		return err
-	}
-	return nil
+	add("tsm-nexus-gql/graph/schema.graphqls", tsmFile)
+	return files, nil
 }
 
 func RenderGraphqlSchemaTemplate(vars GraphDetails, crdModulePath string) (*bytes.Buffer, error) {
@@ -647,6 +982,13 @@ func RenderGQLGenTemplate(vars GraphDetails, crdModulePath string) (*bytes.Buffe
 
 func RenderGraphqlResolverTemplate(vars GraphDetails, crdModulePath string) (*bytes.Buffer, error) {
 	vars.BaseImportPath = crdModulePath
+	// base apis packages of the nexus nodes are required by the mutations
+	vars.ApisImports = make(map[string]string)
+	for _, n := range vars.Nodes {
+		if n.IsNexusNode {
+			vars.ApisImports[n.BaseImportName] = n.ApisImportPath
+		}
+	}
 	gqlResolverTemplate, err := readTemplateFile(graphqlResolverTemplateFile)
 	if err != nil {
 		return nil, err
@@ -659,25 +1001,6 @@ type ServerVars struct {
 	BaseImportPath string
 }
 
-func RenderGqlserver(outputDir, crdModulePath string) error {
-	gqlserverFolder := outputDir + "/nexus-gql"
-
-	// Render Gql Server Template
-	var vars ServerVars
-	vars.BaseImportPath = crdModulePath
-	file, err := RenderGqlServerTemplate(vars)
-	if err != nil {
-		return err
-	}
-	log.Debugf("Rendered gqlserver template: %s", file)
-	err = createFile(gqlserverFolder, "server.go", file, false)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func RenderGqlServerTemplate(vars ServerVars) (*bytes.Buffer, error) {
 	registerGqlserverTemplate, err := readTemplateFile(gqlserverTemplateFile)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/util"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
@@ -405,6 +406,9 @@ func tsmProcessNexusFields(pkg parser.Package, aliasNameMap map[string]string, n
 func GenerateTsmGraphqlSchemaVars(baseGroupName, crdModulePath string, pkgs parser.Packages, parentsMap map[string]parser.NodeHelper, nonNexusTypes *parser.NonNexusTypes) ([]NodeProperty, error) {
 	sortedKeys := make([]string, 0, len(pkgs))
 	gqlSpecMap := parser.ParseGraphqlSpecs(pkgs)
+	if err := diagnostics.Err(); err != nil {
+		return nil, err
+	}
 	for k := range pkgs {
 		sortedKeys = append(sortedKeys, k)
 	}
@@ -20,6 +20,10 @@ const (
 	openapigen  string = "// +k8s:openapi-gen=true"
 	deepcopygen string = "// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object"
 	clientgen   string = "// +genclient\n// +genclient:noStatus\n// +genclient:nonNamespaced"
+
+	nexusDefaultMarker        string = "nexus-default:"
+	nexusImmutableMarker      string = "nexus-immutable"
+	nexusValidationRuleMarker string = "nexus-validation-rule:"
 )
 
 func parsePackageCRDs(pkg parser.Package, aliasNameMap map[string]string) string {
@@ -34,7 +38,7 @@ func parsePackageCRDs(pkg parser.Package, aliasNameMap map[string]string) string
 func generateType(pkg parser.Package, node *ast.TypeSpec, aliasNameMap map[string]string) string {
 	var output string
 	output += generateCRDStructType(pkg, node)
-	output += generateNodeSpec(node, aliasNameMap)
+	output += generateNodeSpec(pkg, node, aliasNameMap)
 	output += generateListDef(node)
 
 	return output
@@ -153,9 +157,9 @@ func getTag(f *ast.Field, name string, omitempty bool) string {
 	return fmt.Sprintf("`%s`", tag)
 }
 
-func generateNodeSpec(node *ast.TypeSpec, aliasNameMap map[string]string) string {
+func generateNodeSpec(pkg parser.Package, node *ast.TypeSpec, aliasNameMap map[string]string) string {
 	var crdTemplate = openapigen + `
-type {{.Name}}Spec struct {
+{{.Markers}}type {{.Name}}Spec struct {
 {{.Fields}}}
 
 `
@@ -165,11 +169,16 @@ type {{.Name}}Spec struct {
 		return ""
 	}
 	var specDef struct {
-		Name   string
-		Fields string
+		Name    string
+		Markers string
+		Fields  string
 	}
 
 	specDef.Name = parser.GetTypeName(node)
+	// markers of the node apply to its spec
+	for _, marker := range getNexusMarkerComments(pkg.GetTypeDoc(node)) {
+		specDef.Markers += marker + "\n"
+	}
 
 	for _, field := range parser.GetSpecFields(node) {
 		var name string
@@ -187,6 +196,9 @@ type {{.Name}}Spec struct {
 				specDef.Fields += comment + "\n"
 			}
 		}
+		for _, marker := range getNexusMarkerComments(field.Doc) {
+			specDef.Fields += marker + "\n"
+		}
 
 		specDef.Fields += "\t" + name + " "
 		typeString := ConstructType(aliasNameMap, field)
@@ -272,23 +284,27 @@ type {{.Name}}List struct {
 func parsePackageStructs(pkg parser.Package, aliasNameMap map[string]string) string {
 	var output string
 	for _, node := range pkg.GetNodes() {
-		output += generateNonNexusTypes(node, aliasNameMap)
+		output += generateNonNexusTypes(pkg, node, aliasNameMap)
 	}
 	return output
 }
 
-func generateNonNexusTypes(node *ast.TypeSpec, aliasNameMap map[string]string) string {
+func generateNonNexusTypes(pkg parser.Package, node *ast.TypeSpec, aliasNameMap map[string]string) string {
 	var crdTemplate = openapigen + `
-type {{.Name}} struct {
+{{.Markers}}type {{.Name}} struct {
 {{.Fields}}}
 
 `
 
 	var specDef struct {
-		Name   string
-		Fields string
+		Name    string
+		Markers string
+		Fields  string
 	}
 	specDef.Name = parser.GetTypeName(node)
+	for _, marker := range getNexusMarkerComments(pkg.GetTypeDoc(node)) {
+		specDef.Markers += marker + "\n"
+	}
 	for _, field := range parser.GetSpecFields(node) {
 		name, err := parser.GetFieldName(field)
 		if err != nil {
@@ -299,6 +315,9 @@ type {{.Name}} struct {
 				specDef.Fields += comment + "\n"
 			}
 		}
+		for _, marker := range getNexusMarkerComments(field.Doc) {
+			specDef.Fields += marker + "\n"
+		}
 		specDef.Fields += "\t" + name + " "
 		typeString := ConstructType(aliasNameMap, field)
 
@@ -332,6 +351,10 @@ func parsePackageTypes(pkg parser.Package) string {
 		if err != nil {
 			log.Fatalf("failed to translate type gen decl to string: %v", err)
 		}
+		// openapi-gen adds values of types marked with +enum to the schema
+		if typeSpec, ok := node.Specs[0].(*ast.TypeSpec); ok && parser.IsEnumType(&node, typeSpec) {
+			output += "// +enum\n"
+		}
 		output += t + "\n"
 	}
 
@@ -509,6 +532,31 @@ func getNexusValidationComments(field *ast.Field) []string {
 	return comments
 }
 
+// getNexusMarkerComments translates nexus markers of a field or type to openapi-gen tags, which are rendered into
+// the CRD schema by openapi_generator:
+//
+//	// nexus-default: <JSON value>     ->  // +nexus:default=<JSON value>
+//	// nexus-immutable                 ->  // +nexus:immutable=true
+//	// nexus-validation-rule: <CEL>    ->  // +nexus:validation-rule=<CEL>
+func getNexusMarkerComments(doc *ast.CommentGroup) []string {
+	var markers []string
+	if doc == nil {
+		return markers
+	}
+	for _, val := range doc.List {
+		text := strings.TrimSpace(strings.TrimPrefix(val.Text, "//"))
+		switch {
+		case strings.HasPrefix(text, nexusDefaultMarker):
+			markers = append(markers, "// +nexus:default="+strings.TrimSpace(strings.TrimPrefix(text, nexusDefaultMarker)))
+		case text == nexusImmutableMarker:
+			markers = append(markers, "// +nexus:immutable=true")
+		case strings.HasPrefix(text, nexusValidationRuleMarker):
+			markers = append(markers, "// +nexus:validation-rule="+strings.TrimSpace(strings.TrimPrefix(text, nexusValidationRuleMarker)))
+		}
+	}
+	return markers
+}
+
 func convertGoStdType(t string) string {
 	switch t {
 	case "string":
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,10 +20,29 @@ func splitCRDs(content []byte) []string {
 	return strings.Split(string(content), "---")
 }
 
+// CompatibilityReportOptions configures the machine-readable report written by CheckBackwardCompatibilityWithReport.
+type CompatibilityReportOptions struct {
+	Format nexus_compare.ReportFormat
+	Output io.Writer
+	// SourcesPath is the DSL source map written by the compiler, changes of the report point to the DSL when it is set.
+	SourcesPath string
+}
+
 func CheckBackwardCompatibility(existingCRDsPath, yamlsPath string, force bool) error {
+	return CheckBackwardCompatibilityWithReport(existingCRDsPath, yamlsPath, force, CompatibilityReportOptions{
+		Format: nexus_compare.TextFormat,
+	})
+}
+
+// CheckBackwardCompatibilityWithReport checks the compatibility of the new CRDs with the existing ones and,
+// unless the text format is selected, writes a report listing every change of every CRD, and every CRD that
+// is new in yamlsPath, to opts.Output.
+func CheckBackwardCompatibilityWithReport(existingCRDsPath, yamlsPath string, force bool, opts CompatibilityReportOptions) error {
 	var (
 		removedCRDs      []string
 		inCompatibleCRDs []*bytes.Buffer
+		report           nexus_compare.CompatibilityReport
+		existingCRDs     = map[string]bool{}
 	)
 
 	if err := filepath.Walk(existingCRDsPath, func(path string, info os.FileInfo, err error) error {
@@ -77,6 +97,7 @@ func CheckBackwardCompatibility(existingCRDsPath, yamlsPath string, force bool)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling existing CRD: %v", err)
 			}
+			existingCRDs[existingCRD.Name] = true
 
 			found := false
 			for _, newCRDPart := range splitCRDs(newCRDContent) {
@@ -95,12 +116,22 @@ func CheckBackwardCompatibility(existingCRDsPath, yamlsPath string, force bool)
 				}
 
 				found = true
-				isInCompatible, message, err := nexus_compare.CompareFiles([]byte(existingCRDPart), []byte(newCRDPart))
+				result, err := nexus_compare.Compare([]byte(existingCRDPart), []byte(newCRDPart))
 				if err != nil {
 					return err
 				}
-				if isInCompatible {
+				report.AddResult(newFilePath, result)
+				for _, change := range result.Changes {
+					if !change.Breaking {
+						log.Infof("CRD %q has compatible change %q at %s", existingCRD.Name, change.Kind, change.Path)
+					}
+				}
+				if result.IsBreaking() {
 					log.Warnf("CRD %q is incompatible with the previous version", existingCRD.Name)
+					message, err := nexus_compare.WriteBreakingChanges(result)
+					if err != nil {
+						return err
+					}
 					inCompatibleCRDs = append(inCompatibleCRDs, message)
 				}
 			}
@@ -108,6 +139,7 @@ func CheckBackwardCompatibility(existingCRDsPath, yamlsPath string, force bool)
 			// Appears node is removed in the latest version
 			if !found {
 				removedCRDs = append(removedCRDs, existingCRD.Name)
+				report.AddRemoved(path, existingCRD.Name)
 				continue
 			}
 		}
@@ -116,6 +148,22 @@ func CheckBackwardCompatibility(existingCRDsPath, yamlsPath string, force bool)
 		return err
 	}
 
+	if opts.Format != nexus_compare.TextFormat && opts.Output != nil {
+		if err := addNewCRDs(yamlsPath, existingCRDs, &report); err != nil {
+			return err
+		}
+		if opts.SourcesPath != "" {
+			sources, err := nexus_compare.LoadSourceMap(opts.SourcesPath)
+			if err != nil {
+				return err
+			}
+			report.AddSources(sources)
+		}
+		if err := report.Write(opts.Output, opts.Format); err != nil {
+			return fmt.Errorf("writing %s compatibility report failed with error: %v", opts.Format, err)
+		}
+	}
+
 	if len(inCompatibleCRDs) > 0 || len(removedCRDs) > 0 {
 		inCompatibleCRDsChanges := &bytes.Buffer{}
 		for _, crd := range inCompatibleCRDs {
@@ -133,3 +181,34 @@ func CheckBackwardCompatibility(existingCRDsPath, yamlsPath string, force bool)
 
 	return nil
 }
+
+// addNewCRDs adds to the report every CRD of yamlsPath which is not one of the existing CRDs.
+func addNewCRDs(yamlsPath string, existingCRDs map[string]bool, report *nexus_compare.CompatibilityReport) error {
+	return filepath.Walk(yamlsPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("walking new CRD's failed with error: %v", err)
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".yaml") {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading the crd file on the path %q: %v", path, err)
+		}
+		for _, part := range splitCRDs(content) {
+			if part == "" {
+				continue
+			}
+			crd := &extensionsv1.CustomResourceDefinition{}
+			if err := yaml.Unmarshal([]byte(part), crd); err != nil {
+				return fmt.Errorf("error unmarshaling new CRD: %v", err)
+			}
+			if crd.Name == "" || existingCRDs[crd.Name] {
+				continue
+			}
+			report.AddAdded(path, crd.Name)
+		}
+		return nil
+	})
+}
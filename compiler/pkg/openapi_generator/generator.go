@@ -114,6 +114,9 @@ func convertDefinition(input common.OpenAPIDefinition) (*definition, error) {
 	if err != nil {
 		return nil, fmt.Errorf("deserializing schema: %v", err)
 	}
+	if err := addNexusMarkers(input.Schema, &schemaProps); err != nil {
+		return nil, fmt.Errorf("adding nexus markers: %v", err)
+	}
 	dependencies := make([]string, len(input.Dependencies))
 	for i, dep := range input.Dependencies {
 		dependencies[i] = strings.ToLower(dep)
@@ -356,14 +359,12 @@ func (g *Generator) UpdateYAMLs(yamlsPath string) error {
 			if err != nil {
 				return fmt.Errorf("unmarshalling: %v", err)
 			}
-			// TODO we assume here that we have only one version, which is correct
-			// at the time of writing this, but may not be in the future.
-			if len(crd.Spec.Versions) != 1 {
-				return fmt.Errorf("crd %v has no, or more than 1 versions", crd.Name)
+			if len(crd.Spec.Versions) == 0 {
+				return fmt.Errorf("crd %v has no versions", crd.Name)
 			}
 			// TODO another hack. I don't know how to prevent Status field being
 			// generated in YAML file, so we add StoredVersions by hand
-			crd.Status.StoredVersions = []string{crd.Spec.Versions[0].Name}
+			crd.Status.StoredVersions = []string{storageVersion(crd)}
 			err = g.addCustomResourceValidation(&crd)
 			if err != nil {
 				return err
@@ -399,8 +400,26 @@ func (g *Generator) UpdateYAMLs(yamlsPath string) error {
 	})
 }
 
+// storageVersion returns the version in which objects of the CRD are persisted.
+func storageVersion(crd extensionsv1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+	return crd.Spec.Versions[0].Name
+}
+
 func (g *Generator) addCustomResourceValidation(crd *extensionsv1.CustomResourceDefinition) error {
-	name := g.createName(crd.Spec.Group, crd.Spec.Versions[0].Name, crd.Spec.Names.Kind)
+	for i := range crd.Spec.Versions {
+		name := g.createName(crd.Spec.Group, crd.Spec.Versions[i].Name, crd.Spec.Names.Kind)
+		crd.Spec.Versions[i].Schema = &extensionsv1.CustomResourceValidation{OpenAPIV3Schema: g.getVersionSchema(name)}
+	}
+	crd.Spec.PreserveUnknownFields = false
+	return nil
+}
+
+func (g *Generator) getVersionSchema(name string) *extensionsv1.JSONSchemaProps {
 	schemaProps := g.getDefinition(name).schema
 	toReplace := []string{}
 	for _, propName := range schemaProps.Required {
@@ -421,10 +440,7 @@ func (g *Generator) addCustomResourceValidation(crd *extensionsv1.CustomResource
 	schemaProps.Properties["metadata"] = extensionsv1.JSONSchemaProps{
 		Type: "object",
 	}
-
-	crd.Spec.PreserveUnknownFields = false
-	crd.Spec.Versions[0].Schema = &extensionsv1.CustomResourceValidation{OpenAPIV3Schema: schemaProps}
-	return nil
+	return schemaProps
 }
 
 func (g *Generator) createName(group, apiVersion, name string) string {
@@ -1,6 +1,7 @@
 package openapi_generator_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -13,6 +14,7 @@ import (
 
 	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
+	nexus_compare "github.com/vmware-tanzu/graph-framework-for-microservices/common-library/pkg/nexus-compare"
 	pkg_generator "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/generator"
 	generator "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/openapi_generator"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/openapi_generator/test_data/openapi"
@@ -388,6 +390,36 @@ var _ = Describe("Generator", func() {
 			Expect(gen.UpdateYAMLs(tmpDir)).To(Succeed())
 			compareTmpFileWithExpectedFile(tmpFile, "test_data/07_enum_in_array_property.yaml")
 		})
+
+		It("10 keeps values of string enums", func() {
+			barName := getSchemaName("bar")
+			rawDefs := map[string]common.OpenAPIDefinition{
+				barName: {
+					Schema: spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							Properties: map[string]spec.Schema{
+								"color": {
+									SchemaProps: spec.SchemaProps{
+										Type:        []string{"string"},
+										Description: "Possible enum values:\n - `\"Green\"`\n - `\"Red\"`",
+										Enum:        []interface{}{"Green", "Red"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			gen, err := generator.NewGenerator(rawDefs)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(gen.ResolveRefs()).To(Succeed())
+
+			tmpFile := createFileWithEmptyYAMLDefinitions(tmpDir, []string{"bar"})
+			Expect(gen.UpdateYAMLs(tmpDir)).To(Succeed())
+			compareTmpFileWithExpectedFile(tmpFile, "test_data/10_string_enum.yaml")
+		})
 	})
 
 	It("08 adds kubernetes flags", func() {
@@ -467,6 +499,169 @@ var _ = Describe("Generator", func() {
 		compareTmpFileWithExpectedFile(tmpFile, "test_data/08_kubernetes_flags.yaml")
 	})
 
+	It("09 creates schemas for every version", func() {
+		v2Definition := fooDefinition()
+		v2Definition.Schema.Properties["description"] = spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"string"},
+			},
+		}
+		rawDefs := map[string]common.OpenAPIDefinition{
+			getSchemaName("foo"):                  fooDefinition(),
+			"nexustempmodule/apis/test.it/v2.foo": v2Definition,
+		}
+		gen, err := generator.NewGenerator(rawDefs)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(gen.ResolveRefs()).To(Succeed())
+
+		tmpFile := createFileWithEmptyYAMLDefinitions(tmpDir, []string{"foo"})
+		content, err := os.ReadFile(tmpFile)
+		Expect(err).NotTo(HaveOccurred())
+		content = []byte(strings.Replace(string(content), `  versions:
+  - name: v1
+    served: true
+    storage: true
+`, `  versions:
+  - name: v1
+    served: true
+    storage: true
+  - name: v2
+    served: true
+    storage: false
+`, 1))
+		Expect(os.WriteFile(tmpFile, content, 0665)).To(Succeed())
+
+		Expect(gen.UpdateYAMLs(tmpDir)).To(Succeed())
+		compareTmpFileWithExpectedFile(tmpFile, "test_data/09_multiple_versions.yaml")
+	})
+
+	Context("nexus markers", func() {
+		It("11 renders nexus markers", func() {
+			barName := getSchemaName("bar")
+			rawDefs := map[string]common.OpenAPIDefinition{
+				barName: {
+					Schema: spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							Properties: map[string]spec.Schema{
+								"port": {
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int32",
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-nexus-default":          "8080",
+											"x-nexus-validation-rules": []interface{}{"self > 0"},
+										},
+									},
+								},
+								"logLevel": {
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"string"},
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-nexus-default": "info",
+										},
+									},
+								},
+								"region": {
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"string"},
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-nexus-immutable": "true",
+										},
+									},
+								},
+								"version": {
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"string"},
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-nexus-default": "1.10",
+										},
+									},
+								},
+							},
+						},
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-nexus-validation-rules": []interface{}{"self.port != 22"},
+							},
+						},
+					},
+				},
+			}
+			gen, err := generator.NewGenerator(rawDefs)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(gen.ResolveRefs()).To(Succeed())
+
+			tmpFile := createFileWithEmptyYAMLDefinitions(tmpDir, []string{"bar"})
+			Expect(gen.UpdateYAMLs(tmpDir)).To(Succeed())
+			compareTmpFileWithExpectedFile(tmpFile, "test_data/11_nexus_markers.yaml")
+		})
+
+		It("12 fails on invalid default values", func() {
+			barName := getSchemaName("bar")
+			rawDefs := map[string]common.OpenAPIDefinition{
+				barName: {
+					Schema: spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							Properties: map[string]spec.Schema{
+								"port": {
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"integer"},
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-nexus-default": "eighty",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			_, err := generator.NewGenerator(rawDefs)
+			Expect(err).To(MatchError(ContainSubstring(`property "port": invalid default value eighty`)))
+		})
+
+		It("13 fails on default values of a different type", func() {
+			barName := getSchemaName("bar")
+			rawDefs := map[string]common.OpenAPIDefinition{
+				barName: {
+					Schema: spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							Properties: map[string]spec.Schema{
+								"port": {
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"integer"},
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-nexus-default": `"8080"`,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			_, err := generator.NewGenerator(rawDefs)
+			Expect(err).To(MatchError(ContainSubstring(`property "port": invalid default value "8080": expected a value of type integer`)))
+		})
+	})
+
 	Context("checks backward compatibility", func() {
 		It("should fail when the spec is changed", func() {
 			rawDefs := map[string]common.OpenAPIDefinition{
@@ -607,6 +802,91 @@ var _ = Describe("Generator", func() {
 			cleanTempTestDir(oldCRDDir)
 		})
 
+		It("should not fail when only an optional field is added", func() {
+			oldCRDDir, err := exampleFileTempTestDir("foos.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			defer cleanTempTestDir(oldCRDDir)
+
+			data, err := os.ReadFile("test_data/foos.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			newCRD := strings.Replace(string(data), "              name:\n", "              description:\n                type: string\n              name:\n", 1)
+			Expect(newCRD).NotTo(Equal(string(data)))
+			err = os.WriteFile(filepath.Join(tmpDir, "foos.yaml"), []byte(newCRD), 0665)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = generator.CheckBackwardCompatibility(oldCRDDir, tmpDir, false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should write json report listing removed CRDs", func() {
+			oldCRDDir, err := exampleFileTempTestDir("zoos.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			defer cleanTempTestDir(oldCRDDir)
+
+			out := &bytes.Buffer{}
+			err = generator.CheckBackwardCompatibilityWithReport(oldCRDDir, tmpDir, true, generator.CompatibilityReportOptions{
+				Format: nexus_compare.JSONFormat,
+				Output: out,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			report := nexus_compare.CompatibilityReport{}
+			Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+			Expect(report.CRDs).To(HaveLen(1))
+			Expect(report.CRDs[0].Name).To(Equal("foos"))
+			Expect(report.CRDs[0].Removed).To(BeTrue())
+			Expect(report.CRDs[0].Breaking).To(BeTrue())
+		})
+
+		It("should write json report listing added CRDs", func() {
+			emptyDir, err := exampleTestDir()
+			Expect(err).NotTo(HaveOccurred())
+			defer cleanTempTestDir(emptyDir)
+
+			data, err := os.ReadFile("test_data/foos.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(tmpDir, "foos.yaml"), data, 0665)).To(Succeed())
+
+			out := &bytes.Buffer{}
+			err = generator.CheckBackwardCompatibilityWithReport(emptyDir, tmpDir, false, generator.CompatibilityReportOptions{
+				Format: nexus_compare.JSONFormat,
+				Output: out,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			report := nexus_compare.CompatibilityReport{}
+			Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+			Expect(report.CRDs).To(HaveLen(1))
+			Expect(report.CRDs[0].Name).To(Equal("foos"))
+			Expect(report.CRDs[0].Added).To(BeTrue())
+			Expect(report.CRDs[0].Breaking).To(BeFalse())
+		})
+
+		It("should point the json report to DSL sources", func() {
+			emptyDir, err := exampleTestDir()
+			Expect(err).NotTo(HaveOccurred())
+			defer cleanTempTestDir(emptyDir)
+
+			data, err := os.ReadFile("test_data/foos.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(tmpDir, "foos.yaml"), data, 0665)).To(Succeed())
+			sourcesPath := filepath.Join(emptyDir, "dsl_sources.json")
+			Expect(os.WriteFile(sourcesPath, []byte(`{"foos":{"node":{"file":"foo/foo.go","line":7,"column":6}}}`), 0665)).To(Succeed())
+
+			out := &bytes.Buffer{}
+			err = generator.CheckBackwardCompatibilityWithReport(emptyDir, tmpDir, false, generator.CompatibilityReportOptions{
+				Format:      nexus_compare.JSONFormat,
+				Output:      out,
+				SourcesPath: sourcesPath,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			report := nexus_compare.CompatibilityReport{}
+			Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+			Expect(report.CRDs).To(HaveLen(1))
+			Expect(report.CRDs[0].Source).To(Equal(&nexus_compare.SourcePosition{File: "foo/foo.go", Line: 7, Column: 6}))
+		})
+
 		It("should not fail when the existing CRDs directory is empty", func() {
 			// shouldn't fail when no crds exists
 			emptyDir, err := exampleTestDir()
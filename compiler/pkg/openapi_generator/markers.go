@@ -0,0 +1,135 @@
+package openapi_generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/kube-openapi/pkg/validation/spec"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// Vendor extensions created by openapi-gen from nexus DSL markers, see `nexus-default`, `nexus-immutable` and
+// `nexus-validation-rule` in DSL.md.
+const (
+	defaultExtension         = "x-nexus-default"
+	immutableExtension       = "x-nexus-immutable"
+	validationRulesExtension = "x-nexus-validation-rules"
+
+	immutableRule    = "self == oldSelf"
+	immutableMessage = "Value is immutable"
+)
+
+// addNexusMarkers renders nexus markers of the source schema and its properties into the CRD schema as
+// `default` values and `x-kubernetes-validations` rules, which are enforced by the API server.
+func addNexusMarkers(src spec.Schema, dst *extensionsv1.JSONSchemaProps) error {
+	if err := addDefault(src.Extensions, dst); err != nil {
+		return err
+	}
+	if values := extensionValues(src.Extensions, immutableExtension); len(values) > 0 && values[0] == "true" {
+		addValidationRule(dst, extensionsv1.ValidationRule{Rule: immutableRule, Message: immutableMessage})
+	}
+	for _, rule := range extensionValues(src.Extensions, validationRulesExtension) {
+		addValidationRule(dst, extensionsv1.ValidationRule{Rule: rule})
+	}
+
+	for name, prop := range src.Properties {
+		dstProp, ok := dst.Properties[name]
+		if !ok {
+			continue
+		}
+		if err := addNexusMarkers(prop, &dstProp); err != nil {
+			return fmt.Errorf("property %q: %v", name, err)
+		}
+		dst.Properties[name] = dstProp
+	}
+	if src.Items != nil && src.Items.Schema != nil && dst.Items != nil && dst.Items.Schema != nil {
+		if err := addNexusMarkers(*src.Items.Schema, dst.Items.Schema); err != nil {
+			return err
+		}
+	}
+	if src.AdditionalProperties != nil && src.AdditionalProperties.Schema != nil &&
+		dst.AdditionalProperties != nil && dst.AdditionalProperties.Schema != nil {
+		if err := addNexusMarkers(*src.AdditionalProperties.Schema, dst.AdditionalProperties.Schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addDefault sets the default value given as JSON. Values of string properties may be given without quotes, so they
+// are always encoded as strings, e.g. a default "1.10" of a version stays a string. Values of other properties must
+// match the type of the property.
+func addDefault(extensions spec.Extensions, dst *extensionsv1.JSONSchemaProps) error {
+	values := extensionValues(extensions, defaultExtension)
+	if len(values) == 0 {
+		return nil
+	}
+	if len(values) > 1 {
+		return fmt.Errorf("only one default value is allowed, found %d", len(values))
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(values[0]), &value); err != nil && dst.Type != "string" {
+		return fmt.Errorf("invalid default value %s: %v", values[0], err)
+	}
+	if dst.Type == "string" {
+		if _, ok := value.(string); !ok {
+			value = values[0]
+		}
+	} else if !matchesType(value, dst.Type) {
+		return fmt.Errorf("invalid default value %s: expected a value of type %s", values[0], dst.Type)
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	dst.Default = &extensionsv1.JSON{Raw: raw}
+	return nil
+}
+
+// matchesType returns true if the value decoded from JSON is valid for the schema type, any value is valid for
+// schemas without type.
+func matchesType(value interface{}, schemaType string) bool {
+	if schemaType == "" {
+		return true
+	}
+	switch v := value.(type) {
+	case float64:
+		return schemaType == "number" || schemaType == "integer" && v == math.Trunc(v)
+	case bool:
+		return schemaType == "boolean"
+	case []interface{}:
+		return schemaType == "array"
+	case map[string]interface{}:
+		return schemaType == "object"
+	}
+	return false
+}
+
+// addValidationRule adds the rule once, schemas of types used by many nodes are shared.
+func addValidationRule(dst *extensionsv1.JSONSchemaProps, rule extensionsv1.ValidationRule) {
+	for _, r := range dst.XValidations {
+		if r.Rule == rule.Rule {
+			return
+		}
+	}
+	dst.XValidations = append(dst.XValidations, rule)
+}
+
+func extensionValues(extensions spec.Extensions, name string) []string {
+	switch v := extensions[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var values []string
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	case []string:
+		return v
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
+)
+
+// NexusEnumAnnotation marks a named string type as an enum. Values of the enum are the constants of this type
+// declared in the same package.
+const NexusEnumAnnotation = "nexus-enum"
+
+var graphqlNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// Enum is a named string type annotated with NexusEnumAnnotation.
+type Enum struct {
+	Name     string
+	TypeSpec *ast.TypeSpec
+	Values   []EnumValue
+}
+
+type EnumValue struct {
+	Name  string
+	Value string
+}
+
+// ValueStrings returns values of the enum in the order of declaration.
+func (e Enum) ValueStrings() []string {
+	var values []string
+	for _, v := range e.Values {
+		values = append(values, v.Value)
+	}
+	return values
+}
+
+// IsGraphqlEnum returns true if all values of the enum are valid GraphQL enum values.
+// Other enums are exposed as String in GraphQL.
+func (e Enum) IsGraphqlEnum() bool {
+	if len(e.Values) == 0 {
+		return false
+	}
+	for _, v := range e.Values {
+		if !graphqlNameRegex.MatchString(v.Value) || v.Value == "true" || v.Value == "false" || v.Value == "null" {
+			return false
+		}
+	}
+	return true
+}
+
+func hasEnumAnnotation(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if strings.TrimSpace(strings.TrimPrefix(c.Text, "//")) == NexusEnumAnnotation {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEnumType returns true if the type is annotated with `// nexus-enum`.
+func IsEnumType(genDecl *ast.GenDecl, typeSpec *ast.TypeSpec) bool {
+	if genDecl.Lparen.IsValid() {
+		return hasEnumAnnotation(typeSpec.Doc)
+	}
+	return hasEnumAnnotation(genDecl.Doc)
+}
+
+// GetEnums returns all enums declared in the package, sorted by name.
+func (p *Package) GetEnums() []Enum {
+	var enums []Enum
+	for i := range p.GenDecls {
+		genDecl := &p.GenDecls[i]
+		if genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			if typeSpec, ok := spec.(*ast.TypeSpec); ok && IsEnumType(genDecl, typeSpec) {
+				enums = append(enums, Enum{
+					Name:     typeSpec.Name.Name,
+					TypeSpec: typeSpec,
+					Values:   p.getEnumValues(typeSpec.Name.Name),
+				})
+			}
+		}
+	}
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].Name < enums[j].Name
+	})
+	return enums
+}
+
+// GetEnum returns the enum with the given name declared in the package.
+func (p *Package) GetEnum(name string) (Enum, bool) {
+	for _, enum := range p.GetEnums() {
+		if enum.Name == name {
+			return enum, true
+		}
+	}
+	return Enum{}, false
+}
+
+func (p *Package) getEnumValues(enumName string) []EnumValue {
+	var values []EnumValue
+	for _, valueSpec := range p.GetConsts() {
+		if typeName, ok := valueSpec.Type.(*ast.Ident); !ok || typeName.Name != enumName {
+			continue
+		}
+		for i, name := range valueSpec.Names {
+			if i >= len(valueSpec.Values) {
+				break
+			}
+			lit, ok := valueSpec.Values[i].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+			value, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				continue
+			}
+			values = append(values, EnumValue{Name: name.Name, Value: value})
+		}
+	}
+	return values
+}
+
+// checkEnums records errors of enums which can't be rendered.
+func checkEnums(packages Packages) {
+	for _, pkg := range packages {
+		for _, genDecl := range pkg.GenDecls {
+			if genDecl.Tok != token.TYPE || !genDecl.Lparen.IsValid() {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok && hasEnumAnnotation(typeSpec.Doc) {
+					diagnostics.Errorf(diagnostics.PositionFor(pkg.FileSet, typeSpec.Pos()),
+						"Enum %s must be declared in its own type declaration", typeSpec.Name.Name)
+				}
+			}
+		}
+
+		for _, enum := range pkg.GetEnums() {
+			pos := diagnostics.PositionFor(pkg.FileSet, enum.TypeSpec.Pos())
+			if ident, ok := enum.TypeSpec.Type.(*ast.Ident); !ok || ident.Name != "string" || enum.TypeSpec.Assign.IsValid() {
+				diagnostics.Errorf(pos, "Enum %s must be a named string type", enum.Name)
+				continue
+			}
+			for _, valueSpec := range pkg.GetConsts() {
+				if typeName, ok := valueSpec.Type.(*ast.Ident); !ok || typeName.Name != enum.Name {
+					continue
+				}
+				for i, name := range valueSpec.Names {
+					if i < len(valueSpec.Values) {
+						if lit, ok := valueSpec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+							continue
+						}
+					}
+					diagnostics.Errorf(diagnostics.PositionFor(pkg.FileSet, name.Pos()),
+						"Value of constant %s of enum %s must be a string literal", name.Name, enum.Name)
+				}
+			}
+			if len(enum.Values) == 0 {
+				diagnostics.Errorf(pos, "Enum %s has no values. Please declare constants of type %s.", enum.Name, enum.Name)
+				continue
+			}
+			if !enum.IsGraphqlEnum() {
+				diagnostics.Warnf(pos, "Values of enum %s aren't valid GraphQL enum values, the enum is exposed as String in GraphQL", enum.Name)
+			}
+		}
+	}
+}
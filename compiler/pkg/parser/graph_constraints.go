@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
+)
+
+// LinkScope is the part of the graph in which targets of a link must be placed, relative to the source node.
+type LinkScope string
+
+const (
+	// LinkScopeSibling requires the target to have the same parent as the source node.
+	LinkScopeSibling LinkScope = "sibling"
+	// LinkScopeSubtree requires the target to be placed under the parent of the source node, at any depth.
+	LinkScopeSubtree LinkScope = "subtree"
+)
+
+// GraphConstraints are invariants of a child or link field given as options of the nexus tag, e.g.
+//
+//	Gns    GnsNode  `nexus:"children,max=10"`
+//	Policy ACPolicy `nexus:"link,scope=sibling"`
+//
+// They are rendered into the nexus annotation of CRD and enforced by the validation webhook.
+type GraphConstraints struct {
+	// Max is the maximal number of children or links of the field, 0 means no limit.
+	Max   int
+	Scope LinkScope
+}
+
+// GetGraphConstraints returns constraints of child and link fields of the node, keyed by field name. Invalid options
+// are skipped, they are reported by ParseDSLPkg.
+func GetGraphConstraints(node *ast.TypeSpec) map[string]GraphConstraints {
+	constraints := make(map[string]GraphConstraints)
+	for _, f := range append(GetChildFields(node), GetLinkFields(node)...) {
+		name, err := GetNodeFieldName(f)
+		if err != nil {
+			continue
+		}
+		constraints[name] = parseGraphConstraints(f, func(token.Pos, string, ...interface{}) {})
+	}
+	return constraints
+}
+
+func parseGraphConstraints(f *ast.Field, errorf func(pos token.Pos, format string, args ...interface{})) GraphConstraints {
+	var constraints GraphConstraints
+	if f.Tag == nil {
+		return constraints
+	}
+	val, err := ParseFieldTags(f.Tag.Value).Get("nexus")
+	if err != nil {
+		return constraints
+	}
+	kind := strings.ToLower(val.Name)
+	for _, option := range val.Options {
+		key, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+		switch key {
+		case "max":
+			if kind != "children" && kind != "links" {
+				errorf(f.Pos(), "Option max of field %s is only supported for children and links", f.Names)
+				continue
+			}
+			max, err := strconv.Atoi(value)
+			if err != nil || max <= 0 {
+				errorf(f.Pos(), "Invalid max %q of field %s, expected a positive number", value, f.Names)
+				continue
+			}
+			constraints.Max = max
+		case "scope":
+			if kind != "link" && kind != "links" {
+				errorf(f.Pos(), "Option scope of field %s is only supported for link and links", f.Names)
+				continue
+			}
+			scope := LinkScope(value)
+			if scope != LinkScopeSibling && scope != LinkScopeSubtree {
+				errorf(f.Pos(), "Unsupported scope %q of field %s, expected one of: %s, %s",
+					value, f.Names, LinkScopeSibling, LinkScopeSubtree)
+				continue
+			}
+			constraints.Scope = scope
+		default:
+			errorf(f.Pos(), "Unknown option %q of nexus tag of field %s, expected max or scope", option, f.Names)
+		}
+	}
+	return constraints
+}
+
+// checkGraphConstraints records errors of nexus tag options of child and link fields.
+func checkGraphConstraints(packages Packages) {
+	for _, pkg := range packages {
+		for _, node := range pkg.GetNexusNodes() {
+			for _, f := range append(GetChildFields(node), GetLinkFields(node)...) {
+				parseGraphConstraints(f, func(pos token.Pos, format string, args ...interface{}) {
+					diagnostics.Errorf(diagnostics.PositionFor(pkg.FileSet, pos), format, args...)
+				})
+			}
+		}
+	}
+}
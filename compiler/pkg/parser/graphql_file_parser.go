@@ -6,12 +6,18 @@ import (
 	"path/filepath"
 
 	log "github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 )
 
 // ParseDSLNodes walks recursively through given path and looks for structs types definitions to add them to graph
 func ParseGraphQLFiles(startPath string) map[string]string {
 	graphqlFiles := make(map[string]string, 0)
 	err := filepath.Walk(startPath, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			diagnostics.Errorf(diagnostics.Position{File: path}, "Failed to read DSL: %v", err)
+			return nil
+		}
 		if info.IsDir() {
 			if info.Name() == "build" {
 				log.Infof("Ignoring build directory...")
@@ -25,7 +31,8 @@ func ParseGraphQLFiles(startPath string) map[string]string {
 			if filepath.Ext(path) == ".graphql" {
 				data, err := os.ReadFile(path)
 				if err != nil {
-					return err
+					diagnostics.Errorf(diagnostics.Position{File: path}, "Failed to read graphql file: %v", err)
+					return nil
 				}
 				graphqlFiles[path] = string(data)
 			}
@@ -33,7 +40,7 @@ func ParseGraphQLFiles(startPath string) map[string]string {
 		return nil
 	})
 	if err != nil {
-		log.Fatalf("Failed to ParseDSLNodes %v", err)
+		diagnostics.Errorf(diagnostics.Position{File: startPath}, "Failed to read DSL: %v", err)
 	}
 
 	return graphqlFiles
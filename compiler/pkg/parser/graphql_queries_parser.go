@@ -2,9 +2,10 @@ package parser
 
 import (
 	"go/ast"
+	"go/token"
 	"strconv"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
 )
 
@@ -27,16 +28,19 @@ func parseQuerySpec(v *ast.CompositeLit, p Package) nexus.GraphQLQuerySpec {
 	for _, queryElt := range v.Elts {
 		querykv, ok := queryElt.(*ast.KeyValueExpr)
 		if !ok {
-			log.Fatalf("Wrong format of graphql query spec, please check graphql spec")
+			graphqlQueryErrorf(p, queryElt, "Wrong format of graphql query spec, please check graphql spec")
+			continue
 		}
 		val, ok := querykv.Value.(*ast.CompositeLit)
 		if !ok {
-			log.Fatalf("Wrong format of graphql query spec value, please check graphql spec")
+			graphqlQueryErrorf(p, querykv.Value, "Wrong format of graphql query spec value, please check graphql spec")
+			continue
 		}
 		for _, querySpecElt := range val.Elts {
 			queryComp, ok := querySpecElt.(*ast.CompositeLit)
 			if !ok {
-				log.Fatalf("Wrong format of graphql query spec field, please check graphql spec")
+				graphqlQueryErrorf(p, querySpecElt, "Wrong format of graphql query spec field, please check graphql spec")
+				continue
 			}
 
 			newQuery := parseQuery(queryComp, p)
@@ -51,36 +55,58 @@ func parseQuery(queryComp *ast.CompositeLit, p Package) (newQuery nexus.GraphQLQ
 	for _, queryFieldCompElt := range queryComp.Elts {
 		queryFieldExp, ok := queryFieldCompElt.(*ast.KeyValueExpr)
 		if !ok {
-			log.Fatalf("Wrong format of graphql query spec field element, please check graphql spec")
+			graphqlQueryErrorf(p, queryFieldCompElt, "Wrong format of graphql query spec field element, please check graphql spec")
+			continue
 		}
 
-		queryFieldName := queryFieldExp.Key.(*ast.Ident)
+		queryFieldName, ok := queryFieldExp.Key.(*ast.Ident)
+		if !ok {
+			graphqlQueryErrorf(p, queryFieldExp.Key, "Wrong format of graphql query spec field element, please check graphql spec")
+			continue
+		}
 		switch queryFieldName.String() {
 		case "Name":
-			queryFieldValue := queryFieldExp.Value.(*ast.BasicLit)
-			name, err := strconv.Unquote(queryFieldValue.Value)
-			if err != nil {
-				log.Fatalf("Internal compiler error, failed to unqote name in graphql")
+			name, ok := unquoteLit(queryFieldExp.Value)
+			if !ok {
+				graphqlQueryErrorf(p, queryFieldExp.Value, "Name of graphql query must be a string literal")
+				continue
 			}
 			newQuery.Name = name
 		case "ServiceEndpoint":
-			queryFieldValue := queryFieldExp.Value.(*ast.CompositeLit)
+			queryFieldValue, ok := queryFieldExp.Value.(*ast.CompositeLit)
+			if !ok {
+				graphqlQueryErrorf(p, queryFieldExp.Value, "ServiceEndpoint of graphql query must be a nexus.GraphQLQueryEndpoint literal")
+				continue
+			}
 			for _, serviceEndpointField := range queryFieldValue.Elts {
-				serviceEndpointFieldKeyKv := serviceEndpointField.(*ast.KeyValueExpr)
-				serviceEndpointFieldKey := serviceEndpointFieldKeyKv.Key.(*ast.Ident)
+				serviceEndpointFieldKeyKv, ok := serviceEndpointField.(*ast.KeyValueExpr)
+				if !ok {
+					graphqlQueryErrorf(p, serviceEndpointField, "Wrong format of graphql query ServiceEndpoint, please check graphql spec")
+					continue
+				}
+				serviceEndpointFieldKey, ok := serviceEndpointFieldKeyKv.Key.(*ast.Ident)
+				if !ok {
+					graphqlQueryErrorf(p, serviceEndpointFieldKeyKv.Key, "Wrong format of graphql query ServiceEndpoint, please check graphql spec")
+					continue
+				}
 				if serviceEndpointFieldKey.String() == "Port" {
-					serviceEndpointFieldValue := serviceEndpointFieldKeyKv.Value.(*ast.BasicLit)
+					serviceEndpointFieldValue, ok := serviceEndpointFieldKeyKv.Value.(*ast.BasicLit)
+					if !ok {
+						graphqlQueryErrorf(p, serviceEndpointFieldKeyKv.Value, "Port of graphql query ServiceEndpoint must be an integer literal")
+						continue
+					}
 					port, err := strconv.Atoi(serviceEndpointFieldValue.Value)
 					if err != nil {
-						log.Fatalf("Internal compiler error, failed to parse port to int in graphql spec")
+						graphqlQueryErrorf(p, serviceEndpointFieldValue, "Port of graphql query ServiceEndpoint must be an integer literal: %v", err)
+						continue
 					}
 					newQuery.ServiceEndpoint.Port = port
 				}
 				if serviceEndpointFieldKey.String() == "Domain" {
-					serviceEndpointFieldValue := serviceEndpointFieldKeyKv.Value.(*ast.BasicLit)
-					domain, err := strconv.Unquote(serviceEndpointFieldValue.Value)
-					if err != nil {
-						log.Fatalf("Internal compiler error, failed to unqote domain in graphql")
+					domain, ok := unquoteLit(serviceEndpointFieldKeyKv.Value)
+					if !ok {
+						graphqlQueryErrorf(p, serviceEndpointFieldKeyKv.Value, "Domain of graphql query ServiceEndpoint must be a string literal")
+						continue
 					}
 					newQuery.ServiceEndpoint.Domain = domain
 				}
@@ -92,7 +118,8 @@ func parseQuery(queryComp *ast.CompositeLit, p Package) (newQuery nexus.GraphQLQ
 			}
 			typ, ok := queryFieldValue.Type.(*ast.Ident)
 			if !ok {
-				log.Fatalf("Graphql query args must not be imported, wrong type: %v", queryFieldValue.Type)
+				graphqlQueryErrorf(p, queryFieldValue, "Graphql query args must not be imported, wrong type: %v", queryFieldValue.Type)
+				continue
 			}
 			// translate args to map[arg.fieldName]arg.type
 			args := parseArgs(typ.Name, p)
@@ -100,7 +127,8 @@ func parseQuery(queryComp *ast.CompositeLit, p Package) (newQuery nexus.GraphQLQ
 		case "ApiType":
 			selExpr, ok := queryFieldExp.Value.(*ast.SelectorExpr)
 			if !ok {
-				log.Fatalf("Failed to parse ApiType param in graphql custom query")
+				graphqlQueryErrorf(p, queryFieldExp.Value, "Failed to parse ApiType param in graphql custom query")
+				continue
 			}
 			sel := selExpr.Sel.String()
 			switch sel {
@@ -116,6 +144,23 @@ func parseQuery(queryComp *ast.CompositeLit, p Package) (newQuery nexus.GraphQLQ
 	return
 }
 
+// unquoteLit returns the value of a string literal.
+func unquoteLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	val, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return val, true
+}
+
+func graphqlQueryErrorf(p Package, node ast.Node, format string, args ...interface{}) {
+	diagnostics.Errorf(diagnostics.PositionFor(p.FileSet, node.Pos()), format, args...)
+}
+
 type GraphQlArg struct {
 	Name      string
 	Type      string
@@ -132,7 +177,8 @@ func parseArgs(argsTypeName string, p Package) []GraphQlArg {
 			}
 			for _, field := range GetSpecFields(v) {
 				if len(field.Names) == 0 {
-					log.Fatalf("Field in graphql args must be named, args %s", argsTypeName)
+					graphqlQueryErrorf(p, field, "Field in graphql args must be named, args %s", argsTypeName)
+					continue
 				}
 				// AliasName Annotation
 				var fName, fType string
@@ -2,9 +2,10 @@ package parser
 
 import (
 	"go/ast"
+	"go/types"
 	"strconv"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
 )
 
@@ -28,22 +29,37 @@ func parseGqlSpec(v *ast.CompositeLit, p Package) nexus.GraphQLSpec {
 		IdNullable: true,
 	}
 	for _, gqlSpecElt := range v.Elts {
+		pos := diagnostics.PositionFor(p.FileSet, gqlSpecElt.Pos())
 		gqlSpecKv, ok := gqlSpecElt.(*ast.KeyValueExpr)
 		if !ok {
-			log.Fatalf("Wrong format of graphql query spec, please check graphql spec")
+			diagnostics.Errorf(pos, "Wrong format of graphql spec, fields of the spec must be set by name")
+			continue
 		}
 
-		gqlSpecFieldName := gqlSpecKv.Key.(*ast.Ident)
+		gqlSpecFieldName, ok := gqlSpecKv.Key.(*ast.Ident)
+		if !ok {
+			diagnostics.Errorf(pos, "Wrong format of graphql spec, unexpected field %s", types.ExprString(gqlSpecKv.Key))
+			continue
+		}
 		switch gqlSpecFieldName.String() {
 		case "IdName":
-			gqlSpecFieldValue := gqlSpecKv.Value.(*ast.BasicLit)
+			gqlSpecFieldValue, ok := gqlSpecKv.Value.(*ast.BasicLit)
+			if !ok {
+				diagnostics.Errorf(pos, "IdName of graphql spec must be a string literal")
+				continue
+			}
 			name, err := strconv.Unquote(gqlSpecFieldValue.Value)
 			if err != nil {
-				log.Fatalf("Internal compiler error, failed to unqote name in graphql")
+				diagnostics.Errorf(pos, "IdName of graphql spec must be a string literal: %v", err)
+				continue
 			}
 			spec.IdName = name
 		case "IdNullable":
-			gqlSpecFieldValue := gqlSpecKv.Value.(*ast.Ident)
+			gqlSpecFieldValue, ok := gqlSpecKv.Value.(*ast.Ident)
+			if !ok {
+				diagnostics.Errorf(pos, "IdNullable of graphql spec must be true or false")
+				continue
+			}
 			val := gqlSpecFieldValue.String()
 			if val == "false" {
 				spec.IdNullable = false
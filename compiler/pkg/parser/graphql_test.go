@@ -1,8 +1,13 @@
 package parser_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"os"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
 )
@@ -87,4 +92,36 @@ var _ = Describe("Graphql parsing tests", func() {
 		}))
 	})
 
+	It("should report invalid graphql specs with their positions", func() {
+		out := &bytes.Buffer{}
+		diagnostics.Default.SetOutput(diagnostics.JSONFormat, out)
+		defer diagnostics.Default.SetOutput(diagnostics.TextFormat, os.Stderr)
+
+		pkgs = parser.ParseDSLPkg("../../example/test-utils/invalid-graphql-spec-datamodel")
+		parser.ParseGraphqlSpecs(pkgs)
+		Expect(diagnostics.Err()).To(HaveOccurred())
+
+		var report struct {
+			Diagnostics []diagnostics.Diagnostic `json:"diagnostics"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		positional := SatisfyAll(
+			HaveField("Position.File", HaveSuffix("invalid-graphql-spec-datamodel/root.go")),
+			HaveField("Position.Line", 7),
+			HaveField("Message", ContainSubstring("fields of the spec must be set by name")),
+		)
+		Expect(report.Diagnostics).To(ConsistOf(
+			positional,
+			positional,
+			SatisfyAll(
+				HaveField("Position.Line", 10),
+				HaveField("Message", ContainSubstring("IdName of graphql spec must be a string literal")),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 11),
+				HaveField("Message", ContainSubstring("IdNullable of graphql spec must be true or false")),
+			),
+		))
+	})
+
 })
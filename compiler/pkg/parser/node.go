@@ -3,6 +3,7 @@ package parser
 import (
 	"go/ast"
 
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
 )
 
@@ -14,6 +15,7 @@ type Node struct {
 	IsSingleton      bool
 	Imports          []*ast.ImportSpec
 	TypeSpec         *ast.TypeSpec
+	Pos              diagnostics.Position
 	Parents          []string
 	SingleChildren   map[string]Node
 	MultipleChildren map[string]Node
@@ -40,6 +42,9 @@ type NodeHelperChild struct {
 	FieldNameGvk   string `json:"fieldNameGvk"`
 	GoFieldNameGvk string `json:"goFieldNameGvk"`
 	IsNamed        bool   `json:"isNamed"`
+	// Max and Scope are graph constraints of the field, see GraphConstraints.
+	Max   int       `json:"max,omitempty"`
+	Scope LinkScope `json:"scope,omitempty"`
 }
 
 type NonNexusTypes struct {
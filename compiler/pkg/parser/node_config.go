@@ -5,7 +5,7 @@ import (
 	"regexp"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 )
 
 const (
@@ -23,7 +23,14 @@ func GetNexusSecretSpecAnnotation(pkg Package, name string) (string, bool) {
 func GetNexusRestAPIGenAnnotation(pkg Package, name string) (string, bool) {
 	anno, ok := getNexusAnnotation(pkg, name, NexusRestApiGenAnnotation)
 	if ok && !pkg.IsVarPresent(anno) {
-		log.Fatalf("Error: var %+s is not present", anno)
+		pos := diagnostics.NoPos
+		for _, node := range pkg.GetNexusNodes() {
+			if GetTypeName(node) == name {
+				pos = diagnostics.PositionFor(pkg.FileSet, node.Pos())
+			}
+		}
+		diagnostics.Errorf(pos, "var %s of %s annotation of %s is not present", anno, NexusRestApiGenAnnotation, name)
+		return anno, false
 	}
 	return anno, ok
 }
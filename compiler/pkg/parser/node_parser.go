@@ -8,10 +8,12 @@ import (
 	"go/types"
 	"io/fs"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"k8s.io/utils/strings/slices"
 
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/util"
@@ -21,9 +23,24 @@ import (
 )
 
 // ParseDSLNodes walks recursively through given path and looks for structs types definitions to add them to graph
+// Errors in the DSL stop the compilation.
 func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 	graphqlQueries map[string]nexus.GraphQLQuerySpec) (map[string]Node, *NonNexusTypes, *token.FileSet) {
+	graph, nonNexusTypes, fileset, err := ParseDSLGraph(startPath, baseGroupName, packages, graphqlQueries)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return graph, nonNexusTypes, fileset
+}
+
+// ParseDSLGraph is ParseDSLNodes which returns errors in the DSL together with the parsed nodes, so the DSL can be
+// parsed again, e.g. in watch mode.
+func ParseDSLGraph(startPath string, baseGroupName string, packages Packages,
+	graphqlQueries map[string]nexus.GraphQLQuerySpec) (map[string]Node, *NonNexusTypes, *token.FileSet, error) {
 	modulePath := GetModulePath(startPath)
+	if modulePath == "" {
+		return nil, nil, nil, diagnostics.Err()
+	}
 
 	rootNodes := make([]string, 0)
 	nodes := make(map[string]Node)
@@ -34,6 +51,10 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 	}
 	fileset := token.NewFileSet()
 	err := filepath.Walk(startPath, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			diagnostics.Errorf(diagnostics.Position{File: path}, "Failed to read DSL: %v", err)
+			return nil
+		}
 		if info.IsDir() {
 			if info.Name() == "build" {
 				log.Infof("Ignoring build directory...")
@@ -51,18 +72,26 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 			}
 			pkgs, err := parser.ParseDir(fileset, path, nil, parser.ParseComments)
 			if err != nil {
-				log.Fatalf("Failed to parse directory %s: %v", path, err)
+				diagnostics.AddParseError(err)
+				return nil
 			}
 			for _, v := range pkgs {
 				if v.Name == "" {
-					log.Fatalf("Failed to get package name for %#v", v)
+					diagnostics.Errorf(PackagePosition(fileset, v), "Failed to get package name")
+					continue
+				}
+				pkgImport := strings.TrimSuffix(strings.ReplaceAll(path, startPath, modulePath), "/")
+				if pkg, ok := packages[pkgImport]; ok && !pkg.IsHub() {
+					// nodes of additional versions are part of the graph through their hub version
+					log.Debugf("Skipping nodes of package %s version %s", v.Name, pkg.Version)
+					continue
 				}
 				if _, ok := pkgsMap[v.Name]; ok {
-					log.Fatalf("Invalid Package name. Package name <%v> is already defined. Please make sure the package names are not duplicated.", v.Name)
+					diagnostics.Errorf(PackagePosition(fileset, v), "Invalid Package name. Package name <%v> is already defined. Please make sure the package names are not duplicated.", v.Name)
+					continue
 				}
 
 				pkgsMap[v.Name] = v.Name
-				pkgImport := strings.TrimSuffix(strings.ReplaceAll(path, startPath, modulePath), "/")
 				for _, file := range v.Files {
 					for _, decl := range file.Decls {
 						genDecl, ok := decl.(*ast.GenDecl)
@@ -70,7 +99,7 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 							for _, spec := range genDecl.Specs {
 								if typeSpec, ok := spec.(*ast.TypeSpec); ok {
 									if _, ok := typeSpec.Type.(*ast.StructType); ok {
-										checkIfReserved(typeSpec.Name.Name)
+										checkIfReserved(fileset, typeSpec)
 										crdName := util.GetCrdName(typeSpec.Name.Name, v.Name, baseGroupName)
 										if IsNexusNode(typeSpec) {
 											// Detect root nodes
@@ -80,6 +109,7 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 
 											node := Node{
 												Name:             typeSpec.Name.Name,
+												Pos:              diagnostics.PositionFor(fileset, typeSpec.Pos()),
 												PkgName:          v.Name,
 												FullName:         pkgImport,
 												CrdName:          crdName,
@@ -93,7 +123,8 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 												MultipleLink:     make(map[string]Node),
 											}
 											if node.CrdName == "" {
-												log.Fatalf("Internal compiler failure: Failed to determine crd name of node %v", node.Name)
+												diagnostics.Errorf(node.Pos, "Internal compiler failure: Failed to determine crd name of node %v", node.Name)
+												continue
 											}
 											annotation, exists := GetNexusGraphqlAnnotation(packages[pkgImport], typeSpec.Name.Name)
 											if exists {
@@ -104,6 +135,9 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 												GraphqlQuerySpec, ok := graphqlQueries[annotation]
 												if ok {
 													node.GraphqlQuerySpec = GraphqlQuerySpec
+												} else {
+													diagnostics.Warnf(diagnostics.PositionFor(fileset, typeSpec.Pos()),
+														"GraphQL query spec %s of node %s not found", annotation, typeSpec.Name.Name)
 												}
 											}
 											nodes[crdName] = node
@@ -121,7 +155,8 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 								if valueSpec, ok := spec.(*ast.ValueSpec); ok {
 									out, err := util.RenderDecl(decl, fileset)
 									if err != nil {
-										return err
+										diagnostics.Errorf(diagnostics.PositionFor(fileset, valueSpec.Pos()), "Failed to render declaration: %v", err)
+										continue
 									}
 									outStr := out.String()
 
@@ -146,7 +181,8 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 						if _, ok := decl.(*ast.FuncDecl); ok {
 							out, err := util.RenderDecl(decl, fileset)
 							if err != nil {
-								return err
+								diagnostics.Errorf(diagnostics.PositionFor(fileset, decl.Pos()), "Failed to render declaration: %v", err)
+								continue
 							}
 							outStr := out.String()
 
@@ -161,12 +197,12 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 		return nil
 	})
 	if err != nil {
-		log.Fatalf("Failed to ParseDSLNodes %v", err)
+		diagnostics.Errorf(diagnostics.Position{File: startPath}, "Failed to read DSL: %v", err)
 	}
 
 	// TEMP FIX: Make more optimal way to auto discover root nodes.
 	// https://jira.eng.vmware.com/browse/NPT-340
-	graph := buildGraph(nodes, rootNodes, baseGroupName)
+	graph := buildGraph(fileset, nodes, rootNodes, baseGroupName)
 	// Find if any node have root node set as child - if yes remove it from rootNodes
 	for _, v := range graph {
 		v.Walk(func(node *Node) {
@@ -197,14 +233,15 @@ func ParseDSLNodes(startPath string, baseGroupName string, packages Packages,
 		})
 	}
 
-	return buildGraph(nodes, rootNodes, baseGroupName), &nonNexusTypes, fileset
+	graph = buildGraph(fileset, nodes, rootNodes, baseGroupName)
+	return graph, &nonNexusTypes, fileset, diagnostics.Err()
 }
 
-func buildGraph(nodes map[string]Node, rootNodes []string, baseGroupName string) map[string]Node {
+func buildGraph(fileset *token.FileSet, nodes map[string]Node, rootNodes []string, baseGroupName string) map[string]Node {
 	graph := make(map[string]Node)
 	for _, root := range rootNodes {
 		r := nodes[root]
-		processNode(&r, nodes, baseGroupName)
+		processNode(fileset, &r, nodes, baseGroupName)
 		graph[root] = r
 	}
 	return graph
@@ -214,57 +251,71 @@ func CreateParentsMap(graph map[string]Node) map[string]NodeHelper {
 	parents := make(map[string]NodeHelper)
 	for _, root := range graph {
 		root.Walk(func(node *Node) {
+			constraints := GetGraphConstraints(node.TypeSpec)
 			children := make(map[string]NodeHelperChild)
 			for key, child := range node.SingleChildren {
 				if child.CrdName == "" {
-					log.Fatalf("Internal compiler failure: Failed to determine crd name of child %v", child)
+					diagnostics.Errorf(node.Pos, "Internal compiler failure: Failed to determine crd name of child %s of node %s", key, node.Name)
+					continue
 				}
 				children[child.CrdName] = NodeHelperChild{
 					IsNamed:        false,
 					FieldName:      key,
 					FieldNameGvk:   util.GetGvkFieldTagName(key),
 					GoFieldNameGvk: key + "Gvk",
+					Max:            constraints[key].Max,
+					Scope:          constraints[key].Scope,
 				}
 			}
 
 			for key, child := range node.MultipleChildren {
 				if child.CrdName == "" {
-					log.Fatalf("Internal compiler failure: Failed to determine crd name of child %v", child)
+					diagnostics.Errorf(node.Pos, "Internal compiler failure: Failed to determine crd name of child %s of node %s", key, node.Name)
+					continue
 				}
 				children[child.CrdName] = NodeHelperChild{
 					IsNamed:        true,
 					FieldName:      key,
 					FieldNameGvk:   util.GetGvkFieldTagName(key),
 					GoFieldNameGvk: key + "Gvk",
+					Max:            constraints[key].Max,
+					Scope:          constraints[key].Scope,
 				}
 			}
 			links := make(map[string]NodeHelperChild)
 			for key, link := range node.SingleLink {
 				if link.CrdName == "" {
-					log.Fatalf("Internal compiler failure: Failed to determine crd name of link %v", link)
+					diagnostics.Errorf(node.Pos, "Internal compiler failure: Failed to determine crd name of link %s of node %s", key, node.Name)
+					continue
 				}
 				links[key] = NodeHelperChild{
 					IsNamed:        false,
 					FieldName:      key,
 					FieldNameGvk:   util.GetGvkFieldTagName(key),
 					GoFieldNameGvk: key + "Gvk",
+					Max:            constraints[key].Max,
+					Scope:          constraints[key].Scope,
 				}
 			}
 
 			for key, link := range node.MultipleLink {
 				if link.CrdName == "" {
-					log.Fatalf("Internal compiler failure: Failed to determine crd name of link %v", link)
+					diagnostics.Errorf(node.Pos, "Internal compiler failure: Failed to determine crd name of link %s of node %s", key, node.Name)
+					continue
 				}
 				links[key] = NodeHelperChild{
 					IsNamed:        true,
 					FieldName:      key,
 					FieldNameGvk:   util.GetGvkFieldTagName(key),
 					GoFieldNameGvk: key + "Gvk",
+					Max:            constraints[key].Max,
+					Scope:          constraints[key].Scope,
 				}
 			}
 
 			if node.CrdName == "" {
-				log.Fatalf("Internal compiler failure: Failed to determine crd name of node %v", node)
+				diagnostics.Errorf(node.Pos, "Internal compiler failure: Failed to determine crd name of node %s", node.Name)
+				return
 			}
 
 			parents[node.CrdName] = NodeHelper{
@@ -282,13 +333,14 @@ func CreateParentsMap(graph map[string]Node) map[string]NodeHelper {
 	return parents
 }
 
-func processNode(node *Node, nodes map[string]Node, baseGroupName string) {
+func processNode(fileset *token.FileSet, node *Node, nodes map[string]Node, baseGroupName string) {
 	childFields := GetChildFields(node.TypeSpec)
 	linkFields := GetLinkFields(node.TypeSpec)
 
 	processField := func(f *ast.Field, isChild bool, isLink bool) {
+		pos := diagnostics.PositionFor(fileset, f.Pos())
 		if IsArrayField(f) || IsFieldPointer(f) || IsMapField(f) {
-			log.Fatalf(`Invalid Type for %v. Nexus Child or Link can not be an array or a pointer or a map`+"\n"+
+			diagnostics.Errorf(pos, `Invalid Type for %v. Nexus Child or Link can not be an array or a pointer or a map`+"\n"+
 				`Please use nexus:"children" tag to create children or links(named child or link)`, f.Names)
 			return
 		}
@@ -296,33 +348,38 @@ func processNode(node *Node, nodes map[string]Node, baseGroupName string) {
 		isNamed := IsNamedChildOrLink(f)
 
 		if isNamed {
-			childNode := findNodeDefForField(f, node, nodes)
+			childNode := findNodeDefForField(fileset, f, node, nodes)
 			if childNode == nil {
-				log.Fatalf("Internal compiler failure: couldn't determine child node of field %s", f.Names)
+				diagnostics.Errorf(pos, "Couldn't determine node of field %s in node %s", f.Names, node.Name)
+				return
 			}
 			if IsSingletonNode(childNode.TypeSpec) {
-				log.Fatalf("Singleton can't be used as a named child, wrong field name %s in node %s",
+				diagnostics.Errorf(pos, "Singleton can't be used as a named child, wrong field name %s in node %s",
 					f.Names, node.Name)
+				return
 			}
 		}
 		fieldName, _ := GetNodeFieldName(f)
 		if fieldName == "" {
-			log.Fatalf("Internal compiler failure: failed to find field name for field: %v in node %v", f.Names, node.Name)
+			diagnostics.Errorf(pos, "Internal compiler failure: failed to find field name for field: %v in node %v", f.Names, node.Name)
+			return
 		}
-		key := findFieldKeyForNode(f, node, nodes, baseGroupName)
+		key := findFieldKeyForNode(fileset, f, node, nodes, baseGroupName)
 		if key == "" {
-			log.Fatalf("Internal compiler failure: failed to find field key for field %v in node %v", f.Names, node.Name)
+			diagnostics.Errorf(pos, "Couldn't determine node of field %s in node %s", f.Names, node.Name)
+			return
 		}
 		if isChild {
 			n, ok := nodes[key]
 			if !ok {
-				log.Fatalf("Internal compiler failure: couldn't find node for key %v", key)
+				diagnostics.Errorf(pos, "Couldn't find node %s of field %s in node %s", key, f.Names, node.Name)
+				return
 			}
 			p := make([]string, len(node.Parents))
 			copy(p, node.Parents)
 
 			n.Parents = append(p, node.CrdName)
-			processNode(&n, nodes, baseGroupName)
+			processNode(fileset, &n, nodes, baseGroupName)
 
 			if isNamed {
 				node.MultipleChildren[fieldName] = n
@@ -332,10 +389,15 @@ func processNode(node *Node, nodes map[string]Node, baseGroupName string) {
 		}
 
 		if isLink {
+			n, ok := nodes[key]
+			if !ok {
+				diagnostics.Errorf(pos, "Couldn't find node %s of field %s in node %s", key, f.Names, node.Name)
+				return
+			}
 			if isNamed {
-				node.MultipleLink[fieldName] = nodes[key]
+				node.MultipleLink[fieldName] = n
 			} else {
-				node.SingleLink[fieldName] = nodes[key]
+				node.SingleLink[fieldName] = n
 			}
 		}
 	}
@@ -349,7 +411,7 @@ func processNode(node *Node, nodes map[string]Node, baseGroupName string) {
 	}
 }
 
-func findFieldKeyForNode(f *ast.Field, node *Node, nodes map[string]Node, baseGroupName string) (key string) {
+func findFieldKeyForNode(fileset *token.FileSet, f *ast.Field, node *Node, nodes map[string]Node, baseGroupName string) (key string) {
 	fieldTypeStr := GetFieldType(f)
 	fieldType := strings.Split(fieldTypeStr, ".")
 	if len(fieldType) == 1 {
@@ -359,7 +421,8 @@ func findFieldKeyForNode(f *ast.Field, node *Node, nodes map[string]Node, baseGr
 		for _, importSpec := range node.Imports {
 			importPath, err := strconv.Unquote(importSpec.Path.Value)
 			if err != nil {
-				log.Fatalf("Failed to parse imports: %v", err)
+				diagnostics.Errorf(diagnostics.PositionFor(fileset, importSpec.Pos()), "Failed to parse imports: %v", err)
+				continue
 			}
 			importPathSplit := strings.Split(importPath, ".")
 			packageDir := importPathSplit[len(importPathSplit)-1]
@@ -380,7 +443,7 @@ func findFieldKeyForNode(f *ast.Field, node *Node, nodes map[string]Node, baseGr
 	return
 }
 
-func findNodeDefForField(f *ast.Field, baseNode *Node, allNodes map[string]Node) *Node {
+func findNodeDefForField(fileset *token.FileSet, f *ast.Field, baseNode *Node, allNodes map[string]Node) *Node {
 	fieldTypeStr := GetFieldType(f)
 	fieldType := strings.Split(fieldTypeStr, ".")
 	var importPathOfNode string
@@ -390,7 +453,7 @@ func findNodeDefForField(f *ast.Field, baseNode *Node, allNodes map[string]Node)
 		nodeName = fieldTypeStr
 	} else if len(fieldType) == 2 {
 		nodeName = fieldType[1]
-		importPathOfNode = findMatchingImport(nodeName, baseNode.Imports, allNodes)
+		importPathOfNode = findMatchingImport(fileset, nodeName, baseNode.Imports, allNodes)
 	}
 	for _, n := range allNodes {
 		if n.FullName == importPathOfNode && n.Name == nodeName {
@@ -400,11 +463,12 @@ func findNodeDefForField(f *ast.Field, baseNode *Node, allNodes map[string]Node)
 	return nil
 }
 
-func findMatchingImport(nodeName string, imports []*ast.ImportSpec, allNodes map[string]Node) string {
+func findMatchingImport(fileset *token.FileSet, nodeName string, imports []*ast.ImportSpec, allNodes map[string]Node) string {
 	for _, importSpec := range imports {
 		importPath, err := strconv.Unquote(importSpec.Path.Value)
 		if err != nil {
-			log.Fatalf("Failed to parse imports: %v", err)
+			diagnostics.Errorf(diagnostics.PositionFor(fileset, importSpec.Pos()), "Failed to parse imports: %v", err)
+			continue
 		}
 		importPathSplit := strings.Split(importPath, ".")
 		packageDir := importPathSplit[len(importPathSplit)-1]
@@ -421,10 +485,24 @@ func findMatchingImport(nodeName string, imports []*ast.ImportSpec, allNodes map
 	return ""
 }
 
-func checkIfReserved(name string) {
+func checkIfReserved(fileset *token.FileSet, typeSpec *ast.TypeSpec) {
 	for _, reservedName := range ReservedTypeNames {
-		if name == reservedName {
-			log.Fatalf("Name %s is reserved. Please change type name.", reservedName)
+		if typeSpec.Name.Name == reservedName {
+			diagnostics.Errorf(diagnostics.PositionFor(fileset, typeSpec.Name.Pos()),
+				"Name %s is reserved. Please change type name.", reservedName)
 		}
 	}
 }
+
+// PackagePosition returns the position of the package clause of the first file of a package.
+func PackagePosition(fileset *token.FileSet, pkg *ast.Package) diagnostics.Position {
+	var files []string
+	for name := range pkg.Files {
+		files = append(files, name)
+	}
+	if len(files) == 0 {
+		return diagnostics.NoPos
+	}
+	sort.Strings(files)
+	return diagnostics.PositionFor(fileset, pkg.Files[files[0]].Name.Pos())
+}
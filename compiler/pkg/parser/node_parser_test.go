@@ -1,7 +1,10 @@
 package parser_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"go/ast"
+	"os"
 	"sort"
 
 	. "github.com/onsi/ginkgo"
@@ -9,6 +12,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	generator "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/generator"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser/rest"
@@ -102,19 +106,53 @@ var _ = Describe("Node parser tests", func() {
 		Expect(fail).To(BeTrue())
 	})
 
-	It("should fail when nexus child is singleton node and is named", func() {
+	It("should report all errors with their positions", func() {
 		defer func() { log.StandardLogger().ExitFunc = nil }()
+		out := &bytes.Buffer{}
+		diagnostics.Default.SetOutput(diagnostics.JSONFormat, out)
+		defer diagnostics.Default.SetOutput(diagnostics.TextFormat, os.Stderr)
 
 		fail := false
 		log.StandardLogger().ExitFunc = func(int) {
 			fail = true
 		}
 
-		parser.ParseDSLNodes("../../example/test-utils/invalid-singleton-child", baseGroupName, nil, nil)
+		parser.ParseDSLNodes("../../example/test-utils/multiple-errors-datamodel", baseGroupName, nil, nil)
 		Expect(fail).To(BeTrue())
+
+		var report struct {
+			Diagnostics []diagnostics.Diagnostic `json:"diagnostics"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		Expect(report.Diagnostics).To(ConsistOf(
+			SatisfyAll(
+				HaveField("Severity", diagnostics.Error),
+				HaveField("Position.File", HaveSuffix("multiple-errors-datamodel/config/config.go")),
+				HaveField("Position.Line", 12),
+				HaveField("Message", ContainSubstring("Name Link is reserved")),
+			),
+			SatisfyAll(
+				HaveField("Severity", diagnostics.Error),
+				HaveField("Position.File", HaveSuffix("multiple-errors-datamodel/root.go")),
+				HaveField("Position.Line", 10),
+				HaveField("Message", ContainSubstring("can not be an array or a pointer or a map")),
+			),
+			SatisfyAll(
+				HaveField("Severity", diagnostics.Error),
+				HaveField("Position.File", HaveSuffix("multiple-errors-datamodel/root.go")),
+				HaveField("Position.Line", 11),
+				HaveField("Message", ContainSubstring("can not be an array or a pointer or a map")),
+			),
+			SatisfyAll(
+				HaveField("Severity", diagnostics.Error),
+				HaveField("Position.File", HaveSuffix("multiple-errors-datamodel/root.go")),
+				HaveField("Position.Line", 12),
+				HaveField("Message", ContainSubstring("Couldn't determine node of field [Missing] in node Root")),
+			),
+		))
 	})
 
-	It("should fail when nexus-rest-api-gen var name doesn't exist or when var name is wrong", func() {
+	It("should fail when nexus child is singleton node and is named", func() {
 		defer func() { log.StandardLogger().ExitFunc = nil }()
 
 		fail := false
@@ -122,6 +160,15 @@ var _ = Describe("Node parser tests", func() {
 			fail = true
 		}
 
+		parser.ParseDSLNodes("../../example/test-utils/invalid-singleton-child", baseGroupName, nil, nil)
+		Expect(fail).To(BeTrue())
+	})
+
+	It("should fail when nexus-rest-api-gen var name doesn't exist or when var name is wrong", func() {
+		out := &bytes.Buffer{}
+		diagnostics.Default.SetOutput(diagnostics.JSONFormat, out)
+		defer diagnostics.Default.SetOutput(diagnostics.TextFormat, os.Stderr)
+
 		pkgs := parser.ParseDSLPkg("../../example/test-utils/nexus-rest-api-gen-wrong-name")
 		pkg, ok := pkgs["github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/test-utils/nexus-rest-api-gen-wrong-name"]
 		Expect(ok).To(BeTrue())
@@ -129,9 +176,34 @@ var _ = Describe("Node parser tests", func() {
 		graph, _, _ := parser.ParseDSLNodes("../../example/test-utils/nexus-rest-api-gen-wrong-name", baseGroupName, pkgs, graphqlQueries)
 		parentsMap := parser.CreateParentsMap(graph)
 		methods, codes := rest.ParseResponses(pkgs)
-		_, err := generator.RenderCRDBaseTemplate(baseGroupName, pkg, parentsMap, methods, codes)
-		Expect(err).NotTo(HaveOccurred())
-		Expect(fail).To(BeTrue())
+		generator.CheckRestApiSpecs(baseGroupName, pkg, parentsMap, methods, codes)
+		Expect(diagnostics.Err()).To(HaveOccurred())
+
+		var report struct {
+			Diagnostics []diagnostics.Diagnostic `json:"diagnostics"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		Expect(report.Diagnostics).To(ConsistOf(SatisfyAll(
+			HaveField("Position.File", HaveSuffix("nexus-rest-api-gen-wrong-name/root.go")),
+			HaveField("Message", ContainSubstring("var LeaderRestAPI of nexus-rest-api-gen annotation of Leader is not present")),
+		)))
+	})
+
+	It("should add graph constraints of child and link fields to parents map", func() {
+		graph, _, _ := parser.ParseDSLNodes("../../example/test-utils/graph-constraints-datamodel", baseGroupName, nil, nil)
+		parentsMap := parser.CreateParentsMap(graph)
+
+		root := parentsMap["roots.root.tsm.tanzu.vmware.com"]
+		Expect(root.Children["projects.root.tsm.tanzu.vmware.com"].Max).To(Equal(10))
+
+		project := parentsMap["projects.root.tsm.tanzu.vmware.com"]
+		Expect(project.Children["teams.root.tsm.tanzu.vmware.com"].Max).To(BeZero())
+		Expect(project.Links["Reviewers"].Max).To(Equal(3))
+		Expect(project.Links["Reviewers"].Scope).To(Equal(parser.LinkScopeSubtree))
+
+		team := parentsMap["teams.root.tsm.tanzu.vmware.com"]
+		Expect(team.Links["Mentor"].Max).To(BeZero())
+		Expect(team.Links["Mentor"].Scope).To(Equal(parser.LinkScopeSibling))
 	})
 
 	It("should fail when used type name is reserved", func() {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/fatih/structtag"
 	log "github.com/sirupsen/logrus"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/util"
 )
 
@@ -34,6 +35,11 @@ type Package struct {
 	Pkg      ast.Package
 	GenDecls []ast.GenDecl
 	FileSet  *token.FileSet
+
+	// Version is the API version of the package nodes, see GetPackageVersion.
+	Version string
+	// NodeVersions maps node names of a hub package to the additional versions declared by version packages.
+	NodeVersions map[string][]string
 }
 
 type FieldAnnotation string
@@ -212,6 +218,37 @@ func (p *Package) IsVarPresent(varName string) bool {
 	return false
 }
 
+// GetTypeDoc returns the doc comment of the given type declared in the package.
+func (p *Package) GetTypeDoc(t *ast.TypeSpec) *ast.CommentGroup {
+	if t.Doc != nil {
+		return t.Doc
+	}
+	for _, genDecl := range p.GenDecls {
+		for _, spec := range genDecl.Specs {
+			if spec == ast.Spec(t) {
+				return genDecl.Doc
+			}
+		}
+	}
+	return nil
+}
+
+// GetVarPos returns the position of the given package level var or token.NoPos if it's not declared.
+func (p *Package) GetVarPos(varName string) token.Pos {
+	for _, genDecl := range p.GenDecls {
+		if genDecl.Tok == token.VAR {
+			for _, spec := range genDecl.Specs {
+				if valueSpec, ok := spec.(*ast.ValueSpec); ok {
+					if varName == valueSpec.Names[0].Name {
+						return valueSpec.Pos()
+					}
+				}
+			}
+		}
+	}
+	return token.NoPos
+}
+
 func IsNexusNode(n *ast.TypeSpec) bool {
 	if n == nil {
 		return false
@@ -479,18 +516,14 @@ func GetStatusField(n *ast.TypeSpec) *ast.Field {
 		return nil
 	}
 
-	var field *ast.Field
 	if val, ok := n.Type.(*ast.StructType); ok {
 		for _, f := range val.Fields.List {
 			if IsStatusField(f) && !IsNexusTypeField(f) {
-				if field != nil {
-					log.Fatalf("Only one field can be a nexus status field")
-				}
-				field = f
+				return f
 			}
 		}
 	}
-	return field
+	return nil
 }
 
 func IsStatusField(f *ast.Field) bool {
@@ -619,9 +652,8 @@ func FillEmptyTag(ts *structtag.Tags, n, tag string) *structtag.Tags {
 		Name:    util.GetTag(n),
 		Options: nil,
 	}
-	err := ts.Set(&jt)
-	if err != nil {
-		log.Fatalf("Failed to set tag: %v", err)
+	if err := ts.Set(&jt); err != nil {
+		log.Warnf("Failed to set tag %s of field %s: %v", tag, n, err)
 	}
 
 	return ts
@@ -686,17 +718,60 @@ func IsFieldPointer(f *ast.Field) bool {
 	return star
 }
 
+// ParseFieldTags returns the tags of a field. Invalid tags are reported by checkFieldTags when the DSL is parsed,
+// so no tags are returned for them here.
 func ParseFieldTags(tag string) *structtag.Tags {
+	tags, err := parseFieldTags(tag)
+	if err != nil {
+		return &structtag.Tags{}
+	}
+	return tags
+}
+
+func parseFieldTags(tag string) (*structtag.Tags, error) {
 	tagsStr, err := strconv.Unquote(tag)
 	if err != nil {
-		log.Fatalf("Failed to parse field tags: %v", err)
+		return nil, fmt.Errorf("failed to parse field tags: %v", err)
 	}
 	tags, err := structtag.Parse(tagsStr)
 	if err != nil {
-		log.Fatalf("Failed to parse field tags: %v, tag: %s", err, tag)
+		return nil, fmt.Errorf("failed to parse field tags: %v, tag: %s", err, tag)
 	}
+	return tags, nil
+}
 
-	return tags
+// checkFieldTags records errors of struct fields with tags which can't be parsed and of nexus nodes with more
+// than one status field.
+func checkFieldTags(packages Packages) {
+	for _, pkg := range packages {
+		for _, typeSpec := range pkg.GetStructs() {
+			ast.Inspect(typeSpec.Type, func(n ast.Node) bool {
+				f, ok := n.(*ast.Field)
+				if !ok || f.Tag == nil {
+					return true
+				}
+				if _, err := parseFieldTags(f.Tag.Value); err != nil {
+					diagnostics.Errorf(diagnostics.PositionFor(pkg.FileSet, f.Tag.Pos()), "Invalid tag of field in %s: %v", typeSpec.Name.Name, err)
+				}
+				return true
+			})
+
+			if !IsNexusNode(typeSpec) {
+				continue
+			}
+			statusFields := 0
+			for _, f := range typeSpec.Type.(*ast.StructType).Fields.List {
+				if !IsStatusField(f) || IsNexusTypeField(f) {
+					continue
+				}
+				statusFields++
+				if statusFields > 1 {
+					diagnostics.Errorf(diagnostics.PositionFor(pkg.FileSet, f.Pos()),
+						"Only one field can be a nexus status field, node %s", typeSpec.Name.Name)
+				}
+			}
+		}
+	}
 }
 
 func (p *Package) TypeSpecToString(t *ast.TypeSpec) (string, error) {
@@ -12,14 +12,33 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 )
 
 // ParseDSLPkg walks recursively through given path and looks for structs types definitions to add them to a Package map
+// Errors in the DSL stop the compilation.
 func ParseDSLPkg(startPath string) Packages {
+	packages, err := ParseDSLPackages(startPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return packages
+}
+
+// ParseDSLPackages is ParseDSLPkg which returns errors in the DSL together with the parsed packages, so the DSL can
+// be parsed again, e.g. in watch mode.
+func ParseDSLPackages(startPath string) (Packages, error) {
 	modulePath := GetModulePath(startPath)
+	if modulePath == "" {
+		return nil, diagnostics.Err()
+	}
 
 	packages := make(Packages)
 	err := filepath.Walk(startPath, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			diagnostics.Errorf(diagnostics.Position{File: path}, "Failed to read DSL: %v", err)
+			return nil
+		}
 		if info.IsDir() {
 			if info.Name() == "build" {
 				log.Infof("Ignoring build directory...")
@@ -40,7 +59,8 @@ func ParseDSLPkg(startPath string) Packages {
 			fileset := token.NewFileSet()
 			pkgs, err := parser.ParseDir(fileset, path, nil, parser.ParseComments)
 			if err != nil {
-				log.Fatalf("failed to parse directory %s: %v", path, err)
+				diagnostics.AddParseError(err)
+				return nil
 			}
 			for _, v := range pkgs {
 				if v.Name == "nexus" {
@@ -49,7 +69,8 @@ func ParseDSLPkg(startPath string) Packages {
 				}
 
 				if SpecialCharsPresent(v.Name) {
-					log.Fatalf("Invalid package-name <%v>, special characters are not allowed. Please use only lowercase alphanumeric characters.", v.Name)
+					diagnostics.Errorf(PackagePosition(fileset, v), "Invalid package-name <%v>, special characters are not allowed. Please use only lowercase alphanumeric characters.", v.Name)
+					continue
 				}
 				pkgImport := strings.TrimSuffix(strings.ReplaceAll(path, startPath, modulePath), "/")
 				pkg := Package{
@@ -58,6 +79,7 @@ func ParseDSLPkg(startPath string) Packages {
 					ModPath:  modulePath,
 					FileSet:  fileset,
 					Pkg:      *v,
+					Version:  GetPackageVersion(path, v.Name),
 				}
 				ParseGenDecls(v, &pkg)
 				packages[pkgImport] = pkg
@@ -66,10 +88,14 @@ func ParseDSLPkg(startPath string) Packages {
 		return nil
 	})
 	if err != nil {
-		log.Fatalf("Failed to parse DSL: %v", err)
+		diagnostics.Errorf(diagnostics.Position{File: startPath}, "Failed to read DSL: %v", err)
 	}
-
-	return packages
+	checkFieldTags(packages)
+	linkPackageVersions(packages)
+	checkEnums(packages)
+	checkUniqueConstraints(packages)
+	checkGraphConstraints(packages)
+	return packages, diagnostics.Err()
 }
 
 func ParseGenDecls(v *ast.Package, pkg *Package) {
@@ -1,9 +1,16 @@
 package parser_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"os"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
 )
 
@@ -26,4 +33,219 @@ var _ = Describe("Pkg parser tests", func() {
 		}
 		Expect(ignored_imported).To(BeFalse())
 	})
+	It("should detect additional versions of nodes", func() {
+		modPath := "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/test-utils/multi-version-datamodel"
+		pkgs := parser.ParseDSLPkg("../../example/test-utils/multi-version-datamodel")
+
+		hub, ok := pkgs[modPath+"/project"]
+		Expect(ok).To(BeTrue())
+		Expect(hub.IsHub()).To(BeTrue())
+		Expect(hub.Version).To(Equal(parser.DefaultVersion))
+		Expect(hub.GetVersions("Project")).To(Equal([]string{"v1", "v2"}))
+		Expect(hub.GetAllVersions()).To(Equal([]string{"v1", "v2"}))
+
+		v2, ok := pkgs[modPath+"/project/v2"]
+		Expect(ok).To(BeTrue())
+		Expect(v2.IsHub()).To(BeFalse())
+		Expect(v2.Version).To(Equal("v2"))
+		Expect(v2.HubImport()).To(Equal(hub.FullName))
+
+		root := pkgs[modPath]
+		Expect(root.GetVersions("Root")).To(Equal([]string{"v1"}))
+
+		hubs, versions := parser.SplitVersionPackages(pkgs)
+		Expect(hubs).To(HaveLen(2))
+		Expect(versions).To(HaveLen(1))
+	})
+	It("should detect enums", func() {
+		modPath := "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/test-utils/enum-datamodel"
+		pkgs := parser.ParseDSLPkg("../../example/test-utils/enum-datamodel")
+		Expect(diagnostics.Default.Diagnostics()).To(ConsistOf(SatisfyAll(
+			HaveField("Severity", diagnostics.Warning),
+			HaveField("Message", ContainSubstring("enum Protocol aren't valid GraphQL enum values")),
+		)))
+		diagnostics.Flush()
+
+		root := pkgs[modPath]
+		enums := root.GetEnums()
+		Expect(enums).To(HaveLen(2))
+		Expect(enums[0].Name).To(Equal("Color"))
+		Expect(enums[0].ValueStrings()).To(Equal([]string{"Red", "Green"}))
+		Expect(enums[0].IsGraphqlEnum()).To(BeTrue())
+		Expect(enums[1].Name).To(Equal("Protocol"))
+		Expect(enums[1].ValueStrings()).To(Equal([]string{"http/1.1", "h2"}))
+		Expect(enums[1].IsGraphqlEnum()).To(BeFalse())
+
+		common := pkgs[modPath+"/common"]
+		size, ok := common.GetEnum("Size")
+		Expect(ok).To(BeTrue())
+		Expect(size.ValueStrings()).To(Equal([]string{"Small", "Large"}))
+	})
+	It("should report invalid enums", func() {
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		out := &bytes.Buffer{}
+		diagnostics.Default.SetOutput(diagnostics.JSONFormat, out)
+		defer diagnostics.Default.SetOutput(diagnostics.TextFormat, os.Stderr)
+
+		fail := false
+		log.StandardLogger().ExitFunc = func(int) {
+			fail = true
+		}
+
+		parser.ParseDSLPkg("../../example/test-utils/invalid-enum-datamodel")
+		Expect(fail).To(BeTrue())
+
+		var report struct {
+			Diagnostics []diagnostics.Diagnostic `json:"diagnostics"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		Expect(report.Diagnostics).To(ConsistOf(
+			SatisfyAll(
+				HaveField("Position.Line", 8),
+				HaveField("Message", Equal("Enum Priority must be a named string type")),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 11),
+				HaveField("Message", Equal("Enum Status has no values. Please declare constants of type Status.")),
+			),
+		))
+	})
+	It("should detect unique constraints", func() {
+		modPath := "github.com/vmware-tanzu/graph-framework-for-microservices/compiler/example/test-utils/unique-datamodel"
+		pkgs := parser.ParseDSLPkg("../../example/test-utils/unique-datamodel")
+		root := pkgs[modPath]
+
+		constraints := make(map[string][]parser.UniqueConstraint)
+		for _, node := range root.GetNexusNodes() {
+			constraints[node.Name.Name] = parser.GetUniqueConstraints(root, node)
+		}
+		Expect(constraints["Root"]).To(BeEmpty())
+		Expect(constraints["Project"]).To(ConsistOf(SatisfyAll(
+			HaveField("Fields", Equal([]string{"Owner"})),
+			HaveField("JSONFields", Equal([]string{"owner"})),
+			HaveField("Scope", parser.UniqueScopeGlobal),
+		)))
+		Expect(constraints["Gns"]).To(HaveLen(2))
+		Expect(constraints["Gns"][0].Name()).To(Equal("DomainAndPort"))
+		Expect(constraints["Gns"][0].JSONFields).To(Equal([]string{"domain", "port"}))
+		Expect(constraints["Gns"][0].Scope).To(Equal(parser.UniqueScopeGlobal))
+		Expect(constraints["Gns"][1].Name()).To(Equal("Domain"))
+		Expect(constraints["Gns"][1].Scope).To(Equal(parser.UniqueScopeParent))
+	})
+	It("should report invalid unique constraints", func() {
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		out := &bytes.Buffer{}
+		diagnostics.Default.SetOutput(diagnostics.JSONFormat, out)
+		defer diagnostics.Default.SetOutput(diagnostics.TextFormat, os.Stderr)
+
+		fail := false
+		log.StandardLogger().ExitFunc = func(int) {
+			fail = true
+		}
+
+		parser.ParseDSLPkg("../../example/test-utils/invalid-unique-datamodel")
+		Expect(fail).To(BeTrue())
+
+		var report struct {
+			Diagnostics []diagnostics.Diagnostic `json:"diagnostics"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		Expect(report.Diagnostics).To(ConsistOf(
+			SatisfyAll(
+				HaveField("Position.Line", 7),
+				HaveField("Message", Equal("Unique field Missing is not a spec field of node Root")),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 8),
+				HaveField("Message", Equal(`Unsupported scope "everywhere" of unique constraint, expected one of: parent, global`)),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 8),
+				HaveField("Message", Equal("Unique constraint of node Root has no fields, please list them after 'nexus-unique:'")),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 12),
+				HaveField("Message", Equal("Unique field Labels of node Root must be of a basic type or a type declared in the same package")),
+			),
+		))
+	})
+	It("should report invalid field tags and graphql queries", func() {
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		out := &bytes.Buffer{}
+		diagnostics.Default.SetOutput(diagnostics.JSONFormat, out)
+		defer diagnostics.Default.SetOutput(diagnostics.TextFormat, os.Stderr)
+
+		fail := false
+		log.StandardLogger().ExitFunc = func(int) {
+			fail = true
+		}
+
+		pkgs := parser.ParseDSLPkg("../../example/test-utils/invalid-tags-datamodel")
+		Expect(fail).To(BeTrue())
+
+		var report struct {
+			Diagnostics []diagnostics.Diagnostic `json:"diagnostics"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		Expect(report.Diagnostics).To(ConsistOf(
+			SatisfyAll(
+				HaveField("Position.Line", 9),
+				HaveField("Message", ContainSubstring("Invalid tag of field in Root")),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 11),
+				HaveField("Message", Equal("Only one field can be a nexus status field, node Root")),
+			),
+		))
+
+		parser.ParseGraphqlQuerySpecs(pkgs)
+		Expect(diagnostics.Default.Diagnostics()).To(ConsistOf(
+			SatisfyAll(
+				HaveField("Position.Line", 23),
+				HaveField("Message", Equal("Name of graphql query must be a string literal")),
+			),
+		))
+		diagnostics.Flush()
+	})
+	It("should report invalid options of child and link fields", func() {
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		out := &bytes.Buffer{}
+		diagnostics.Default.SetOutput(diagnostics.JSONFormat, out)
+		defer diagnostics.Default.SetOutput(diagnostics.TextFormat, os.Stderr)
+
+		fail := false
+		log.StandardLogger().ExitFunc = func(int) {
+			fail = true
+		}
+
+		parser.ParseDSLPkg("../../example/test-utils/invalid-graph-constraints-datamodel")
+		Expect(fail).To(BeTrue())
+
+		var report struct {
+			Diagnostics []diagnostics.Diagnostic `json:"diagnostics"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		Expect(report.Diagnostics).To(ConsistOf(
+			SatisfyAll(
+				HaveField("Position.Line", 9),
+				HaveField("Message", Equal("Option max of field [Project] is only supported for children and links")),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 10),
+				HaveField("Message", Equal(`Invalid max "none" of field [Projects], expected a positive number`)),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 10),
+				HaveField("Message", Equal("Option scope of field [Projects] is only supported for link and links")),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 11),
+				HaveField("Message", Equal(`Unsupported scope "everywhere" of field [Lead], expected one of: sibling, subtree`)),
+			),
+			SatisfyAll(
+				HaveField("Position.Line", 11),
+				HaveField("Message", Equal(`Unknown option "min=1" of nexus tag of field [Lead], expected max or scope`)),
+			),
+		))
+	})
 })
@@ -178,7 +178,7 @@ var _ = Describe("Pkg tests", func() {
 		Expect(fieldType).To(Equal("service_group.SvcGroup"))
 	})
 
-	It("should fail if wrong struct tag is given", func() {
+	It("should return no tags and not fail if wrong struct tag is given", func() {
 		defer func() { log.StandardLogger().ExitFunc = nil }()
 
 		fail := false
@@ -186,8 +186,9 @@ var _ = Describe("Pkg tests", func() {
 			fail = true
 		}
 
-		parser.ParseFieldTags("`nexus: \"child\"`")
-		Expect(fail).To(BeTrue())
+		tags := parser.ParseFieldTags("`nexus: \"child\"`")
+		Expect(fail).To(BeFalse())
+		Expect(tags.Len()).To(Equal(0))
 	})
 
 	It("should receive false when empty node is given", func() {
@@ -7,12 +7,19 @@ import (
 	"strconv"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
 )
 
+// uris are URIs of all RestAPISpecs checked by CheckRestApiSpec, so duplicates of different nodes are found.
 var uris = map[string]string{}
 
+// ResetURIs forgets URIs checked by a previous compilation of the DSL.
+func ResetURIs() {
+	uris = map[string]string{}
+}
+
 func GetRestApiSpecs(p parser.Package, httpMethods map[string]nexus.HTTPMethodsResponses,
 	httpCodes map[string]nexus.HTTPCodesResponse, parentsMap map[string]parser.NodeHelper) map[string]nexus.RestAPISpec {
 
@@ -34,6 +41,40 @@ func GetRestApiSpecs(p parser.Package, httpMethods map[string]nexus.HTTPMethodsR
 	return apiSpecs
 }
 
+// ApiSpecPositions are positions of a RestAPISpec and of each of its URIs in the DSL.
+type ApiSpecPositions struct {
+	Spec diagnostics.Position
+	Uris []diagnostics.Position
+}
+
+// uri returns the position of the i-th URI of the spec or the position of the spec if it's not known.
+func (p ApiSpecPositions) uri(i int) diagnostics.Position {
+	if i < len(p.Uris) && p.Uris[i].IsValid() {
+		return p.Uris[i]
+	}
+	return p.Spec
+}
+
+// GetRestApiSpecPositions returns positions of RestAPISpecs of the package, keyed by the same names as
+// GetRestApiSpecs.
+func GetRestApiSpecPositions(p parser.Package) map[string]ApiSpecPositions {
+	positions := make(map[string]ApiSpecPositions)
+	for _, spec := range parser.GetNexusSpecs(p, "nexus.RestAPISpec") {
+		pos := ApiSpecPositions{Spec: diagnostics.PositionFor(p.FileSet, p.GetVarPos(spec.Name))}
+		for _, elt := range spec.Value.Elts {
+			uris := elt.(*ast.KeyValueExpr)
+
+			for _, uri := range uris.Value.(*ast.CompositeLit).Elts {
+				pos.Uris = append(pos.Uris, diagnostics.PositionFor(p.FileSet, uri.Pos()))
+			}
+		}
+
+		positions[spec.Name] = pos
+	}
+
+	return positions
+}
+
 func extractApiSpecRestURI(uri *ast.CompositeLit, httpMethods map[string]nexus.HTTPMethodsResponses, httpCodes map[string]nexus.HTTPCodesResponse) nexus.RestURIs {
 	restUri := nexus.RestURIs{}
 	for _, elt := range uri.Elts {
@@ -92,29 +133,38 @@ func extractApiSpecQueryParams(kv *ast.KeyValueExpr) []string {
 	return params
 }
 
-func ValidateRestApiSpec(apiSpec nexus.RestAPISpec, parentsMap map[string]parser.NodeHelper, crdName string) {
+// ValidateRestApiSpec validates the RestAPISpec of a node, declared at pos, and stops the compilation if it's invalid.
+func ValidateRestApiSpec(apiSpec nexus.RestAPISpec, parentsMap map[string]parser.NodeHelper, crdName string, pos ApiSpecPositions) {
+	CheckRestApiSpec(apiSpec, parentsMap, crdName, pos)
+	diagnostics.FailOnErrors()
+}
+
+// CheckRestApiSpec records every problem of the RestAPISpec of a node as a diagnostic at the position of the
+// offending URI.
+func CheckRestApiSpec(apiSpec nexus.RestAPISpec, parentsMap map[string]parser.NodeHelper, crdName string, positions ApiSpecPositions) {
 	r := regexp.MustCompile(`{([^{}]+)}`)
 	crdHelper := parentsMap[crdName]
 
-	for _, uri := range apiSpec.Uris {
+	for i, uri := range apiSpec.Uris {
+		pos := positions.uri(i)
 		uriRegex, _ := regexp.Compile("{.*?}")
 		redactedUri := uriRegex.ReplaceAllString(uri.Uri, "{param}")
 
 		if u, ok := uris[redactedUri]; ok {
-			log.Fatalf("RestApiSpec: Duplicate found: %s and %s", u, uri.Uri)
+			diagnostics.Errorf(pos, "RestApiSpec: Duplicate found: %s and %s", u, uri.Uri)
 		}
 
 		uriParams := r.FindAllStringSubmatch(uri.Uri, -1)
 		if _, ok := uri.Methods["LIST"]; ok {
 			if nodeExist(crdHelper.RestName, uriParams) || queryParamExist(crdHelper.RestName, uri.QueryParams) {
-				log.Fatalf("RestApiSpec: Provided node name (%s) cannot be applied as a param because endpoint is a list. URI: %s", crdHelper.RestName, uri.Uri)
+				diagnostics.Errorf(pos, "RestApiSpec: Provided node name (%s) cannot be applied as a param because endpoint is a list. URI: %s", crdHelper.RestName, uri.Uri)
 			}
 		}
 
 		// Check if node name is in both URI and Query param
 		// We are ignoring checking for node in URL because endpoint can be a list, and then we don't need this param
 		if nodeExist(crdHelper.RestName, uriParams) && queryParamExist(crdHelper.RestName, uri.QueryParams) {
-			log.Fatalf("RestApiSpec: Provided node name (%s) cannot be applied to both URI Param and Query Param. URI: %s", crdHelper.RestName, uri.Uri)
+			diagnostics.Errorf(pos, "RestApiSpec: Provided node name (%s) cannot be applied to both URI Param and Query Param. URI: %s", crdHelper.RestName, uri.Uri)
 		}
 
 		for _, parentCrd := range crdHelper.Parents {
@@ -126,11 +176,11 @@ func ValidateRestApiSpec(apiSpec nexus.RestAPISpec, parentsMap map[string]parser
 			}
 
 			if nodeExist(parentName, uriParams) && queryParamExist(parentName, uri.QueryParams) {
-				log.Fatalf("RestApiSpec: Provided node name (%s) cannot be applied to both URI Param and Query Param. URI: %s", parentName, uri.Uri)
+				diagnostics.Errorf(pos, "RestApiSpec: Provided node name (%s) cannot be applied to both URI Param and Query Param. URI: %s", parentName, uri.Uri)
 			}
 
 			if !nodeExist(parentName, uriParams) && !queryParamExist(parentName, uri.QueryParams) {
-				log.Fatalf("RestApiSpec: Provided node name (%s) not found for uri: %s", parentName, uri.Uri)
+				diagnostics.Errorf(pos, "RestApiSpec: Provided node name (%s) not found for uri: %s", parentName, uri.Uri)
 			}
 		}
 
@@ -17,8 +17,15 @@ var HttpMethodsResponsesMap = map[string]nexus.HTTPMethodsResponses{
 }
 
 func ParseResponses(pkgs parser.Packages) (map[string]nexus.HTTPMethodsResponses, map[string]nexus.HTTPCodesResponse) {
-	methods := HttpMethodsResponsesMap
-	codes := HttpCodesResponsesMap
+	// defaults are copied, so responses declared in the DSL don't leak to the next compilation
+	methods := make(map[string]nexus.HTTPMethodsResponses, len(HttpMethodsResponsesMap))
+	for name, responses := range HttpMethodsResponsesMap {
+		methods[name] = responses
+	}
+	codes := make(map[string]nexus.HTTPCodesResponse, len(HttpCodesResponsesMap))
+	for name, responses := range HttpCodesResponsesMap {
+		codes[name] = responses
+	}
 
 	// Iterate through packages to get all HTTP Codes
 	for _, pkg := range pkgs {
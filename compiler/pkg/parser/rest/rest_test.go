@@ -7,6 +7,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser/rest"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/nexus/nexus"
@@ -98,7 +99,7 @@ var _ = Describe("Rest tests", func() {
 				},
 			},
 		}
-		rest.ValidateRestApiSpec(restApiSpec, parentsMap, "dnses.gns.tsm.tanzu.vmware.com")
+		rest.ValidateRestApiSpec(restApiSpec, parentsMap, "dnses.gns.tsm.tanzu.vmware.com", rest.ApiSpecPositions{})
 		Expect(fail).To(BeFalse())
 	})
 
@@ -121,7 +122,7 @@ var _ = Describe("Rest tests", func() {
 				},
 			},
 		}
-		rest.ValidateRestApiSpec(restApiSpec, parentsMap, "dnses.gns.tsm.tanzu.vmware.com")
+		rest.ValidateRestApiSpec(restApiSpec, parentsMap, "dnses.gns.tsm.tanzu.vmware.com", rest.ApiSpecPositions{})
 		Expect(fail).To(BeTrue())
 	})
 
@@ -137,14 +138,31 @@ var _ = Describe("Rest tests", func() {
 		g, _, _ := parser.ParseDSLNodes("../../../example/test-utils/duplicated-uris-datamodel", baseGroupName, pkgs, nil)
 		parents := parser.CreateParentsMap(g)
 
+		rest.ResetURIs()
 		for _, p := range pkgs {
 			restAPISpecMap := rest.GetRestApiSpecs(p, methods, codes, parents)
+			positions := rest.GetRestApiSpecPositions(p)
 
-			for _, apiSpec := range restAPISpecMap {
-				rest.ValidateRestApiSpec(apiSpec, parents, "...")
+			for name, apiSpec := range restAPISpecMap {
+				rest.CheckRestApiSpec(apiSpec, parents, "...", positions[name])
 			}
 		}
 
+		Expect(diagnostics.Default.Diagnostics()).To(ConsistOf(SatisfyAll(
+			HaveField("Severity", diagnostics.Error),
+			HaveField("Message", ContainSubstring("Duplicate found")),
+			Or(
+				SatisfyAll(
+					HaveField("Position.File", HaveSuffix("duplicated-uris-datamodel/project/project.go")),
+					HaveField("Position.Line", 10),
+				),
+				SatisfyAll(
+					HaveField("Position.File", HaveSuffix("duplicated-uris-datamodel/config/config.go")),
+					HaveField("Position.Line", 9),
+				),
+			),
+		)))
+		diagnostics.FailOnErrors()
 		Expect(fail).To(BeTrue())
 	})
 })
@@ -0,0 +1,218 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/util"
+)
+
+// NexusUniqueAnnotation marks a spec field, or a tuple of spec fields when added above a node, whose values can't
+// repeat between objects of the node, e.g.
+//
+//	// nexus-unique: Domain, Port, scope=global
+const NexusUniqueAnnotation = "nexus-unique"
+
+// UniqueScope is the set of objects in which values of unique fields can't repeat.
+type UniqueScope string
+
+const (
+	// UniqueScopeParent allows objects of different parents to use the same values. Objects of nodes without
+	// a parent are checked globally.
+	UniqueScopeParent UniqueScope = "parent"
+	UniqueScopeGlobal UniqueScope = "global"
+)
+
+// UniqueConstraint is a unique field or tuple of fields of a node. It's rendered into the nexus annotation of CRD,
+// so the validation webhook can reject duplicates.
+type UniqueConstraint struct {
+	// Fields are go names of the spec fields.
+	Fields []string `json:"-"`
+	// JSONFields are names of the spec fields in the stored objects.
+	JSONFields []string    `json:"fields"`
+	Scope      UniqueScope `json:"scope"`
+	Pos        token.Pos   `json:"-"`
+}
+
+// Name returns the name of the constraint, which is used in names of generated lookups, e.g. DomainAndPort.
+func (c UniqueConstraint) Name() string {
+	return strings.Join(c.Fields, "And")
+}
+
+// GetUniqueConstraints returns unique constraints declared for the node. Invalid constraints are skipped, they are
+// reported by ParseDSLPkg.
+func GetUniqueConstraints(pkg Package, node *ast.TypeSpec) []UniqueConstraint {
+	return parseUniqueConstraints(pkg, node, func(token.Pos, string, ...interface{}) {})
+}
+
+func parseUniqueConstraints(pkg Package, node *ast.TypeSpec,
+	errorf func(pos token.Pos, format string, args ...interface{})) []UniqueConstraint {
+	var constraints []UniqueConstraint
+	add := func(c UniqueConstraint) {
+		for _, existing := range constraints {
+			if existing.Name() == c.Name() {
+				errorf(c.Pos, "Unique constraint %s of node %s is declared more than once", c.Name(), node.Name.Name)
+				return
+			}
+		}
+		constraints = append(constraints, c)
+	}
+
+	specFields := make(map[string]*ast.Field)
+	for _, f := range GetSpecFields(node) {
+		for _, name := range f.Names {
+			specFields[name.Name] = f
+		}
+	}
+
+	if doc := pkg.GetTypeDoc(node); doc != nil {
+		for _, c := range doc.List {
+			args, ok := getUniqueAnnotationArgs(c)
+			if !ok {
+				continue
+			}
+			constraint := UniqueConstraint{Scope: UniqueScopeParent, Pos: c.Pos()}
+			valid := true
+			for _, arg := range args {
+				if strings.HasPrefix(arg, "scope=") {
+					var ok bool
+					if constraint.Scope, ok = parseUniqueScope(arg, c.Pos(), errorf); !ok {
+						valid = false
+					}
+					continue
+				}
+				f, ok := specFields[arg]
+				if !ok {
+					errorf(c.Pos(), "Unique field %s is not a spec field of node %s", arg, node.Name.Name)
+					valid = false
+					continue
+				}
+				for _, existing := range constraint.Fields {
+					if existing == arg {
+						errorf(c.Pos(), "Unique field %s of node %s is repeated", arg, node.Name.Name)
+						valid = false
+					}
+				}
+				if !checkUniqueFieldType(f, arg, node, errorf) {
+					valid = false
+				}
+				constraint.Fields = append(constraint.Fields, arg)
+				constraint.JSONFields = append(constraint.JSONFields, getUniqueFieldJSONName(f, arg))
+			}
+			if len(constraint.Fields) == 0 {
+				errorf(c.Pos(), "Unique constraint of node %s has no fields, please list them after '%s:'",
+					node.Name.Name, NexusUniqueAnnotation)
+				valid = false
+			}
+			if valid {
+				add(constraint)
+			}
+		}
+	}
+
+	for _, f := range GetSpecFields(node) {
+		if f.Doc == nil || len(f.Names) == 0 {
+			continue
+		}
+		name := f.Names[0].Name
+		for _, c := range f.Doc.List {
+			args, ok := getUniqueAnnotationArgs(c)
+			if !ok {
+				continue
+			}
+			constraint := UniqueConstraint{
+				Fields:     []string{name},
+				JSONFields: []string{getUniqueFieldJSONName(f, name)},
+				Scope:      UniqueScopeParent,
+				Pos:        c.Pos(),
+			}
+			valid := checkUniqueFieldType(f, name, node, errorf)
+			for _, arg := range args {
+				if !strings.HasPrefix(arg, "scope=") {
+					errorf(c.Pos(), "Unexpected argument %q of %s annotation of field %s, only scope can be given above a field",
+						arg, NexusUniqueAnnotation, name)
+					valid = false
+					continue
+				}
+				var ok bool
+				if constraint.Scope, ok = parseUniqueScope(arg, c.Pos(), errorf); !ok {
+					valid = false
+				}
+			}
+			if valid {
+				add(constraint)
+			}
+		}
+	}
+	return constraints
+}
+
+// getUniqueAnnotationArgs returns comma separated arguments of the annotation, if the comment is a unique annotation.
+func getUniqueAnnotationArgs(c *ast.Comment) ([]string, bool) {
+	text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+	if !strings.HasPrefix(text, NexusUniqueAnnotation) {
+		return nil, false
+	}
+	text = strings.TrimPrefix(text, NexusUniqueAnnotation)
+	if text == "" {
+		return nil, true
+	}
+	if !strings.HasPrefix(text, ":") {
+		return nil, false
+	}
+	var args []string
+	for _, arg := range strings.Split(strings.TrimPrefix(text, ":"), ",") {
+		if arg = strings.TrimSpace(arg); arg != "" {
+			args = append(args, arg)
+		}
+	}
+	return args, true
+}
+
+func parseUniqueScope(arg string, pos token.Pos, errorf func(pos token.Pos, format string, args ...interface{})) (UniqueScope, bool) {
+	scope := UniqueScope(strings.TrimSpace(strings.TrimPrefix(arg, "scope=")))
+	if scope != UniqueScopeParent && scope != UniqueScopeGlobal {
+		errorf(pos, "Unsupported scope %q of unique constraint, expected one of: %s, %s", scope, UniqueScopeParent, UniqueScopeGlobal)
+		return UniqueScopeParent, false
+	}
+	return scope, true
+}
+
+// checkUniqueFieldType allows fields of basic types and of types declared in the package of the node (e.g. enums),
+// so they can be used as arguments of generated lookups.
+func checkUniqueFieldType(f *ast.Field, name string, node *ast.TypeSpec,
+	errorf func(pos token.Pos, format string, args ...interface{})) bool {
+	if _, ok := f.Type.(*ast.Ident); !ok {
+		errorf(f.Pos(), "Unique field %s of node %s must be of a basic type or a type declared in the same package",
+			name, node.Name.Name)
+		return false
+	}
+	return true
+}
+
+func getUniqueFieldJSONName(f *ast.Field, name string) string {
+	if tag := GetFieldNameJsonTag(f); tag != "" {
+		return tag
+	}
+	return util.GetTag(name)
+}
+
+// IsBasicType returns true if the type name is a predeclared go type, e.g. string.
+func IsBasicType(typeName string) bool {
+	_, ok := types.Universe.Lookup(typeName).(*types.TypeName)
+	return ok
+}
+
+// checkUniqueConstraints records errors of unique constraints which can't be rendered.
+func checkUniqueConstraints(packages Packages) {
+	for _, pkg := range packages {
+		for _, node := range pkg.GetNexusNodes() {
+			parseUniqueConstraints(pkg, node, func(pos token.Pos, format string, args ...interface{}) {
+				diagnostics.Errorf(diagnostics.PositionFor(pkg.FileSet, pos), format, args...)
+			})
+		}
+	}
+}
@@ -9,12 +9,16 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/mod/modfile"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 )
 
 func GetModulePath(startPath string) string {
-	file, err := os.ReadFile(path.Join(startPath, "go.mod"))
+	modFile := path.Join(startPath, "go.mod")
+	file, err := os.ReadFile(modFile)
 	if err != nil {
-		log.Fatalf("failed to get module path %v", err)
+		diagnostics.Errorf(diagnostics.Position{File: modFile}, "Failed to get module path: %v", err)
+		return ""
 	}
 	return modfile.ModulePath(file)
 }
@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
+)
+
+// DefaultVersion is the API version of nodes declared in a regular DSL package. It's the hub and storage version
+// of multi-version nodes.
+const DefaultVersion = "v1"
+
+var versionDirRegex = regexp.MustCompile(`^v[1-9][0-9]*((alpha|beta)[1-9][0-9]*)?$`)
+
+// GetPackageVersion returns the API version declared by the DSL package `pkgName` placed in `dir`.
+// A package in a directory named like a Kubernetes API version (e.g. `v2` or `v1beta1`) which has the same package
+// name as the package in the parent directory declares an additional version of the parent package nodes.
+// All other packages are declared in DefaultVersion.
+func GetPackageVersion(dir, pkgName string) string {
+	version := filepath.Base(dir)
+	if version == DefaultVersion || !versionDirRegex.MatchString(version) {
+		return DefaultVersion
+	}
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), filepath.Dir(dir), nil, parser.PackageClauseOnly)
+	if err != nil {
+		diagnostics.AddParseError(err)
+		return DefaultVersion
+	}
+	if _, ok := pkgs[pkgName]; ok {
+		return version
+	}
+	return DefaultVersion
+}
+
+// IsHub returns true if the package declares nodes in DefaultVersion.
+func (p *Package) IsHub() bool {
+	return p.Version == "" || p.Version == DefaultVersion
+}
+
+// HubImport returns the import path of the package which declares the hub version of a version package nodes.
+func (p *Package) HubImport() string {
+	return filepath.Dir(p.FullName)
+}
+
+// GetVersions returns all API versions served for the given node of a hub package, hub version first.
+func (p *Package) GetVersions(nodeName string) []string {
+	return append([]string{DefaultVersion}, p.NodeVersions[nodeName]...)
+}
+
+// linkPackageVersions records versions declared by version packages in NodeVersions of their hub packages.
+func linkPackageVersions(packages Packages) {
+	for _, pkg := range packages {
+		if pkg.IsHub() {
+			continue
+		}
+		hub, ok := packages[pkg.HubImport()]
+		if !ok {
+			diagnostics.Errorf(PackagePosition(pkg.FileSet, &pkg.Pkg),
+				"Failed to find package %s declaring hub version of package %s", pkg.HubImport(), pkg.FullName)
+			continue
+		}
+		if hub.NodeVersions == nil {
+			hub.NodeVersions = make(map[string][]string)
+		}
+		hubNodes := make(map[string]bool)
+		for _, node := range hub.GetNexusNodes() {
+			hubNodes[node.Name.Name] = true
+		}
+		for _, node := range pkg.GetNexusNodes() {
+			if !hubNodes[node.Name.Name] {
+				diagnostics.Errorf(diagnostics.PositionFor(pkg.FileSet, node.Pos()),
+					"Node %s declared in version %s of package %s is missing in version %s. "+
+						"Please declare the node in package %s first.", node.Name.Name, pkg.Version, pkg.Name,
+					DefaultVersion, hub.FullName)
+				continue
+			}
+			hub.NodeVersions[node.Name.Name] = append(hub.NodeVersions[node.Name.Name], pkg.Version)
+			sort.Strings(hub.NodeVersions[node.Name.Name])
+		}
+		packages[hub.FullName] = hub
+	}
+}
+
+// GetAllVersions returns all API versions served for nodes of a hub package, hub version first.
+func (p *Package) GetAllVersions() []string {
+	var versions []string
+	seen := make(map[string]bool)
+	for _, nodeVersions := range p.NodeVersions {
+		for _, v := range nodeVersions {
+			if !seen[v] {
+				seen[v] = true
+				versions = append(versions, v)
+			}
+		}
+	}
+	sort.Strings(versions)
+	return append([]string{DefaultVersion}, versions...)
+}
+
+// SplitVersionPackages splits packages into hub packages and packages declaring additional versions of nodes.
+func SplitVersionPackages(packages Packages) (hubs Packages, versions Packages) {
+	hubs = make(Packages)
+	versions = make(Packages)
+	for k, pkg := range packages {
+		if pkg.IsHub() {
+			hubs[k] = pkg
+		} else {
+			versions[k] = pkg
+		}
+	}
+	return hubs, versions
+}
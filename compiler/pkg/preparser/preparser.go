@@ -16,6 +16,7 @@ import (
 	"text/template"
 
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/config"
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/diagnostics"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/parser"
@@ -24,10 +25,28 @@ import (
 
 var pkgImportToPkg = make(map[string]string, 0)
 
+// Parse parses DSL packages grouped by their names, errors in the DSL stop the compilation.
 func Parse(startPath string) map[string][]*parser.Package {
+	packages, err := ParsePackages(startPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return packages
+}
+
+// ParsePackages is Parse which returns errors in the DSL together with the parsed packages, so the DSL can be parsed
+// again, e.g. in watch mode.
+func ParsePackages(startPath string) (map[string][]*parser.Package, error) {
 	packages := map[string][]*parser.Package{}
 	modulePath := parser.GetModulePath(startPath)
+	if modulePath == "" {
+		return nil, diagnostics.Err()
+	}
 	err := filepath.Walk(startPath, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			diagnostics.Errorf(diagnostics.Position{File: path}, "Failed to read DSL: %v", err)
+			return nil
+		}
 		if info.IsDir() {
 			if info.Name() == "build" {
 				log.Infof("Ignoring build directory...")
@@ -46,7 +65,8 @@ func Parse(startPath string) map[string][]*parser.Package {
 			fileset := token.NewFileSet()
 			pkgs, err := goParser.ParseDir(fileset, path, nil, goParser.ParseComments)
 			if err != nil {
-				log.Fatalf("failed to parse directory %s: %v", path, err)
+				diagnostics.AddParseError(err)
+				return nil
 			}
 			pkgImport := strings.TrimSuffix(strings.ReplaceAll(path, startPath, modulePath), "/")
 			for _, v := range pkgs {
@@ -56,7 +76,8 @@ func Parse(startPath string) map[string][]*parser.Package {
 				}
 
 				if parser.SpecialCharsPresent(v.Name) {
-					log.Fatalf("Invalid package-name <%v>, special characters are not allowed. Please use only lowercase alphanumeric characters.", v.Name)
+					diagnostics.Errorf(parser.PackagePosition(fileset, v), "Invalid package-name <%v>, special characters are not allowed. Please use only lowercase alphanumeric characters.", v.Name)
+					continue
 				}
 				pkgImportToPkg[pkgImport] = v.Name
 
@@ -67,20 +88,26 @@ func Parse(startPath string) map[string][]*parser.Package {
 					FileSet:  fileset,
 					Dir:      info.Name(),
 					Pkg:      *v,
+					Version:  parser.GetPackageVersion(path, v.Name),
 				}
 				parser.ParseGenDecls(v, &pkg)
-				packages[pkg.Name] = append(packages[pkg.Name], &pkg)
+				key := pkg.Name
+				if !pkg.IsHub() {
+					// version packages share the name of their hub package, they must not be merged with it
+					pkg.Dir = filepath.Join(filepath.Base(filepath.Dir(path)), pkg.Version)
+					key = filepath.Join(pkg.Name, pkg.Version)
+				}
+				packages[key] = append(packages[key], &pkg)
 			}
 		}
 		return nil
 	})
 	if err != nil {
-		log.Fatalf("Failed to parse DSL: %v", err)
+		diagnostics.Errorf(diagnostics.Position{File: startPath}, "Failed to read DSL: %v", err)
 	}
 
 	detectDuplicates(packages)
-
-	return packages
+	return packages, diagnostics.Err()
 }
 
 func detectDuplicates(packages map[string][]*parser.Package) {
@@ -95,7 +122,8 @@ func detectDuplicates(packages map[string][]*parser.Package) {
 				for _, node := range nodes {
 					if node.Name.String() == fmt.Sprintf("%sSpec", nexusNode.Name) ||
 						node.Name.String() == fmt.Sprintf("%sList", nexusNode.Name) {
-						log.Fatalf(`Duplicated type (%s) found in package %s ("%s" is already used by node "%s")`, node.Name, pkg.Name, node.Name, nexusNode.Name)
+						diagnostics.Errorf(diagnostics.PositionFor(pkg.FileSet, node.Pos()),
+							`Duplicated type (%s) found in package %s ("%s" is already used by node "%s")`, node.Name, pkg.Name, node.Name, nexusNode.Name)
 					}
 				}
 			}
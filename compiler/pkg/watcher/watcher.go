@@ -0,0 +1,133 @@
+package watcher
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultDebounce is how long the watcher waits for more changes before calling the callback, editors and
+// `git checkout` usually write many files at once.
+const DefaultDebounce = 200 * time.Millisecond
+
+// Watcher calls a callback when DSL files of a directory tree change. Directories created later are watched too.
+type Watcher struct {
+	// Dir is the root of the DSL.
+	Dir string
+	// IgnoredDirs are names of directories which are not watched, e.g. the output of the compiler.
+	IgnoredDirs []string
+	Debounce    time.Duration
+}
+
+// New returns a watcher of the DSL directory. Directories ignored by the parser (build, vendor and ignoredDirs) and
+// hidden directories are not watched.
+func New(dir string, ignoredDirs []string) *Watcher {
+	return &Watcher{
+		Dir:         dir,
+		IgnoredDirs: append([]string{"build", "vendor"}, ignoredDirs...),
+		Debounce:    DefaultDebounce,
+	}
+}
+
+// Run calls onChange after DSL files were changed until the context is cancelled. Changes made while onChange
+// runs are collected and reported by the next call.
+func (w *Watcher) Run(ctx context.Context, onChange func(changed []string)) error {
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer fsWatcher.Close()
+
+	if err = w.addDirs(fsWatcher, w.Dir); err != nil {
+		return err
+	}
+
+	var (
+		timer   <-chan time.Time
+		changed = make(map[string]bool)
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-fsWatcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err = w.addDirs(fsWatcher, event.Name); err != nil {
+						log.Warnf("Failed to watch directory %s: %v", event.Name, err)
+					}
+					continue
+				}
+			}
+			if !IsDSLFile(event.Name) || event.Op == fsnotify.Chmod {
+				continue
+			}
+			log.Debugf("DSL file changed: %s", event)
+			changed[event.Name] = true
+			timer = time.After(w.Debounce)
+		case err, ok := <-fsWatcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Warnf("Watching DSL failed: %v", err)
+		case <-timer:
+			timer = nil
+			files := make([]string, 0, len(changed))
+			for f := range changed {
+				files = append(files, f)
+			}
+			changed = make(map[string]bool)
+			onChange(files)
+		}
+	}
+}
+
+func (w *Watcher) addDirs(fsWatcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != w.Dir && w.isIgnored(d.Name()) {
+			return filepath.SkipDir
+		}
+		log.Debugf("Watching directory %s", path)
+		return fsWatcher.Add(path)
+	})
+}
+
+func (w *Watcher) isIgnored(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return true
+	}
+	for _, ignored := range w.IgnoredDirs {
+		if name == ignored {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDSLFile returns true for files read by the compiler: go sources, go.mod and GraphQL schemas.
+func IsDSLFile(path string) bool {
+	name := filepath.Base(path)
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	switch filepath.Ext(name) {
+	case ".go", ".graphql", ".graphqls":
+		return true
+	}
+	return name == "go.mod"
+}
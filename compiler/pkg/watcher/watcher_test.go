@@ -0,0 +1,83 @@
+package watcher_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/graph-framework-for-microservices/compiler/pkg/watcher"
+)
+
+var _ = Describe("Watcher tests", func() {
+	var (
+		dir     string
+		cancel  context.CancelFunc
+		changes chan []string
+		done    chan error
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "watcher")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.MkdirAll(filepath.Join(dir, "build"), os.ModePerm)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(dir, "output"), os.ModePerm)).To(Succeed())
+
+		w := watcher.New(dir, []string{"output"})
+		w.Debounce = 20 * time.Millisecond
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		changes = make(chan []string, 10)
+		done = make(chan error, 1)
+		go func() {
+			done <- w.Run(ctx, func(changed []string) {
+				changes <- changed
+			})
+		}()
+		// give the watcher time to add directories
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+		os.RemoveAll(dir)
+	})
+
+	It("should report changed DSL files once", func() {
+		path := filepath.Join(dir, "root.go")
+		Expect(os.WriteFile(path, []byte("package root\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(path, []byte("package root\n\n"), 0644)).To(Succeed())
+		Eventually(changes).Should(Receive(Equal([]string{path})))
+		Consistently(changes, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("should watch new directories", func() {
+		pkgDir := filepath.Join(dir, "config")
+		Expect(os.Mkdir(pkgDir, os.ModePerm)).To(Succeed())
+		time.Sleep(50 * time.Millisecond)
+		path := filepath.Join(pkgDir, "config.go")
+		Expect(os.WriteFile(path, []byte("package config\n"), 0644)).To(Succeed())
+		Eventually(changes).Should(Receive(Equal([]string{path})))
+	})
+
+	It("should ignore other files and ignored directories", func() {
+		Expect(os.WriteFile(filepath.Join(dir, ".root.go.swp"), []byte("swap"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "build", "types.go"), []byte("package build\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "output", "types.go"), []byte("package output\n"), 0644)).To(Succeed())
+		Consistently(changes, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("should recognize DSL files", func() {
+		Expect(watcher.IsDSLFile("datamodel/root.go")).To(BeTrue())
+		Expect(watcher.IsDSLFile("datamodel/go.mod")).To(BeTrue())
+		Expect(watcher.IsDSLFile("datamodel/global/schema.graphql")).To(BeTrue())
+		Expect(watcher.IsDSLFile("datamodel/go.sum")).To(BeFalse())
+		Expect(watcher.IsDSLFile("datamodel/.root.go")).To(BeFalse())
+	})
+})
@@ -121,19 +121,15 @@ func (m *Plugin) generatePerSchema(data *codegen.Data) error {
 			comment := strings.TrimSpace(strings.TrimLeft(rewriter.GetMethodComment(structName, f.GoFieldName), `\`))
 			if implementation == "" {
 				args := ""
-				if len(f.Arguments) > 0 {
-					for _, a := range f.Arguments {
-						if args != "" {
-							args += fmt.Sprintf(", %s", templates.ToGoPrivate(a.Name))
-						} else {
-							args += fmt.Sprintf("%s", templates.ToGoPrivate(a.Name))
-						}
-					}
+				for _, a := range f.Arguments {
+					args += fmt.Sprintf(", %s", templates.ToGoPrivate(a.Name))
 				}
-				if templates.ToGo(o.Name) == "Query" {
-					implementation = fmt.Sprintf("return get%sResolver(%s)", templates.ToGo(f.Name), args)
+				// resolvers are bound to the context of the request, which carries the per-request loader
+				// of nexus objects or the websocket connection of a subscription
+				if templates.ToGo(o.Name) == "Query" || templates.ToGo(o.Name) == "Mutation" || templates.ToGo(o.Name) == "Subscription" {
+					implementation = fmt.Sprintf("return get%sResolver(ctx%s)", templates.ToGo(f.Name), args)
 				} else {
-					implementation = fmt.Sprintf("return get%s%sResolver(%s,%s)", templates.ToGo(o.Name), f.Name, "obj", args)
+					implementation = fmt.Sprintf("return get%s%sResolver(ctx, obj%s)", templates.ToGo(o.Name), f.Name, args)
 				}
 			}
 			if comment == "" {
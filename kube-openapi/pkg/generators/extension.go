@@ -70,6 +70,18 @@ var tagToExtension = map[string]extensionAttributes{
 		xName: "x-kubernetes-validations",
 		kind:  types.Slice,
 	},
+	// Nexus DSL markers, rendered into CRD schemas by the nexus compiler.
+	"nexus:default": {
+		xName: "x-nexus-default",
+	},
+	"nexus:immutable": {
+		xName:         "x-nexus-immutable",
+		allowedValues: sets.NewString("true"),
+	},
+	"nexus:validation-rule": {
+		xName:        "x-nexus-validation-rules",
+		enforceArray: true,
+	},
 }
 
 // Extension encapsulates information necessary to generate an OpenAPI extension.
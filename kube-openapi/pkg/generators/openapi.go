@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	openapi "github.com/vmware-tanzu/graph-framework-for-microservices/kube-openapi/pkg/common"
@@ -521,7 +522,7 @@ func (g openAPITypeWriter) emitExtensions(extensions []extension, unions []union
 			g.Do("[]interface{}{\n", nil)
 		}
 		for _, value := range extension.values {
-			g.Do("\"$.$\",\n", value)
+			g.Do("$.$,\n", strconv.Quote(value))
 		}
 		if extension.hasMultipleValues() || extension.isAlwaysArrayFormat() {
 			g.Do("},\n", nil)
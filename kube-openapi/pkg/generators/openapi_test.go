@@ -1488,6 +1488,81 @@ Extensions: spec.Extensions{
 `, funcBuffer.String())
 }
 
+func TestNexusMarkers(t *testing.T) {
+	callErr, funcErr, assert, callBuffer, funcBuffer := testOpenAPITypeWriter(t, `
+package foo
+
+// Blah is a test.
+// +k8s:openapi-gen=true
+// +nexus:validation-rule=self.min <= self.max
+type Blah struct {
+	// +nexus:default=8080
+	// +nexus:immutable=true
+	Port int
+
+	// +nexus:validation-rule=self != ""
+	// +nexus:validation-rule=self.size() < 10
+	Name string
+}
+		`)
+	if callErr != nil {
+		t.Fatal(callErr)
+	}
+	if funcErr != nil {
+		t.Fatal(funcErr)
+	}
+	assert.Equal(`"base/foo.Blah": schema_base_foo_Blah(ref),
+`, callBuffer.String())
+	assert.Equal(`func schema_base_foo_Blah(ref common.ReferenceCallback) common.OpenAPIDefinition {
+return common.OpenAPIDefinition{
+Schema: spec.Schema{
+SchemaProps: spec.SchemaProps{
+Description: "Blah is a test.",
+Type: []string{"object"},
+Properties: map[string]spec.Schema{
+"Port": {
+VendorExtensible: spec.VendorExtensible{
+Extensions: spec.Extensions{
+"x-nexus-default": "8080",
+"x-nexus-immutable": "true",
+},
+},
+SchemaProps: spec.SchemaProps{
+Type: []string{"integer"},
+Format: "int32",
+},
+},
+"Name": {
+VendorExtensible: spec.VendorExtensible{
+Extensions: spec.Extensions{
+"x-nexus-validation-rules": []interface{}{
+"self != \"\"",
+"self.size() < 10",
+},
+},
+},
+SchemaProps: spec.SchemaProps{
+Type: []string{"string"},
+Format: "",
+},
+},
+},
+Required: []string{"Port","Name"},
+},
+VendorExtensible: spec.VendorExtensible{
+Extensions: spec.Extensions{
+"x-nexus-validation-rules": []interface{}{
+"self.min <= self.max",
+},
+},
+},
+},
+}
+}
+
+`, funcBuffer.String())
+}
+
 func TestUnion(t *testing.T) {
 	callErr, funcErr, assert, callBuffer, funcBuffer := testOpenAPITypeWriter(t, `
 package foo
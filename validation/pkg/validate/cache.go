@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+const cacheSyncTimeout = 30 * time.Second
+
+// Objects keeps informers of nexus objects, so the webhook doesn't list all objects of a CRD on every admission.
+var Objects = &objectCache{}
+
+type objectCache struct {
+	mu        sync.Mutex
+	client    dynamic.Interface
+	factory   dynamicinformer.DynamicSharedInformerFactory
+	stopCh    chan struct{}
+	informers map[schema.GroupVersionResource]cache.SharedIndexInformer
+}
+
+// List returns objects of the given resource from the informer cache. The informer of the resource is started and
+// synced on the first call. Informers are recreated when a different client is given.
+func (c *objectCache) List(client dynamic.Interface, gvr schema.GroupVersionResource) ([]*unstructured.Unstructured, error) {
+	informer, err := c.informer(client, gvr)
+	if err != nil {
+		return nil, err
+	}
+
+	items := informer.GetStore().List()
+	objs := make([]*unstructured.Unstructured, 0, len(items))
+	for _, item := range items {
+		if obj, ok := item.(*unstructured.Unstructured); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+func (c *objectCache) informer(client dynamic.Interface, gvr schema.GroupVersionResource) (cache.SharedIndexInformer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != client || c.factory == nil {
+		if c.stopCh != nil {
+			close(c.stopCh)
+		}
+		c.client = client
+		c.factory = dynamicinformer.NewDynamicSharedInformerFactory(client, 0)
+		c.stopCh = make(chan struct{})
+		c.informers = make(map[schema.GroupVersionResource]cache.SharedIndexInformer)
+	}
+
+	if informer, ok := c.informers[gvr]; ok {
+		return informer, nil
+	}
+
+	informer := c.factory.ForResource(gvr).Informer()
+	c.factory.Start(c.stopCh)
+	ctx, cancel := context.WithTimeout(context.Background(), cacheSyncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return nil, fmt.Errorf("timed out waiting for cache of %s to sync", gvr.String())
+	}
+	c.informers[gvr] = informer
+	return informer, nil
+}
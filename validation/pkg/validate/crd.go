@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -11,6 +12,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -124,13 +126,10 @@ func Crd(client dynamic.Interface, r admissionv1.AdmissionReview) (*admissionv1.
 		return admRes, nil
 	}
 
+	// parents are ordered from the root, so the last one found is the nearest parent
+	var parentObj *unstructured.Unstructured
 	for _, parent := range parents {
-		parts := strings.Split(parent, ".")
-		gvr := schema.GroupVersionResource{
-			Group:    strings.Join(parts[1:], "."),
-			Version:  "v1",
-			Resource: parts[0],
-		}
+		gvr := getGvr(parent)
 		parentParents, err := CRDs.GetParents(parent, client)
 		if err != nil {
 			message := fmt.Sprintf("Couldn't determine parent info %s for CRD %s, please make sure CRD definition is applied", parent, crdName)
@@ -154,7 +153,7 @@ func Crd(client dynamic.Interface, r admissionv1.AdmissionReview) (*admissionv1.
 			} else {
 				name = label
 			}
-			if getCrdObject(client, gvr, name) == nil {
+			if parentObj = getCrdObject(client, gvr, name); parentObj == nil {
 				message := fmt.Sprintf("required parent %s with display name %s not found", parent, displayName)
 				setResponseToNotAllowed(admRes, message)
 				return admRes, nil
@@ -167,7 +166,7 @@ func Crd(client dynamic.Interface, r admissionv1.AdmissionReview) (*admissionv1.
 			}
 
 			log.Warnf("label %s not found", parent)
-			if getCrdObject(client, gvr, name) == nil {
+			if parentObj = getCrdObject(client, gvr, name); parentObj == nil {
 				message := fmt.Sprintf("required parent %s with name default not found", parent)
 				setResponseToNotAllowed(admRes, message)
 				return admRes, nil
@@ -175,10 +174,280 @@ func Crd(client dynamic.Interface, r admissionv1.AdmissionReview) (*admissionv1.
 		}
 	}
 
+	if message := checkUniqueFields(client, crdName, parents, raw); message != "" {
+		setResponseToNotAllowed(admRes, message)
+		return admRes, nil
+	}
+
+	if message := checkChildrenCount(crdName, parents, parentObj, raw); message != "" {
+		setResponseToNotAllowed(admRes, message)
+		return admRes, nil
+	}
+
+	if message := checkChildrenFields(crdName, raw, r.Request.OldObject.Raw); message != "" {
+		setResponseToNotAllowed(admRes, message)
+		return admRes, nil
+	}
+
+	if message := checkLinks(client, crdName, parents, raw); message != "" {
+		setResponseToNotAllowed(admRes, message)
+		return admRes, nil
+	}
+
 	return admRes, nil
 }
 
-func getCrdObject(client dynamic.Interface, gvr schema.GroupVersionResource, name string) interface{} {
+// checkUniqueFields returns a message if other object of the CRD has the same values of unique fields.
+// Objects have the same parent if labels of all parents are the same. Constraints with an unset field aren't
+// checked, so objects which don't set an optional unique field aren't duplicates of each other.
+func checkUniqueFields(client dynamic.Interface, crdName string, parents []string, raw []byte) string {
+	constraints := CRDs.GetUniqueConstraints(crdName)
+	if len(constraints) == 0 {
+		return ""
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(raw); err != nil {
+		return fmt.Sprintf("could not unmarshal object: %v", err)
+	}
+
+	var items []*unstructured.Unstructured
+	for _, constraint := range constraints {
+		values, ok := getSpecValues(obj, constraint.Fields)
+		if !ok {
+			continue
+		}
+		if items == nil {
+			var err error
+			if items, err = Objects.List(client, getGvr(crdName)); err != nil {
+				return fmt.Sprintf("could not list objects of %s to check unique fields: %v", crdName, err)
+			}
+		}
+		for _, item := range items {
+			if item.GetName() == obj.GetName() {
+				continue
+			}
+			if constraint.Scope != UNIQUE_SCOPE_GLOBAL && !haveSameParents(obj.GetLabels(), item.GetLabels(), parents) {
+				continue
+			}
+			if itemValues, ok := getSpecValues(item, constraint.Fields); ok && reflect.DeepEqual(values, itemValues) {
+				return fmt.Sprintf("values %v of unique fields %s are already used by %s %s", values,
+					strings.Join(constraint.Fields, ", "), crdName, item.GetName())
+			}
+		}
+	}
+	return ""
+}
+
+// checkChildrenCount returns a message if the child field of the nearest parent already has the maximal number of
+// children and the object isn't one of them. Children are counted in the field of the parent, so objects of the CRD
+// in other fields or under other parents aren't counted.
+func checkChildrenCount(crdName string, parents []string, parentObj *unstructured.Unstructured, raw []byte) string {
+	if len(parents) == 0 || parentObj == nil {
+		return ""
+	}
+	parent := parents[len(parents)-1]
+	child, ok := CRDs.GetChildren(parent)[crdName]
+	if !ok || child.Max == 0 || !child.IsNamed {
+		return ""
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(raw); err != nil {
+		return fmt.Sprintf("could not unmarshal object: %v", err)
+	}
+
+	children := getChildren(parentObj, child)
+	if _, ok := children[getDisplayName(obj)]; ok {
+		return ""
+	}
+	if len(children) >= child.Max {
+		return fmt.Sprintf("%s %s can't have more than %d children %s", parent,
+			getParentDisplayName(obj.GetLabels(), parent), child.Max, child.FieldName)
+	}
+	return ""
+}
+
+// checkChildrenFields returns a message if a child field of the object has more children than its maximal number.
+// Updates which don't add children are allowed, so objects created before the maximum was lowered can be updated.
+func checkChildrenFields(crdName string, raw, oldRaw []byte) string {
+	children := CRDs.GetChildren(crdName)
+	if len(children) == 0 {
+		return ""
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(raw); err != nil {
+		return fmt.Sprintf("could not unmarshal object: %v", err)
+	}
+	var oldObj *unstructured.Unstructured
+	if len(oldRaw) > 0 {
+		oldObj = &unstructured.Unstructured{}
+		if err := oldObj.UnmarshalJSON(oldRaw); err != nil {
+			return fmt.Sprintf("could not unmarshal old object: %v", err)
+		}
+	}
+
+	for _, child := range children {
+		if child.Max == 0 || !child.IsNamed {
+			continue
+		}
+		count := len(getChildren(obj, child))
+		if count <= child.Max || oldObj != nil && count <= len(getChildren(oldObj, child)) {
+			continue
+		}
+		return fmt.Sprintf("%s %s can't have more than %d children %s, got %d", crdName, getDisplayName(obj),
+			child.Max, child.FieldName, count)
+	}
+	return ""
+}
+
+// getChildren returns gvk of children in the named child field of the object keyed by their display names.
+func getChildren(obj *unstructured.Unstructured, child GraphField) map[string]interface{} {
+	children, _, _ := unstructured.NestedMap(obj.Object, "spec", child.FieldNameGvk)
+	return children
+}
+
+// checkLinks returns a message if links of the object exceed the maximal number of links of the field or point to
+// objects outside of the scope of the field.
+func checkLinks(client dynamic.Interface, crdName string, parents []string, raw []byte) string {
+	links := CRDs.GetLinks(crdName)
+	if len(links) == 0 {
+		return ""
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(raw); err != nil {
+		return fmt.Sprintf("could not unmarshal object: %v", err)
+	}
+
+	for _, link := range links {
+		if link.Max == 0 && link.Scope == "" {
+			continue
+		}
+		targets := getLinkTargets(obj, link)
+		if link.Max > 0 && len(targets) > link.Max {
+			return fmt.Sprintf("%s can't have more than %d links %s, got %d", crdName, link.Max, link.FieldName,
+				len(targets))
+		}
+		if link.Scope == "" {
+			continue
+		}
+		for _, target := range targets {
+			if message := checkLinkScope(client, obj, parents, link, target); message != "" {
+				return message
+			}
+		}
+	}
+	return ""
+}
+
+// checkLinkScope returns a message if the target isn't placed in the scope of the link. Siblings have the same
+// hierarchy as the source object, objects in the subtree have it as a prefix. In both cases labels of the common
+// parents must be the same.
+func checkLinkScope(client dynamic.Interface, obj *unstructured.Unstructured, parents []string, link GraphField,
+	target map[string]interface{}) string {
+	group, _ := target["group"].(string)
+	kind, _ := target["kind"].(string)
+	name, _ := target["name"].(string)
+	targetCrdName, ok := CRDs.GetCrdName(group, kind)
+	if !ok {
+		return fmt.Sprintf("couldn't determine CRD of %s link target %s.%s", link.FieldName, kind, group)
+	}
+	targetParents, err := CRDs.GetParents(targetCrdName, client)
+	if err != nil {
+		return fmt.Sprintf("couldn't determine parents info of %s link target %s", link.FieldName, targetCrdName)
+	}
+
+	var inScope bool
+	switch link.Scope {
+	case LINK_SCOPE_SIBLING:
+		inScope = reflect.DeepEqual(targetParents, parents)
+	case LINK_SCOPE_SUBTREE:
+		inScope = len(targetParents) >= len(parents) && reflect.DeepEqual(targetParents[:len(parents)], parents)
+	default:
+		return fmt.Sprintf("unsupported scope %s of link %s", link.Scope, link.FieldName)
+	}
+	if inScope {
+		targetObj, err := client.Resource(getGvr(targetCrdName)).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Sprintf("%s link target %s %s not found", link.FieldName, targetCrdName, name)
+		}
+		inScope = haveSameParents(obj.GetLabels(), targetObj.GetLabels(), parents)
+	}
+	if !inScope {
+		return fmt.Sprintf("%s link target %s %s is not in %s scope of %s", link.FieldName, targetCrdName, name,
+			link.Scope, obj.GetName())
+	}
+	return ""
+}
+
+// getLinkTargets returns gvk of linked objects, named links are stored as a map of gvk.
+func getLinkTargets(obj *unstructured.Unstructured, link GraphField) []map[string]interface{} {
+	value, ok, _ := unstructured.NestedMap(obj.Object, "spec", link.FieldNameGvk)
+	if !ok {
+		return nil
+	}
+	if !link.IsNamed {
+		return []map[string]interface{}{value}
+	}
+	var targets []map[string]interface{}
+	for _, target := range value {
+		if gvk, ok := target.(map[string]interface{}); ok {
+			targets = append(targets, gvk)
+		}
+	}
+	return targets
+}
+
+// getGvr returns the resource of the CRD in its storage version.
+func getGvr(crdName string) schema.GroupVersionResource {
+	parts := strings.Split(crdName, ".")
+	return schema.GroupVersionResource{
+		Group:    strings.Join(parts[1:], "."),
+		Version:  CRDs.GetVersion(crdName),
+		Resource: parts[0],
+	}
+}
+
+// getSpecValues returns values of the given spec fields and false if any of them is unset.
+func getSpecValues(obj *unstructured.Unstructured, fields []string) ([]interface{}, bool) {
+	values := make([]interface{}, len(fields))
+	for i, field := range fields {
+		value, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", field)
+		if !found || value == nil {
+			return nil, false
+		}
+		values[i] = value
+	}
+	return values, true
+}
+
+func haveSameParents(labels, otherLabels map[string]string, parents []string) bool {
+	for _, parent := range parents {
+		if getParentDisplayName(labels, parent) != getParentDisplayName(otherLabels, parent) {
+			return false
+		}
+	}
+	return true
+}
+
+// getDisplayName returns the name the object is known by in child fields of its parent.
+func getDisplayName(obj *unstructured.Unstructured) string {
+	if displayName, ok := obj.GetLabels()[DISPLAY_NAME_LABEL]; ok {
+		return displayName
+	}
+	return obj.GetName()
+}
+
+func getParentDisplayName(labels map[string]string, parent string) string {
+	if label, ok := labels[parent]; ok {
+		return label
+	}
+	return DEFAULT_KEY
+}
+
+func getCrdObject(client dynamic.Interface, gvr schema.GroupVersionResource, name string) *unstructured.Unstructured {
 	obj, err := client.Resource(gvr).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
 		return nil
@@ -214,3 +214,60 @@ metadata:
       nexus/display_name: "foo"
 `
 }
+
+func getRootCRDObjectWithSpec(name, displayName, specName string) string {
+	return `
+apiVersion: orgchart.vmware.org/v1
+kind: Root
+metadata:
+   name: ` + name + `
+   labels:
+      nexus/is_name_hashed: "true"
+      nexus/display_name: ` + displayName + `
+spec:
+   name: ` + specName + `
+`
+}
+
+func getRootCRDObjectWithEmployees(name string, employees ...string) string {
+	obj := `
+apiVersion: orgchart.vmware.org/v1
+kind: Root
+metadata:
+   name: ` + name + `
+   labels:
+      nexus/is_name_hashed: "true"
+      nexus/display_name: ` + name + `
+spec:
+   employeeRoleGvk:
+`
+	for _, employee := range employees {
+		obj += `      ` + employee + `:
+         group: role.vmware.org
+         kind: Employee
+         name: ` + employee + `
+`
+	}
+	return obj
+}
+
+func getEmployeeCRDObjectWithSpec(name, rootName, spec string) string {
+	return `
+apiVersion: role.vmware.org/v1
+kind: Employee
+metadata:
+   name: ` + name + `
+   labels:
+      roots.orgchart.vmware.org: ` + rootName + `
+spec:
+` + spec
+}
+
+func getEmployeeLinkSpec(name string) string {
+	return `
+   mentorGvk:
+      group: role.vmware.org
+      kind: Employee
+      name: ` + name + `
+`
+}
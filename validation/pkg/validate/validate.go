@@ -19,6 +19,7 @@ import (
 
 const (
 	DEFAULT_KEY          = "default"
+	DEFAULT_VERSION      = "v1"
 	DISPLAY_NAME_LABEL   = "nexus/display_name"
 	IS_NAME_HASHED_LABEL = "nexus/is_name_hashed"
 )
@@ -26,6 +27,11 @@ const (
 type CRDStates struct {
 	ParentsMap     sync.Map
 	IsSingletonMap sync.Map
+	UniqueMap      sync.Map
+	ChildrenMap    sync.Map
+	LinksMap       sync.Map
+	KindsMap       sync.Map
+	VersionsMap    sync.Map
 }
 
 func (c *CRDStates) ProcessNewCRDType(crd v1.CustomResourceDefinition) error {
@@ -47,6 +53,16 @@ func (c *CRDStates) ProcessNewCRDType(crd v1.CustomResourceDefinition) error {
 	c.IsSingletonMap.Store(crd.Name, annotation.IsSingleton)
 	log.Infof("Added %s to IsSingleton map (%v)", crd.Name, annotation.IsSingleton)
 
+	c.UniqueMap.Store(crd.Name, annotation.Unique)
+	log.Infof("Added %s to unique map (%v)", crd.Name, annotation.Unique)
+
+	c.ChildrenMap.Store(crd.Name, annotation.Children)
+	c.LinksMap.Store(crd.Name, annotation.Links)
+	c.KindsMap.Store(getKindKey(crd.Spec.Group, crd.Spec.Names.Kind), crd.Name)
+	if version := getStorageVersion(crd); version != "" {
+		c.VersionsMap.Store(crd.Name, version)
+	}
+
 	return nil
 }
 
@@ -84,15 +100,111 @@ func (c *CRDStates) IsSingleton(crdName string) bool {
 	return false
 }
 
+func (c *CRDStates) GetUniqueConstraints(crdName string) []UniqueConstraint {
+	constraints, ok := c.UniqueMap.Load(crdName)
+	if ok {
+		return constraints.([]UniqueConstraint)
+	}
+	return nil
+}
+
+// GetChildren returns child fields of the CRD keyed by CRD name of the child.
+func (c *CRDStates) GetChildren(crdName string) map[string]GraphField {
+	children, ok := c.ChildrenMap.Load(crdName)
+	if ok {
+		return children.(map[string]GraphField)
+	}
+	return nil
+}
+
+// GetLinks returns link fields of the CRD keyed by field name.
+func (c *CRDStates) GetLinks(crdName string) map[string]GraphField {
+	links, ok := c.LinksMap.Load(crdName)
+	if ok {
+		return links.(map[string]GraphField)
+	}
+	return nil
+}
+
+// GetCrdName returns name of the CRD of the kind, which is used to resolve targets of links.
+func (c *CRDStates) GetCrdName(group, kind string) (string, bool) {
+	crdName, ok := c.KindsMap.Load(getKindKey(group, kind))
+	if ok {
+		return crdName.(string), true
+	}
+	return "", false
+}
+
+// GetVersion returns the version objects of the CRD are read in, which is the storage version of the CRD.
+// DEFAULT_VERSION is returned for CRDs which weren't processed yet.
+func (c *CRDStates) GetVersion(crdName string) string {
+	version, ok := c.VersionsMap.Load(crdName)
+	if ok {
+		return version.(string)
+	}
+	return DEFAULT_VERSION
+}
+
+// getStorageVersion returns the storage version of the CRD or its first served version if none is marked as storage.
+func getStorageVersion(crd v1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			return version.Name
+		}
+	}
+	return ""
+}
+
+func getKindKey(group, kind string) string {
+	return fmt.Sprintf("%s.%s", kind, group)
+}
+
 var CRDs = CRDStates{
 	ParentsMap:     sync.Map{},
 	IsSingletonMap: sync.Map{},
+	UniqueMap:      sync.Map{},
+	ChildrenMap:    sync.Map{},
+	LinksMap:       sync.Map{},
+	KindsMap:       sync.Map{},
+	VersionsMap:    sync.Map{},
 }
 
 type NexusAnnotation struct {
-	Name        string   `json:"name,omitempty"`
-	Hierarchy   []string `json:"hierarchy,omitempty"`
-	IsSingleton bool     `json:"is_singleton"`
+	Name        string                `json:"name,omitempty"`
+	Hierarchy   []string              `json:"hierarchy,omitempty"`
+	IsSingleton bool                  `json:"is_singleton"`
+	Unique      []UniqueConstraint    `json:"unique,omitempty"`
+	Children    map[string]GraphField `json:"children,omitempty"`
+	Links       map[string]GraphField `json:"links,omitempty"`
+}
+
+const UNIQUE_SCOPE_GLOBAL = "global"
+
+// UniqueConstraint lists spec fields whose values can't repeat between objects of the same parent, or between all
+// objects of the CRD if the scope is global.
+type UniqueConstraint struct {
+	Fields []string `json:"fields"`
+	Scope  string   `json:"scope"`
+}
+
+const (
+	LINK_SCOPE_SIBLING = "sibling"
+	LINK_SCOPE_SUBTREE = "subtree"
+)
+
+// GraphField is a child or link field of a node with graph constraints given in the nexus DSL, e.g.
+// `nexus:"children,max=10"` or `nexus:"link,scope=sibling"`.
+type GraphField struct {
+	FieldName    string `json:"fieldName"`
+	FieldNameGvk string `json:"fieldNameGvk"`
+	IsNamed      bool   `json:"isNamed"`
+	Max          int    `json:"max,omitempty"`
+	Scope        string `json:"scope,omitempty"`
 }
 
 func UpdateValidationWebhook(client kubernetes.Interface) {
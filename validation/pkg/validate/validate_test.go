@@ -11,6 +11,7 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
@@ -265,6 +266,300 @@ var _ = Describe("Validation tests", func() {
 			Equal("required parent roots.orgchart.vmware.org with display name par not found"))
 	})
 
+	It("should reject object with the same values of unique fields", func() {
+		crdDef := strings.Replace(getRootCRDDef(false), `"is_singleton":false`,
+			`"is_singleton":false,"unique":[{"fields":["name"],"scope":"global"}]`, 1)
+		crdDefJson, err := yaml.YAMLToJSON([]byte(crdDef))
+		Expect(err).NotTo(HaveOccurred())
+		admReq := admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Kind:      metav1.GroupVersionKind{Kind: "CustomResourceDefinition"},
+				Object: runtime.RawExtension{
+					Raw: crdDefJson,
+				},
+			},
+		}
+		admRes := validate.CrdType(fakeClient, admReq)
+		Expect(admRes.Response.Allowed).To(BeTrue())
+		Expect(validate.CRDs.GetUniqueConstraints("roots.orgchart.vmware.org")).To(Equal([]validate.UniqueConstraint{{
+			Fields: []string{"name"},
+			Scope:  "global",
+		}}))
+
+		existingJson, err := yaml.YAMLToJSON([]byte(getRootCRDObjectWithSpec("otherHashedName", "bar", "foo")))
+		Expect(err).NotTo(HaveOccurred())
+		existing := &unstructured.Unstructured{}
+		Expect(existing.UnmarshalJSON(existingJson)).To(Succeed())
+		dynamicClient = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}: "CustomResourceDefinitionList",
+				{Group: "orgchart.vmware.org", Version: "v1", Resource: "roots"}:                      "RootList",
+			}, existing)
+
+		for specName, allowed := range map[string]bool{"foo": false, "baz": true} {
+			crdObjJson, err := yaml.YAMLToJSON([]byte(getRootCRDObjectWithSpec("someHashedName", "foo", specName)))
+			Expect(err).NotTo(HaveOccurred())
+			admReqCRDObj := admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Kind:      metav1.GroupVersionKind{Kind: "Root"},
+					Object: runtime.RawExtension{
+						Raw: crdObjJson,
+					},
+					Resource: metav1.GroupVersionResource{
+						Group:    "orgchart.vmware.org",
+						Resource: "roots",
+					},
+				},
+			}
+
+			admResCrd, err := validate.Crd(dynamicClient, admReqCRDObj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admResCrd.Response.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(admResCrd.Response.Result.Message).To(Equal(
+					"values [foo] of unique fields name are already used by roots.orgchart.vmware.org otherHashedName"))
+			}
+		}
+	})
+
+	It("should accept objects which don't set unique fields", func() {
+		crdDef := strings.Replace(getRootCRDDef(false), `"is_singleton":false`,
+			`"is_singleton":false,"unique":[{"fields":["name"],"scope":"global"}]`, 1)
+		crdDefJson, err := yaml.YAMLToJSON([]byte(crdDef))
+		Expect(err).NotTo(HaveOccurred())
+		admReq := admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Kind:      metav1.GroupVersionKind{Kind: "CustomResourceDefinition"},
+				Object: runtime.RawExtension{
+					Raw: crdDefJson,
+				},
+			},
+		}
+		admRes := validate.CrdType(fakeClient, admReq)
+		Expect(admRes.Response.Allowed).To(BeTrue())
+
+		existingJson, err := yaml.YAMLToJSON([]byte(getRootCRDObjectWithSpec("otherHashedName", "bar", "null")))
+		Expect(err).NotTo(HaveOccurred())
+		existing := &unstructured.Unstructured{}
+		Expect(existing.UnmarshalJSON(existingJson)).To(Succeed())
+		dynamicClient = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}: "CustomResourceDefinitionList",
+				{Group: "orgchart.vmware.org", Version: "v1", Resource: "roots"}:                      "RootList",
+			}, existing)
+
+		crdObjJson, err := yaml.YAMLToJSON([]byte(getRootCRDObjectWithSpec("someHashedName", "foo", "null")))
+		Expect(err).NotTo(HaveOccurred())
+		admReqCRDObj := admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Kind:      metav1.GroupVersionKind{Kind: "Root"},
+				Object: runtime.RawExtension{
+					Raw: crdObjJson,
+				},
+				Resource: metav1.GroupVersionResource{
+					Group:    "orgchart.vmware.org",
+					Resource: "roots",
+				},
+			},
+		}
+
+		admResCrd, err := validate.Crd(dynamicClient, admReqCRDObj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(admResCrd.Response.Allowed).To(BeTrue())
+	})
+
+	It("should reject children over the maximal number of children", func() {
+		crdDef := strings.Replace(getRootCRDDef(false),
+			`"fieldNameGvk":"employeeRoleGvk","isNamed":false`, `"fieldNameGvk":"employeeRoleGvk","isNamed":true,"max":1`, 1)
+		for _, def := range []string{crdDef, getEmployeeCRDDef()} {
+			crdDefJson, err := yaml.YAMLToJSON([]byte(def))
+			Expect(err).NotTo(HaveOccurred())
+			admRes := validate.CrdType(fakeClient, admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Kind:      metav1.GroupVersionKind{Kind: "CustomResourceDefinition"},
+					Object:    runtime.RawExtension{Raw: crdDefJson},
+				},
+			})
+			Expect(admRes.Response.Allowed).To(BeTrue())
+		}
+		Expect(validate.CRDs.GetChildren("roots.orgchart.vmware.org")["employees.role.vmware.org"].Max).To(Equal(1))
+
+		var objects []runtime.Object
+		for _, obj := range []string{
+			getRootCRDObjectWithEmployees("r1", "e1"),
+			getRootCRDObjectWithEmployees("r2"),
+			getEmployeeCRDObjectWithSpec("e1", "r1", ""),
+			getEmployeeCRDObjectWithSpec("e3", "r2", ""),
+		} {
+			objJson, err := yaml.YAMLToJSON([]byte(obj))
+			Expect(err).NotTo(HaveOccurred())
+			u := &unstructured.Unstructured{}
+			Expect(u.UnmarshalJSON(objJson)).To(Succeed())
+			objects = append(objects, u)
+		}
+		dynamicClient = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}: "CustomResourceDefinitionList",
+				{Group: "orgchart.vmware.org", Version: "v1", Resource: "roots"}:                      "RootList",
+				{Group: "role.vmware.org", Version: "v1", Resource: "employees"}:                      "EmployeeList",
+			}, objects...)
+
+		for _, tc := range []struct {
+			name      string
+			root      string
+			operation admissionv1.Operation
+			allowed   bool
+		}{
+			{name: "e2", root: "r1", operation: admissionv1.Create, allowed: false},
+			{name: "e2", root: "r2", operation: admissionv1.Create, allowed: true},
+			{name: "e1", root: "r1", operation: admissionv1.Update, allowed: true},
+		} {
+			crdObjJson, err := yaml.YAMLToJSON([]byte(getEmployeeCRDObjectWithSpec(tc.name, tc.root, "")))
+			Expect(err).NotTo(HaveOccurred())
+			admResCrd, err := validate.Crd(dynamicClient, admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					Kind:      metav1.GroupVersionKind{Kind: "Employee"},
+					Object:    runtime.RawExtension{Raw: crdObjJson},
+					Resource: metav1.GroupVersionResource{
+						Group:    "role.vmware.org",
+						Resource: "employees",
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admResCrd.Response.Allowed).To(Equal(tc.allowed))
+			if !tc.allowed {
+				Expect(admResCrd.Response.Result.Message).To(Equal(
+					"roots.orgchart.vmware.org r1 can't have more than 1 children EmployeeRole"))
+			}
+		}
+
+		for _, tc := range []struct {
+			name      string
+			operation admissionv1.Operation
+			obj       string
+			oldObj    string
+			allowed   bool
+		}{
+			{name: "too many children on create", operation: admissionv1.Create,
+				obj: getRootCRDObjectWithEmployees("r3", "e1", "e2"), allowed: false},
+			{name: "children added on update", operation: admissionv1.Update,
+				obj: getRootCRDObjectWithEmployees("r2", "e2", "e3"), oldObj: getRootCRDObjectWithEmployees("r2"), allowed: false},
+			{name: "children not added on update", operation: admissionv1.Update,
+				obj: getRootCRDObjectWithEmployees("r2", "e2", "e3"), oldObj: getRootCRDObjectWithEmployees("r2", "e2", "e3"), allowed: true},
+			{name: "children in the limit", operation: admissionv1.Update,
+				obj: getRootCRDObjectWithEmployees("r2", "e3"), oldObj: getRootCRDObjectWithEmployees("r2"), allowed: true},
+		} {
+			objJson, err := yaml.YAMLToJSON([]byte(tc.obj))
+			Expect(err).NotTo(HaveOccurred())
+			req := &admissionv1.AdmissionRequest{
+				Operation: tc.operation,
+				Kind:      metav1.GroupVersionKind{Kind: "Root"},
+				Object:    runtime.RawExtension{Raw: objJson},
+				Resource: metav1.GroupVersionResource{
+					Group:    "orgchart.vmware.org",
+					Resource: "roots",
+				},
+			}
+			if tc.oldObj != "" {
+				oldJson, err := yaml.YAMLToJSON([]byte(tc.oldObj))
+				Expect(err).NotTo(HaveOccurred())
+				req.OldObject = runtime.RawExtension{Raw: oldJson}
+			}
+			admResCrd, err := validate.Crd(dynamicClient, admissionv1.AdmissionReview{Request: req})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admResCrd.Response.Allowed).To(Equal(tc.allowed), tc.name)
+			if !tc.allowed {
+				Expect(admResCrd.Response.Result.Message).To(HaveSuffix("can't have more than 1 children EmployeeRole, got 2"), tc.name)
+			}
+		}
+	})
+
+	It("should read objects in the storage version of their CRD", func() {
+		crd := v1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "projects.orgchart.vmware.org",
+				Annotations: map[string]string{"nexus": `{"name":"orgchart.Project","is_singleton":false}`},
+			},
+			Spec: v1.CustomResourceDefinitionSpec{
+				Group: "orgchart.vmware.org",
+				Names: v1.CustomResourceDefinitionNames{Kind: "Project"},
+				Versions: []v1.CustomResourceDefinitionVersion{
+					{Name: "v1", Served: true},
+					{Name: "v2", Served: true, Storage: true},
+				},
+			},
+		}
+		Expect(validate.CRDs.ProcessNewCRDType(crd)).To(Succeed())
+		Expect(validate.CRDs.GetVersion("projects.orgchart.vmware.org")).To(Equal("v2"))
+		Expect(validate.CRDs.GetVersion("unknowns.orgchart.vmware.org")).To(Equal(validate.DEFAULT_VERSION))
+	})
+
+	It("should reject links to objects outside of the link scope", func() {
+		crdDef := strings.Replace(getEmployeeCRDDef(), `"is_singleton":false`,
+			`"is_singleton":false,"links":{"Mentor":{"fieldName":"Mentor","fieldNameGvk":"mentorGvk","isNamed":false,"scope":"sibling"}}`, 1)
+		for _, def := range []string{getRootCRDDef(false), crdDef} {
+			crdDefJson, err := yaml.YAMLToJSON([]byte(def))
+			Expect(err).NotTo(HaveOccurred())
+			admRes := validate.CrdType(fakeClient, admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Kind:      metav1.GroupVersionKind{Kind: "CustomResourceDefinition"},
+					Object:    runtime.RawExtension{Raw: crdDefJson},
+				},
+			})
+			Expect(admRes.Response.Allowed).To(BeTrue())
+		}
+
+		var objects []runtime.Object
+		for _, obj := range []string{
+			getRootCRDObjectWithSpec("r1", "r1", "r1"),
+			getRootCRDObjectWithSpec("r2", "r2", "r2"),
+			getEmployeeCRDObjectWithSpec("e1", "r1", ""),
+			getEmployeeCRDObjectWithSpec("e2", "r2", ""),
+		} {
+			objJson, err := yaml.YAMLToJSON([]byte(obj))
+			Expect(err).NotTo(HaveOccurred())
+			u := &unstructured.Unstructured{}
+			Expect(u.UnmarshalJSON(objJson)).To(Succeed())
+			objects = append(objects, u)
+		}
+		dynamicClient = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}: "CustomResourceDefinitionList",
+				{Group: "orgchart.vmware.org", Version: "v1", Resource: "roots"}:                      "RootList",
+				{Group: "role.vmware.org", Version: "v1", Resource: "employees"}:                      "EmployeeList",
+			}, objects...)
+
+		for mentor, allowed := range map[string]bool{"e1": true, "e2": false} {
+			crdObjJson, err := yaml.YAMLToJSON([]byte(getEmployeeCRDObjectWithSpec("e3", "r1", getEmployeeLinkSpec(mentor))))
+			Expect(err).NotTo(HaveOccurred())
+			admResCrd, err := validate.Crd(dynamicClient, admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Kind:      metav1.GroupVersionKind{Kind: "Employee"},
+					Object:    runtime.RawExtension{Raw: crdObjJson},
+					Resource: metav1.GroupVersionResource{
+						Group:    "role.vmware.org",
+						Resource: "employees",
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admResCrd.Response.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(admResCrd.Response.Result.Message).To(Equal(
+					"Mentor link target employees.role.vmware.org e2 is not in sibling scope of e3"))
+			}
+		}
+	})
+
 	It("should allow updating the crd type", func() {
 		// should allow updating the crd type
 		crdDefJson, err := yaml.YAMLToJSON([]byte(getEmployeeCRDDef()))